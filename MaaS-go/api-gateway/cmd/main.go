@@ -6,18 +6,26 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 
+	"maas-platform/api-gateway/internal/auth"
+	"maas-platform/api-gateway/internal/cache"
 	"maas-platform/api-gateway/internal/config"
 	"maas-platform/api-gateway/internal/handler"
+	"maas-platform/api-gateway/internal/metering"
 	"maas-platform/api-gateway/internal/middleware"
 	"maas-platform/api-gateway/internal/router"
 	"maas-platform/api-gateway/internal/service"
 	rpc "maas-platform/api-gateway/pkg/grpc"
 	"maas-platform/api-gateway/pkg/logger"
+	"maas-platform/api-gateway/pkg/metrics"
+	"maas-platform/shared/servicetoken"
+	"maas-platform/shared/tracing"
 )
 
 // @title MaaS Platform API
@@ -69,18 +77,144 @@ func main() {
 
 	// Print configuration summary
 	printConfigSummary(cfg, log)
+	metrics.SetServiceInfo("1.0.0", cfg.Environment)
+
+	// Set up tracing before the gRPC client so its stats handler uses the provider
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Exporter:       cfg.Tracing.Exporter,
+		Endpoint:       cfg.Tracing.Endpoint,
+		Insecure:       cfg.Tracing.Insecure,
+		SampleRatio:    cfg.Tracing.SampleRatio,
+		ServiceName:    "api-gateway",
+		ServiceVersion: "1.0.0",
+		Environment:    cfg.Environment,
+	})
+	if err != nil {
+		log.Fatal("Failed to set up tracing", "error", err)
+	}
 
 	// Initialize gRPC client to Model Registry
-	log.Info("Connecting to Model Registry gRPC service...", "address", cfg.Services.ModelRegistry)
-	grpcClient, err := rpc.NewClient(cfg.Services.ModelRegistry)
+	log.Info("Connecting to Model Registry gRPC service...",
+		"endpoints", cfg.RegistryEndpoints(),
+		"load_balancing", cfg.Registry.LoadBalancing,
+	)
+	signer, err := servicetoken.New(cfg.Registry.ServiceSecret, 0)
+	if err != nil {
+		log.Fatal("Invalid registry service secret", "error", err)
+	}
+	grpcClient, err := rpc.NewClient(cfg.RegistryEndpoints(), rpc.ClientConfig{
+		Resolver:       cfg.Registry.Resolver,
+		LoadBalancing:  cfg.Registry.LoadBalancing,
+		HealthCheck:    cfg.Registry.HealthCheck,
+		Signer:         signer,
+		DefaultTimeout: cfg.Registry.DefaultTimeout,
+		Timeouts:       cfg.Registry.Timeouts,
+		Retry: rpc.RetryConfig{
+			MaxAttempts:       cfg.Registry.Retry.MaxAttempts,
+			InitialBackoff:    cfg.Registry.Retry.InitialBackoff,
+			MaxBackoff:        cfg.Registry.Retry.MaxBackoff,
+			BackoffMultiplier: cfg.Registry.Retry.BackoffMultiplier,
+		},
+		Breaker: rpc.BreakerConfig{
+			Enabled:          cfg.Registry.CircuitBreaker.Enabled,
+			FailureThreshold: cfg.Registry.CircuitBreaker.FailureThreshold,
+			OpenTimeout:      cfg.Registry.CircuitBreaker.OpenTimeout,
+			HalfOpenRequests: cfg.Registry.CircuitBreaker.HalfOpenRequests,
+		},
+		OnBreakerStateChange: func(name string, state rpc.BreakerState) {
+			metrics.SetCircuitBreakerState(name, int(state))
+			if state != rpc.BreakerClosed {
+				log.Warn("Circuit breaker state changed", "name", name, "state", state.String())
+			} else {
+				log.Info("Circuit breaker state changed", "name", name, "state", state.String())
+			}
+		},
+		UnaryInterceptors:  []grpc.UnaryClientInterceptor{metrics.UnaryClientInterceptor()},
+		StreamInterceptors: []grpc.StreamClientInterceptor{metrics.StreamClientInterceptor()},
+	})
 	if err != nil {
 		log.Fatal("Failed to connect to Model Registry", "error", err)
 	}
 	defer grpcClient.Close()
-	log.Info("Connected to Model Registry gRPC service")
+	log.Info("Model Registry gRPC client ready")
+
+	// Initialize the model lookup cache
+	modelCache, err := cache.New(cache.Config{
+		Backend:       cfg.Cache.Backend,
+		MaxEntries:    cfg.Cache.MaxEntries,
+		KeyPrefix:     cfg.Cache.KeyPrefix,
+		RedisAddr:     cfg.RedisAddr(),
+		RedisPassword: cfg.Redis.Password,
+		RedisDB:       cfg.Redis.DB,
+	})
+	if err != nil {
+		log.Fatal("Failed to initialize cache", "error", err)
+	}
+	if modelCache != nil {
+		defer modelCache.Close()
+	}
 
 	// Initialize model service client
-	modelServiceClient := service.NewModelServiceClient(grpcClient, log)
+	modelServiceClient := service.NewModelServiceClient(grpcClient, modelCache, cfg.Cache.TTL, cfg.Cache.NegativeTTL, log)
+	auditClient := service.NewAuditClient(grpcClient, log)
+	webhookClient := service.NewWebhookClient(grpcClient, log)
+	apiKeyClient := service.NewAPIKeyClient(grpcClient, cfg.APIKeys.VerifyCacheTTL, log)
+	userClient := service.NewUserClient(grpcClient, log)
+	usageClient := service.NewUsageClient(grpcClient, log)
+	billingClient := service.NewBillingClient(grpcClient, log)
+	tokens := auth.NewTokenIssuer(cfg.JWT.Secret, time.Duration(cfg.JWT.ExpiresIn)*time.Second)
+
+	// Single sign-on through an OpenID Connect provider
+	var oidcLogin *auth.OIDC
+	if cfg.OIDC.Enabled {
+		mappings := make([]auth.GroupMapping, 0, len(cfg.OIDC.GroupMappings))
+		for _, m := range cfg.OIDC.GroupMappings {
+			mappings = append(mappings, auth.GroupMapping{Group: m.Group, Role: m.Role, TenantID: m.TenantID})
+		}
+		oidcLogin = auth.NewOIDC(auth.OIDCConfig{
+			IssuerURL:       cfg.OIDC.IssuerURL,
+			ClientID:        cfg.OIDC.ClientID,
+			ClientSecret:    cfg.OIDC.ClientSecret,
+			RedirectURL:     cfg.OIDC.RedirectURL,
+			Scopes:          cfg.OIDC.Scopes,
+			GroupsClaim:     cfg.OIDC.GroupsClaim,
+			DefaultRole:     cfg.OIDC.DefaultRole,
+			DefaultTenantID: cfg.OIDC.DefaultTenantID,
+			GroupMappings:   mappings,
+			StateTTL:        cfg.OIDC.StateTTL,
+		}, cfg.JWT.Secret)
+		log.Info("OIDC login enabled", "issuer", cfg.OIDC.IssuerURL)
+	}
+
+	// Evict cached models as the registry reports changes
+	invalidationCtx, stopInvalidation := context.WithCancel(context.Background())
+	defer stopInvalidation()
+	go modelServiceClient.RunInvalidation(invalidationCtx)
+
+	// Meter inference calls into the configured sinks
+	var sinks []metering.Sink
+	if cfg.Metering.Enabled {
+		for _, name := range cfg.Metering.Sinks {
+			switch name {
+			case "registry":
+				sinks = append(sinks, usageClient)
+			case "billing":
+				sinks = append(sinks, metering.NewHTTPSink(strings.TrimRight(cfg.Services.Billing, "/")+cfg.Metering.BillingPath, cfg.Metering.BillingToken, cfg.Metering.SendTimeout))
+			}
+		}
+	}
+	meter := metering.NewMeter(metering.Config{
+		BufferSize:    cfg.Metering.BufferSize,
+		BatchSize:     cfg.Metering.BatchSize,
+		FlushInterval: cfg.Metering.FlushInterval,
+		SendTimeout:   cfg.Metering.SendTimeout,
+	}, log, sinks...)
+	meterCtx, stopMeter := context.WithCancel(context.Background())
+	meterDone := make(chan struct{})
+	go func() {
+		defer close(meterDone)
+		meter.Run(meterCtx)
+	}()
 
 	// Set gin mode
 	if cfg.Environment == "production" {
@@ -95,17 +229,38 @@ func main() {
 	r.Use(middleware.Logger(log))
 	r.Use(middleware.CORS())
 	r.Use(middleware.RequestID())
+	r.Use(tracing.Middleware("api-gateway"))
+	r.Use(metrics.PrometheusMiddleware())
 
 	// Health check
 	r.GET("/health", func(c *gin.Context) {
+		status := "ok"
+		breakerState := "disabled"
+		if breaker := grpcClient.Breaker(); breaker != nil {
+			state := breaker.State()
+			breakerState = state.String()
+			if state != rpc.BreakerClosed {
+				status = "degraded"
+			}
+		}
+
 		c.JSON(http.StatusOK, gin.H{
-			"status":    "ok",
+			"status":    status,
 			"service":   "api-gateway",
 			"timestamp": time.Now().Unix(),
 			"config":    cfg.Environment,
+			"dependencies": gin.H{
+				"model_registry": gin.H{
+					"circuit_breaker": breakerState,
+					"connectivity":    grpcClient.State().String(),
+				},
+			},
 		})
 	})
 
+	// Prometheus metrics
+	r.GET("/metrics", metrics.Handler())
+
 	// Config info endpoint (for debugging, disable in production)
 	if cfg.IsDevelopment() {
 		r.GET("/config", func(c *gin.Context) {
@@ -122,14 +277,18 @@ func main() {
 					"host": cfg.Redis.Host,
 					"port": cfg.Redis.Port,
 				},
+				"cache": gin.H{
+					"backend": cfg.Cache.Backend,
+					"ttl":     cfg.Cache.TTL.String(),
+				},
 			})
 		})
 	}
 
 	// Register routes
 	api := r.Group("/api/v1")
-	h := handler.New(cfg, log, modelServiceClient)
-	router.RegisterRoutes(api, h)
+	h := handler.New(cfg, log, modelServiceClient, auditClient, webhookClient, apiKeyClient, userClient, usageClient, billingClient, tokens, oidcLogin)
+	router.RegisterRoutes(api, h, middleware.Auth(tokens, apiKeyClient, log), middleware.Meter(meter))
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -147,6 +306,8 @@ func main() {
 		<-sigChan
 
 		log.Info("Shutting down server...")
+		metrics.SetServiceUp(false)
+		stopInvalidation()
 
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
@@ -154,14 +315,26 @@ func main() {
 		if err := srv.Shutdown(ctx); err != nil {
 			log.Error("Server forced to shutdown", "error", err)
 		}
+		stopMeter()
 	}()
 
 	// Start server
 	log.Info("Server starting", "addr", srv.Addr)
+	metrics.SetServiceUp(true)
 	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		log.Fatal("Failed to start server", "error", err)
 	}
 
+	// Wait for the usage of calls that completed during shutdown to be delivered
+	<-meterDone
+
+	// Flush the spans still buffered in the batch processor
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		log.Error("Failed to flush traces", "error", err)
+	}
+
 	log.Info("Server exited")
 }
 
@@ -210,4 +383,10 @@ func printConfigSummary(cfg *config.Config, log *logger.Logger) {
 		"port", cfg.Redis.Port,
 		"db", cfg.Redis.DB,
 	)
+
+	log.Info("Cache configuration",
+		"backend", cfg.Cache.Backend,
+		"ttl", cfg.Cache.TTL,
+		"negative_ttl", cfg.Cache.NegativeTTL,
+	)
 }
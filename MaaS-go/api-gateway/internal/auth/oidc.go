@@ -0,0 +1,308 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+
+	"maas-platform/shared/policy"
+)
+
+// OIDC errors
+var (
+	// ErrInvalidState is returned when a callback does not match the login that started it
+	ErrInvalidState = errors.New("invalid or expired login state")
+	// ErrNotMapped is returned when no role or tenant can be derived for a user
+	ErrNotMapped = errors.New("identity is not mapped to a role and tenant")
+	// ErrProviderUnavailable is returned when the identity provider cannot be discovered
+	ErrProviderUnavailable = errors.New("identity provider unavailable")
+)
+
+// OIDCConfig configures login through an OpenID Connect provider
+type OIDCConfig struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// Scopes are requested in addition to openid
+	Scopes          []string
+	GroupsClaim     string
+	DefaultRole     string
+	DefaultTenantID string
+	GroupMappings   []GroupMapping
+	StateTTL        time.Duration
+	// HTTPClient is used for discovery, JWKS and token requests; nil uses http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// GroupMapping maps an identity provider group to a role and tenant
+type GroupMapping struct {
+	Group    string
+	Role     string
+	TenantID string
+}
+
+// ExternalIdentity is a user verified by the identity provider
+type ExternalIdentity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Username      string
+	Groups        []string
+	// Role and TenantID are derived from the group mappings
+	Role     string
+	TenantID string
+}
+
+// stateClaims carry a pending login between the redirect and the callback
+type stateClaims struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	jwt.RegisteredClaims
+}
+
+// idTokenClaims are the ID token claims the platform reads
+type idTokenClaims struct {
+	Email             string `json:"email"`
+	EmailVerified     *bool  `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+	Name              string `json:"name"`
+}
+
+// OIDC runs the authorization code flow with PKCE against an OpenID Connect provider.
+// Discovery happens on first use and is retried until it succeeds; signing keys are
+// cached by the verifier and refetched when the provider rotates them.
+type OIDC struct {
+	cfg      OIDCConfig
+	stateKey []byte
+
+	mu       sync.Mutex
+	verifier *oidc.IDTokenVerifier
+	oauth    *oauth2.Config
+}
+
+// NewOIDC creates an OIDC login flow; stateSecret signs the short-lived login state
+func NewOIDC(cfg OIDCConfig, stateSecret string) *OIDC {
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = "groups"
+	}
+	if cfg.StateTTL <= 0 {
+		cfg.StateTTL = 10 * time.Minute
+	}
+	// Derived so that login state can never pass as a platform token
+	key := sha256.Sum256([]byte("oidc-state:" + stateSecret))
+	return &OIDC{
+		cfg:      cfg,
+		stateKey: key[:],
+	}
+}
+
+// StateTTL returns how long a started login stays valid
+func (o *OIDC) StateTTL() time.Duration {
+	return o.cfg.StateTTL
+}
+
+// Begin starts a login and returns the provider authorization URL along with
+// the sealed state the caller must hand back to Complete
+func (o *OIDC) Begin(ctx context.Context) (authURL, sealed string, err error) {
+	conf, _, err := o.discover(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	claims := stateClaims{
+		State:    randomToken(),
+		Nonce:    randomToken(),
+		Verifier: oauth2.GenerateVerifier(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(o.cfg.StateTTL)),
+		},
+	}
+	sealed, err = jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(o.stateKey)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to seal login state: %w", err)
+	}
+
+	authURL = conf.AuthCodeURL(claims.State, oidc.Nonce(claims.Nonce), oauth2.S256ChallengeOption(claims.Verifier))
+	return authURL, sealed, nil
+}
+
+// Complete checks a callback against the sealed state, exchanges the code and
+// verifies the ID token
+func (o *OIDC) Complete(ctx context.Context, sealed, state, code string) (*ExternalIdentity, error) {
+	var pending stateClaims
+	_, err := jwt.ParseWithClaims(sealed, &pending, func(*jwt.Token) (interface{}, error) {
+		return o.stateKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || state == "" || pending.State != state {
+		return nil, ErrInvalidState
+	}
+	if code == "" {
+		return nil, fmt.Errorf("%w: missing authorization code", ErrInvalidToken)
+	}
+
+	conf, verifier, err := o.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := conf.Exchange(o.clientContext(ctx), code, oauth2.VerifierOption(pending.Verifier))
+	if err != nil {
+		return nil, fmt.Errorf("%w: code exchange failed: %v", ErrInvalidToken, err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("%w: no id_token in token response", ErrInvalidToken)
+	}
+
+	idToken, err := verifier.Verify(o.clientContext(ctx), rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if idToken.Nonce != pending.Nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidToken)
+	}
+
+	var claims idTokenClaims
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	var all map[string]interface{}
+	if err := idToken.Claims(&all); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	identity := &ExternalIdentity{
+		Issuer:   idToken.Issuer,
+		Subject:  idToken.Subject,
+		Email:    claims.Email,
+		Username: claims.PreferredUsername,
+		Groups:   stringList(all[o.cfg.GroupsClaim]),
+	}
+	if claims.EmailVerified != nil {
+		identity.EmailVerified = *claims.EmailVerified
+	}
+	if identity.Username == "" {
+		identity.Username = claims.Name
+	}
+
+	identity.Role, identity.TenantID = o.mapGroups(identity.Groups)
+	if identity.Role == "" || identity.TenantID == "" {
+		return identity, ErrNotMapped
+	}
+	return identity, nil
+}
+
+// mapGroups picks the most privileged mapped role and the tenant of the first
+// mapping that sets one, falling back to the defaults
+func (o *OIDC) mapGroups(groups []string) (role, tenantID string) {
+	member := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		member[group] = true
+	}
+
+	for _, m := range o.cfg.GroupMappings {
+		if !member[m.Group] {
+			continue
+		}
+		if roleRank(m.Role) > roleRank(role) {
+			role = m.Role
+		}
+		if tenantID == "" {
+			tenantID = m.TenantID
+		}
+	}
+
+	if role == "" {
+		role = o.cfg.DefaultRole
+	}
+	if tenantID == "" {
+		tenantID = o.cfg.DefaultTenantID
+	}
+	return role, tenantID
+}
+
+// discover returns the OAuth2 config and ID token verifier, discovering the provider on first use
+func (o *OIDC) discover(ctx context.Context) (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.oauth != nil {
+		return o.oauth, o.verifier, nil
+	}
+
+	// The provider keeps the context for background JWKS refreshes, so it must outlive the request
+	provider, err := oidc.NewProvider(o.clientContext(context.WithoutCancel(ctx)), o.cfg.IssuerURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrProviderUnavailable, err)
+	}
+
+	o.oauth = &oauth2.Config{
+		ClientID:     o.cfg.ClientID,
+		ClientSecret: o.cfg.ClientSecret,
+		RedirectURL:  o.cfg.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       append([]string{oidc.ScopeOpenID}, o.cfg.Scopes...),
+	}
+	o.verifier = provider.Verifier(&oidc.Config{ClientID: o.cfg.ClientID})
+	return o.oauth, o.verifier, nil
+}
+
+// clientContext makes the configured HTTP client available to the OIDC and OAuth2 libraries
+func (o *OIDC) clientContext(ctx context.Context) context.Context {
+	if o.cfg.HTTPClient == nil {
+		return ctx
+	}
+	return oidc.ClientContext(ctx, o.cfg.HTTPClient)
+}
+
+// roleRank orders roles by privilege; unknown roles rank lowest
+func roleRank(role string) int {
+	switch role {
+	case policy.RoleAdmin:
+		return 3
+	case policy.RoleDeveloper:
+		return 2
+	case policy.RoleViewer:
+		return 1
+	}
+	return 0
+}
+
+// stringList reads a claim holding a string or a list of strings
+func stringList(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
+
+// randomToken returns a random URL-safe token
+func randomToken() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
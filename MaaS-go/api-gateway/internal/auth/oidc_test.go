@@ -0,0 +1,283 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"maas-platform/api-gateway/internal/auth"
+	"maas-platform/api-gateway/internal/auth/oidctest"
+)
+
+const (
+	testClientID     = "maas-gateway"
+	testClientSecret = "gateway-secret"
+)
+
+// newOIDC starts a provider and a login flow against it; configure adjusts the flow settings
+func newOIDC(t *testing.T, configure func(*auth.OIDCConfig)) (*auth.OIDC, *oidctest.Server) {
+	t.Helper()
+	idp := oidctest.NewServer(testClientID, testClientSecret)
+	t.Cleanup(idp.Close)
+
+	cfg := auth.OIDCConfig{
+		IssuerURL:       idp.Issuer(),
+		ClientID:        testClientID,
+		ClientSecret:    testClientSecret,
+		RedirectURL:     "http://gateway.test/api/v1/auth/oidc/callback",
+		DefaultRole:     "viewer",
+		DefaultTenantID: "tenant-default",
+		HTTPClient:      idp.Client(),
+	}
+	if configure != nil {
+		configure(&cfg)
+	}
+	return auth.NewOIDC(cfg, "state-secret"), idp
+}
+
+// begin starts a login and follows it through the provider to the callback
+func begin(t *testing.T, o *auth.OIDC, idp *oidctest.Server) (sealed string, callback url.Values) {
+	t.Helper()
+	authURL, sealed, err := o.Begin(context.Background())
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	location, err := idp.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	return sealed, location.Query()
+}
+
+// login runs a complete login
+func login(t *testing.T, o *auth.OIDC, idp *oidctest.Server) (*auth.ExternalIdentity, error) {
+	t.Helper()
+	sealed, callback := begin(t, o, idp)
+	return o.Complete(context.Background(), sealed, callback.Get("state"), callback.Get("code"))
+}
+
+func TestOIDCLogin(t *testing.T) {
+	o, idp := newOIDC(t, nil)
+
+	authURL, _, err := o.Begin(context.Background())
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	q := mustParse(t, authURL).Query()
+	if q.Get("code_challenge") == "" || q.Get("code_challenge_method") != "S256" {
+		t.Errorf("authorization URL lacks an S256 challenge: %s", authURL)
+	}
+	if q.Get("state") == "" || q.Get("nonce") == "" {
+		t.Errorf("authorization URL lacks state or nonce: %s", authURL)
+	}
+
+	identity, err := login(t, o, idp)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if identity.Issuer != idp.Issuer() || identity.Subject != "oidctest-user" {
+		t.Errorf("identity = %s/%s, want %s/oidctest-user", identity.Issuer, identity.Subject, idp.Issuer())
+	}
+	if identity.Email != "user@oidctest.local" || !identity.EmailVerified || identity.Username != "oidctest" {
+		t.Errorf("unexpected profile: %+v", identity)
+	}
+}
+
+func TestOIDCCodeBoundToVerifier(t *testing.T) {
+	o, idp := newOIDC(t, nil)
+
+	// A code issued for one login cannot be redeemed with another login's verifier
+	_, first := begin(t, o, idp)
+	sealed, second := begin(t, o, idp)
+	_, err := o.Complete(context.Background(), sealed, second.Get("state"), first.Get("code"))
+	if !errors.Is(err, auth.ErrInvalidToken) {
+		t.Fatalf("exchange with foreign verifier: err = %v, want ErrInvalidToken", err)
+	}
+
+	// The code of the login itself still redeems
+	_, err = o.Complete(context.Background(), sealed, second.Get("state"), second.Get("code"))
+	if err != nil {
+		t.Fatalf("exchange with own verifier: %v", err)
+	}
+}
+
+func TestOIDCRejectsState(t *testing.T) {
+	o, idp := newOIDC(t, nil)
+	sealed, callback := begin(t, o, idp)
+	otherSealed, other := begin(t, o, idp)
+
+	tests := []struct {
+		name   string
+		sealed string
+		state  string
+	}{
+		{"missing cookie", "", callback.Get("state")},
+		{"missing state", sealed, ""},
+		{"state of another login", sealed, other.Get("state")},
+		{"cookie of another login", otherSealed, callback.Get("state")},
+		{"tampered cookie", sealed[:len(sealed)-2] + "xx", callback.Get("state")},
+		{"cookie signed elsewhere", sealedBy(t, idp), callback.Get("state")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := o.Complete(context.Background(), tt.sealed, tt.state, callback.Get("code"))
+			if !errors.Is(err, auth.ErrInvalidState) {
+				t.Fatalf("err = %v, want ErrInvalidState", err)
+			}
+		})
+	}
+}
+
+func TestOIDCRejectsExpiredState(t *testing.T) {
+	o, idp := newOIDC(t, func(cfg *auth.OIDCConfig) { cfg.StateTTL = time.Nanosecond })
+
+	_, err := login(t, o, idp)
+	if !errors.Is(err, auth.ErrInvalidState) {
+		t.Fatalf("err = %v, want ErrInvalidState", err)
+	}
+}
+
+func TestOIDCRejectsNonce(t *testing.T) {
+	o, idp := newOIDC(t, nil)
+
+	// Swap the nonce on the way to the provider, as a replayed ID token would carry another one
+	authURL, sealed, err := o.Begin(context.Background())
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	u := mustParse(t, authURL)
+	q := u.Query()
+	q.Set("nonce", "replayed-nonce")
+	u.RawQuery = q.Encode()
+
+	location, err := idp.Authorize(u.String())
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	callback := location.Query()
+	_, err = o.Complete(context.Background(), sealed, callback.Get("state"), callback.Get("code"))
+	if !errors.Is(err, auth.ErrInvalidToken) {
+		t.Fatalf("err = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestOIDCGroupMapping(t *testing.T) {
+	mappings := []auth.GroupMapping{
+		{Group: "maas-admins", Role: "admin"},
+		{Group: "team-vision", Role: "developer", TenantID: "tenant-vision"},
+		{Group: "team-nlp", Role: "viewer", TenantID: "tenant-nlp"},
+	}
+
+	tests := []struct {
+		name       string
+		groups     []string
+		defaults   bool
+		wantRole   string
+		wantTenant string
+		wantErr    error
+	}{
+		{"single mapping", []string{"team-vision"}, true, "developer", "tenant-vision", nil},
+		{"most privileged role wins", []string{"team-nlp", "maas-admins"}, true, "admin", "tenant-nlp", nil},
+		{"first mapping with a tenant wins", []string{"team-nlp", "team-vision"}, true, "developer", "tenant-vision", nil},
+		{"role without tenant uses default tenant", []string{"maas-admins"}, true, "admin", "tenant-default", nil},
+		{"unmapped groups use defaults", []string{"contractors"}, true, "viewer", "tenant-default", nil},
+		{"unmapped without defaults", []string{"contractors"}, false, "", "", auth.ErrNotMapped},
+		{"role without tenant or default", []string{"maas-admins"}, false, "admin", "", auth.ErrNotMapped},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, idp := newOIDC(t, func(cfg *auth.OIDCConfig) {
+				cfg.GroupMappings = mappings
+				if !tt.defaults {
+					cfg.DefaultRole, cfg.DefaultTenantID = "", ""
+				}
+			})
+			idp.SetUser(oidctest.User{
+				Subject:       "user-1",
+				Email:         "user-1@example.com",
+				EmailVerified: true,
+				Groups:        tt.groups,
+			})
+
+			identity, err := login(t, o, idp)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if identity == nil {
+				t.Fatal("no identity returned")
+			}
+			if identity.Role != tt.wantRole || identity.TenantID != tt.wantTenant {
+				t.Errorf("mapped to %q/%q, want %q/%q", identity.Role, identity.TenantID, tt.wantRole, tt.wantTenant)
+			}
+		})
+	}
+}
+
+// The registry links a login to an existing local user only by verified
+// email, so the flag must reflect the provider's claim exactly
+func TestOIDCEmailVerification(t *testing.T) {
+	for _, verified := range []bool{true, false} {
+		o, idp := newOIDC(t, nil)
+		idp.SetUser(oidctest.User{
+			Subject:       "user-1",
+			Email:         "user-1@example.com",
+			EmailVerified: verified,
+		})
+
+		identity, err := login(t, o, idp)
+		if err != nil {
+			t.Fatalf("login: %v", err)
+		}
+		if identity.EmailVerified != verified {
+			t.Errorf("EmailVerified = %v, want %v", identity.EmailVerified, verified)
+		}
+	}
+}
+
+func TestOIDCKeyRotation(t *testing.T) {
+	o, idp := newOIDC(t, nil)
+
+	if _, err := login(t, o, idp); err != nil {
+		t.Fatalf("first login: %v", err)
+	}
+	if _, err := login(t, o, idp); err != nil {
+		t.Fatalf("second login: %v", err)
+	}
+	cached := idp.JWKSRequests()
+
+	idp.RotateKey()
+	if _, err := login(t, o, idp); err != nil {
+		t.Fatalf("login after rotation: %v", err)
+	}
+	if idp.JWKSRequests() <= cached {
+		t.Errorf("keys were not refetched after rotation")
+	}
+}
+
+// sealedBy returns login state sealed by a flow with a different secret
+func sealedBy(t *testing.T, idp *oidctest.Server) string {
+	t.Helper()
+	o := auth.NewOIDC(auth.OIDCConfig{
+		IssuerURL:    idp.Issuer(),
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  "http://gateway.test/api/v1/auth/oidc/callback",
+		HTTPClient:   idp.Client(),
+	}, "another-secret")
+	_, sealed, err := o.Begin(context.Background())
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	return sealed
+}
+
+func mustParse(t *testing.T, raw string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("parse %s: %v", raw, err)
+	}
+	return u
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidToken is returned for malformed, expired or wrongly signed tokens
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims are the platform JWT claims
+type Claims struct {
+	UserID   string `json:"user_id"`
+	TenantID string `json:"tenant_id"`
+	Role     string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// Identity is the user a token is issued for
+type Identity struct {
+	UserID   string
+	TenantID string
+	Role     string
+}
+
+// TokenIssuer issues and verifies HS256 platform tokens
+type TokenIssuer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewTokenIssuer creates a token issuer
+func NewTokenIssuer(secret string, ttl time.Duration) *TokenIssuer {
+	return &TokenIssuer{
+		secret: []byte(secret),
+		ttl:    ttl,
+	}
+}
+
+// TTL returns how long issued tokens are valid
+func (t *TokenIssuer) TTL() time.Duration {
+	return t.ttl
+}
+
+// Issue signs a token for the identity
+func (t *TokenIssuer) Issue(id Identity) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID:   id.UserID,
+		TenantID: id.TenantID,
+		Role:     id.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   id.UserID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(t.ttl)),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(t.secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+	return token, nil
+}
+
+// Parse verifies a token and returns its identity
+func (t *TokenIssuer) Parse(token string) (Identity, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return t.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	userID := claims.UserID
+	if userID == "" {
+		userID = claims.Subject
+	}
+	if userID == "" {
+		return Identity{}, fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	}
+	return Identity{UserID: userID, TenantID: claims.TenantID, Role: claims.Role}, nil
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrMiss is returned when a key is not cached
+var ErrMiss = errors.New("cache miss")
+
+// Cache is a byte-oriented key/value cache with per-entry expiry
+type Cache interface {
+	// Get returns the cached value or ErrMiss
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+	Close() error
+}
+
+// Config selects and configures a cache backend
+type Config struct {
+	// Backend is redis, memory or none
+	Backend string
+	// MaxEntries bounds the memory backend
+	MaxEntries int
+	// KeyPrefix namespaces keys in a shared Redis
+	KeyPrefix string
+
+	RedisAddr     string
+	RedisPassword string
+	RedisDB       int
+}
+
+// New creates the configured cache backend; it returns nil for none
+func New(cfg Config) (Cache, error) {
+	switch cfg.Backend {
+	case "redis":
+		return NewRedisCache(cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB, cfg.KeyPrefix)
+	case "memory", "":
+		return NewMemoryCache(cfg.MaxEntries), nil
+	case "none":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown cache backend: %s", cfg.Backend)
+	}
+}
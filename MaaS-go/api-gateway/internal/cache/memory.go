@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// defaultMaxEntries bounds the memory cache when no size is configured
+const defaultMaxEntries = 10000
+
+// memoryEntry is a cached value with its expiry
+type memoryEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-process LRU cache for single-node setups and tests
+type MemoryCache struct {
+	entries *lru.Cache[string, memoryEntry]
+}
+
+// NewMemoryCache creates an LRU cache holding at most maxEntries keys
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultMaxEntries
+	}
+	// Only fails for a non-positive size
+	entries, _ := lru.New[string, memoryEntry](maxEntries)
+	return &MemoryCache{entries: entries}
+}
+
+// Get returns the cached value unless it expired
+func (c *MemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	entry, ok := c.entries.Get(key)
+	if !ok {
+		return nil, ErrMiss
+	}
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		c.entries.Remove(key)
+		return nil, ErrMiss
+	}
+	return entry.value, nil
+}
+
+// Set stores a value; a zero ttl never expires
+func (c *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	entry := memoryEntry{value: value}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+	c.entries.Add(key, entry)
+	return nil
+}
+
+// Delete removes keys
+func (c *MemoryCache) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		c.entries.Remove(key)
+	}
+	return nil
+}
+
+// Close drops all entries
+func (c *MemoryCache) Close() error {
+	c.entries.Purge()
+	return nil
+}
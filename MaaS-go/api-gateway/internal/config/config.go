@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
@@ -22,14 +23,32 @@ type Config struct {
 	// Redis
 	Redis RedisConfig `mapstructure:"redis"`
 
+	// Cache
+	Cache CacheConfig `mapstructure:"cache"`
+
 	// JWT
 	JWT JWTConfig `mapstructure:"jwt"`
 
+	// API keys
+	APIKeys APIKeyConfig `mapstructure:"api_keys"`
+
+	// OIDC single sign-on
+	OIDC OIDCConfig `mapstructure:"oidc"`
+
+	// Usage metering
+	Metering MeteringConfig `mapstructure:"metering"`
+
 	// Services
 	Services ServiceConfig `mapstructure:"services"`
 
+	// Model registry client resilience
+	Registry RegistryClientConfig `mapstructure:"registry"`
+
 	// Rate Limiting
 	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+
+	// Tracing
+	Tracing TracingConfig `mapstructure:"tracing"`
 }
 
 // DatabaseConfig holds database configuration
@@ -50,12 +69,67 @@ type RedisConfig struct {
 	DB       int    `mapstructure:"db"`
 }
 
+// CacheConfig holds the model lookup cache configuration
+type CacheConfig struct {
+	// Backend is redis, memory or none
+	Backend     string        `mapstructure:"backend"`
+	TTL         time.Duration `mapstructure:"ttl"`
+	NegativeTTL time.Duration `mapstructure:"negative_ttl"`
+	MaxEntries  int           `mapstructure:"max_entries"`
+	KeyPrefix   string        `mapstructure:"key_prefix"`
+}
+
 // JWTConfig holds JWT configuration
 type JWTConfig struct {
 	Secret    string `mapstructure:"secret"`
 	ExpiresIn int    `mapstructure:"expires_in"`
 }
 
+// APIKeyConfig holds API key authentication settings
+type APIKeyConfig struct {
+	// VerifyCacheTTL bounds how long a revoked key keeps working on other gateways
+	VerifyCacheTTL time.Duration `mapstructure:"verify_cache_ttl"`
+}
+
+// OIDCConfig holds OIDC single sign-on configuration
+type OIDCConfig struct {
+	Enabled      bool     `mapstructure:"enabled"`
+	IssuerURL    string   `mapstructure:"issuer_url"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+	// GroupsClaim names the ID token claim listing the user's groups
+	GroupsClaim string `mapstructure:"groups_claim"`
+	// DefaultRole and DefaultTenantID apply when no group mapping sets them;
+	// an empty value rejects users no mapping matches
+	DefaultRole     string             `mapstructure:"default_role"`
+	DefaultTenantID string             `mapstructure:"default_tenant_id"`
+	GroupMappings   []OIDCGroupMapping `mapstructure:"group_mappings"`
+	StateTTL        time.Duration      `mapstructure:"state_ttl"`
+}
+
+// OIDCGroupMapping maps an identity provider group to a role and tenant
+type OIDCGroupMapping struct {
+	Group    string `mapstructure:"group"`
+	Role     string `mapstructure:"role"`
+	TenantID string `mapstructure:"tenant_id"`
+}
+
+// MeteringConfig holds usage metering configuration
+type MeteringConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Sinks lists where records go: registry (hourly rollups) and billing (services.billing)
+	Sinks         []string      `mapstructure:"sinks"`
+	BufferSize    int           `mapstructure:"buffer_size"`
+	BatchSize     int           `mapstructure:"batch_size"`
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	SendTimeout   time.Duration `mapstructure:"send_timeout"`
+	// BillingPath is appended to services.billing; BillingToken is sent as a bearer token
+	BillingPath  string `mapstructure:"billing_path"`
+	BillingToken string `mapstructure:"billing_token"`
+}
+
 // ServiceConfig holds downstream service URLs
 type ServiceConfig struct {
 	ModelRegistry string `mapstructure:"model_registry"`
@@ -64,6 +138,42 @@ type ServiceConfig struct {
 	Billing       string `mapstructure:"billing"`
 }
 
+// RegistryClientConfig holds balancing, deadline, retry and circuit breaker settings for model registry calls
+type RegistryClientConfig struct {
+	// Endpoints lists registry replicas; defaults to services.model_registry
+	Endpoints []string `mapstructure:"endpoints"`
+	// Resolver is dns or static; empty picks dns for one endpoint and static for several
+	Resolver string `mapstructure:"resolver"`
+	// LoadBalancing is round_robin, least_request or pick_first
+	LoadBalancing string `mapstructure:"load_balancing"`
+	// HealthCheck ejects replicas reporting NOT_SERVING on the gRPC health service
+	HealthCheck bool `mapstructure:"health_check"`
+	// ServiceSecret signs every registry call and must match the registry's auth.service_secret
+	ServiceSecret string `mapstructure:"service_secret"`
+
+	DefaultTimeout time.Duration `mapstructure:"default_timeout"`
+	// Timeouts overrides the default per gRPC method name, e.g. listmodels
+	Timeouts       map[string]time.Duration `mapstructure:"timeouts"`
+	Retry          RetryConfig              `mapstructure:"retry"`
+	CircuitBreaker CircuitBreakerConfig     `mapstructure:"circuit_breaker"`
+}
+
+// RetryConfig holds the retry policy for idempotent registry reads
+type RetryConfig struct {
+	MaxAttempts       int           `mapstructure:"max_attempts"`
+	InitialBackoff    time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff        time.Duration `mapstructure:"max_backoff"`
+	BackoffMultiplier float64       `mapstructure:"backoff_multiplier"`
+}
+
+// CircuitBreakerConfig holds circuit breaker configuration
+type CircuitBreakerConfig struct {
+	Enabled          bool          `mapstructure:"enabled"`
+	FailureThreshold int           `mapstructure:"failure_threshold"`
+	OpenTimeout      time.Duration `mapstructure:"open_timeout"`
+	HalfOpenRequests int           `mapstructure:"half_open_requests"`
+}
+
 // RateLimitConfig holds rate limiting configuration
 type RateLimitConfig struct {
 	Enabled bool `mapstructure:"enabled"`
@@ -71,6 +181,15 @@ type RateLimitConfig struct {
 	Burst   int  `mapstructure:"burst"`
 }
 
+// TracingConfig holds OpenTelemetry tracing configuration
+type TracingConfig struct {
+	// Exporter is otlp, stdout or none
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
 // Load returns the application configuration
 func Load() (*Config, error) {
 	v := viper.New()
@@ -131,17 +250,57 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("redis.password", "")
 	v.SetDefault("redis.db", 0)
 
+	v.SetDefault("cache.backend", "memory")
+	v.SetDefault("cache.ttl", "30s")
+	v.SetDefault("cache.negative_ttl", "5s")
+	v.SetDefault("cache.max_entries", 10000)
+	v.SetDefault("cache.key_prefix", "maas:gateway:")
+
 	v.SetDefault("jwt.secret", "change-me-in-production")
 	v.SetDefault("jwt.expires_in", 86400)
 
-	v.SetDefault("services.model_registry", "http://localhost:8081")
+	v.SetDefault("api_keys.verify_cache_ttl", "30s")
+
+	v.SetDefault("oidc.enabled", false)
+	v.SetDefault("oidc.redirect_url", "http://localhost:8080/api/v1/auth/oidc/callback")
+	v.SetDefault("oidc.scopes", []string{"profile", "email"})
+	v.SetDefault("oidc.groups_claim", "groups")
+	v.SetDefault("oidc.default_role", "viewer")
+	v.SetDefault("oidc.state_ttl", "10m")
+
+	v.SetDefault("metering.enabled", true)
+	v.SetDefault("metering.sinks", []string{"registry"})
+	v.SetDefault("metering.buffer_size", 10000)
+	v.SetDefault("metering.batch_size", 500)
+	v.SetDefault("metering.flush_interval", "10s")
+	v.SetDefault("metering.send_timeout", "10s")
+	v.SetDefault("metering.billing_path", "/v1/usage")
+
+	v.SetDefault("services.model_registry", "dns:///localhost:9090")
 	v.SetDefault("services.inference", "http://localhost:8082")
 	v.SetDefault("services.user_center", "http://localhost:8083")
 	v.SetDefault("services.billing", "http://localhost:8084")
 
+	v.SetDefault("registry.load_balancing", "round_robin")
+	v.SetDefault("registry.health_check", true)
+	v.SetDefault("registry.default_timeout", "5s")
+	v.SetDefault("registry.retry.max_attempts", 3)
+	v.SetDefault("registry.retry.initial_backoff", "100ms")
+	v.SetDefault("registry.retry.max_backoff", "1s")
+	v.SetDefault("registry.retry.backoff_multiplier", 2.0)
+	v.SetDefault("registry.circuit_breaker.enabled", true)
+	v.SetDefault("registry.circuit_breaker.failure_threshold", 5)
+	v.SetDefault("registry.circuit_breaker.open_timeout", "30s")
+	v.SetDefault("registry.circuit_breaker.half_open_requests", 1)
+
 	v.SetDefault("rate_limit.enabled", true)
 	v.SetDefault("rate_limit.rpm", 1000)
 	v.SetDefault("rate_limit.burst", 100)
+
+	v.SetDefault("tracing.exporter", "none")
+	v.SetDefault("tracing.endpoint", "localhost:4317")
+	v.SetDefault("tracing.insecure", true)
+	v.SetDefault("tracing.sample_ratio", 1.0)
 }
 
 // loadConfigFile attempts to load configuration from file
@@ -271,6 +430,76 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate cache
+	switch c.Cache.Backend {
+	case "redis", "memory", "none":
+	default:
+		return fmt.Errorf("invalid cache backend: %s", c.Cache.Backend)
+	}
+
+	// Validate registry client; gRPC caps retries at five attempts
+	switch c.Registry.Resolver {
+	case "", "dns", "static":
+	default:
+		return fmt.Errorf("invalid registry resolver: %s", c.Registry.Resolver)
+	}
+	switch c.Registry.LoadBalancing {
+	case "round_robin", "least_request", "pick_first":
+	default:
+		return fmt.Errorf("invalid registry load balancing policy: %s", c.Registry.LoadBalancing)
+	}
+	if c.Registry.Retry.MaxAttempts < 0 || c.Registry.Retry.MaxAttempts > 5 {
+		return fmt.Errorf("registry retry max attempts must be between 0 and 5")
+	}
+	if c.Registry.ServiceSecret == "" {
+		return fmt.Errorf("registry service secret must be set")
+	}
+	if c.Environment == "production" && len(c.Registry.ServiceSecret) < 32 {
+		return fmt.Errorf("registry service secret must be at least 32 characters in production")
+	}
+
+	// Validate OIDC
+	if c.OIDC.Enabled {
+		if c.OIDC.IssuerURL == "" || c.OIDC.ClientID == "" || c.OIDC.RedirectURL == "" {
+			return fmt.Errorf("OIDC issuer URL, client ID and redirect URL are required")
+		}
+		for _, m := range c.OIDC.GroupMappings {
+			if m.Group == "" {
+				return fmt.Errorf("OIDC group mapping requires a group")
+			}
+			switch m.Role {
+			case "", "admin", "developer", "viewer":
+			default:
+				return fmt.Errorf("invalid OIDC mapped role: %s", m.Role)
+			}
+		}
+	}
+
+	// Validate metering
+	if c.Metering.Enabled {
+		for _, sink := range c.Metering.Sinks {
+			switch sink {
+			case "registry":
+			case "billing":
+				if c.Services.Billing == "" {
+					return fmt.Errorf("billing metering sink requires services.billing")
+				}
+			default:
+				return fmt.Errorf("invalid metering sink: %s", sink)
+			}
+		}
+	}
+
+	// Validate tracing
+	switch c.Tracing.Exporter {
+	case "otlp", "stdout", "none":
+	default:
+		return fmt.Errorf("invalid tracing exporter: %s", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio must be between 0 and 1")
+	}
+
 	// Validate rate limit
 	if c.RateLimit.RPM <= 0 {
 		return fmt.Errorf("rate limit RPM must be positive")
@@ -313,3 +542,11 @@ func (c *Config) DatabaseDSN() string {
 func (c *Config) RedisAddr() string {
 	return fmt.Sprintf("%s:%d", c.Redis.Host, c.Redis.Port)
 }
+
+// RegistryEndpoints returns the model registry replicas to balance across
+func (c *Config) RegistryEndpoints() []string {
+	if len(c.Registry.Endpoints) > 0 {
+		return c.Registry.Endpoints
+	}
+	return []string{c.Services.ModelRegistry}
+}
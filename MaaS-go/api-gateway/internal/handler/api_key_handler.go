@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	modelpb "maas-platform/shared/proto"
+)
+
+// APIKeyRequest represents an API key creation request
+type APIKeyRequest struct {
+	Name   string   `json:"name" binding:"required"`
+	Scopes []string `json:"scopes" binding:"required"`
+	// UserID issues the key for another user of the tenant; admins only
+	UserID    string     `json:"user_id"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// APIKeyResponse represents an API key
+type APIKeyResponse struct {
+	ID         string   `json:"id"`
+	TenantID   string   `json:"tenant_id"`
+	UserID     string   `json:"user_id"`
+	Role       string   `json:"role"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`
+	Scopes     []string `json:"scopes"`
+	ExpiresAt  string   `json:"expires_at,omitempty"`
+	LastUsedAt string   `json:"last_used_at,omitempty"`
+	RevokedAt  string   `json:"revoked_at,omitempty"`
+	CreatedAt  string   `json:"created_at"`
+	// Secret is only returned on creation and rotation
+	Secret string `json:"secret,omitempty"`
+}
+
+// CreateAPIKey creates an API key for the caller's tenant via gRPC
+func (h *Handler) CreateAPIKey(c *gin.Context) {
+	var req APIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	grpcReq := &modelpb.CreateAPIKeyRequest{
+		TenantId: c.GetString("tenant_id"),
+		UserId:   req.UserID,
+		Name:     req.Name,
+		Scopes:   req.Scopes,
+	}
+	if req.ExpiresAt != nil {
+		grpcReq.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+
+	key, secret, err := h.apiKeyClient.Create(h.rpcContext(c), grpcReq)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	resp := convertProtoAPIKeyToResponse(key)
+	resp.Secret = secret
+	h.Success(c, resp)
+}
+
+// ListAPIKeys lists the caller's tenant API keys via gRPC
+func (h *Handler) ListAPIKeys(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	includeRevoked, _ := strconv.ParseBool(c.Query("include_revoked"))
+
+	resp, err := h.apiKeyClient.List(h.rpcContext(c), &modelpb.ListAPIKeysRequest{
+		TenantId:       c.Query("tenant_id"),
+		IncludeRevoked: includeRevoked,
+		Page:           int32(page),
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	keys := make([]APIKeyResponse, len(resp.ApiKeys))
+	for i, k := range resp.ApiKeys {
+		keys[i] = convertProtoAPIKeyToResponse(k)
+	}
+
+	h.Success(c, gin.H{
+		"api_keys": keys,
+		"total":    resp.Total,
+		"page":     page,
+		"limit":    limit,
+	})
+}
+
+// GetAPIKey gets an API key via gRPC
+func (h *Handler) GetAPIKey(c *gin.Context) {
+	key, err := h.apiKeyClient.Get(h.rpcContext(c), c.Param("id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoAPIKeyToResponse(key))
+}
+
+// RotateAPIKey replaces the secret of an API key via gRPC
+func (h *Handler) RotateAPIKey(c *gin.Context) {
+	key, secret, err := h.apiKeyClient.Rotate(h.rpcContext(c), c.Param("id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	resp := convertProtoAPIKeyToResponse(key)
+	resp.Secret = secret
+	h.Success(c, resp)
+}
+
+// RevokeAPIKey revokes an API key via gRPC
+func (h *Handler) RevokeAPIKey(c *gin.Context) {
+	key, err := h.apiKeyClient.Revoke(h.rpcContext(c), c.Param("id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoAPIKeyToResponse(key))
+}
+
+// convertProtoAPIKeyToResponse converts protobuf APIKey to HTTP response
+func convertProtoAPIKeyToResponse(k *modelpb.APIKey) APIKeyResponse {
+	resp := APIKeyResponse{
+		ID:        k.Id,
+		TenantID:  k.TenantId,
+		UserID:    k.UserId,
+		Role:      k.Role,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: k.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+	if k.ExpiresAt != nil {
+		resp.ExpiresAt = k.ExpiresAt.AsTime().Format(time.RFC3339)
+	}
+	if k.LastUsedAt != nil {
+		resp.LastUsedAt = k.LastUsedAt.AsTime().Format(time.RFC3339)
+	}
+	if k.RevokedAt != nil {
+		resp.RevokedAt = k.RevokedAt.AsTime().Format(time.RFC3339)
+	}
+	return resp
+}
@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+
+	modelpb "maas-platform/shared/proto"
+)
+
+// ApprovalPolicyRequest represents the approval policy of a tenant for an
+// action; no timeout means the default and no tenant the caller's
+type ApprovalPolicyRequest struct {
+	TenantID          string `json:"tenant_id"`
+	RequiredApprovals int32  `json:"required_approvals" binding:"required,min=1,max=10"`
+	ApproverRole      string `json:"approver_role" binding:"required,oneof=admin developer"`
+	TimeoutSeconds    int64  `json:"timeout_seconds" binding:"min=0"`
+}
+
+// ApprovalPolicyResponse represents an approval policy
+type ApprovalPolicyResponse struct {
+	ID                string `json:"id"`
+	TenantID          string `json:"tenant_id"`
+	Action            string `json:"action"`
+	RequiredApprovals int32  `json:"required_approvals"`
+	ApproverRole      string `json:"approver_role"`
+	TimeoutSeconds    int64  `json:"timeout_seconds"`
+	CreatedBy         string `json:"created_by,omitempty"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+}
+
+// ApprovalDecisionRequest represents an approver's verdict on a pending request
+type ApprovalDecisionRequest struct {
+	Approve *bool  `json:"approve" binding:"required"`
+	Comment string `json:"comment" binding:"max=1000"`
+}
+
+// ApprovalDecisionResponse represents an approver's verdict
+type ApprovalDecisionResponse struct {
+	ApproverID string `json:"approver_id"`
+	Approve    bool   `json:"approve"`
+	Comment    string `json:"comment,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// ApprovalRequestResponse represents a change held back until enough approvers agree to it
+type ApprovalRequestResponse struct {
+	ID                string                     `json:"id"`
+	TenantID          string                     `json:"tenant_id"`
+	ModelID           string                     `json:"model_id"`
+	Action            string                     `json:"action"`
+	TargetStatus      string                     `json:"target_status,omitempty"`
+	TargetVersion     string                     `json:"target_version,omitempty"`
+	State             string                     `json:"state"`
+	RequiredApprovals int32                      `json:"required_approvals"`
+	ApproverRole      string                     `json:"approver_role"`
+	RequestedBy       string                     `json:"requested_by"`
+	Error             string                     `json:"error,omitempty"`
+	Decisions         []ApprovalDecisionResponse `json:"decisions"`
+	ExpiresAt         string                     `json:"expires_at"`
+	ResolvedAt        string                     `json:"resolved_at,omitempty"`
+	CreatedAt         string                     `json:"created_at"`
+}
+
+// ListApprovalPolicies lists the approval policies of a tenant via gRPC
+func (h *Handler) ListApprovalPolicies(c *gin.Context) {
+	policies, err := h.modelClient.ListApprovalPolicies(h.rpcContext(c), c.Query("tenant_id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	resp := make([]ApprovalPolicyResponse, len(policies))
+	for i, p := range policies {
+		resp[i] = convertProtoPolicyToResponse(p)
+	}
+	h.Success(c, gin.H{"policies": resp})
+}
+
+// SetApprovalPolicy makes an action of a tenant wait for approval via gRPC
+func (h *Handler) SetApprovalPolicy(c *gin.Context) {
+	action := c.Param("action")
+	if action != "promote" && action != "publish" {
+		h.NotFound(c, "approval action")
+		return
+	}
+	var req ApprovalPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	p, err := h.modelClient.SetApprovalPolicy(h.rpcContext(c), &modelpb.ApprovalPolicy{
+		TenantId:          req.TenantID,
+		Action:            action,
+		RequiredApprovals: req.RequiredApprovals,
+		ApproverRole:      req.ApproverRole,
+		TimeoutSeconds:    req.TimeoutSeconds,
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoPolicyToResponse(p))
+}
+
+// DeleteApprovalPolicy stops an action of a tenant waiting for approval via gRPC
+func (h *Handler) DeleteApprovalPolicy(c *gin.Context) {
+	if err := h.modelClient.DeleteApprovalPolicy(h.rpcContext(c), c.Query("tenant_id"), c.Param("action")); err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListApprovalRequests lists approval requests, newest first via gRPC
+func (h *Handler) ListApprovalRequests(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	resp, err := h.modelClient.ListApprovalRequests(h.rpcContext(c), &modelpb.ListApprovalRequestsRequest{
+		TenantId: c.Query("tenant_id"),
+		ModelId:  c.Query("model_id"),
+		State:    c.Query("state"),
+		Page:     int32(page),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	requests := make([]ApprovalRequestResponse, len(resp.Requests))
+	for i, r := range resp.Requests {
+		requests[i] = convertProtoApprovalToResponse(r)
+	}
+
+	h.Success(c, gin.H{
+		"requests": requests,
+		"total":    resp.Total,
+		"page":     page,
+		"limit":    limit,
+	})
+}
+
+// GetApprovalRequest gets an approval request with its decisions via gRPC
+func (h *Handler) GetApprovalRequest(c *gin.Context) {
+	r, err := h.modelClient.GetApprovalRequest(h.rpcContext(c), c.Param("id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoApprovalToResponse(r))
+}
+
+// DecideApprovalRequest approves or rejects a pending approval request via
+// gRPC; the change is applied once enough approvers agree
+func (h *Handler) DecideApprovalRequest(c *gin.Context) {
+	var req ApprovalDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	r, err := h.modelClient.DecideApprovalRequest(h.rpcContext(c), c.Param("id"), *req.Approve, req.Comment)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoApprovalToResponse(r))
+}
+
+// approvalPending answers 202 Accepted with the pending request when the
+// status reports a change held back for approval, and reports whether it did
+func (h *Handler) approvalPending(c *gin.Context, st *status.Status) bool {
+	for _, detail := range st.Details() {
+		r, ok := detail.(*modelpb.ApprovalRequest)
+		if !ok {
+			continue
+		}
+		c.JSON(http.StatusAccepted, Response{
+			Code:      http.StatusAccepted,
+			Message:   st.Message(),
+			Data:      convertProtoApprovalToResponse(r),
+			RequestID: c.GetString("request_id"),
+			TraceID:   c.GetString("trace_id"),
+		})
+		return true
+	}
+	return false
+}
+
+// convertProtoPolicyToResponse converts a protobuf approval policy to HTTP response
+func convertProtoPolicyToResponse(p *modelpb.ApprovalPolicy) ApprovalPolicyResponse {
+	return ApprovalPolicyResponse{
+		ID:                p.Id,
+		TenantID:          p.TenantId,
+		Action:            p.Action,
+		RequiredApprovals: p.RequiredApprovals,
+		ApproverRole:      p.ApproverRole,
+		TimeoutSeconds:    p.TimeoutSeconds,
+		CreatedBy:         p.CreatedBy,
+		CreatedAt:         p.CreatedAt.AsTime().Format(time.RFC3339),
+		UpdatedAt:         p.UpdatedAt.AsTime().Format(time.RFC3339),
+	}
+}
+
+// convertProtoApprovalToResponse converts a protobuf approval request to HTTP response
+func convertProtoApprovalToResponse(r *modelpb.ApprovalRequest) ApprovalRequestResponse {
+	resp := ApprovalRequestResponse{
+		ID:                r.Id,
+		TenantID:          r.TenantId,
+		ModelID:           r.ModelId,
+		Action:            r.Action,
+		TargetStatus:      r.TargetStatus,
+		TargetVersion:     r.TargetVersion,
+		State:             r.State,
+		RequiredApprovals: r.RequiredApprovals,
+		ApproverRole:      r.ApproverRole,
+		RequestedBy:       r.RequestedBy,
+		Error:             r.Error,
+		Decisions:         make([]ApprovalDecisionResponse, len(r.Decisions)),
+		ExpiresAt:         r.ExpiresAt.AsTime().Format(time.RFC3339),
+		CreatedAt:         r.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+	if r.ResolvedAt != nil {
+		resp.ResolvedAt = r.ResolvedAt.AsTime().Format(time.RFC3339)
+	}
+	for i, d := range r.Decisions {
+		resp.Decisions[i] = ApprovalDecisionResponse{
+			ApproverID: d.ApproverId,
+			Approve:    d.Approve,
+			Comment:    d.Comment,
+			CreatedAt:  d.CreatedAt.AsTime().Format(time.RFC3339),
+		}
+	}
+	return resp
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	modelpb "maas-platform/shared/proto"
+)
+
+// AuditEventResponse represents an audit log entry
+type AuditEventResponse struct {
+	ID            string          `json:"id"`
+	OccurredAt    string          `json:"occurred_at"`
+	ActorID       string          `json:"actor_id"`
+	TenantID      string          `json:"tenant_id"`
+	ActorTenantID string          `json:"actor_tenant_id,omitempty"`
+	RequestID     string          `json:"request_id"`
+	Source        string          `json:"source"`
+	Action        string          `json:"action"`
+	TargetType    string          `json:"target_type"`
+	TargetID      string          `json:"target_id"`
+	Before        json.RawMessage `json:"before,omitempty"`
+	After         json.RawMessage `json:"after,omitempty"`
+	Diff          json.RawMessage `json:"diff,omitempty"`
+}
+
+// ListAuditEvents queries the audit log via gRPC
+func (h *Handler) ListAuditEvents(c *gin.Context) {
+	req, ok := h.parseAuditRequest(c)
+	if !ok {
+		return
+	}
+
+	events, total, err := h.auditClient.List(h.rpcContext(c), req)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	response := make([]AuditEventResponse, len(events))
+	for i, e := range events {
+		response[i] = convertProtoAuditEventToResponse(e)
+	}
+
+	h.Success(c, gin.H{
+		"events": response,
+		"total":  total,
+		"page":   req.Page,
+		"limit":  req.Limit,
+	})
+}
+
+// ExportAuditEvents streams the audit log as JSON Lines
+func (h *Handler) ExportAuditEvents(c *gin.Context) {
+	req, ok := h.parseAuditRequest(c)
+	if !ok {
+		return
+	}
+
+	encoder := json.NewEncoder(c.Writer)
+	started := false
+	err := h.auditClient.Export(h.rpcContext(c), req, func(e *modelpb.AuditEvent) error {
+		if !started {
+			c.Header("Content-Type", "application/x-ndjson")
+			c.Header("Content-Disposition", `attachment; filename="audit.jsonl"`)
+			c.Status(http.StatusOK)
+			started = true
+		}
+		return encoder.Encode(convertProtoAuditEventToResponse(e))
+	})
+	if err != nil {
+		if !started {
+			h.RPCError(c, err)
+			return
+		}
+		// Headers are already sent, so the export can only be cut short
+		c.Error(err)
+		return
+	}
+	if !started {
+		c.Header("Content-Type", "application/x-ndjson")
+		c.Status(http.StatusOK)
+	}
+}
+
+// recordAuthEvent reports an authentication event to the audit log
+func (h *Handler) recordAuthEvent(c *gin.Context, action string, user UserInfo) {
+	after, _ := json.Marshal(gin.H{"username": user.Username, "ip": c.ClientIP()})
+	err := h.auditClient.Record(h.rpcContext(c), &modelpb.RecordAuditEventRequest{
+		Action:     action,
+		TargetType: "user",
+		TargetId:   user.ID,
+		Source:     "api-gateway",
+		ActorId:    user.ID,
+		After:      string(after),
+	})
+	if err != nil {
+		h.logger.Warn("Failed to record auth event", "action", action, "error", err)
+	}
+}
+
+// parseAuditRequest reads audit filters from the query string
+func (h *Handler) parseAuditRequest(c *gin.Context) (*modelpb.ListAuditEventsRequest, bool) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := &modelpb.ListAuditEventsRequest{
+		TargetId: c.Query("target"),
+		ActorId:  c.Query("actor"),
+		TenantId: c.Query("tenant"),
+		Action:   c.Query("action"),
+		Page:     int32(page),
+		Limit:    int32(limit),
+	}
+
+	for param, dst := range map[string]**timestamppb.Timestamp{"from": &req.From, "to": &req.To} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			h.BadRequest(c, "invalid "+param+": "+err.Error())
+			return nil, false
+		}
+		*dst = timestamppb.New(t)
+	}
+
+	return req, true
+}
+
+// convertProtoAuditEventToResponse converts protobuf AuditEvent to HTTP response
+func convertProtoAuditEventToResponse(e *modelpb.AuditEvent) AuditEventResponse {
+	resp := AuditEventResponse{
+		ID:            e.Id,
+		OccurredAt:    e.OccurredAt.AsTime().Format(time.RFC3339Nano),
+		ActorID:       e.ActorId,
+		TenantID:      e.TenantId,
+		ActorTenantID: e.ActorTenantId,
+		RequestID:     e.RequestId,
+		Source:        e.Source,
+		Action:        e.Action,
+		TargetType:    e.TargetType,
+		TargetID:      e.TargetId,
+	}
+	if e.Before != "" {
+		resp.Before = json.RawMessage(e.Before)
+	}
+	if e.After != "" {
+		resp.After = json.RawMessage(e.After)
+	}
+	if e.Diff != "" {
+		resp.Diff = json.RawMessage(e.Diff)
+	}
+	return resp
+}
@@ -0,0 +1,485 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/api-gateway/pkg/pdf"
+	modelpb "maas-platform/shared/proto"
+)
+
+// PricingPlanRequest represents a pricing plan; prices are millionths of the currency unit
+type PricingPlanRequest struct {
+	Name               string                `json:"name" binding:"required"`
+	Description        string                `json:"description"`
+	Currency           string                `json:"currency"`
+	RequestPriceMicros int64                 `json:"request_price_micros"`
+	TokenPriceMicros   int64                 `json:"token_price_micros"`
+	StoragePriceMicros int64                 `json:"storage_price_micros"`
+	FreeRequests       int64                 `json:"free_requests"`
+	FreeTokens         int64                 `json:"free_tokens"`
+	FreeStorageGBMonth float64               `json:"free_storage_gb_month"`
+	DiscountTiers      []DiscountTierRequest `json:"discount_tiers"`
+}
+
+// DiscountTierRequest discounts the part of a subtotal from a threshold up to the next tier
+type DiscountTierRequest struct {
+	FromMicros int64   `json:"from_micros"`
+	Percent    float64 `json:"percent"`
+}
+
+// PricingPlanResponse represents a pricing plan
+type PricingPlanResponse struct {
+	ID string `json:"id"`
+	PricingPlanRequest
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// TenantPlanRequest represents a tenant plan assignment
+type TenantPlanRequest struct {
+	PlanID string `json:"plan_id" binding:"required"`
+}
+
+// GenerateInvoiceRequest represents an invoice generation request
+type GenerateInvoiceRequest struct {
+	TenantID string `json:"tenant_id" binding:"required"`
+	// Month is formatted as YYYY-MM
+	Month string `json:"month" binding:"required"`
+}
+
+// InvoiceResponse represents an invoice; amounts are given both in micros and formatted
+type InvoiceResponse struct {
+	ID             string                    `json:"id"`
+	TenantID       string                    `json:"tenant_id"`
+	Month          string                    `json:"month"`
+	PeriodStart    string                    `json:"period_start"`
+	PeriodEnd      string                    `json:"period_end"`
+	PlanID         string                    `json:"plan_id"`
+	PlanName       string                    `json:"plan_name"`
+	Currency       string                    `json:"currency"`
+	LineItems      []InvoiceLineItemResponse `json:"line_items"`
+	SubtotalMicros int64                     `json:"subtotal_micros"`
+	DiscountMicros int64                     `json:"discount_micros"`
+	TotalMicros    int64                     `json:"total_micros"`
+	Subtotal       string                    `json:"subtotal"`
+	Discount       string                    `json:"discount"`
+	Total          string                    `json:"total"`
+	Final          bool                      `json:"final"`
+	GeneratedAt    string                    `json:"generated_at"`
+}
+
+// InvoiceLineItemResponse represents an invoice line item
+type InvoiceLineItemResponse struct {
+	Kind            string  `json:"kind"`
+	Description     string  `json:"description"`
+	ModelID         string  `json:"model_id,omitempty"`
+	Quantity        float64 `json:"quantity"`
+	Unit            string  `json:"unit"`
+	UnitPriceMicros int64   `json:"unit_price_micros"`
+	AmountMicros    int64   `json:"amount_micros"`
+	Amount          string  `json:"amount"`
+}
+
+// CreatePricingPlan creates a pricing plan via gRPC
+func (h *Handler) CreatePricingPlan(c *gin.Context) {
+	var req PricingPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	plan, err := h.billingClient.CreatePlan(h.rpcContext(c), convertPlanRequestToProto(req))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoPlanToResponse(plan))
+}
+
+// UpdatePricingPlan replaces a pricing plan via gRPC; existing invoices change only when regenerated
+func (h *Handler) UpdatePricingPlan(c *gin.Context) {
+	var req PricingPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	grpcReq := convertPlanRequestToProto(req)
+	grpcReq.Id = c.Param("id")
+	plan, err := h.billingClient.UpdatePlan(h.rpcContext(c), grpcReq)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoPlanToResponse(plan))
+}
+
+// ListPricingPlans lists pricing plans via gRPC
+func (h *Handler) ListPricingPlans(c *gin.Context) {
+	plans, err := h.billingClient.ListPlans(h.rpcContext(c))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	response := make([]PricingPlanResponse, len(plans))
+	for i, p := range plans {
+		response[i] = convertProtoPlanToResponse(p)
+	}
+	h.Success(c, gin.H{"plans": response})
+}
+
+// SetTenantPlan attaches a pricing plan to a tenant via gRPC
+func (h *Handler) SetTenantPlan(c *gin.Context) {
+	var req TenantPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.billingClient.SetTenantPlan(h.rpcContext(c), c.Param("tenant"), req.PlanID); err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, gin.H{"tenant_id": c.Param("tenant"), "plan_id": req.PlanID})
+}
+
+// GenerateInvoice generates or regenerates a tenant's invoice for one month via gRPC
+func (h *Handler) GenerateInvoice(c *gin.Context) {
+	var req GenerateInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+	month, err := time.Parse("2006-01", req.Month)
+	if err != nil {
+		h.BadRequest(c, "month must be formatted as YYYY-MM")
+		return
+	}
+
+	invoice, err := h.billingClient.GenerateInvoice(h.rpcContext(c), &modelpb.GenerateInvoiceRequest{
+		TenantId: req.TenantID,
+		Month:    timestamppb.New(month),
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoInvoiceToResponse(invoice))
+}
+
+// ListInvoices lists invoices via gRPC; from and to are months formatted as YYYY-MM
+func (h *Handler) ListInvoices(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := &modelpb.ListInvoicesRequest{
+		TenantId: c.Query("tenant_id"),
+		Page:     int32(page),
+		Limit:    int32(limit),
+	}
+	if from := c.Query("from"); from != "" {
+		t, err := time.Parse("2006-01", from)
+		if err != nil {
+			h.BadRequest(c, "from must be formatted as YYYY-MM")
+			return
+		}
+		req.From = timestamppb.New(t)
+	}
+	if to := c.Query("to"); to != "" {
+		t, err := time.Parse("2006-01", to)
+		if err != nil {
+			h.BadRequest(c, "to must be formatted as YYYY-MM")
+			return
+		}
+		// to is inclusive
+		req.To = timestamppb.New(t.AddDate(0, 1, 0))
+	}
+
+	resp, err := h.billingClient.ListInvoices(h.rpcContext(c), req)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	invoices := make([]InvoiceResponse, len(resp.Invoices))
+	for i, inv := range resp.Invoices {
+		invoices[i] = convertProtoInvoiceToResponse(inv)
+	}
+
+	h.Success(c, gin.H{
+		"invoices": invoices,
+		"total":    resp.Total,
+		"page":     page,
+		"limit":    limit,
+	})
+}
+
+// GetInvoice gets an invoice via gRPC as JSON, or as a download with format=csv or format=pdf
+func (h *Handler) GetInvoice(c *gin.Context) {
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" && format != "pdf" {
+		h.BadRequest(c, "format must be json, csv or pdf")
+		return
+	}
+
+	invoice, err := h.billingClient.GetInvoice(h.rpcContext(c), c.Param("id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	resp := convertProtoInvoiceToResponse(invoice)
+	switch format {
+	case "csv":
+		h.writeInvoiceCSV(c, resp)
+	case "pdf":
+		h.writeInvoicePDF(c, resp)
+	default:
+		h.Success(c, resp)
+	}
+}
+
+// invoiceFilename names a downloaded invoice after its tenant and month
+func invoiceFilename(inv InvoiceResponse, ext string) string {
+	return fmt.Sprintf(`attachment; filename="invoice-%s-%s.%s"`, inv.TenantID, inv.Month, ext)
+}
+
+// writeInvoiceCSV writes the line items of an invoice followed by its totals
+func (h *Handler) writeInvoiceCSV(c *gin.Context, inv InvoiceResponse) {
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", invoiceFilename(inv, "csv"))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	w.Write([]string{"kind", "description", "model_id", "quantity", "unit", "unit_price", "amount", "currency"})
+	for _, item := range inv.LineItems {
+		w.Write([]string{
+			item.Kind,
+			item.Description,
+			item.ModelID,
+			strconv.FormatFloat(item.Quantity, 'f', -1, 64),
+			item.Unit,
+			formatUnitPrice(item.UnitPriceMicros),
+			item.Amount,
+			inv.Currency,
+		})
+	}
+	w.Write([]string{"subtotal", "", "", "", "", "", inv.Subtotal, inv.Currency})
+	w.Write([]string{"discount", "", "", "", "", "", inv.Discount, inv.Currency})
+	w.Write([]string{"total", "", "", "", "", "", inv.Total, inv.Currency})
+	w.Flush()
+	if err := w.Error(); err != nil {
+		c.Error(err)
+	}
+}
+
+// writeInvoicePDF renders an invoice as a one or more page PDF
+func (h *Handler) writeInvoicePDF(c *gin.Context, inv InvoiceResponse) {
+	const (
+		left     = 50.0
+		right    = pdf.PageWidth - 50
+		qtyX     = 370.0
+		priceX   = 450.0
+		bottom   = 70.0
+		lineGap  = 16.0
+		fontSize = 9.0
+	)
+
+	doc := pdf.New()
+	doc.AddPage()
+	y := pdf.PageHeight - 60
+
+	doc.Text(left, y, 20, true, "Invoice")
+	status := "DRAFT"
+	if inv.Final {
+		status = "FINAL"
+	}
+	doc.TextRight(right, y, 12, true, status)
+	y -= 30
+	for _, line := range [][2]string{
+		{"Invoice", inv.ID},
+		{"Tenant", inv.TenantID},
+		{"Period", inv.PeriodStart[:10] + " to " + inv.PeriodEnd[:10]},
+		{"Plan", inv.PlanName},
+		{"Generated", inv.GeneratedAt},
+	} {
+		doc.Text(left, y, 10, true, line[0])
+		doc.Text(left+80, y, 10, false, line[1])
+		y -= lineGap
+	}
+
+	header := func() {
+		y -= 10
+		doc.Text(left, y, fontSize, true, "Description")
+		doc.TextRight(qtyX, y, fontSize, true, "Quantity")
+		doc.TextRight(priceX, y, fontSize, true, "Unit price")
+		doc.TextRight(right, y, fontSize, true, "Amount ("+inv.Currency+")")
+		y -= 6
+		doc.Line(left, y, right, y)
+		y -= lineGap
+	}
+	header()
+	for _, item := range inv.LineItems {
+		if y < bottom {
+			doc.AddPage()
+			y = pdf.PageHeight - 60
+			header()
+		}
+		doc.Text(left, y, fontSize, false, truncateText(item.Description, qtyX-left-70, fontSize))
+		if item.Kind != "discount" {
+			doc.TextRight(qtyX, y, fontSize, false, strconv.FormatFloat(item.Quantity, 'f', -1, 64)+" "+item.Unit)
+			doc.TextRight(priceX, y, fontSize, false, formatUnitPrice(item.UnitPriceMicros))
+		}
+		doc.TextRight(right, y, fontSize, false, item.Amount)
+		y -= lineGap
+	}
+	if len(inv.LineItems) == 0 {
+		doc.Text(left, y, fontSize, false, "No billable usage in this period")
+		y -= lineGap
+	}
+
+	if y < bottom+3*lineGap {
+		doc.AddPage()
+		y = pdf.PageHeight - 60
+	}
+	doc.Line(priceX-60, y+lineGap-6, right, y+lineGap-6)
+	for _, total := range [][2]string{
+		{"Subtotal", inv.Subtotal},
+		{"Discount", "-" + inv.Discount},
+		{"Total", inv.Total + " " + inv.Currency},
+	} {
+		doc.TextRight(priceX, y, 10, true, total[0])
+		doc.TextRight(right, y, 10, total[0] == "Total", total[1])
+		y -= lineGap
+	}
+
+	var buf bytes.Buffer
+	if _, err := doc.WriteTo(&buf); err != nil {
+		h.InternalError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", invoiceFilename(inv, "pdf"))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+// truncateText shortens text to fit a width in points
+func truncateText(s string, width, size float64) string {
+	if pdf.TextWidth(s, size) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && pdf.TextWidth(string(runes)+"...", size) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+// formatMoney formats an amount in micros with two decimals
+func formatMoney(micros int64) string {
+	sign := ""
+	if micros < 0 {
+		sign, micros = "-", -micros
+	}
+	cents := (micros + 5000) / 10000
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// formatUnitPrice formats a unit price in micros, keeping sub-cent digits
+func formatUnitPrice(micros int64) string {
+	return strconv.FormatFloat(float64(micros)/1e6, 'f', -1, 64)
+}
+
+// convertPlanRequestToProto converts a pricing plan request to protobuf
+func convertPlanRequestToProto(req PricingPlanRequest) *modelpb.PricingPlan {
+	tiers := make([]*modelpb.DiscountTier, len(req.DiscountTiers))
+	for i, t := range req.DiscountTiers {
+		tiers[i] = &modelpb.DiscountTier{FromMicros: t.FromMicros, Percent: t.Percent}
+	}
+	return &modelpb.PricingPlan{
+		Name:               req.Name,
+		Description:        req.Description,
+		Currency:           req.Currency,
+		RequestPriceMicros: req.RequestPriceMicros,
+		TokenPriceMicros:   req.TokenPriceMicros,
+		StoragePriceMicros: req.StoragePriceMicros,
+		FreeRequests:       req.FreeRequests,
+		FreeTokens:         req.FreeTokens,
+		FreeStorageGbMonth: req.FreeStorageGBMonth,
+		DiscountTiers:      tiers,
+	}
+}
+
+// convertProtoPlanToResponse converts protobuf PricingPlan to HTTP response
+func convertProtoPlanToResponse(p *modelpb.PricingPlan) PricingPlanResponse {
+	tiers := make([]DiscountTierRequest, len(p.DiscountTiers))
+	for i, t := range p.DiscountTiers {
+		tiers[i] = DiscountTierRequest{FromMicros: t.FromMicros, Percent: t.Percent}
+	}
+	return PricingPlanResponse{
+		ID: p.Id,
+		PricingPlanRequest: PricingPlanRequest{
+			Name:               p.Name,
+			Description:        p.Description,
+			Currency:           p.Currency,
+			RequestPriceMicros: p.RequestPriceMicros,
+			TokenPriceMicros:   p.TokenPriceMicros,
+			StoragePriceMicros: p.StoragePriceMicros,
+			FreeRequests:       p.FreeRequests,
+			FreeTokens:         p.FreeTokens,
+			FreeStorageGBMonth: p.FreeStorageGbMonth,
+			DiscountTiers:      tiers,
+		},
+		CreatedAt: p.CreatedAt.AsTime().Format(time.RFC3339),
+		UpdatedAt: p.UpdatedAt.AsTime().Format(time.RFC3339),
+	}
+}
+
+// convertProtoInvoiceToResponse converts protobuf Invoice to HTTP response
+func convertProtoInvoiceToResponse(inv *modelpb.Invoice) InvoiceResponse {
+	items := make([]InvoiceLineItemResponse, len(inv.LineItems))
+	for i, item := range inv.LineItems {
+		items[i] = InvoiceLineItemResponse{
+			Kind:            item.Kind,
+			Description:     item.Description,
+			ModelID:         item.ModelId,
+			Quantity:        item.Quantity,
+			Unit:            item.Unit,
+			UnitPriceMicros: item.UnitPriceMicros,
+			AmountMicros:    item.AmountMicros,
+			Amount:          formatMoney(item.AmountMicros),
+		}
+	}
+	periodStart := inv.PeriodStart.AsTime()
+	return InvoiceResponse{
+		ID:             inv.Id,
+		TenantID:       inv.TenantId,
+		Month:          periodStart.Format("2006-01"),
+		PeriodStart:    periodStart.Format(time.RFC3339),
+		PeriodEnd:      inv.PeriodEnd.AsTime().Format(time.RFC3339),
+		PlanID:         inv.PlanId,
+		PlanName:       inv.PlanName,
+		Currency:       inv.Currency,
+		LineItems:      items,
+		SubtotalMicros: inv.SubtotalMicros,
+		DiscountMicros: inv.DiscountMicros,
+		TotalMicros:    inv.TotalMicros,
+		Subtotal:       formatMoney(inv.SubtotalMicros),
+		Discount:       formatMoney(inv.DiscountMicros),
+		Total:          formatMoney(inv.TotalMicros),
+		Final:          inv.Final,
+		GeneratedAt:    inv.GeneratedAt.AsTime().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,292 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	modelpb "maas-platform/shared/proto"
+)
+
+// RecordEvaluationsRequest represents evaluation results to record against a version
+type RecordEvaluationsRequest struct {
+	Results []EvaluationResultRequest `json:"results" binding:"required,min=1,dive"`
+}
+
+// EvaluationResultRequest represents one evaluation result; evaluated_at defaults to now
+type EvaluationResultRequest struct {
+	Metric      string     `json:"metric" binding:"required"`
+	Value       float64    `json:"value"`
+	Dataset     string     `json:"dataset"`
+	Split       string     `json:"split"`
+	EvaluatedAt *time.Time `json:"evaluated_at"`
+}
+
+// EvaluationResponse represents an evaluation result recorded against a version
+type EvaluationResponse struct {
+	ID          string  `json:"id"`
+	ModelID     string  `json:"model_id"`
+	Version     string  `json:"version"`
+	Metric      string  `json:"metric"`
+	Value       float64 `json:"value"`
+	Dataset     string  `json:"dataset,omitempty"`
+	Split       string  `json:"split,omitempty"`
+	EvaluatedAt string  `json:"evaluated_at"`
+	RecordedBy  string  `json:"recorded_by,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+// PromotionRulesRequest represents the promotion rules of a model
+type PromotionRulesRequest struct {
+	Rules []PromotionRuleRequest `json:"rules" binding:"dive"`
+}
+
+// PromotionRuleRequest represents a rule that blocks promoting a version whose
+// metric regresses by more than max_regression, a fraction when relative is set
+type PromotionRuleRequest struct {
+	Metric        string  `json:"metric" binding:"required"`
+	Dataset       string  `json:"dataset"`
+	Split         string  `json:"split"`
+	Direction     string  `json:"direction" binding:"required,oneof=higher lower"`
+	MaxRegression float64 `json:"max_regression" binding:"min=0"`
+	Relative      bool    `json:"relative"`
+}
+
+// PromotionRuleResponse represents a promotion rule
+type PromotionRuleResponse struct {
+	ID            string  `json:"id"`
+	Metric        string  `json:"metric"`
+	Dataset       string  `json:"dataset,omitempty"`
+	Split         string  `json:"split,omitempty"`
+	Direction     string  `json:"direction"`
+	MaxRegression float64 `json:"max_regression"`
+	Relative      bool    `json:"relative"`
+	CreatedBy     string  `json:"created_by,omitempty"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+// VersionComparisonResponse represents the differences between two versions
+type VersionComparisonResponse struct {
+	ModelID    string               `json:"model_id"`
+	Base       ModelVersionResponse `json:"base"`
+	Target     ModelVersionResponse `json:"target"`
+	SizeDelta  int64                `json:"size_delta"`
+	Metrics    []MetricDiffResponse `json:"metrics"`
+	Signature  []TensorDiffResponse `json:"signature"`
+	Fields     []FieldDiffResponse  `json:"fields"`
+	Rules      []RuleCheckResponse  `json:"rules"`
+	Promotable bool                 `json:"promotable"`
+}
+
+// MetricDiffResponse compares the latest result of a metric in two versions;
+// base or target is absent when that version has no such result
+type MetricDiffResponse struct {
+	Metric        string   `json:"metric"`
+	Dataset       string   `json:"dataset,omitempty"`
+	Split         string   `json:"split,omitempty"`
+	Base          *float64 `json:"base,omitempty"`
+	Target        *float64 `json:"target,omitempty"`
+	Delta         *float64 `json:"delta,omitempty"`
+	RelativeDelta *float64 `json:"relative_delta,omitempty"`
+}
+
+// TensorDiffResponse represents a signature input or output that was added, removed or changed
+type TensorDiffResponse struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Change string `json:"change"`
+	Base   string `json:"base,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
+// FieldDiffResponse represents a version attribute that differs
+type FieldDiffResponse struct {
+	Field  string `json:"field"`
+	Base   string `json:"base"`
+	Target string `json:"target"`
+}
+
+// RuleCheckResponse represents the outcome of a promotion rule
+type RuleCheckResponse struct {
+	Rule    PromotionRuleResponse `json:"rule"`
+	Passed  bool                  `json:"passed"`
+	Message string                `json:"message"`
+}
+
+// RecordEvaluations records evaluation results against a version via gRPC
+func (h *Handler) RecordEvaluations(c *gin.Context) {
+	var req RecordEvaluationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	results := make([]*modelpb.EvaluationResult, len(req.Results))
+	for i, r := range req.Results {
+		results[i] = &modelpb.EvaluationResult{
+			Metric:  r.Metric,
+			Value:   r.Value,
+			Dataset: r.Dataset,
+			Split:   r.Split,
+		}
+		if r.EvaluatedAt != nil {
+			results[i].EvaluatedAt = timestamppb.New(*r.EvaluatedAt)
+		}
+	}
+
+	evaluations, err := h.modelClient.RecordEvaluations(h.rpcContext(c), c.Param("id"), c.Param("version"), results)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, gin.H{"evaluations": convertProtoEvaluationsToResponse(evaluations)})
+}
+
+// ListEvaluations lists the evaluation results of a model, or of one version,
+// newest first via gRPC; metric narrows them to one metric
+func (h *Handler) ListEvaluations(c *gin.Context) {
+	evaluations, err := h.modelClient.ListEvaluations(h.rpcContext(c), c.Param("id"), c.Param("version"), c.Query("metric"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, gin.H{"evaluations": convertProtoEvaluationsToResponse(evaluations)})
+}
+
+// CompareVersions diffs the metrics, signatures, sizes and attributes of two
+// versions via gRPC and checks the promotion rules; without base the target is
+// compared against the current version
+func (h *Handler) CompareVersions(c *gin.Context) {
+	target := c.Query("target")
+	if target == "" {
+		h.BadRequest(c, "target is required")
+		return
+	}
+
+	resp, err := h.modelClient.CompareVersions(h.rpcContext(c), c.Param("id"), c.Query("base"), target)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	cmp := VersionComparisonResponse{
+		ModelID:    resp.ModelId,
+		Base:       convertProtoVersionToResponse(resp.Base),
+		Target:     convertProtoVersionToResponse(resp.Target),
+		SizeDelta:  resp.Target.Size - resp.Base.Size,
+		Metrics:    make([]MetricDiffResponse, len(resp.Metrics)),
+		Signature:  make([]TensorDiffResponse, len(resp.Signature)),
+		Fields:     make([]FieldDiffResponse, len(resp.Fields)),
+		Rules:      make([]RuleCheckResponse, len(resp.Rules)),
+		Promotable: resp.Promotable,
+	}
+	for i, d := range resp.Metrics {
+		cmp.Metrics[i] = MetricDiffResponse{
+			Metric:        d.Metric,
+			Dataset:       d.Dataset,
+			Split:         d.Split,
+			Base:          d.Base,
+			Target:        d.Target,
+			Delta:         d.Delta,
+			RelativeDelta: d.RelativeDelta,
+		}
+	}
+	for i, d := range resp.Signature {
+		cmp.Signature[i] = TensorDiffResponse{Kind: d.Kind, Name: d.Name, Change: d.Change, Base: d.Base, Target: d.Target}
+	}
+	for i, d := range resp.Fields {
+		cmp.Fields[i] = FieldDiffResponse{Field: d.Field, Base: d.Base, Target: d.Target}
+	}
+	for i, r := range resp.Rules {
+		cmp.Rules[i] = RuleCheckResponse{Rule: convertProtoRuleToResponse(r.Rule), Passed: r.Passed, Message: r.Message}
+	}
+
+	h.Success(c, cmp)
+}
+
+// GetPromotionRules gets the promotion rules of a model via gRPC
+func (h *Handler) GetPromotionRules(c *gin.Context) {
+	rules, err := h.modelClient.GetPromotionRules(h.rpcContext(c), c.Param("id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, gin.H{"rules": convertProtoRulesToResponse(rules)})
+}
+
+// SetPromotionRules replaces the promotion rules of a model via gRPC
+func (h *Handler) SetPromotionRules(c *gin.Context) {
+	var req PromotionRulesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	rules := make([]*modelpb.PromotionRule, len(req.Rules))
+	for i, r := range req.Rules {
+		rules[i] = &modelpb.PromotionRule{
+			Metric:        r.Metric,
+			Dataset:       r.Dataset,
+			Split:         r.Split,
+			Direction:     r.Direction,
+			MaxRegression: r.MaxRegression,
+			Relative:      r.Relative,
+		}
+	}
+
+	rules, err := h.modelClient.SetPromotionRules(h.rpcContext(c), c.Param("id"), rules)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, gin.H{"rules": convertProtoRulesToResponse(rules)})
+}
+
+// convertProtoEvaluationsToResponse converts protobuf evaluations to HTTP response
+func convertProtoEvaluationsToResponse(evaluations []*modelpb.Evaluation) []EvaluationResponse {
+	resp := make([]EvaluationResponse, len(evaluations))
+	for i, e := range evaluations {
+		resp[i] = EvaluationResponse{
+			ID:          e.Id,
+			ModelID:     e.ModelId,
+			Version:     e.Version,
+			Metric:      e.Metric,
+			Value:       e.Value,
+			Dataset:     e.Dataset,
+			Split:       e.Split,
+			EvaluatedAt: e.EvaluatedAt.AsTime().Format(time.RFC3339),
+			RecordedBy:  e.RecordedBy,
+			CreatedAt:   e.CreatedAt.AsTime().Format(time.RFC3339),
+		}
+	}
+	return resp
+}
+
+// convertProtoRulesToResponse converts protobuf promotion rules to HTTP response
+func convertProtoRulesToResponse(rules []*modelpb.PromotionRule) []PromotionRuleResponse {
+	resp := make([]PromotionRuleResponse, len(rules))
+	for i, r := range rules {
+		resp[i] = convertProtoRuleToResponse(r)
+	}
+	return resp
+}
+
+// convertProtoRuleToResponse converts a protobuf promotion rule to HTTP response
+func convertProtoRuleToResponse(r *modelpb.PromotionRule) PromotionRuleResponse {
+	return PromotionRuleResponse{
+		ID:            r.Id,
+		Metric:        r.Metric,
+		Dataset:       r.Dataset,
+		Split:         r.Split,
+		Direction:     r.Direction,
+		MaxRegression: r.MaxRegression,
+		Relative:      r.Relative,
+		CreatedBy:     r.CreatedBy,
+		CreatedAt:     r.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+}
@@ -1,30 +1,53 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"maas-platform/api-gateway/internal/auth"
 	"maas-platform/api-gateway/internal/config"
 	"maas-platform/api-gateway/internal/service"
+	rpc "maas-platform/api-gateway/pkg/grpc"
 	"maas-platform/api-gateway/pkg/logger"
 	modelpb "maas-platform/shared/proto"
+	"maas-platform/shared/query"
+	"maas-platform/shared/signature"
 )
 
 // Handler handles HTTP requests
 type Handler struct {
-	config      *config.Config
-	logger      *logger.Logger
-	modelClient *service.ModelServiceClient
+	config        *config.Config
+	logger        *logger.Logger
+	modelClient   *service.ModelServiceClient
+	auditClient   *service.AuditClient
+	webhookClient *service.WebhookClient
+	apiKeyClient  *service.APIKeyClient
+	userClient    *service.UserClient
+	usageClient   *service.UsageClient
+	billingClient *service.BillingClient
+	tokens        *auth.TokenIssuer
+	oidc          *auth.OIDC
 }
 
 // New creates a new handler
-func New(cfg *config.Config, log *logger.Logger, modelClient *service.ModelServiceClient) *Handler {
+func New(cfg *config.Config, log *logger.Logger, modelClient *service.ModelServiceClient, auditClient *service.AuditClient, webhookClient *service.WebhookClient, apiKeyClient *service.APIKeyClient, userClient *service.UserClient, usageClient *service.UsageClient, billingClient *service.BillingClient, tokens *auth.TokenIssuer, oidc *auth.OIDC) *Handler {
 	return &Handler{
-		config:      cfg,
-		logger:      log,
-		modelClient: modelClient,
+		config:        cfg,
+		logger:        log,
+		modelClient:   modelClient,
+		auditClient:   auditClient,
+		webhookClient: webhookClient,
+		apiKeyClient:  apiKeyClient,
+		userClient:    userClient,
+		usageClient:   usageClient,
+		billingClient: billingClient,
+		tokens:        tokens,
+		oidc:          oidc,
 	}
 }
 
@@ -34,6 +57,7 @@ type Response struct {
 	Message   string      `json:"message"`
 	Data      interface{} `json:"data,omitempty"`
 	RequestID string      `json:"request_id,omitempty"`
+	TraceID   string      `json:"trace_id,omitempty"`
 }
 
 // Success returns a successful response
@@ -44,6 +68,7 @@ func (h *Handler) Success(c *gin.Context, data interface{}) {
 		Message:   "success",
 		Data:      data,
 		RequestID: requestID,
+		TraceID:   c.GetString("trace_id"),
 	})
 }
 
@@ -54,6 +79,7 @@ func (h *Handler) Error(c *gin.Context, code int, message string) {
 		Code:      code,
 		Message:   message,
 		RequestID: requestID,
+		TraceID:   c.GetString("trace_id"),
 	})
 }
 
@@ -79,10 +105,53 @@ func (h *Handler) NotFound(c *gin.Context, resource string) {
 
 // InternalError returns a 500 error
 func (h *Handler) InternalError(c *gin.Context, err error) {
-	h.logger.Error("Internal error", "error", err)
+	h.logger.WithTrace(c.Request.Context()).Error("Internal error", "error", err)
 	h.Error(c, http.StatusInternalServerError, "internal server error")
 }
 
+// RPCError translates a gRPC error from a downstream service into an HTTP response
+func (h *Handler) RPCError(c *gin.Context, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		h.InternalError(c, err)
+		return
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		h.BadRequest(c, st.Message())
+	case codes.Unauthenticated:
+		h.Unauthorized(c)
+	case codes.PermissionDenied:
+		h.Forbidden(c)
+	case codes.NotFound:
+		h.Error(c, http.StatusNotFound, st.Message())
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		if h.approvalPending(c, st) {
+			return
+		}
+		h.Error(c, http.StatusConflict, st.Message())
+	case codes.OutOfRange:
+		h.Error(c, http.StatusGone, st.Message())
+	case codes.Unavailable, codes.DeadlineExceeded:
+		h.logger.WithTrace(c.Request.Context()).Error("Downstream service unavailable", "error", err)
+		h.Error(c, http.StatusServiceUnavailable, "service unavailable")
+	default:
+		h.InternalError(c, err)
+	}
+}
+
+// rpcContext returns the request context carrying the caller identity for downstream calls
+func (h *Handler) rpcContext(c *gin.Context) context.Context {
+	return rpc.WithCaller(c.Request.Context(), rpc.Caller{
+		UserID:    c.GetString("user_id"),
+		TenantID:  c.GetString("tenant_id"),
+		Role:      c.GetString("role"),
+		RequestID: c.GetString("request_id"),
+		Scopes:    c.GetStringSlice("scopes"),
+	})
+}
+
 // ModelRequest represents a model upload request
 type ModelRequest struct {
 	Name        string            `json:"name" binding:"required"`
@@ -91,6 +160,8 @@ type ModelRequest struct {
 	Framework   string            `json:"framework" binding:"required"`
 	Tags        []string          `json:"tags"`
 	Metadata    map[string]string `json:"metadata"`
+	// Signature types the inputs that inference requests are checked against
+	Signature *signature.Signature `json:"signature"`
 }
 
 // ModelResponse represents a model response
@@ -106,6 +177,9 @@ type ModelResponse struct {
 	Metadata    map[string]string `json:"metadata"`
 	CreatedAt   string            `json:"created_at"`
 	UpdatedAt   string            `json:"updated_at"`
+	DeletedAt   string            `json:"deleted_at,omitempty"`
+
+	Signature *signature.Signature `json:"signature,omitempty"`
 }
 
 // CreateModel creates a new model via gRPC
@@ -140,11 +214,12 @@ func (h *Handler) CreateModel(c *gin.Context) {
 		OwnerId:     ownerIDStr,
 		TenantId:    tenantIDStr,
 		IsPublic:    false,
+		Signature:   signature.ToProto(req.Signature),
 	}
 
-	model, err := h.modelClient.CreateModel(c.Request.Context(), grpcReq)
+	model, err := h.modelClient.CreateModel(h.rpcContext(c), grpcReq)
 	if err != nil {
-		h.InternalError(c, err)
+		h.RPCError(c, err)
 		return
 	}
 
@@ -156,25 +231,34 @@ func (h *Handler) ListModels(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
 
-	ownerID, _ := c.Get("user_id")
-	ownerIDStr, _ := ownerID.(string)
-
-	grpcReq := &modelpb.ListModelsRequest{
-		Page:    int32(page),
-		Limit:   int32(limit),
-		OwnerId: ownerIDStr,
+	isPublic, err := query.ParseOptionalBool(c.Query("is_public"))
+	if err != nil {
+		h.BadRequest(c, "invalid is_public: "+err.Error())
+		return
 	}
 
-	if framework := c.Query("framework"); framework != "" {
-		grpcReq.Framework = framework
+	// Authenticated callers see their own models unless they pick another scope
+	scope := c.Query("scope")
+	if scope == "" && c.GetString("user_id") != "" {
+		scope = "mine"
 	}
-	if status := c.Query("status"); status != "" {
-		grpcReq.Status = status
+
+	grpcReq := &modelpb.ListModelsRequest{
+		Name:      c.Query("name"),
+		Framework: c.Query("framework"),
+		Status:    c.Query("status"),
+		OwnerId:   c.Query("owner_id"),
+		TenantId:  c.Query("tenant_id"),
+		Tags:      query.ParseList(c.QueryArray("tags")),
+		IsPublic:  isPublic,
+		Scope:     scope,
+		Page:      int32(page),
+		Limit:     int32(limit),
 	}
 
-	models, total, err := h.modelClient.ListModels(c.Request.Context(), grpcReq)
+	models, total, err := h.modelClient.ListModels(h.rpcContext(c), grpcReq)
 	if err != nil {
-		h.InternalError(c, err)
+		h.RPCError(c, err)
 		return
 	}
 
@@ -195,7 +279,7 @@ func (h *Handler) ListModels(c *gin.Context) {
 func (h *Handler) GetModel(c *gin.Context) {
 	id := c.Param("id")
 
-	model, err := h.modelClient.GetModel(c.Request.Context(), id)
+	model, err := h.modelClient.GetModel(h.rpcContext(c), id)
 	if err != nil {
 		h.InternalError(c, err)
 		return
@@ -204,13 +288,19 @@ func (h *Handler) GetModel(c *gin.Context) {
 	h.Success(c, convertProtoModelToResponse(model))
 }
 
-// DeleteModel deletes a model via gRPC
+// DeleteModel deletes a model via gRPC; one that other models were derived
+// from is only deleted with force=true
 func (h *Handler) DeleteModel(c *gin.Context) {
 	id := c.Param("id")
+	force, err := strconv.ParseBool(c.DefaultQuery("force", "false"))
+	if err != nil {
+		h.BadRequest(c, "invalid force parameter")
+		return
+	}
 
-	err := h.modelClient.DeleteModel(c.Request.Context(), id)
+	err = h.modelClient.DeleteModel(h.rpcContext(c), id, force)
 	if err != nil {
-		h.InternalError(c, err)
+		h.RPCError(c, err)
 		return
 	}
 
@@ -242,9 +332,9 @@ func (h *Handler) UpdateModel(c *gin.Context) {
 		IsPublic:    req.IsPublic,
 	}
 
-	model, err := h.modelClient.UpdateModel(c.Request.Context(), grpcReq)
+	model, err := h.modelClient.UpdateModel(h.rpcContext(c), grpcReq)
 	if err != nil {
-		h.InternalError(c, err)
+		h.RPCError(c, err)
 		return
 	}
 
@@ -263,9 +353,9 @@ func (h *Handler) UpdateModelStatus(c *gin.Context) {
 		return
 	}
 
-	model, err := h.modelClient.UpdateModelStatus(c.Request.Context(), id, req.Status)
+	model, err := h.modelClient.UpdateModelStatus(h.rpcContext(c), id, req.Status)
 	if err != nil {
-		h.InternalError(c, err)
+		h.RPCError(c, err)
 		return
 	}
 
@@ -284,7 +374,7 @@ func (h *Handler) AddModelTags(c *gin.Context) {
 		return
 	}
 
-	err := h.modelClient.AddModelTags(c.Request.Context(), id, req.Tags)
+	err := h.modelClient.AddModelTags(h.rpcContext(c), id, req.Tags)
 	if err != nil {
 		h.InternalError(c, err)
 		return
@@ -305,7 +395,7 @@ func (h *Handler) RemoveModelTags(c *gin.Context) {
 		return
 	}
 
-	err := h.modelClient.RemoveModelTags(c.Request.Context(), id, req.Tags)
+	err := h.modelClient.RemoveModelTags(h.rpcContext(c), id, req.Tags)
 	if err != nil {
 		h.InternalError(c, err)
 		return
@@ -326,7 +416,7 @@ func (h *Handler) SetModelMetadata(c *gin.Context) {
 		return
 	}
 
-	err := h.modelClient.SetModelMetadata(c.Request.Context(), id, req.Metadata)
+	err := h.modelClient.SetModelMetadata(h.rpcContext(c), id, req.Metadata)
 	if err != nil {
 		h.InternalError(c, err)
 		return
@@ -339,7 +429,7 @@ func (h *Handler) SetModelMetadata(c *gin.Context) {
 func (h *Handler) GetModelMetadata(c *gin.Context) {
 	id := c.Param("id")
 
-	metadata, err := h.modelClient.GetModelMetadata(c.Request.Context(), id)
+	metadata, err := h.modelClient.GetModelMetadata(h.rpcContext(c), id)
 	if err != nil {
 		h.InternalError(c, err)
 		return
@@ -348,9 +438,170 @@ func (h *Handler) GetModelMetadata(c *gin.Context) {
 	h.Success(c, gin.H{"metadata": metadata})
 }
 
+// ListDeletedModels lists models in the trash via gRPC
+func (h *Handler) ListDeletedModels(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	scope := c.Query("scope")
+	if scope == "" && c.GetString("user_id") != "" {
+		scope = "mine"
+	}
+
+	grpcReq := &modelpb.ListDeletedModelsRequest{
+		Name:     c.Query("name"),
+		OwnerId:  c.Query("owner_id"),
+		TenantId: c.Query("tenant_id"),
+		Scope:    scope,
+		Page:     int32(page),
+		Limit:    int32(limit),
+	}
+
+	models, total, err := h.modelClient.ListDeletedModels(h.rpcContext(c), grpcReq)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	response := make([]ModelResponse, len(models))
+	for i, m := range models {
+		response[i] = convertProtoModelToResponse(m)
+	}
+
+	h.Success(c, gin.H{
+		"models": response,
+		"total":  total,
+		"page":   page,
+		"limit":  limit,
+	})
+}
+
+// RestoreModel restores a model from the trash via gRPC
+func (h *Handler) RestoreModel(c *gin.Context) {
+	id := c.Param("id")
+
+	model, err := h.modelClient.RestoreModel(h.rpcContext(c), id)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoModelToResponse(model))
+}
+
+// PurgeModel permanently deletes a model in the trash via gRPC
+func (h *Handler) PurgeModel(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.modelClient.PurgeModel(h.rpcContext(c), id); err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ModelVersionRequest represents a model version creation request
+type ModelVersionRequest struct {
+	Version     string `json:"version" binding:"required"`
+	Size        int64  `json:"size"`
+	Checksum    string `json:"checksum"`
+	StoragePath string `json:"storage_path"`
+	DockerImage string `json:"docker_image"`
+	ChangeLog   string `json:"change_log"`
+
+	Signature *signature.Signature `json:"signature"`
+}
+
+// ModelVersionResponse represents a model version response
+type ModelVersionResponse struct {
+	ID          string `json:"id"`
+	ModelID     string `json:"model_id"`
+	Version     string `json:"version"`
+	Status      string `json:"status"`
+	Size        int64  `json:"size"`
+	Checksum    string `json:"checksum"`
+	DockerImage string `json:"docker_image"`
+	ChangeLog   string `json:"change_log"`
+	CreatedBy   string `json:"created_by"`
+	CreatedAt   string `json:"created_at"`
+
+	Signature *signature.Signature `json:"signature,omitempty"`
+}
+
+// CreateModelVersion records a new model version via gRPC
+func (h *Handler) CreateModelVersion(c *gin.Context) {
+	var req ModelVersionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	version, err := h.modelClient.CreateModelVersion(h.rpcContext(c), &modelpb.CreateModelVersionRequest{
+		ModelId:     c.Param("id"),
+		Version:     req.Version,
+		Size:        req.Size,
+		Checksum:    req.Checksum,
+		StoragePath: req.StoragePath,
+		DockerImage: req.DockerImage,
+		ChangeLog:   req.ChangeLog,
+		Signature:   signature.ToProto(req.Signature),
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoVersionToResponse(version))
+}
+
+// ListModelVersions lists the versions of a model via gRPC
+func (h *Handler) ListModelVersions(c *gin.Context) {
+	versions, err := h.modelClient.ListModelVersions(h.rpcContext(c), c.Param("id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	resp := make([]ModelVersionResponse, len(versions))
+	for i, v := range versions {
+		resp[i] = convertProtoVersionToResponse(v)
+	}
+
+	h.Success(c, gin.H{"versions": resp})
+}
+
+// PromoteVersion makes a version the current one of its model via gRPC
+func (h *Handler) PromoteVersion(c *gin.Context) {
+	model, err := h.modelClient.PromoteVersion(h.rpcContext(c), c.Param("id"), c.Param("version"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoModelToResponse(model))
+}
+
+// convertProtoVersionToResponse converts protobuf ModelVersion to HTTP response
+func convertProtoVersionToResponse(v *modelpb.ModelVersion) ModelVersionResponse {
+	return ModelVersionResponse{
+		ID:          v.Id,
+		ModelID:     v.ModelId,
+		Version:     v.Version,
+		Status:      v.Status,
+		Size:        v.Size,
+		Checksum:    v.Checksum,
+		DockerImage: v.DockerImage,
+		ChangeLog:   v.ChangeLog,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   v.CreatedAt.AsTime().Format("2006-01-02T15:04:05Z"),
+		Signature:   signature.FromProto(v.Signature),
+	}
+}
+
 // convertProtoModelToResponse converts protobuf Model to HTTP response
 func convertProtoModelToResponse(m *modelpb.Model) ModelResponse {
-	return ModelResponse{
+	resp := ModelResponse{
 		ID:          m.Id,
 		Name:        m.Name,
 		Description: m.Description,
@@ -362,5 +613,10 @@ func convertProtoModelToResponse(m *modelpb.Model) ModelResponse {
 		Metadata:    make(map[string]string),
 		CreatedAt:   m.CreatedAt.AsTime().Format("2006-01-02T15:04:05Z"),
 		UpdatedAt:   m.UpdatedAt.AsTime().Format("2006-01-02T15:04:05Z"),
+		Signature:   signature.FromProto(m.Signature),
+	}
+	if m.DeletedAt != nil {
+		resp.DeletedAt = m.DeletedAt.AsTime().Format("2006-01-02T15:04:05Z")
 	}
+	return resp
 }
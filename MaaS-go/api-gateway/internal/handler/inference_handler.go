@@ -1,12 +1,19 @@
 package handler
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
+
+	"maas-platform/api-gateway/internal/metering"
+	"maas-platform/shared/signature"
 )
 
 // InferenceRequest represents an inference request
 type InferenceRequest struct {
 	ModelID string                 `json:"model_id" binding:"required"`
+	Version string                 `json:"version"`
 	Input   map[string]interface{} `json:"input" binding:"required"`
 }
 
@@ -25,8 +32,37 @@ func (h *Handler) RunInference(c *gin.Context) {
 		h.BadRequest(c, err.Error())
 		return
 	}
+	usage := metering.Annotate(c)
+	usage.ModelID = req.ModelID
+	usage.Version = req.Version
+
+	sig, found, err := h.inputSignature(c, req.ModelID, req.Version)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+	if !found {
+		h.NotFound(c, "model version")
+		return
+	}
+	if sig != nil && len(sig.Inputs) > 0 {
+		input, err := sig.Coerce(req.Input)
+		var verr *signature.ValidationError
+		if errors.As(err, &verr) {
+			c.JSON(http.StatusBadRequest, Response{
+				Code:      http.StatusBadRequest,
+				Message:   verr.Error(),
+				Data:      gin.H{"errors": verr.Errors, "truncated": verr.Truncated},
+				RequestID: c.GetString("request_id"),
+				TraceID:   c.GetString("trace_id"),
+			})
+			return
+		}
+		req.Input = input
+	}
 
 	// TODO: Call inference service
+
 	h.Success(c, InferenceResponse{
 		ModelID:   req.ModelID,
 		Output:    map[string]interface{}{"prediction": "result"},
@@ -34,3 +70,26 @@ func (h *Handler) RunInference(c *gin.Context) {
 		RequestID: c.GetString("request_id"),
 	})
 }
+
+// inputSignature returns the signature of the requested model version, the
+// current one when version is empty; found is false for an unknown version
+func (h *Handler) inputSignature(c *gin.Context, modelID, version string) (*signature.Signature, bool, error) {
+	m, err := h.modelClient.GetModel(h.rpcContext(c), modelID)
+	if err != nil {
+		return nil, false, err
+	}
+	if version == "" || version == m.Version {
+		return signature.FromProto(m.Signature), true, nil
+	}
+
+	versions, err := h.modelClient.ListModelVersions(h.rpcContext(c), modelID)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, v := range versions {
+		if v.Version == version {
+			return signature.FromProto(v.Signature), true, nil
+		}
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	modelpb "maas-platform/shared/proto"
+)
+
+// LineageEdgeRequest represents where a model, or one of its versions, came
+// from: trained_on_dataset names a dataset, the other relations a parent model
+type LineageEdgeRequest struct {
+	Version       string `json:"version"`
+	Relation      string `json:"relation" binding:"required,oneof=derived_from fine_tuned_from quantized_from trained_on_dataset"`
+	ParentModelID string `json:"parent_model_id"`
+	ParentVersion string `json:"parent_version"`
+	DatasetName   string `json:"dataset_name"`
+	DatasetURI    string `json:"dataset_uri"`
+}
+
+// LineageEdgeResponse represents a lineage edge
+type LineageEdgeResponse struct {
+	ID            string `json:"id"`
+	ModelID       string `json:"model_id"`
+	Version       string `json:"version,omitempty"`
+	Relation      string `json:"relation"`
+	ParentModelID string `json:"parent_model_id,omitempty"`
+	ParentVersion string `json:"parent_version,omitempty"`
+	DatasetName   string `json:"dataset_name,omitempty"`
+	DatasetURI    string `json:"dataset_uri,omitempty"`
+	CreatedBy     string `json:"created_by,omitempty"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// LineageNodeResponse represents a model or dataset reached by a lineage query
+type LineageNodeResponse struct {
+	Kind        string `json:"kind"`
+	ModelID     string `json:"model_id,omitempty"`
+	ModelName   string `json:"model_name,omitempty"`
+	Version     string `json:"version,omitempty"`
+	DatasetName string `json:"dataset_name,omitempty"`
+	DatasetURI  string `json:"dataset_uri,omitempty"`
+	Depth       int32  `json:"depth"`
+}
+
+// LineageResponse represents the part of the lineage graph a query reached
+type LineageResponse struct {
+	ModelID   string                `json:"model_id"`
+	Direction string                `json:"direction"`
+	Depth     int32                 `json:"depth"`
+	Nodes     []LineageNodeResponse `json:"nodes"`
+	Edges     []LineageEdgeResponse `json:"edges"`
+	Truncated bool                  `json:"truncated"`
+}
+
+// AddLineageEdge records where a model came from via gRPC
+func (h *Handler) AddLineageEdge(c *gin.Context) {
+	var req LineageEdgeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	edge, err := h.modelClient.AddLineageEdge(h.rpcContext(c), &modelpb.AddLineageEdgeRequest{
+		ModelId:       c.Param("id"),
+		Version:       req.Version,
+		Relation:      req.Relation,
+		ParentModelId: req.ParentModelID,
+		ParentVersion: req.ParentVersion,
+		DatasetName:   req.DatasetName,
+		DatasetUri:    req.DatasetURI,
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoEdgeToResponse(edge))
+}
+
+// RemoveLineageEdge removes a lineage edge of a model via gRPC
+func (h *Handler) RemoveLineageEdge(c *gin.Context) {
+	if err := h.modelClient.RemoveLineageEdge(h.rpcContext(c), c.Param("id"), c.Param("edge_id")); err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetLineage walks the ancestors or descendants of a model via gRPC, following
+// at most depth edges
+func (h *Handler) GetLineage(c *gin.Context) {
+	direction := c.Param("direction")
+	if direction != "ancestors" && direction != "descendants" {
+		h.NotFound(c, "lineage direction")
+		return
+	}
+	depth, err := strconv.ParseInt(c.DefaultQuery("depth", "0"), 10, 32)
+	if err != nil {
+		h.BadRequest(c, "invalid depth parameter")
+		return
+	}
+
+	resp, err := h.modelClient.GetLineage(h.rpcContext(c), c.Param("id"), direction, int32(depth))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	lineage := LineageResponse{
+		ModelID:   resp.ModelId,
+		Direction: resp.Direction,
+		Depth:     resp.Depth,
+		Nodes:     make([]LineageNodeResponse, len(resp.Nodes)),
+		Edges:     make([]LineageEdgeResponse, len(resp.Edges)),
+		Truncated: resp.Truncated,
+	}
+	for i, n := range resp.Nodes {
+		lineage.Nodes[i] = LineageNodeResponse{
+			Kind:        n.Kind,
+			ModelID:     n.ModelId,
+			ModelName:   n.ModelName,
+			Version:     n.Version,
+			DatasetName: n.DatasetName,
+			DatasetURI:  n.DatasetUri,
+			Depth:       n.Depth,
+		}
+	}
+	for i, e := range resp.Edges {
+		lineage.Edges[i] = convertProtoEdgeToResponse(e)
+	}
+
+	h.Success(c, lineage)
+}
+
+// convertProtoEdgeToResponse converts a protobuf lineage edge to HTTP response
+func convertProtoEdgeToResponse(e *modelpb.LineageEdge) LineageEdgeResponse {
+	return LineageEdgeResponse{
+		ID:            e.Id,
+		ModelID:       e.ModelId,
+		Version:       e.Version,
+		Relation:      e.Relation,
+		ParentModelID: e.ParentModelId,
+		ParentVersion: e.ParentVersion,
+		DatasetName:   e.DatasetName,
+		DatasetURI:    e.DatasetUri,
+		CreatedBy:     e.CreatedBy,
+		CreatedAt:     e.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"maas-platform/shared/modelcard"
+	modelpb "maas-platform/shared/proto"
+)
+
+// ModelCardResponse represents the model card of a version
+type ModelCardResponse struct {
+	ModelID   string          `json:"model_id"`
+	ModelName string          `json:"model_name"`
+	Version   string          `json:"version"`
+	Card      *modelcard.Card `json:"card"`
+}
+
+// GetModelCard gets the model card of a version via gRPC as JSON, or rendered
+// with format=markdown or format=html; without a version it is the current one
+func (h *Handler) GetModelCard(c *gin.Context) {
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "markdown" && format != "html" {
+		h.BadRequest(c, "format must be json, markdown or html")
+		return
+	}
+
+	resp, err := h.modelClient.GetModelCard(h.rpcContext(c), c.Param("id"), c.Param("version"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	card := convertProtoCardToResponse(resp)
+	if card.Card == nil {
+		h.NotFound(c, "model card")
+		return
+	}
+	switch format {
+	case "markdown":
+		c.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(card.Card.Markdown(card.ModelName, card.Version)))
+	case "html":
+		page, err := card.Card.HTML(card.ModelName, card.Version)
+		if err != nil {
+			h.InternalError(c, err)
+			return
+		}
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(page))
+	default:
+		h.Success(c, card)
+	}
+}
+
+// SetModelCard replaces the model card of a version via gRPC after checking it
+// against the model card schema; without a version it is the current one
+func (h *Handler) SetModelCard(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+	card, err := modelcard.Parse(body)
+	if errors.Is(err, modelcard.ErrInvalid) {
+		h.BadRequest(c, err.Error())
+		return
+	}
+	if err != nil {
+		h.InternalError(c, err)
+		return
+	}
+
+	resp, err := h.modelClient.SetModelCard(h.rpcContext(c), c.Param("id"), c.Param("version"), modelcard.ToProto(card))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoCardToResponse(resp))
+}
+
+// GetModelCardSchema returns the JSON Schema model cards are validated against
+func (h *Handler) GetModelCardSchema(c *gin.Context) {
+	c.Data(http.StatusOK, "application/schema+json", modelcard.Schema)
+}
+
+// convertProtoCardToResponse converts a protobuf model card to HTTP response
+func convertProtoCardToResponse(resp *modelpb.ModelCardResponse) ModelCardResponse {
+	return ModelCardResponse{
+		ModelID:   resp.ModelId,
+		ModelName: resp.ModelName,
+		Version:   resp.Version,
+		Card:      modelcard.FromProto(resp.Card),
+	}
+}
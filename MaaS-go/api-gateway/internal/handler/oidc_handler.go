@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"maas-platform/api-gateway/internal/auth"
+	modelpb "maas-platform/shared/proto"
+)
+
+const (
+	// oidcStateCookie holds the signed state of a login in progress
+	oidcStateCookie = "maas_oidc_state"
+	// oidcCookiePath limits the state cookie to the OIDC endpoints
+	oidcCookiePath = "/api/v1/auth/oidc"
+)
+
+// OIDCLogin redirects to the identity provider to start single sign-on
+func (h *Handler) OIDCLogin(c *gin.Context) {
+	if h.oidc == nil {
+		h.NotFound(c, "oidc login")
+		return
+	}
+
+	authURL, sealed, err := h.oidc.Begin(c.Request.Context())
+	if err != nil {
+		h.oidcError(c, err)
+		return
+	}
+
+	h.setOIDCState(c, sealed, int(h.oidc.StateTTL().Seconds()))
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// OIDCCallback completes single sign-on, provisions the user and issues a platform token
+func (h *Handler) OIDCCallback(c *gin.Context) {
+	if h.oidc == nil {
+		h.NotFound(c, "oidc login")
+		return
+	}
+
+	sealed, _ := c.Cookie(oidcStateCookie)
+	// The state is single use whatever the outcome
+	h.setOIDCState(c, "", -1)
+
+	if idpErr := c.Query("error"); idpErr != "" {
+		h.logger.Warn("Identity provider rejected login", "error", idpErr, "description", c.Query("error_description"))
+		h.Unauthorized(c)
+		return
+	}
+
+	identity, err := h.oidc.Complete(c.Request.Context(), sealed, c.Query("state"), c.Query("code"))
+	if err != nil {
+		h.oidcError(c, err)
+		return
+	}
+
+	// Login is unauthenticated, so provisioning runs as a trusted internal call
+	user, created, err := h.userClient.Provision(h.rpcContext(c), &modelpb.ProvisionUserRequest{
+		Issuer:        identity.Issuer,
+		Subject:       identity.Subject,
+		Email:         identity.Email,
+		EmailVerified: identity.EmailVerified,
+		Username:      identity.Username,
+		Role:          identity.Role,
+		TenantId:      identity.TenantID,
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	info := UserInfo{
+		ID:       user.Id,
+		Username: user.Username,
+		Email:    user.Email,
+		Role:     user.Role,
+		TenantID: user.TenantId,
+	}
+	token, err := h.tokens.Issue(auth.Identity{UserID: info.ID, TenantID: info.TenantID, Role: info.Role})
+	if err != nil {
+		h.InternalError(c, err)
+		return
+	}
+	h.recordAuthEvent(c, "auth.login", info)
+	h.logger.Info("OIDC login", "user_id", info.ID, "issuer", identity.Issuer, "created", created)
+
+	h.Success(c, LoginResponse{
+		Token:     token,
+		ExpiresIn: int(h.tokens.TTL().Seconds()),
+		User:      info,
+	})
+}
+
+// setOIDCState stores or, with a negative max age, clears the login state cookie
+func (h *Handler) setOIDCState(c *gin.Context, value string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, value, maxAge, oidcCookiePath, "", !h.config.IsDevelopment(), true)
+}
+
+// oidcError translates a single sign-on failure into an HTTP response
+func (h *Handler) oidcError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, auth.ErrInvalidState):
+		h.BadRequest(c, err.Error())
+	case errors.Is(err, auth.ErrNotMapped):
+		h.logger.Warn("OIDC login denied", "error", err)
+		h.Error(c, http.StatusForbidden, err.Error())
+	case errors.Is(err, auth.ErrProviderUnavailable):
+		h.logger.Error("Identity provider unavailable", "error", err)
+		h.Error(c, http.StatusServiceUnavailable, "identity provider unavailable")
+	case errors.Is(err, auth.ErrInvalidToken):
+		h.logger.Warn("OIDC login failed", "error", err)
+		h.Unauthorized(c)
+	default:
+		h.InternalError(c, err)
+	}
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	modelpb "maas-platform/shared/proto"
+)
+
+// UsageResponse represents usage aggregated over one group; dimensions not grouped by are omitted
+type UsageResponse struct {
+	PeriodStart      string  `json:"period_start,omitempty"`
+	TenantID         string  `json:"tenant_id,omitempty"`
+	ModelID          string  `json:"model_id,omitempty"`
+	Version          string  `json:"version,omitempty"`
+	Status           string  `json:"status,omitempty"`
+	Requests         int64   `json:"requests"`
+	AvgLatencyMs     float64 `json:"avg_latency_ms"`
+	MaxLatencyMs     int64   `json:"max_latency_ms"`
+	RequestBytes     int64   `json:"request_bytes"`
+	ResponseBytes    int64   `json:"response_bytes"`
+	PromptTokens     int64   `json:"prompt_tokens"`
+	CompletionTokens int64   `json:"completion_tokens"`
+}
+
+// usageDimensionColumns maps group_by dimensions to their CSV columns
+var usageDimensionColumns = map[string]string{
+	"hour":    "period_start",
+	"day":     "period_start",
+	"month":   "period_start",
+	"tenant":  "tenant_id",
+	"model":   "model_id",
+	"version": "version",
+	"status":  "status",
+}
+
+// GetUsage summarizes metered usage via gRPC as JSON, or as CSV with format=csv
+func (h *Handler) GetUsage(c *gin.Context) {
+	req := &modelpb.ListUsageRequest{
+		TenantId: c.Query("tenant"),
+		ModelId:  c.Query("model"),
+	}
+	for _, dim := range strings.Split(c.Query("group_by"), ",") {
+		if dim = strings.TrimSpace(dim); dim != "" {
+			req.GroupBy = append(req.GroupBy, dim)
+		}
+	}
+	for param, dst := range map[string]**timestamppb.Timestamp{"from": &req.From, "to": &req.To} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			h.BadRequest(c, "invalid "+param+": "+err.Error())
+			return
+		}
+		*dst = timestamppb.New(t)
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		h.BadRequest(c, "format must be json or csv")
+		return
+	}
+
+	items, err := h.usageClient.List(h.rpcContext(c), req)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	response := make([]UsageResponse, len(items))
+	for i, u := range items {
+		response[i] = convertProtoUsageToResponse(u)
+	}
+
+	if format == "csv" {
+		h.writeUsageCSV(c, req.GroupBy, response)
+		return
+	}
+	h.Success(c, gin.H{
+		"items":    response,
+		"group_by": req.GroupBy,
+	})
+}
+
+// writeUsageCSV writes usage as CSV with a column per grouped dimension followed by the totals
+func (h *Handler) writeUsageCSV(c *gin.Context, groupBy []string, rows []UsageResponse) {
+	header := make([]string, 0, len(groupBy)+7)
+	for _, dim := range groupBy {
+		header = append(header, usageDimensionColumns[dim])
+	}
+	header = append(header, "requests", "avg_latency_ms", "max_latency_ms", "request_bytes", "response_bytes", "prompt_tokens", "completion_tokens")
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", `attachment; filename="usage.csv"`)
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	w.Write(header)
+	for _, r := range rows {
+		record := make([]string, 0, len(header))
+		for _, dim := range groupBy {
+			switch usageDimensionColumns[dim] {
+			case "period_start":
+				record = append(record, r.PeriodStart)
+			case "tenant_id":
+				record = append(record, r.TenantID)
+			case "model_id":
+				record = append(record, r.ModelID)
+			case "version":
+				record = append(record, r.Version)
+			case "status":
+				record = append(record, r.Status)
+			}
+		}
+		record = append(record,
+			strconv.FormatInt(r.Requests, 10),
+			strconv.FormatFloat(r.AvgLatencyMs, 'f', 2, 64),
+			strconv.FormatInt(r.MaxLatencyMs, 10),
+			strconv.FormatInt(r.RequestBytes, 10),
+			strconv.FormatInt(r.ResponseBytes, 10),
+			strconv.FormatInt(r.PromptTokens, 10),
+			strconv.FormatInt(r.CompletionTokens, 10),
+		)
+		w.Write(record)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		c.Error(err)
+	}
+}
+
+// convertProtoUsageToResponse converts protobuf UsageSummary to HTTP response
+func convertProtoUsageToResponse(u *modelpb.UsageSummary) UsageResponse {
+	resp := UsageResponse{
+		TenantID:         u.TenantId,
+		ModelID:          u.ModelId,
+		Version:          u.Version,
+		Status:           u.Status,
+		Requests:         u.Requests,
+		MaxLatencyMs:     u.MaxLatencyMs,
+		RequestBytes:     u.RequestBytes,
+		ResponseBytes:    u.ResponseBytes,
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+	}
+	if u.PeriodStart != nil {
+		resp.PeriodStart = u.PeriodStart.AsTime().Format(time.RFC3339)
+	}
+	if u.Requests > 0 {
+		resp.AvgLatencyMs = float64(u.TotalLatencyMs) / float64(u.Requests)
+	}
+	return resp
+}
@@ -1,8 +1,9 @@
 package handler
 
 import (
-
 	"github.com/gin-gonic/gin"
+
+	"maas-platform/api-gateway/internal/auth"
 )
 
 // LoginRequest represents a login request
@@ -24,6 +25,7 @@ type UserInfo struct {
 	Username string `json:"username"`
 	Email    string `json:"email"`
 	Role     string `json:"role"`
+	TenantID string `json:"tenant_id"`
 }
 
 // RegisterRequest represents a registration request
@@ -41,16 +43,31 @@ func (h *Handler) Login(c *gin.Context) {
 		return
 	}
 
-	// TODO: Call user center service for authentication
+	// Login is unauthenticated, so the password check runs as a trusted internal call
+	u, err := h.userClient.Authenticate(h.rpcContext(c), req.Username, req.Password)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+	user := UserInfo{
+		ID:       u.Id,
+		Username: u.Username,
+		Email:    u.Email,
+		Role:     u.Role,
+		TenantID: u.TenantId,
+	}
+
+	token, err := h.tokens.Issue(auth.Identity{UserID: user.ID, TenantID: user.TenantID, Role: user.Role})
+	if err != nil {
+		h.InternalError(c, err)
+		return
+	}
+	h.recordAuthEvent(c, "auth.login", user)
+
 	h.Success(c, LoginResponse{
-		Token:     "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...",
-		ExpiresIn: 86400,
-		User: UserInfo{
-			ID:       "user-123",
-			Username: req.Username,
-			Email:    "user@example.com",
-			Role:     "developer",
-		},
+		Token:     token,
+		ExpiresIn: int(h.tokens.TTL().Seconds()),
+		User:      user,
 	})
 }
 
@@ -63,21 +80,26 @@ func (h *Handler) Register(c *gin.Context) {
 	}
 
 	// TODO: Call user center service for registration
-	h.Success(c, UserInfo{
+	user := UserInfo{
 		ID:       "user-456",
 		Username: req.Username,
 		Email:    req.Email,
 		Role:     "developer",
-	})
+		TenantID: "default",
+	}
+	h.recordAuthEvent(c, "auth.register", user)
+
+	h.Success(c, user)
 }
 
 // GetCurrentUser returns the current user
 func (h *Handler) GetCurrentUser(c *gin.Context) {
-	// TODO: Get user from JWT token
+	// TODO: Load the profile from the user center service
 	h.Success(c, UserInfo{
-		ID:       "user-123",
+		ID:       c.GetString("user_id"),
 		Username: "johndoe",
 		Email:    "john@example.com",
-		Role:     "developer",
+		Role:     c.GetString("role"),
+		TenantID: c.GetString("tenant_id"),
 	})
 }
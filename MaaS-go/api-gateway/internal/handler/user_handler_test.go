@@ -0,0 +1,133 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"maas-platform/api-gateway/internal/auth"
+	"maas-platform/api-gateway/internal/config"
+	"maas-platform/api-gateway/internal/handler"
+	"maas-platform/api-gateway/internal/service"
+	rpc "maas-platform/api-gateway/pkg/grpc"
+	"maas-platform/api-gateway/pkg/logger"
+	modelpb "maas-platform/shared/proto"
+	"maas-platform/shared/servicetoken"
+)
+
+// fakeUsers stands in for the registry user service
+type fakeUsers struct {
+	modelpb.UnimplementedUserServiceServer
+}
+
+func (fakeUsers) AuthenticateUser(ctx context.Context, req *modelpb.AuthenticateUserRequest) (*modelpb.AuthenticateUserResponse, error) {
+	switch {
+	case req.Username == "alice" && req.Password == "alice-password":
+		return &modelpb.AuthenticateUserResponse{User: &modelpb.User{
+			Id:       "0b6f7c1e-5d7a-4a53-9c1b-2f1c3e4d5a6b",
+			Username: "alice",
+			Email:    "alice@example.com",
+			Role:     "viewer",
+			TenantId: "tenant-a",
+		}}, nil
+	case req.Username == "bob" && req.Password == "bob-password":
+		return nil, status.Error(codes.PermissionDenied, "user is disabled")
+	}
+	return nil, status.Error(codes.Unauthenticated, "invalid username or password")
+}
+
+// newLoginHandler serves Login against a fake registry
+func newLoginHandler(t *testing.T, tokens *auth.TokenIssuer) http.Handler {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := grpclib.NewServer()
+	modelpb.RegisterUserServiceServer(srv, fakeUsers{})
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	signer, err := servicetoken.New("login-test-secret", 0)
+	if err != nil {
+		t.Fatalf("signer: %v", err)
+	}
+	client, err := rpc.NewClient([]string{lis.Addr().String()}, rpc.ClientConfig{
+		DefaultTimeout: 5 * time.Second,
+		Signer:         signer,
+	})
+	if err != nil {
+		t.Fatalf("client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	log := logger.New("error")
+	h := handler.New(&config.Config{}, log, nil, service.NewAuditClient(client, log), nil, nil,
+		service.NewUserClient(client, log), nil, nil, tokens, nil)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/login", h.Login)
+	return r
+}
+
+func TestLogin(t *testing.T) {
+	tokens := auth.NewTokenIssuer("login-test-token-secret", time.Hour)
+	h := newLoginHandler(t, tokens)
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{"valid credentials", `{"username":"alice","password":"alice-password"}`, http.StatusOK},
+		{"wrong password", `{"username":"alice","password":"guess"}`, http.StatusUnauthorized},
+		{"unknown user", `{"username":"mallory","password":"alice-password"}`, http.StatusUnauthorized},
+		{"disabled user", `{"username":"bob","password":"bob-password"}`, http.StatusForbidden},
+		{"missing password", `{"username":"alice"}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantCode, rec.Body)
+			}
+			var resp struct {
+				Data *handler.LoginResponse `json:"data"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if tt.wantCode != http.StatusOK {
+				if resp.Data != nil && resp.Data.Token != "" {
+					t.Errorf("failed login returned a token")
+				}
+				return
+			}
+
+			// The token carries the registry's user, not anything the client sent
+			id, err := tokens.Parse(resp.Data.Token)
+			if err != nil {
+				t.Fatalf("parse token: %v", err)
+			}
+			want := auth.Identity{UserID: "0b6f7c1e-5d7a-4a53-9c1b-2f1c3e4d5a6b", TenantID: "tenant-a", Role: "viewer"}
+			if id != want {
+				t.Errorf("token identity = %+v, want %+v", id, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	modelpb "maas-platform/shared/proto"
+	"maas-platform/shared/query"
+)
+
+// watchHeartbeatInterval keeps idle streams alive through proxies
+const watchHeartbeatInterval = 15 * time.Second
+
+// ModelWatchEventResponse represents a model change sent over the watch stream
+type ModelWatchEventResponse struct {
+	Type        string        `json:"type"`
+	EventType   string        `json:"event_type"`
+	Model       ModelResponse `json:"model"`
+	ResumeToken string        `json:"resume_token"`
+	OccurredAt  string        `json:"occurred_at"`
+}
+
+// WatchModels streams model changes as Server-Sent Events.
+// Reconnecting clients resume via the Last-Event-ID header or resume_token.
+func (h *Handler) WatchModels(c *gin.Context) {
+	req := &modelpb.WatchModelsRequest{
+		TenantId:    c.Query("tenant_id"),
+		ModelIds:    query.ParseList(c.QueryArray("model_id")),
+		Names:       c.QueryArray("name"),
+		Tags:        query.ParseList(c.QueryArray("tags")),
+		ResumeToken: c.Query("resume_token"),
+	}
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		req.ResumeToken = lastEventID
+	}
+
+	ctx, cancel := context.WithCancel(h.rpcContext(c))
+	defer cancel()
+
+	// The stream outlives the server write timeout
+	rc := http.NewResponseController(c.Writer)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	events := make(chan *modelpb.ModelWatchEvent)
+	done := make(chan error, 1)
+	go func() {
+		done <- h.modelClient.WatchModels(ctx, req, func(e *modelpb.ModelWatchEvent) error {
+			select {
+			case events <- e:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	started := false
+	start := func() {
+		if started {
+			return
+		}
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+		started = true
+	}
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case err := <-done:
+			if err == nil {
+				return
+			}
+			if !started {
+				h.RPCError(c, err)
+				return
+			}
+			writeSSE(c, "", "error", gin.H{"error": err.Error()})
+			return
+		case e := <-events:
+			start()
+			writeSSE(c, e.ResumeToken, e.Type, convertProtoWatchEventToResponse(e))
+		case <-heartbeat.C:
+			start()
+			fmt.Fprint(c.Writer, ": keepalive\n\n")
+			c.Writer.Flush()
+		}
+	}
+}
+
+// writeSSE writes a single Server-Sent Event and flushes it
+func writeSSE(c *gin.Context, id, event string, data interface{}) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var b strings.Builder
+	if id != "" {
+		fmt.Fprintf(&b, "id: %s\n", id)
+	}
+	fmt.Fprintf(&b, "event: %s\ndata: %s\n\n", event, payload)
+	c.Writer.WriteString(b.String())
+	c.Writer.Flush()
+}
+
+// convertProtoWatchEventToResponse converts a protobuf ModelWatchEvent to HTTP response
+func convertProtoWatchEventToResponse(e *modelpb.ModelWatchEvent) ModelWatchEventResponse {
+	resp := ModelWatchEventResponse{
+		Type:        e.Type,
+		EventType:   e.EventType,
+		ResumeToken: e.ResumeToken,
+		OccurredAt:  e.OccurredAt.AsTime().Format(time.RFC3339),
+	}
+	if e.Model != nil {
+		resp.Model = convertProtoModelToResponse(e.Model)
+	}
+	return resp
+}
@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	modelpb "maas-platform/shared/proto"
+)
+
+// WebhookRequest represents a webhook registration request
+type WebhookRequest struct {
+	URL         string   `json:"url" binding:"required"`
+	EventTypes  []string `json:"event_types"`
+	ModelID     string   `json:"model_id"`
+	Description string   `json:"description"`
+}
+
+// UpdateWebhookRequest represents a webhook update request; omitted fields are unchanged
+type UpdateWebhookRequest struct {
+	URL          *string   `json:"url"`
+	EventTypes   *[]string `json:"event_types"`
+	ModelID      *string   `json:"model_id"`
+	Description  *string   `json:"description"`
+	Active       *bool     `json:"active"`
+	RotateSecret bool      `json:"rotate_secret"`
+}
+
+// WebhookResponse represents a webhook subscription
+type WebhookResponse struct {
+	ID          string   `json:"id"`
+	TenantID    string   `json:"tenant_id"`
+	URL         string   `json:"url"`
+	EventTypes  []string `json:"event_types"`
+	ModelID     string   `json:"model_id,omitempty"`
+	Description string   `json:"description"`
+	Active      bool     `json:"active"`
+	CreatedBy   string   `json:"created_by"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+	// Secret is only returned on creation and rotation
+	Secret string `json:"secret,omitempty"`
+}
+
+// WebhookDeliveryResponse represents a webhook delivery
+type WebhookDeliveryResponse struct {
+	ID            string          `json:"id"`
+	WebhookID     string          `json:"webhook_id"`
+	EventID       string          `json:"event_id"`
+	EventType     string          `json:"event_type"`
+	ModelID       string          `json:"model_id"`
+	Status        string          `json:"status"`
+	Attempts      int32           `json:"attempts"`
+	ResponseCode  int32           `json:"response_code,omitempty"`
+	LastError     string          `json:"last_error,omitempty"`
+	RedeliveryOf  string          `json:"redelivery_of,omitempty"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+	NextAttemptAt string          `json:"next_attempt_at,omitempty"`
+	LastAttemptAt string          `json:"last_attempt_at,omitempty"`
+	DeliveredAt   string          `json:"delivered_at,omitempty"`
+	CreatedAt     string          `json:"created_at"`
+}
+
+// CreateWebhook registers a webhook for the caller's tenant via gRPC
+func (h *Handler) CreateWebhook(c *gin.Context) {
+	var req WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	webhook, secret, err := h.webhookClient.Create(h.rpcContext(c), &modelpb.CreateWebhookRequest{
+		TenantId:    c.GetString("tenant_id"),
+		Url:         req.URL,
+		EventTypes:  req.EventTypes,
+		ModelId:     req.ModelID,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	resp := convertProtoWebhookToResponse(webhook)
+	resp.Secret = secret
+	h.Success(c, resp)
+}
+
+// ListWebhooks lists the caller's webhooks via gRPC
+func (h *Handler) ListWebhooks(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	resp, err := h.webhookClient.List(h.rpcContext(c), &modelpb.ListWebhooksRequest{
+		TenantId: c.Query("tenant_id"),
+		Page:     int32(page),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	webhooks := make([]WebhookResponse, len(resp.Webhooks))
+	for i, w := range resp.Webhooks {
+		webhooks[i] = convertProtoWebhookToResponse(w)
+	}
+
+	h.Success(c, gin.H{
+		"webhooks": webhooks,
+		"total":    resp.Total,
+		"page":     page,
+		"limit":    limit,
+	})
+}
+
+// GetWebhook gets a webhook via gRPC
+func (h *Handler) GetWebhook(c *gin.Context) {
+	webhook, err := h.webhookClient.Get(h.rpcContext(c), c.Param("id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoWebhookToResponse(webhook))
+}
+
+// UpdateWebhook updates a webhook via gRPC
+func (h *Handler) UpdateWebhook(c *gin.Context) {
+	var req UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.BadRequest(c, err.Error())
+		return
+	}
+
+	grpcReq := &modelpb.UpdateWebhookRequest{
+		Id:           c.Param("id"),
+		Url:          req.URL,
+		ModelId:      req.ModelID,
+		Description:  req.Description,
+		Active:       req.Active,
+		RotateSecret: req.RotateSecret,
+	}
+	if req.EventTypes != nil {
+		grpcReq.EventTypes = *req.EventTypes
+		grpcReq.SetEventTypes = true
+	}
+
+	webhook, secret, err := h.webhookClient.Update(h.rpcContext(c), grpcReq)
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	resp := convertProtoWebhookToResponse(webhook)
+	resp.Secret = secret
+	h.Success(c, resp)
+}
+
+// DeleteWebhook deletes a webhook via gRPC
+func (h *Handler) DeleteWebhook(c *gin.Context) {
+	if err := h.webhookClient.Delete(h.rpcContext(c), c.Param("id")); err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries lists the delivery history via gRPC; use status=dead_letter for the dead-letter queue
+func (h *Handler) ListWebhookDeliveries(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	resp, err := h.webhookClient.ListDeliveries(h.rpcContext(c), &modelpb.ListWebhookDeliveriesRequest{
+		WebhookId: c.Param("id"),
+		Status:    c.Query("status"),
+		EventId:   c.Query("event_id"),
+		Page:      int32(page),
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	deliveries := make([]WebhookDeliveryResponse, len(resp.Deliveries))
+	for i, d := range resp.Deliveries {
+		deliveries[i] = convertProtoDeliveryToResponse(d)
+	}
+
+	h.Success(c, gin.H{
+		"deliveries": deliveries,
+		"total":      resp.Total,
+		"page":       page,
+		"limit":      limit,
+	})
+}
+
+// RedeliverWebhook queues a past delivery again via gRPC
+func (h *Handler) RedeliverWebhook(c *gin.Context) {
+	delivery, err := h.webhookClient.Redeliver(h.rpcContext(c), c.Param("delivery_id"))
+	if err != nil {
+		h.RPCError(c, err)
+		return
+	}
+
+	h.Success(c, convertProtoDeliveryToResponse(delivery))
+}
+
+// convertProtoWebhookToResponse converts protobuf Webhook to HTTP response
+func convertProtoWebhookToResponse(w *modelpb.Webhook) WebhookResponse {
+	return WebhookResponse{
+		ID:          w.Id,
+		TenantID:    w.TenantId,
+		URL:         w.Url,
+		EventTypes:  w.EventTypes,
+		ModelID:     w.ModelId,
+		Description: w.Description,
+		Active:      w.Active,
+		CreatedBy:   w.CreatedBy,
+		CreatedAt:   w.CreatedAt.AsTime().Format(time.RFC3339),
+		UpdatedAt:   w.UpdatedAt.AsTime().Format(time.RFC3339),
+	}
+}
+
+// convertProtoDeliveryToResponse converts protobuf WebhookDelivery to HTTP response
+func convertProtoDeliveryToResponse(d *modelpb.WebhookDelivery) WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		ID:           d.Id,
+		WebhookID:    d.WebhookId,
+		EventID:      d.EventId,
+		EventType:    d.EventType,
+		ModelID:      d.ModelId,
+		Status:       d.Status,
+		Attempts:     d.Attempts,
+		ResponseCode: d.ResponseCode,
+		LastError:    d.LastError,
+		RedeliveryOf: d.RedeliveryOf,
+		CreatedAt:    d.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+	if d.Payload != "" {
+		resp.Payload = json.RawMessage(d.Payload)
+	}
+	if d.NextAttemptAt != nil {
+		resp.NextAttemptAt = d.NextAttemptAt.AsTime().Format(time.RFC3339)
+	}
+	if d.LastAttemptAt != nil {
+		resp.LastAttemptAt = d.LastAttemptAt.AsTime().Format(time.RFC3339)
+	}
+	if d.DeliveredAt != nil {
+		resp.DeliveredAt = d.DeliveredAt.AsTime().Format(time.RFC3339)
+	}
+	return resp
+}
@@ -0,0 +1,35 @@
+package metering
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// usageKey holds the usage of a metered call in the gin context
+const usageKey = "usage"
+
+// Usage is what a handler knows about a metered call; calls without a model are not billed
+type Usage struct {
+	ModelID string
+	Version string
+	// Token counts stay zero when the model does not report them
+	PromptTokens     int64
+	CompletionTokens int64
+}
+
+// Begin attaches a fresh usage to the call
+func Begin(c *gin.Context) *Usage {
+	u := &Usage{}
+	c.Set(usageKey, u)
+	return u
+}
+
+// Annotate returns the usage of the call for the handler to fill in. On routes
+// that are not metered it returns a usage that is discarded.
+func Annotate(c *gin.Context) *Usage {
+	if v, ok := c.Get(usageKey); ok {
+		if u, ok := v.(*Usage); ok {
+			return u
+		}
+	}
+	return &Usage{}
+}
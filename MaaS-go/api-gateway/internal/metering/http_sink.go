@@ -0,0 +1,61 @@
+package metering
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPSink posts batches as JSON to an external billing endpoint
+type HTTPSink struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewHTTPSink creates a sink posting to url; a non-empty token is sent as a bearer token
+func NewHTTPSink(url, token string, timeout time.Duration) *HTTPSink {
+	return &HTTPSink{
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Name identifies the sink
+func (s *HTTPSink) Name() string {
+	return "billing"
+}
+
+// Send posts {"records": [...]}; any non-2xx response is a failure
+func (s *HTTPSink) Send(ctx context.Context, records []Record) error {
+	body, err := json.Marshal(map[string][]Record{"records": records})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("billing endpoint returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+// Package metering records billable usage of inference calls and forwards it
+// in batches to pluggable sinks such as the registry rollups or a billing service
+package metering
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"maas-platform/api-gateway/pkg/logger"
+	"maas-platform/api-gateway/pkg/metrics"
+)
+
+// Usage statuses
+const (
+	StatusSuccess     = "success"
+	StatusClientError = "client_error"
+	StatusServerError = "server_error"
+)
+
+// Record is the metering record of a single inference call
+type Record struct {
+	// EventID is generated per call so sinks can drop batches delivered twice
+	EventID          string    `json:"event_id"`
+	TenantID         string    `json:"tenant_id"`
+	UserID           string    `json:"user_id,omitempty"`
+	APIKeyID         string    `json:"api_key_id,omitempty"`
+	ModelID          string    `json:"model_id"`
+	Version          string    `json:"version,omitempty"`
+	Status           string    `json:"status"`
+	LatencyMs        int64     `json:"latency_ms"`
+	RequestBytes     int64     `json:"request_bytes"`
+	ResponseBytes    int64     `json:"response_bytes"`
+	PromptTokens     int64     `json:"prompt_tokens,omitempty"`
+	CompletionTokens int64     `json:"completion_tokens,omitempty"`
+	RequestID        string    `json:"request_id,omitempty"`
+	OccurredAt       time.Time `json:"occurred_at"`
+}
+
+// StatusFor classifies an HTTP status code
+func StatusFor(code int) string {
+	switch {
+	case code >= 500:
+		return StatusServerError
+	case code >= 400:
+		return StatusClientError
+	}
+	return StatusSuccess
+}
+
+// Sink receives batches of metering records
+type Sink interface {
+	// Name identifies the sink in logs and metrics
+	Name() string
+	Send(ctx context.Context, records []Record) error
+}
+
+// Config configures buffering and batching
+type Config struct {
+	// BufferSize caps the records held per sink; beyond it records are dropped
+	BufferSize    int
+	BatchSize     int
+	FlushInterval time.Duration
+	// SendTimeout bounds a single batch delivery
+	SendTimeout time.Duration
+}
+
+// Meter buffers records and delivers them to every sink independently, so a
+// slow or failing sink neither blocks requests nor delays the others
+type Meter struct {
+	cfg    Config
+	queues []*queue
+	logger *logger.Logger
+}
+
+// queue holds the records awaiting delivery to one sink
+type queue struct {
+	sink    Sink
+	records chan Record
+	// pending counts the records taken off the channel but not yet delivered
+	pending atomic.Int64
+}
+
+// depth returns the number of records awaiting delivery
+func (q *queue) depth() int {
+	return len(q.records) + int(q.pending.Load())
+}
+
+// NewMeter creates a meter delivering to the given sinks
+func NewMeter(cfg Config, log *logger.Logger, sinks ...Sink) *Meter {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = 10000
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 500
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = 10 * time.Second
+	}
+	if cfg.SendTimeout <= 0 {
+		cfg.SendTimeout = 10 * time.Second
+	}
+
+	queues := make([]*queue, len(sinks))
+	for i, sink := range sinks {
+		queues[i] = &queue{sink: sink, records: make(chan Record, cfg.BufferSize)}
+		metrics.RegisterQueueDepth("metering_"+sink.Name(), queues[i].depth)
+	}
+	return &Meter{
+		cfg:    cfg,
+		queues: queues,
+		logger: log,
+	}
+}
+
+// Record queues a record for every sink without blocking
+func (m *Meter) Record(r Record) {
+	if r.OccurredAt.IsZero() {
+		r.OccurredAt = time.Now().UTC()
+	}
+	for _, q := range m.queues {
+		select {
+		case q.records <- r:
+		default:
+			metrics.RecordUsageDropped(q.sink.Name(), 1)
+		}
+	}
+}
+
+// Run delivers batches until ctx is done, then flushes what is left
+func (m *Meter) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, q := range m.queues {
+		wg.Add(1)
+		go func(q *queue) {
+			defer wg.Done()
+			m.deliver(ctx, q)
+		}(q)
+	}
+	wg.Wait()
+}
+
+// deliver sends batches to one sink when full or on every flush interval.
+// Failed batches are kept and retried on the next interval; the oldest records
+// go once the buffer is full.
+func (m *Meter) deliver(ctx context.Context, q *queue) {
+	ticker := time.NewTicker(m.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	pending := make([]Record, 0, m.cfg.BatchSize)
+	failing := false
+	flush := func(ctx context.Context) {
+		for len(pending) > 0 {
+			n := len(pending)
+			if n > m.cfg.BatchSize {
+				n = m.cfg.BatchSize
+			}
+			if failing = !m.send(ctx, q.sink, pending[:n]); failing {
+				if over := len(pending) - m.cfg.BufferSize; over > 0 {
+					metrics.RecordUsageDropped(q.sink.Name(), over)
+					pending = append(pending[:0], pending[over:]...)
+				}
+				return
+			}
+			pending = append(pending[:0], pending[n:]...)
+		}
+	}
+
+	for {
+		q.pending.Store(int64(len(pending)))
+		select {
+		case r := <-q.records:
+			pending = append(pending, r)
+			if len(pending) > m.cfg.BufferSize {
+				metrics.RecordUsageDropped(q.sink.Name(), 1)
+				pending = append(pending[:0], pending[1:]...)
+			}
+			if len(pending) >= m.cfg.BatchSize && !failing {
+				flush(ctx)
+			}
+		case <-ticker.C:
+			flush(ctx)
+		case <-ctx.Done():
+			// Drain what was queued before shutdown and make a last attempt
+		drain:
+			for {
+				select {
+				case r := <-q.records:
+					pending = append(pending, r)
+				default:
+					break drain
+				}
+			}
+			flush(context.WithoutCancel(ctx))
+			if len(pending) > 0 {
+				m.logger.Warn("Dropping undelivered usage records", "sink", q.sink.Name(), "records", len(pending))
+				metrics.RecordUsageDropped(q.sink.Name(), len(pending))
+			}
+			return
+		}
+	}
+}
+
+// send delivers one batch and reports whether it succeeded
+func (m *Meter) send(ctx context.Context, sink Sink, batch []Record) bool {
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.SendTimeout)
+	defer cancel()
+
+	err := sink.Send(ctx, batch)
+	metrics.RecordUsageFlush(sink.Name(), err == nil)
+	if err != nil {
+		m.logger.Warn("Failed to deliver usage records", "sink", sink.Name(), "records", len(batch), "error", err)
+		return false
+	}
+	return true
+}
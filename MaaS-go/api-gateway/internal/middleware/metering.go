@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"io"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"maas-platform/api-gateway/internal/metering"
+	"maas-platform/api-gateway/pkg/metrics"
+)
+
+// countingReader counts the bytes read from a request body
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+// Read reads from the body and counts the bytes
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// Meter emits a metering record, and counts the call in the inference metrics,
+// for every call that the handler attributed to a model
+func Meter(meter *metering.Meter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		usage := metering.Begin(c)
+		body := &countingReader{ReadCloser: c.Request.Body}
+		c.Request.Body = body
+
+		c.Next()
+
+		if usage.ModelID == "" {
+			return
+		}
+		status := metering.StatusFor(c.Writer.Status())
+		latency := time.Since(start)
+		metrics.RecordInference(usage.ModelID, status, latency)
+
+		responseBytes := int64(c.Writer.Size())
+		if responseBytes < 0 {
+			responseBytes = 0
+		}
+		meter.Record(metering.Record{
+			EventID:          uuid.New().String(),
+			TenantID:         c.GetString("tenant_id"),
+			UserID:           c.GetString("user_id"),
+			APIKeyID:         c.GetString("api_key_id"),
+			ModelID:          usage.ModelID,
+			Version:          usage.Version,
+			Status:           status,
+			LatencyMs:        latency.Milliseconds(),
+			RequestBytes:     body.n,
+			ResponseBytes:    responseBytes,
+			PromptTokens:     usage.PromptTokens,
+			CompletionTokens: usage.CompletionTokens,
+			RequestID:        c.GetString("request_id"),
+			OccurredAt:       start.UTC(),
+		})
+	}
+}
@@ -59,6 +59,9 @@ func Logger(log *logger.Logger) gin.HandlerFunc {
 			"latency", latency,
 			"request_id", requestID,
 		}
+		if traceID := c.GetString("trace_id"); traceID != "" {
+			fields = append(fields, "trace_id", traceID)
+		}
 
 		if len(c.Errors) > 0 {
 			fields = append(fields, "error", c.Errors.String())
@@ -74,7 +77,7 @@ func CORS() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, X-API-Key, accept, origin, Cache-Control, X-Requested-With")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE, PATCH")
 
 		if c.Request.Method == "OPTIONS" {
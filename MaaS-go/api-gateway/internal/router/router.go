@@ -4,19 +4,25 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"maas-platform/api-gateway/internal/handler"
+	"maas-platform/api-gateway/internal/middleware"
+	"maas-platform/shared/policy"
 )
 
-// RegisterRoutes registers all routes
-func RegisterRoutes(r *gin.RouterGroup, h *handler.Handler) {
+// RegisterRoutes registers all routes; authenticate guards everything but login and
+// registration, and meter records the usage of inference calls
+func RegisterRoutes(r *gin.RouterGroup, h *handler.Handler, authenticate, meter gin.HandlerFunc) {
 	// Auth routes (no authentication required)
 	auth := r.Group("/auth")
 	{
 		auth.POST("/login", h.Login)
 		auth.POST("/register", h.Register)
+		auth.GET("/oidc/login", h.OIDCLogin)
+		auth.GET("/oidc/callback", h.OIDCCallback)
 	}
 
 	// Protected routes
 	protected := r.Group("")
+	protected.Use(authenticate)
 	{
 		// User routes
 		users := protected.Group("/users")
@@ -25,10 +31,13 @@ func RegisterRoutes(r *gin.RouterGroup, h *handler.Handler) {
 		}
 
 		// Model routes
-		models := protected.Group("/models")
+		models := protected.Group("/models", middleware.AuthorizeByMethod(policy.ModelsRead, policy.ModelsWrite))
 		{
 			models.POST("", h.CreateModel)
 			models.GET("", h.ListModels)
+			models.GET("/trash", h.ListDeletedModels)
+			models.GET("/watch", h.WatchModels)
+			models.GET("/card-schema", h.GetModelCardSchema)
 			models.GET("/:id", h.GetModel)
 			models.PUT("/:id", h.UpdateModel)
 			models.DELETE("/:id", h.DeleteModel)
@@ -37,9 +46,86 @@ func RegisterRoutes(r *gin.RouterGroup, h *handler.Handler) {
 			models.DELETE("/:id/tags", h.RemoveModelTags)
 			models.GET("/:id/metadata", h.GetModelMetadata)
 			models.PUT("/:id/metadata", h.SetModelMetadata)
+			models.POST("/:id/restore", h.RestoreModel)
+			models.DELETE("/:id/purge", h.PurgeModel)
+			models.POST("/:id/versions", h.CreateModelVersion)
+			models.GET("/:id/versions", h.ListModelVersions)
+			models.POST("/:id/versions/:version/promote", h.PromoteVersion)
+			models.GET("/:id/card", h.GetModelCard)
+			models.PUT("/:id/card", h.SetModelCard)
+			models.GET("/:id/versions/:version/card", h.GetModelCard)
+			models.PUT("/:id/versions/:version/card", h.SetModelCard)
+			models.POST("/:id/versions/:version/evaluations", h.RecordEvaluations)
+			models.GET("/:id/versions/:version/evaluations", h.ListEvaluations)
+			models.GET("/:id/evaluations", h.ListEvaluations)
+			models.GET("/:id/compare", h.CompareVersions)
+			models.GET("/:id/promotion-rules", h.GetPromotionRules)
+			models.PUT("/:id/promotion-rules", h.SetPromotionRules)
+			models.POST("/:id/lineage", h.AddLineageEdge)
+			models.DELETE("/:id/lineage/:edge_id", h.RemoveLineageEdge)
+			models.GET("/:id/lineage/:direction", h.GetLineage)
+		}
+
+		// Approval routes; only admins change approval policies
+		policies := protected.Group("/approval-policies", middleware.AuthorizeByMethod(policy.ModelsRead, policy.ApprovalsManage))
+		{
+			policies.GET("", h.ListApprovalPolicies)
+			policies.PUT("/:action", h.SetApprovalPolicy)
+			policies.DELETE("/:action", h.DeleteApprovalPolicy)
+		}
+		approvals := protected.Group("", middleware.AuthorizeByMethod(policy.ModelsRead, policy.ModelsWrite))
+		{
+			approvals.GET("/approvals", h.ListApprovalRequests)
+			approvals.GET("/approvals/:id", h.GetApprovalRequest)
+			approvals.POST("/approvals/:id/decision", h.DecideApprovalRequest)
+		}
+
+		// Audit routes
+		audit := protected.Group("/audit", middleware.Authorize(policy.AuditRead))
+		{
+			audit.GET("", h.ListAuditEvents)
+			audit.GET("/export", h.ExportAuditEvents)
+		}
+
+		// Webhook routes
+		webhooks := protected.Group("/webhooks", middleware.Authorize(policy.WebhooksManage))
+		{
+			webhooks.POST("", h.CreateWebhook)
+			webhooks.GET("", h.ListWebhooks)
+			webhooks.GET("/deliveries", h.ListWebhookDeliveries)
+			webhooks.POST("/deliveries/:delivery_id/redeliver", h.RedeliverWebhook)
+			webhooks.GET("/:id", h.GetWebhook)
+			webhooks.PUT("/:id", h.UpdateWebhook)
+			webhooks.DELETE("/:id", h.DeleteWebhook)
+			webhooks.GET("/:id/deliveries", h.ListWebhookDeliveries)
+		}
+
+		// API key routes
+		apiKeys := protected.Group("/api-keys", middleware.Authorize(policy.APIKeysManage))
+		{
+			apiKeys.POST("", h.CreateAPIKey)
+			apiKeys.GET("", h.ListAPIKeys)
+			apiKeys.GET("/:id", h.GetAPIKey)
+			apiKeys.POST("/:id/rotate", h.RotateAPIKey)
+			apiKeys.DELETE("/:id", h.RevokeAPIKey)
+		}
+
+		// Usage routes
+		protected.GET("/usage", middleware.Authorize(policy.UsageRead), h.GetUsage)
+
+		// Billing routes; reading invoices is limited to the caller's tenant
+		billing := protected.Group("/billing", middleware.AuthorizeByMethod(policy.BillingRead, policy.BillingManage))
+		{
+			billing.GET("/plans", h.ListPricingPlans)
+			billing.POST("/plans", h.CreatePricingPlan)
+			billing.PUT("/plans/:id", h.UpdatePricingPlan)
+			billing.PUT("/tenants/:tenant/plan", h.SetTenantPlan)
+			billing.GET("/invoices", h.ListInvoices)
+			billing.POST("/invoices", h.GenerateInvoice)
+			billing.GET("/invoices/:id", h.GetInvoice)
 		}
 
 		// Inference routes
-		protected.POST("/inference", h.RunInference)
+		protected.POST("/inference", middleware.Authorize(policy.InferenceInvoke), meter, h.RunInference)
 	}
 }
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"maas-platform/api-gateway/pkg/grpc"
+	"maas-platform/api-gateway/pkg/logger"
+	modelpb "maas-platform/shared/proto"
+)
+
+// APIKeyClient wraps the gRPC client for API key operations
+type APIKeyClient struct {
+	client *grpc.Client
+	logger *logger.Logger
+	// verified caches successful verifications so each request does not hit the registry
+	verified *expirable.LRU[string, *modelpb.APIKey]
+}
+
+// NewAPIKeyClient creates a new API key client; verifications are cached for verifyTTL, zero disables caching
+func NewAPIKeyClient(client *grpc.Client, verifyTTL time.Duration, logger *logger.Logger) *APIKeyClient {
+	s := &APIKeyClient{
+		client: client,
+		logger: logger,
+	}
+	if verifyTTL > 0 {
+		s.verified = expirable.NewLRU[string, *modelpb.APIKey](10000, nil, verifyTTL)
+	}
+	return s
+}
+
+// Create creates an API key via gRPC and returns it with its secret
+func (s *APIKeyClient) Create(ctx context.Context, req *modelpb.CreateAPIKeyRequest) (*modelpb.APIKey, string, error) {
+	resp, err := s.client.CreateAPIKey(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to create API key via gRPC", "error", err)
+		return nil, "", err
+	}
+	return resp.ApiKey, resp.Secret, nil
+}
+
+// Get gets an API key via gRPC
+func (s *APIKeyClient) Get(ctx context.Context, id string) (*modelpb.APIKey, error) {
+	resp, err := s.client.GetAPIKey(ctx, &modelpb.GetAPIKeyRequest{Id: id})
+	if err != nil {
+		s.logger.Error("Failed to get API key via gRPC", "error", err, "id", id)
+		return nil, err
+	}
+	return resp.ApiKey, nil
+}
+
+// List lists API keys via gRPC
+func (s *APIKeyClient) List(ctx context.Context, req *modelpb.ListAPIKeysRequest) (*modelpb.ListAPIKeysResponse, error) {
+	resp, err := s.client.ListAPIKeys(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list API keys via gRPC", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Rotate replaces the secret of an API key via gRPC and returns the new one
+func (s *APIKeyClient) Rotate(ctx context.Context, id string) (*modelpb.APIKey, string, error) {
+	resp, err := s.client.RotateAPIKey(ctx, &modelpb.RotateAPIKeyRequest{Id: id})
+	if err != nil {
+		s.logger.Error("Failed to rotate API key via gRPC", "error", err, "id", id)
+		return nil, "", err
+	}
+	s.forget()
+	return resp.ApiKey, resp.Secret, nil
+}
+
+// Revoke revokes an API key via gRPC
+func (s *APIKeyClient) Revoke(ctx context.Context, id string) (*modelpb.APIKey, error) {
+	resp, err := s.client.RevokeAPIKey(ctx, &modelpb.RevokeAPIKeyRequest{Id: id})
+	if err != nil {
+		s.logger.Error("Failed to revoke API key via gRPC", "error", err, "id", id)
+		return nil, err
+	}
+	s.forget()
+	return resp.ApiKey, nil
+}
+
+// Verify resolves a secret to its API key; ctx must not carry a caller
+func (s *APIKeyClient) Verify(ctx context.Context, secret string) (*modelpb.APIKey, error) {
+	sum := sha256.Sum256([]byte(secret))
+	key := hex.EncodeToString(sum[:])
+	if s.verified != nil {
+		if k, ok := s.verified.Get(key); ok && (k.ExpiresAt == nil || k.ExpiresAt.AsTime().After(time.Now())) {
+			return k, nil
+		}
+	}
+
+	resp, err := s.client.VerifyAPIKey(ctx, &modelpb.VerifyAPIKeyRequest{Key: secret})
+	if err != nil {
+		return nil, err
+	}
+	if s.verified != nil {
+		s.verified.Add(key, resp.ApiKey)
+	}
+	return resp.ApiKey, nil
+}
+
+// forget drops cached verifications after a key changed; other gateways catch up within the cache TTL
+func (s *APIKeyClient) forget() {
+	if s.verified != nil {
+		s.verified.Purge()
+	}
+}
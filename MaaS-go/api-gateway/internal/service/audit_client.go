@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"maas-platform/api-gateway/pkg/grpc"
+	"maas-platform/api-gateway/pkg/logger"
+	modelpb "maas-platform/shared/proto"
+)
+
+// AuditClient wraps the gRPC client for audit log operations
+type AuditClient struct {
+	client *grpc.Client
+	logger *logger.Logger
+}
+
+// NewAuditClient creates a new audit client
+func NewAuditClient(client *grpc.Client, logger *logger.Logger) *AuditClient {
+	return &AuditClient{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Record records an audit event via gRPC
+func (s *AuditClient) Record(ctx context.Context, req *modelpb.RecordAuditEventRequest) error {
+	err := s.client.RecordAuditEvent(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to record audit event via gRPC", "error", err, "action", req.Action)
+		return err
+	}
+	return nil
+}
+
+// List lists audit events via gRPC
+func (s *AuditClient) List(ctx context.Context, req *modelpb.ListAuditEventsRequest) ([]*modelpb.AuditEvent, int64, error) {
+	resp, err := s.client.ListAuditEvents(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list audit events via gRPC", "error", err)
+		return nil, 0, err
+	}
+	return resp.Events, resp.Total, nil
+}
+
+// Export calls fn for every audit event streamed via gRPC
+func (s *AuditClient) Export(ctx context.Context, req *modelpb.ListAuditEventsRequest, fn func(*modelpb.AuditEvent) error) error {
+	stream, err := s.client.ExportAuditEvents(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to export audit events via gRPC", "error", err)
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			s.logger.Error("Failed to receive audit event via gRPC", "error", err)
+			return err
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+
+	"maas-platform/api-gateway/pkg/grpc"
+	"maas-platform/api-gateway/pkg/logger"
+	modelpb "maas-platform/shared/proto"
+)
+
+// BillingClient wraps the gRPC client for pricing plans and invoices
+type BillingClient struct {
+	client *grpc.Client
+	logger *logger.Logger
+}
+
+// NewBillingClient creates a new billing client
+func NewBillingClient(client *grpc.Client, logger *logger.Logger) *BillingClient {
+	return &BillingClient{
+		client: client,
+		logger: logger,
+	}
+}
+
+// CreatePlan creates a pricing plan via gRPC
+func (s *BillingClient) CreatePlan(ctx context.Context, plan *modelpb.PricingPlan) (*modelpb.PricingPlan, error) {
+	resp, err := s.client.CreatePricingPlan(ctx, &modelpb.CreatePricingPlanRequest{Plan: plan})
+	if err != nil {
+		s.logger.Error("Failed to create pricing plan via gRPC", "error", err)
+		return nil, err
+	}
+	return resp.Plan, nil
+}
+
+// UpdatePlan replaces a pricing plan via gRPC
+func (s *BillingClient) UpdatePlan(ctx context.Context, plan *modelpb.PricingPlan) (*modelpb.PricingPlan, error) {
+	resp, err := s.client.UpdatePricingPlan(ctx, &modelpb.UpdatePricingPlanRequest{Plan: plan})
+	if err != nil {
+		s.logger.Error("Failed to update pricing plan via gRPC", "error", err, "id", plan.Id)
+		return nil, err
+	}
+	return resp.Plan, nil
+}
+
+// ListPlans lists pricing plans via gRPC
+func (s *BillingClient) ListPlans(ctx context.Context) ([]*modelpb.PricingPlan, error) {
+	resp, err := s.client.ListPricingPlans(ctx, &modelpb.ListPricingPlansRequest{})
+	if err != nil {
+		s.logger.Error("Failed to list pricing plans via gRPC", "error", err)
+		return nil, err
+	}
+	return resp.Plans, nil
+}
+
+// SetTenantPlan attaches a pricing plan to a tenant via gRPC
+func (s *BillingClient) SetTenantPlan(ctx context.Context, tenantID, planID string) error {
+	err := s.client.SetTenantPlan(ctx, &modelpb.SetTenantPlanRequest{TenantId: tenantID, PlanId: planID})
+	if err != nil {
+		s.logger.Error("Failed to set tenant plan via gRPC", "error", err, "tenant_id", tenantID)
+	}
+	return err
+}
+
+// GenerateInvoice generates a monthly invoice via gRPC
+func (s *BillingClient) GenerateInvoice(ctx context.Context, req *modelpb.GenerateInvoiceRequest) (*modelpb.Invoice, error) {
+	resp, err := s.client.GenerateInvoice(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to generate invoice via gRPC", "error", err, "tenant_id", req.TenantId)
+		return nil, err
+	}
+	return resp.Invoice, nil
+}
+
+// GetInvoice gets an invoice via gRPC
+func (s *BillingClient) GetInvoice(ctx context.Context, id string) (*modelpb.Invoice, error) {
+	resp, err := s.client.GetInvoice(ctx, &modelpb.GetInvoiceRequest{Id: id})
+	if err != nil {
+		s.logger.Error("Failed to get invoice via gRPC", "error", err, "id", id)
+		return nil, err
+	}
+	return resp.Invoice, nil
+}
+
+// ListInvoices lists invoices via gRPC
+func (s *BillingClient) ListInvoices(ctx context.Context, req *modelpb.ListInvoicesRequest) (*modelpb.ListInvoicesResponse, error) {
+	resp, err := s.client.ListInvoices(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list invoices via gRPC", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"maas-platform/api-gateway/internal/cache"
+	"maas-platform/api-gateway/pkg/grpc"
+	"maas-platform/api-gateway/pkg/metrics"
+	modelpb "maas-platform/shared/proto"
+)
+
+// Cache metric resources
+const (
+	resourceModel     = "model"
+	resourceModelList = "model_list"
+)
+
+// Cached entries start with a marker telling a value from a cached not-found
+const (
+	entryValue    byte = 'v'
+	entryNotFound byte = 'n'
+)
+
+const (
+	// listGenerationKey holds the current list generation; bumping it orphans all cached lists
+	listGenerationKey = "models:list:generation"
+	// cacheFetchTimeout bounds a shared fetch, which outlives the caller that started it
+	cacheFetchTimeout = 10 * time.Second
+	// maxInvalidationBackoff caps the delay between watch reconnects
+	maxInvalidationBackoff = 30 * time.Second
+)
+
+// fetchFunc loads a value from the registry on a cache miss
+type fetchFunc func(ctx context.Context) (proto.Message, error)
+
+// readThrough fills dst from the cache, or from fetch on a miss. Concurrent
+// misses on the same key share a single fetch.
+func (s *ModelServiceClient) readThrough(ctx context.Context, resource, key string, dst proto.Message, fetch fetchFunc) error {
+	if s.cache == nil {
+		msg, err := fetch(ctx)
+		if err != nil {
+			return err
+		}
+		proto.Merge(dst, msg)
+		return nil
+	}
+
+	value, err := s.cache.Get(ctx, key)
+	if err == nil {
+		metrics.RecordCacheResult(resource, true)
+		return decodeEntry(value, dst)
+	}
+	if !errors.Is(err, cache.ErrMiss) {
+		s.logger.Warn("Failed to read from cache", "key", key, "error", err)
+	}
+	metrics.RecordCacheResult(resource, false)
+
+	result := s.group.DoChan(key, func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cacheFetchTimeout)
+		defer cancel()
+		// Entries are filled right after writes evict them and then kept for
+		// the TTL, so they are read from the primary rather than a replica
+		// that may not have the write yet
+		fetchCtx = grpc.WithPrimaryRead(fetchCtx)
+
+		msg, err := fetch(fetchCtx)
+		entry, ttl := []byte{entryNotFound}, s.negativeTTL
+		switch {
+		case err == nil:
+			data, err := proto.Marshal(msg)
+			if err != nil {
+				return nil, err
+			}
+			entry, ttl = append([]byte{entryValue}, data...), s.ttl
+		case status.Code(err) != codes.NotFound:
+			return nil, err
+		}
+
+		if entry[0] == entryValue || ttl > 0 {
+			if err := s.cache.Set(fetchCtx, key, entry, ttl); err != nil {
+				s.logger.Warn("Failed to write to cache", "key", key, "error", err)
+			}
+		}
+		return entry, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case r := <-result:
+		if r.Err != nil {
+			return r.Err
+		}
+		return decodeEntry(r.Val.([]byte), dst)
+	}
+}
+
+// decodeEntry unmarshals a cached entry into dst
+func decodeEntry(entry []byte, dst proto.Message) error {
+	if len(entry) == 0 {
+		return errors.New("empty cache entry")
+	}
+	if entry[0] == entryNotFound {
+		return status.Error(codes.NotFound, "model not found")
+	}
+	return proto.Unmarshal(entry[1:], dst)
+}
+
+// modelKey returns the cache key of a single model
+func modelKey(id string) string {
+	return "models:" + id
+}
+
+// listKey returns the cache key of a list request. The caller identity is
+// part of the key since the registry scopes lists to it.
+func (s *ModelServiceClient) listKey(ctx context.Context, req *modelpb.ListModelsRequest) string {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+
+	h := sha256.New()
+	h.Write(data)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	for _, key := range []string{grpc.MetadataUserID, grpc.MetadataTenantID, grpc.MetadataRole} {
+		h.Write([]byte{0})
+		for _, value := range md.Get(key) {
+			h.Write([]byte(value))
+		}
+	}
+
+	return "models:list:" + s.listGeneration(ctx) + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+// listGeneration returns the current list generation, starting a new one when it is unknown
+func (s *ModelServiceClient) listGeneration(ctx context.Context) string {
+	value, err := s.cache.Get(ctx, listGenerationKey)
+	if err == nil {
+		return string(value)
+	}
+	// An evicted generation must not fall back to one whose lists are stale
+	return s.invalidateLists(ctx)
+}
+
+// invalidate evicts a model and all cached lists
+func (s *ModelServiceClient) invalidate(ctx context.Context, id string) {
+	if s.cache == nil {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+
+	if id != "" {
+		s.group.Forget(modelKey(id))
+		if err := s.cache.Delete(ctx, modelKey(id)); err != nil {
+			s.logger.Warn("Failed to evict cached model", "model_id", id, "error", err)
+		}
+	}
+	s.invalidateLists(ctx)
+}
+
+// invalidateLists starts a new list generation and returns it
+func (s *ModelServiceClient) invalidateLists(ctx context.Context) string {
+	generation := strconv.FormatInt(time.Now().UnixNano(), 36)
+	if err := s.cache.Set(ctx, listGenerationKey, []byte(generation), 0); err != nil {
+		s.logger.Warn("Failed to invalidate cached model lists", "error", err)
+	}
+	return generation
+}
+
+// RunInvalidation evicts cached models as the registry reports changes until ctx is done
+func (s *ModelServiceClient) RunInvalidation(ctx context.Context) {
+	if s.cache == nil {
+		return
+	}
+
+	token := ""
+	backoff := time.Second
+	for {
+		err := s.WatchModels(ctx, &modelpb.WatchModelsRequest{ResumeToken: token}, func(e *modelpb.ModelWatchEvent) error {
+			if e.Model != nil {
+				s.invalidate(ctx, e.Model.Id)
+			}
+			token = e.ResumeToken
+			backoff = time.Second
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code(err) == codes.OutOfRange {
+			// Events were missed; lists are dropped now and models age out with the TTL
+			s.logger.Warn("Model change feed expired, dropping cached lists")
+			token = ""
+			s.invalidateLists(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxInvalidationBackoff {
+			backoff = maxInvalidationBackoff
+		}
+	}
+}
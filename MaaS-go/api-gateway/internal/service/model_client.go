@@ -2,23 +2,42 @@ package service
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"maas-platform/api-gateway/internal/cache"
 	"maas-platform/api-gateway/pkg/grpc"
 	"maas-platform/api-gateway/pkg/logger"
+	"maas-platform/shared/policy"
 	modelpb "maas-platform/shared/proto"
 )
 
-// ModelServiceClient wraps the gRPC client for model operations
+// ModelServiceClient wraps the gRPC client for model operations.
+// GetModel and ListModels read through the cache when one is configured.
 type ModelServiceClient struct {
 	client *grpc.Client
-	logger *logger.Logger
+	cache  cache.Cache
+	// ttl bounds how stale a cached model can get if an invalidation is lost
+	ttl         time.Duration
+	negativeTTL time.Duration
+	group       singleflight.Group
+	logger      *logger.Logger
 }
 
-// NewModelServiceClient creates a new model service client
-func NewModelServiceClient(client *grpc.Client, logger *logger.Logger) *ModelServiceClient {
+// NewModelServiceClient creates a new model service client; a nil cache disables caching
+func NewModelServiceClient(client *grpc.Client, c cache.Cache, ttl, negativeTTL time.Duration, logger *logger.Logger) *ModelServiceClient {
 	return &ModelServiceClient{
-		client: client,
-		logger: logger,
+		client:      client,
+		cache:       c,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		logger:      logger,
 	}
 }
 
@@ -29,22 +48,41 @@ func (s *ModelServiceClient) CreateModel(ctx context.Context, req *modelpb.Creat
 		s.logger.Error("Failed to create model via gRPC", "error", err)
 		return nil, err
 	}
+	s.invalidate(ctx, resp.Model.Id)
 	return resp.Model, nil
 }
 
-// GetModel gets a model by ID via gRPC
+// GetModel gets a model by ID via the cache or gRPC. The entry is shared by
+// all callers, so it is fetched as the gateway and read access checked here.
 func (s *ModelServiceClient) GetModel(ctx context.Context, id string) (*modelpb.Model, error) {
-	resp, err := s.client.GetModel(ctx, &modelpb.GetModelRequest{Id: id})
+	m := &modelpb.Model{}
+	err := s.readThrough(ctx, resourceModel, modelKey(id), m, func(ctx context.Context) (proto.Message, error) {
+		resp, err := s.client.GetModel(grpc.WithoutCaller(ctx), &modelpb.GetModelRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Model, nil
+	})
 	if err != nil {
 		s.logger.Error("Failed to get model via gRPC", "error", err, "id", id)
 		return nil, err
 	}
-	return resp.Model, nil
+
+	if caller, ok := grpc.CallerFromContext(ctx); ok {
+		subject := policy.Subject{UserID: caller.UserID, TenantID: caller.TenantID, Role: caller.Role, Scopes: caller.Scopes}
+		if !policy.CanRead(subject, policy.Resource{OwnerID: m.OwnerId, TenantID: m.TenantId, IsPublic: m.IsPublic}) {
+			return nil, status.Error(codes.PermissionDenied, "forbidden")
+		}
+	}
+	return m, nil
 }
 
-// ListModels lists models via gRPC
+// ListModels lists models via the cache or gRPC
 func (s *ModelServiceClient) ListModels(ctx context.Context, req *modelpb.ListModelsRequest) ([]*modelpb.Model, int64, error) {
-	resp, err := s.client.ListModels(ctx, req)
+	resp := &modelpb.ListModelsResponse{}
+	err := s.readThrough(ctx, resourceModelList, s.listKey(ctx, req), resp, func(ctx context.Context) (proto.Message, error) {
+		return s.client.ListModels(ctx, req)
+	})
 	if err != nil {
 		s.logger.Error("Failed to list models via gRPC", "error", err)
 		return nil, 0, err
@@ -59,16 +97,18 @@ func (s *ModelServiceClient) UpdateModel(ctx context.Context, req *modelpb.Updat
 		s.logger.Error("Failed to update model via gRPC", "error", err, "id", req.Id)
 		return nil, err
 	}
+	s.invalidate(ctx, req.Id)
 	return resp.Model, nil
 }
 
-// DeleteModel deletes a model via gRPC
-func (s *ModelServiceClient) DeleteModel(ctx context.Context, id string) error {
-	err := s.client.DeleteModel(ctx, &modelpb.DeleteModelRequest{Id: id})
+// DeleteModel deletes a model via gRPC; force deletes one other models were derived from
+func (s *ModelServiceClient) DeleteModel(ctx context.Context, id string, force bool) error {
+	err := s.client.DeleteModel(ctx, &modelpb.DeleteModelRequest{Id: id, Force: force})
 	if err != nil {
 		s.logger.Error("Failed to delete model via gRPC", "error", err, "id", id)
 		return err
 	}
+	s.invalidate(ctx, id)
 	return nil
 }
 
@@ -82,6 +122,7 @@ func (s *ModelServiceClient) UpdateModelStatus(ctx context.Context, id string, s
 		s.logger.Error("Failed to update model status via gRPC", "error", err, "id", id)
 		return nil, err
 	}
+	s.invalidate(ctx, id)
 	return resp.Model, nil
 }
 
@@ -95,6 +136,7 @@ func (s *ModelServiceClient) AddModelTags(ctx context.Context, modelID string, t
 		s.logger.Error("Failed to add model tags via gRPC", "error", err, "model_id", modelID)
 		return err
 	}
+	s.invalidate(ctx, modelID)
 	return nil
 }
 
@@ -108,6 +150,7 @@ func (s *ModelServiceClient) RemoveModelTags(ctx context.Context, modelID string
 		s.logger.Error("Failed to remove model tags via gRPC", "error", err, "model_id", modelID)
 		return err
 	}
+	s.invalidate(ctx, modelID)
 	return nil
 }
 
@@ -121,6 +164,7 @@ func (s *ModelServiceClient) SetModelMetadata(ctx context.Context, modelID strin
 		s.logger.Error("Failed to set model metadata via gRPC", "error", err, "model_id", modelID)
 		return err
 	}
+	s.invalidate(ctx, modelID)
 	return nil
 }
 
@@ -135,3 +179,252 @@ func (s *ModelServiceClient) GetModelMetadata(ctx context.Context, modelID strin
 	}
 	return resp.Metadata, nil
 }
+
+// ListDeletedModels lists models in the trash via gRPC
+func (s *ModelServiceClient) ListDeletedModels(ctx context.Context, req *modelpb.ListDeletedModelsRequest) ([]*modelpb.Model, int64, error) {
+	resp, err := s.client.ListDeletedModels(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list deleted models via gRPC", "error", err)
+		return nil, 0, err
+	}
+	return resp.Models, resp.Total, nil
+}
+
+// RestoreModel restores a model from the trash via gRPC
+func (s *ModelServiceClient) RestoreModel(ctx context.Context, id string) (*modelpb.Model, error) {
+	resp, err := s.client.RestoreModel(ctx, &modelpb.RestoreModelRequest{Id: id})
+	if err != nil {
+		s.logger.Error("Failed to restore model via gRPC", "error", err, "id", id)
+		return nil, err
+	}
+	s.invalidate(ctx, id)
+	return resp.Model, nil
+}
+
+// PurgeModel permanently deletes a model in the trash via gRPC
+func (s *ModelServiceClient) PurgeModel(ctx context.Context, id string) error {
+	err := s.client.PurgeModel(ctx, &modelpb.PurgeModelRequest{Id: id})
+	if err != nil {
+		s.logger.Error("Failed to purge model via gRPC", "error", err, "id", id)
+		return err
+	}
+	s.invalidate(ctx, id)
+	return nil
+}
+
+// CreateModelVersion records a new model version via gRPC
+func (s *ModelServiceClient) CreateModelVersion(ctx context.Context, req *modelpb.CreateModelVersionRequest) (*modelpb.ModelVersion, error) {
+	resp, err := s.client.CreateModelVersion(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to create model version via gRPC", "error", err, "model_id", req.ModelId)
+		return nil, err
+	}
+	return resp.Version, nil
+}
+
+// ListModelVersions lists the versions of a model via gRPC
+func (s *ModelServiceClient) ListModelVersions(ctx context.Context, modelID string) ([]*modelpb.ModelVersion, error) {
+	resp, err := s.client.ListModelVersions(ctx, &modelpb.ListModelVersionsRequest{ModelId: modelID})
+	if err != nil {
+		s.logger.Error("Failed to list model versions via gRPC", "error", err, "model_id", modelID)
+		return nil, err
+	}
+	return resp.Versions, nil
+}
+
+// PromoteVersion makes a version the current one of its model via gRPC
+func (s *ModelServiceClient) PromoteVersion(ctx context.Context, modelID, version string) (*modelpb.Model, error) {
+	resp, err := s.client.PromoteVersion(ctx, &modelpb.PromoteVersionRequest{ModelId: modelID, Version: version})
+	if err != nil {
+		s.logger.Error("Failed to promote model version via gRPC", "error", err, "model_id", modelID, "version", version)
+		return nil, err
+	}
+	s.invalidate(ctx, modelID)
+	return resp.Model, nil
+}
+
+// GetModelCard gets the model card of a version via gRPC; an empty version means the current one
+func (s *ModelServiceClient) GetModelCard(ctx context.Context, modelID, version string) (*modelpb.ModelCardResponse, error) {
+	resp, err := s.client.GetModelCard(ctx, &modelpb.GetModelCardRequest{ModelId: modelID, Version: version})
+	if err != nil {
+		s.logger.Error("Failed to get model card via gRPC", "error", err, "model_id", modelID, "version", version)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetModelCard replaces the model card of a version via gRPC; an empty version means the current one
+func (s *ModelServiceClient) SetModelCard(ctx context.Context, modelID, version string, card *modelpb.ModelCard) (*modelpb.ModelCardResponse, error) {
+	resp, err := s.client.SetModelCard(ctx, &modelpb.SetModelCardRequest{ModelId: modelID, Version: version, Card: card})
+	if err != nil {
+		s.logger.Error("Failed to set model card via gRPC", "error", err, "model_id", modelID, "version", version)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RecordEvaluations records evaluation results against a version via gRPC
+func (s *ModelServiceClient) RecordEvaluations(ctx context.Context, modelID, version string, results []*modelpb.EvaluationResult) ([]*modelpb.Evaluation, error) {
+	resp, err := s.client.RecordEvaluations(ctx, &modelpb.RecordEvaluationsRequest{ModelId: modelID, Version: version, Results: results})
+	if err != nil {
+		s.logger.Error("Failed to record evaluations via gRPC", "error", err, "model_id", modelID, "version", version)
+		return nil, err
+	}
+	return resp.Evaluations, nil
+}
+
+// ListEvaluations lists the evaluation results of a model via gRPC; empty filters match all
+func (s *ModelServiceClient) ListEvaluations(ctx context.Context, modelID, version, metric string) ([]*modelpb.Evaluation, error) {
+	resp, err := s.client.ListEvaluations(ctx, &modelpb.ListEvaluationsRequest{ModelId: modelID, Version: version, Metric: metric})
+	if err != nil {
+		s.logger.Error("Failed to list evaluations via gRPC", "error", err, "model_id", modelID)
+		return nil, err
+	}
+	return resp.Evaluations, nil
+}
+
+// CompareVersions diffs two versions of a model via gRPC; an empty base means the current version
+func (s *ModelServiceClient) CompareVersions(ctx context.Context, modelID, base, target string) (*modelpb.CompareVersionsResponse, error) {
+	resp, err := s.client.CompareVersions(ctx, &modelpb.CompareVersionsRequest{ModelId: modelID, Base: base, Target: target})
+	if err != nil {
+		s.logger.Error("Failed to compare model versions via gRPC", "error", err, "model_id", modelID, "base", base, "target", target)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetPromotionRules gets the promotion rules of a model via gRPC
+func (s *ModelServiceClient) GetPromotionRules(ctx context.Context, modelID string) ([]*modelpb.PromotionRule, error) {
+	resp, err := s.client.GetPromotionRules(ctx, &modelpb.GetPromotionRulesRequest{ModelId: modelID})
+	if err != nil {
+		s.logger.Error("Failed to get promotion rules via gRPC", "error", err, "model_id", modelID)
+		return nil, err
+	}
+	return resp.Rules, nil
+}
+
+// SetPromotionRules replaces the promotion rules of a model via gRPC
+func (s *ModelServiceClient) SetPromotionRules(ctx context.Context, modelID string, rules []*modelpb.PromotionRule) ([]*modelpb.PromotionRule, error) {
+	resp, err := s.client.SetPromotionRules(ctx, &modelpb.SetPromotionRulesRequest{ModelId: modelID, Rules: rules})
+	if err != nil {
+		s.logger.Error("Failed to set promotion rules via gRPC", "error", err, "model_id", modelID)
+		return nil, err
+	}
+	return resp.Rules, nil
+}
+
+// AddLineageEdge records where a model came from via gRPC
+func (s *ModelServiceClient) AddLineageEdge(ctx context.Context, req *modelpb.AddLineageEdgeRequest) (*modelpb.LineageEdge, error) {
+	edge, err := s.client.AddLineageEdge(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to add lineage edge via gRPC", "error", err, "model_id", req.ModelId)
+		return nil, err
+	}
+	return edge, nil
+}
+
+// RemoveLineageEdge removes a lineage edge of a model via gRPC
+func (s *ModelServiceClient) RemoveLineageEdge(ctx context.Context, modelID, edgeID string) error {
+	err := s.client.RemoveLineageEdge(ctx, &modelpb.RemoveLineageEdgeRequest{ModelId: modelID, EdgeId: edgeID})
+	if err != nil {
+		s.logger.Error("Failed to remove lineage edge via gRPC", "error", err, "model_id", modelID, "edge_id", edgeID)
+		return err
+	}
+	return nil
+}
+
+// GetLineage walks the ancestors or descendants of a model via gRPC; a zero depth means the default
+func (s *ModelServiceClient) GetLineage(ctx context.Context, modelID, direction string, depth int32) (*modelpb.LineageResponse, error) {
+	resp, err := s.client.GetLineage(ctx, &modelpb.GetLineageRequest{ModelId: modelID, Direction: direction, Depth: depth})
+	if err != nil {
+		s.logger.Error("Failed to get lineage via gRPC", "error", err, "model_id", modelID, "direction", direction)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListApprovalPolicies lists the approval policies of a tenant via gRPC; no tenant means the caller's
+func (s *ModelServiceClient) ListApprovalPolicies(ctx context.Context, tenantID string) ([]*modelpb.ApprovalPolicy, error) {
+	resp, err := s.client.ListApprovalPolicies(ctx, &modelpb.ListApprovalPoliciesRequest{TenantId: tenantID})
+	if err != nil {
+		s.logger.Error("Failed to list approval policies via gRPC", "error", err, "tenant_id", tenantID)
+		return nil, err
+	}
+	return resp.Policies, nil
+}
+
+// SetApprovalPolicy makes an action of a tenant wait for approval via gRPC
+func (s *ModelServiceClient) SetApprovalPolicy(ctx context.Context, req *modelpb.ApprovalPolicy) (*modelpb.ApprovalPolicy, error) {
+	p, err := s.client.SetApprovalPolicy(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to set approval policy via gRPC", "error", err, "tenant_id", req.TenantId, "action", req.Action)
+		return nil, err
+	}
+	return p, nil
+}
+
+// DeleteApprovalPolicy stops an action of a tenant waiting for approval via gRPC
+func (s *ModelServiceClient) DeleteApprovalPolicy(ctx context.Context, tenantID, action string) error {
+	err := s.client.DeleteApprovalPolicy(ctx, &modelpb.DeleteApprovalPolicyRequest{TenantId: tenantID, Action: action})
+	if err != nil {
+		s.logger.Error("Failed to delete approval policy via gRPC", "error", err, "tenant_id", tenantID, "action", action)
+		return err
+	}
+	return nil
+}
+
+// ListApprovalRequests lists approval requests via gRPC
+func (s *ModelServiceClient) ListApprovalRequests(ctx context.Context, req *modelpb.ListApprovalRequestsRequest) (*modelpb.ListApprovalRequestsResponse, error) {
+	resp, err := s.client.ListApprovalRequests(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list approval requests via gRPC", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetApprovalRequest gets an approval request via gRPC
+func (s *ModelServiceClient) GetApprovalRequest(ctx context.Context, id string) (*modelpb.ApprovalRequest, error) {
+	r, err := s.client.GetApprovalRequest(ctx, &modelpb.GetApprovalRequestRequest{Id: id})
+	if err != nil {
+		s.logger.Error("Failed to get approval request via gRPC", "error", err, "id", id)
+		return nil, err
+	}
+	return r, nil
+}
+
+// DecideApprovalRequest approves or rejects a pending approval request via gRPC
+func (s *ModelServiceClient) DecideApprovalRequest(ctx context.Context, id string, approve bool, comment string) (*modelpb.ApprovalRequest, error) {
+	r, err := s.client.DecideApprovalRequest(ctx, &modelpb.DecideApprovalRequestRequest{Id: id, Approve: approve, Comment: comment})
+	if err != nil {
+		s.logger.Error("Failed to decide approval request via gRPC", "error", err, "id", id)
+		return nil, err
+	}
+	return r, nil
+}
+
+// WatchModels calls fn for every model change streamed via gRPC until ctx is done
+func (s *ModelServiceClient) WatchModels(ctx context.Context, req *modelpb.WatchModelsRequest, fn func(*modelpb.ModelWatchEvent) error) error {
+	stream, err := s.client.WatchModels(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to watch models via gRPC", "error", err)
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			s.logger.Error("Failed to receive model event via gRPC", "error", err)
+			return err
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/api-gateway/internal/metering"
+	"maas-platform/api-gateway/pkg/grpc"
+	"maas-platform/api-gateway/pkg/logger"
+	modelpb "maas-platform/shared/proto"
+)
+
+// UsageClient wraps the gRPC client for usage metering; it is also the
+// metering sink that feeds the registry rollups
+type UsageClient struct {
+	client *grpc.Client
+	logger *logger.Logger
+}
+
+// NewUsageClient creates a new usage client
+func NewUsageClient(client *grpc.Client, logger *logger.Logger) *UsageClient {
+	return &UsageClient{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Name identifies the client as a metering sink
+func (s *UsageClient) Name() string {
+	return "registry"
+}
+
+// Send adds metering records to the registry rollups as a trusted call
+func (s *UsageClient) Send(ctx context.Context, records []metering.Record) error {
+	req := &modelpb.RecordUsageRequest{Records: make([]*modelpb.UsageRecord, len(records))}
+	for i, r := range records {
+		req.Records[i] = &modelpb.UsageRecord{
+			EventId:          r.EventID,
+			TenantId:         r.TenantID,
+			UserId:           r.UserID,
+			ApiKeyId:         r.APIKeyID,
+			ModelId:          r.ModelID,
+			Version:          r.Version,
+			Status:           r.Status,
+			LatencyMs:        r.LatencyMs,
+			RequestBytes:     r.RequestBytes,
+			ResponseBytes:    r.ResponseBytes,
+			PromptTokens:     r.PromptTokens,
+			CompletionTokens: r.CompletionTokens,
+			RequestId:        r.RequestID,
+			OccurredAt:       timestamppb.New(r.OccurredAt),
+		}
+	}
+
+	resp, err := s.client.RecordUsage(grpc.WithoutCaller(ctx), req)
+	if err != nil {
+		return fmt.Errorf("failed to record usage via gRPC: %w", err)
+	}
+	if skipped := len(records) - int(resp.Accepted); skipped > 0 {
+		s.logger.Warn("Registry skipped usage records", "skipped", skipped)
+	}
+	return nil
+}
+
+// List summarizes usage via gRPC
+func (s *UsageClient) List(ctx context.Context, req *modelpb.ListUsageRequest) ([]*modelpb.UsageSummary, error) {
+	resp, err := s.client.ListUsage(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list usage via gRPC", "error", err)
+		return nil, err
+	}
+	return resp.Items, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"maas-platform/api-gateway/pkg/grpc"
+	"maas-platform/api-gateway/pkg/logger"
+	modelpb "maas-platform/shared/proto"
+)
+
+// UserClient wraps the gRPC client for user operations
+type UserClient struct {
+	client *grpc.Client
+	logger *logger.Logger
+}
+
+// NewUserClient creates a new user client
+func NewUserClient(client *grpc.Client, logger *logger.Logger) *UserClient {
+	return &UserClient{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Provision finds or creates the user of an external identity via gRPC; ctx must not carry a caller
+func (s *UserClient) Provision(ctx context.Context, req *modelpb.ProvisionUserRequest) (*modelpb.User, bool, error) {
+	resp, err := s.client.ProvisionUser(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to provision user via gRPC", "error", err, "issuer", req.Issuer, "subject", req.Subject)
+		return nil, false, err
+	}
+	return resp.User, resp.Created, nil
+}
+
+// Authenticate checks the password of a local user via gRPC; ctx must not carry a caller
+func (s *UserClient) Authenticate(ctx context.Context, username, password string) (*modelpb.User, error) {
+	resp, err := s.client.AuthenticateUser(ctx, &modelpb.AuthenticateUserRequest{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		s.logger.Warn("Failed to authenticate user via gRPC", "error", err, "username", username)
+		return nil, err
+	}
+	return resp.User, nil
+}
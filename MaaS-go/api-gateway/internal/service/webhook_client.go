@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+
+	"maas-platform/api-gateway/pkg/grpc"
+	"maas-platform/api-gateway/pkg/logger"
+	modelpb "maas-platform/shared/proto"
+)
+
+// WebhookClient wraps the gRPC client for webhook subscription operations
+type WebhookClient struct {
+	client *grpc.Client
+	logger *logger.Logger
+}
+
+// NewWebhookClient creates a new webhook client
+func NewWebhookClient(client *grpc.Client, logger *logger.Logger) *WebhookClient {
+	return &WebhookClient{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Create registers a webhook via gRPC and returns it with its signing secret
+func (s *WebhookClient) Create(ctx context.Context, req *modelpb.CreateWebhookRequest) (*modelpb.Webhook, string, error) {
+	resp, err := s.client.CreateWebhook(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to create webhook via gRPC", "error", err)
+		return nil, "", err
+	}
+	return resp.Webhook, resp.Secret, nil
+}
+
+// Get gets a webhook via gRPC
+func (s *WebhookClient) Get(ctx context.Context, id string) (*modelpb.Webhook, error) {
+	resp, err := s.client.GetWebhook(ctx, &modelpb.GetWebhookRequest{Id: id})
+	if err != nil {
+		s.logger.Error("Failed to get webhook via gRPC", "error", err, "id", id)
+		return nil, err
+	}
+	return resp.Webhook, nil
+}
+
+// List lists webhooks via gRPC
+func (s *WebhookClient) List(ctx context.Context, req *modelpb.ListWebhooksRequest) (*modelpb.ListWebhooksResponse, error) {
+	resp, err := s.client.ListWebhooks(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list webhooks via gRPC", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Update updates a webhook via gRPC; the secret is set only when it was rotated
+func (s *WebhookClient) Update(ctx context.Context, req *modelpb.UpdateWebhookRequest) (*modelpb.Webhook, string, error) {
+	resp, err := s.client.UpdateWebhook(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to update webhook via gRPC", "error", err, "id", req.Id)
+		return nil, "", err
+	}
+	return resp.Webhook, resp.Secret, nil
+}
+
+// Delete deletes a webhook via gRPC
+func (s *WebhookClient) Delete(ctx context.Context, id string) error {
+	if err := s.client.DeleteWebhook(ctx, &modelpb.DeleteWebhookRequest{Id: id}); err != nil {
+		s.logger.Error("Failed to delete webhook via gRPC", "error", err, "id", id)
+		return err
+	}
+	return nil
+}
+
+// ListDeliveries lists webhook deliveries via gRPC
+func (s *WebhookClient) ListDeliveries(ctx context.Context, req *modelpb.ListWebhookDeliveriesRequest) (*modelpb.ListWebhookDeliveriesResponse, error) {
+	resp, err := s.client.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		s.logger.Error("Failed to list webhook deliveries via gRPC", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Redeliver queues a past delivery again via gRPC
+func (s *WebhookClient) Redeliver(ctx context.Context, deliveryID string) (*modelpb.WebhookDelivery, error) {
+	resp, err := s.client.RedeliverWebhook(ctx, &modelpb.RedeliverWebhookRequest{DeliveryId: deliveryID})
+	if err != nil {
+		s.logger.Error("Failed to redeliver webhook via gRPC", "error", err, "delivery_id", deliveryID)
+		return nil, err
+	}
+	return resp.Delivery, nil
+}
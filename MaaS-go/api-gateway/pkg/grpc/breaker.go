@@ -0,0 +1,180 @@
+package grpc
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned while the circuit breaker rejects calls
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerState is the state of a circuit breaker
+type BreakerState int
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerHalfOpen
+	BreakerOpen
+)
+
+// String returns the state name
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerHalfOpen:
+		return "half_open"
+	case BreakerOpen:
+		return "open"
+	}
+	return "unknown"
+}
+
+// CallOutcome is what a call guarded by a breaker tells about the server
+type CallOutcome int
+
+const (
+	// CallSucceeded means the server handled the call
+	CallSucceeded CallOutcome = iota
+	// CallFailed means the server looked unhealthy
+	CallFailed
+	// CallAbandoned means the caller gave up first, which says nothing
+	// about the server
+	CallAbandoned
+)
+
+// BreakerConfig configures a circuit breaker
+type BreakerConfig struct {
+	Enabled bool
+	// FailureThreshold is the number of consecutive failures that opens the breaker
+	FailureThreshold int
+	// OpenTimeout is how long the breaker stays open before probing
+	OpenTimeout time.Duration
+	// HalfOpenRequests is the number of probes that must succeed to close the breaker
+	HalfOpenRequests int
+}
+
+// Breaker is a consecutive-failure circuit breaker. After OpenTimeout an
+// open breaker lets HalfOpenRequests probes through; one failed probe opens
+// it again and all of them succeeding closes it.
+type Breaker struct {
+	name     string
+	cfg      BreakerConfig
+	onChange func(name string, state BreakerState)
+
+	mu        sync.Mutex
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	probes    int
+	successes int
+}
+
+// NewBreaker creates a closed circuit breaker; onChange may be nil
+func NewBreaker(name string, cfg BreakerConfig, onChange func(name string, state BreakerState)) *Breaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 5
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = 30 * time.Second
+	}
+	if cfg.HalfOpenRequests <= 0 {
+		cfg.HalfOpenRequests = 1
+	}
+	b := &Breaker{name: name, cfg: cfg, onChange: onChange}
+	if onChange != nil {
+		onChange(name, BreakerClosed)
+	}
+	return b
+}
+
+// Name returns the breaker name
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State returns the current state
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refresh(time.Now())
+	return b.state
+}
+
+// Allow reserves a call; done must be called with its outcome
+func (b *Breaker) Allow() (done func(outcome CallOutcome), err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refresh(time.Now())
+	switch b.state {
+	case BreakerOpen:
+		return nil, ErrCircuitOpen
+	case BreakerHalfOpen:
+		if b.probes >= b.cfg.HalfOpenRequests {
+			return nil, ErrCircuitOpen
+		}
+		b.probes++
+		return func(outcome CallOutcome) { b.finish(outcome, true) }, nil
+	}
+	return func(outcome CallOutcome) { b.finish(outcome, false) }, nil
+}
+
+// finish records the outcome of a call. An abandoned call only gives its
+// probe slot back.
+func (b *Breaker) finish(outcome CallOutcome, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe && b.state == BreakerHalfOpen {
+		b.probes--
+		switch outcome {
+		case CallFailed:
+			b.setState(BreakerOpen)
+		case CallSucceeded:
+			if b.successes++; b.successes >= b.cfg.HalfOpenRequests {
+				b.setState(BreakerClosed)
+			}
+		}
+		return
+	}
+
+	if b.state != BreakerClosed {
+		return
+	}
+	switch outcome {
+	case CallAbandoned:
+		return
+	case CallSucceeded:
+		b.failures = 0
+		return
+	}
+	if b.failures++; b.failures >= b.cfg.FailureThreshold {
+		b.setState(BreakerOpen)
+	}
+}
+
+// refresh moves an open breaker to half-open once the open timeout passed
+func (b *Breaker) refresh(now time.Time) {
+	if b.state == BreakerOpen && now.Sub(b.openedAt) >= b.cfg.OpenTimeout {
+		b.setState(BreakerHalfOpen)
+	}
+}
+
+// setState switches state and resets the counters
+func (b *Breaker) setState(state BreakerState) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	b.failures = 0
+	b.probes = 0
+	b.successes = 0
+	if state == BreakerOpen {
+		b.openedAt = time.Now()
+	}
+	if b.onChange != nil {
+		b.onChange(b.name, state)
+	}
+}
@@ -0,0 +1,117 @@
+package grpc_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	rpc "maas-platform/api-gateway/pkg/grpc"
+)
+
+const testOpenTimeout = 10 * time.Millisecond
+
+// newOpenBreaker returns a breaker that one failure opens
+func newOpenBreaker(t *testing.T, halfOpenRequests int) *rpc.Breaker {
+	t.Helper()
+	b := rpc.NewBreaker("registry", rpc.BreakerConfig{
+		Enabled:          true,
+		FailureThreshold: 1,
+		OpenTimeout:      testOpenTimeout,
+		HalfOpenRequests: halfOpenRequests,
+	}, nil)
+	call(t, b, rpc.CallFailed)
+	if got := b.State(); got != rpc.BreakerOpen {
+		t.Fatalf("state after failure = %v, want open", got)
+	}
+	return b
+}
+
+// call makes one call through the breaker with the given outcome
+func call(t *testing.T, b *rpc.Breaker, outcome rpc.CallOutcome) {
+	t.Helper()
+	done, err := b.Allow()
+	if err != nil {
+		t.Fatalf("Allow: %v", err)
+	}
+	done(outcome)
+}
+
+func TestBreakerClosed(t *testing.T) {
+	tests := []struct {
+		name     string
+		outcomes []rpc.CallOutcome
+		want     rpc.BreakerState
+	}{
+		{"failures below the threshold", []rpc.CallOutcome{rpc.CallFailed, rpc.CallFailed}, rpc.BreakerClosed},
+		{"consecutive failures", []rpc.CallOutcome{rpc.CallFailed, rpc.CallFailed, rpc.CallFailed}, rpc.BreakerOpen},
+		{"success resets the streak", []rpc.CallOutcome{rpc.CallFailed, rpc.CallFailed, rpc.CallSucceeded, rpc.CallFailed}, rpc.BreakerClosed},
+		{"abandoned calls keep the streak", []rpc.CallOutcome{rpc.CallFailed, rpc.CallFailed, rpc.CallAbandoned, rpc.CallFailed}, rpc.BreakerOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := rpc.NewBreaker("registry", rpc.BreakerConfig{Enabled: true, FailureThreshold: 3, OpenTimeout: time.Hour}, nil)
+			for _, outcome := range tt.outcomes {
+				call(t, b, outcome)
+			}
+			if got := b.State(); got != tt.want {
+				t.Errorf("state = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBreakerOpenRejectsCalls(t *testing.T) {
+	b := rpc.NewBreaker("registry", rpc.BreakerConfig{Enabled: true, FailureThreshold: 1, OpenTimeout: time.Hour}, nil)
+	call(t, b, rpc.CallFailed)
+	if _, err := b.Allow(); !errors.Is(err, rpc.ErrCircuitOpen) {
+		t.Errorf("Allow on an open breaker = %v, want %v", err, rpc.ErrCircuitOpen)
+	}
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name     string
+		probes   int
+		outcomes []rpc.CallOutcome
+		want     rpc.BreakerState
+	}{
+		{"successful probe", 1, []rpc.CallOutcome{rpc.CallSucceeded}, rpc.BreakerClosed},
+		{"failed probe", 1, []rpc.CallOutcome{rpc.CallFailed}, rpc.BreakerOpen},
+		{"abandoned probe", 1, []rpc.CallOutcome{rpc.CallAbandoned}, rpc.BreakerHalfOpen},
+		{"abandoned probe then success", 1, []rpc.CallOutcome{rpc.CallAbandoned, rpc.CallSucceeded}, rpc.BreakerClosed},
+		{"too few successes", 2, []rpc.CallOutcome{rpc.CallSucceeded}, rpc.BreakerHalfOpen},
+		{"abandoned probe is not a success", 2, []rpc.CallOutcome{rpc.CallSucceeded, rpc.CallAbandoned}, rpc.BreakerHalfOpen},
+		{"enough successes", 2, []rpc.CallOutcome{rpc.CallSucceeded, rpc.CallAbandoned, rpc.CallSucceeded}, rpc.BreakerClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newOpenBreaker(t, tt.probes)
+			time.Sleep(testOpenTimeout)
+			for _, outcome := range tt.outcomes {
+				call(t, b, outcome)
+			}
+			if got := b.State(); got != tt.want {
+				t.Errorf("state = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBreakerHalfOpenLimitsProbes(t *testing.T) {
+	b := newOpenBreaker(t, 1)
+	time.Sleep(testOpenTimeout)
+
+	done, err := b.Allow()
+	if err != nil {
+		t.Fatalf("Allow probe: %v", err)
+	}
+	if _, err := b.Allow(); !errors.Is(err, rpc.ErrCircuitOpen) {
+		t.Errorf("Allow while the probe is out = %v, want %v", err, rpc.ErrCircuitOpen)
+	}
+
+	// An abandoned probe gives its slot back
+	done(rpc.CallAbandoned)
+	if _, err := b.Allow(); err != nil {
+		t.Errorf("Allow after an abandoned probe: %v", err)
+	}
+}
@@ -5,43 +5,129 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // client-side health checking
 	"google.golang.org/grpc/keepalive"
 
 	modelpb "maas-platform/shared/proto"
+	"maas-platform/shared/servicetoken"
 )
 
-// Client wraps the gRPC model client
+// Client wraps the gRPC model, audit, webhook, API key, user, usage and billing clients
 type Client struct {
-	conn   *grpc.ClientConn
-	client modelpb.ModelServiceClient
+	conn    *grpc.ClientConn
+	client  modelpb.ModelServiceClient
+	audit   modelpb.AuditServiceClient
+	webhook modelpb.WebhookServiceClient
+	apiKey  modelpb.APIKeyServiceClient
+	user    modelpb.UserServiceClient
+	usage   modelpb.UsageServiceClient
+	billing modelpb.BillingServiceClient
+	breaker *Breaker
 }
 
-// NewClient creates a new gRPC client
-func NewClient(address string) (*Client, error) {
+// ClientConfig holds balancing, deadline, retry and circuit breaker settings
+type ClientConfig struct {
+	// Resolver is dns or static; empty picks dns for one endpoint and static for several
+	Resolver string
+	// LoadBalancing is round_robin, least_request or pick_first
+	LoadBalancing string
+	// HealthCheck ejects replicas that report NOT_SERVING on the gRPC health service
+	HealthCheck bool
+
+	// DefaultTimeout applies to unary calls without a per-method timeout
+	DefaultTimeout time.Duration
+	// Timeouts overrides the timeout per method name, e.g. ListModels
+	Timeouts map[string]time.Duration
+	Retry    RetryConfig
+	Breaker  BreakerConfig
+	// OnBreakerStateChange is notified of breaker transitions
+	OnBreakerStateChange func(name string, state BreakerState)
+	// Signer authenticates every call, and the identity it forwards, to the registry
+	Signer *servicetoken.Signer
+	// Interceptors run outermost, so they see breaker rejections and deadlines
+	UnaryInterceptors  []grpc.UnaryClientInterceptor
+	StreamInterceptors []grpc.StreamClientInterceptor
+}
+
+// NewClient creates a new gRPC client balancing across the registry endpoints
+func NewClient(endpoints []string, cfg ClientConfig) (*Client, error) {
+	if cfg.Signer == nil {
+		return nil, fmt.Errorf("a service token signer is required")
+	}
+	target, opts, err := dialTarget(endpoints, cfg.Resolver)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set up connection options
-	opts := []grpc.DialOption{
+	opts = append(opts,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time:                10 * time.Second,
 			Timeout:             20 * time.Second,
 			PermitWithoutStream: true,
 		}),
+		// One client span per attempt, carrying traceparent to the registry
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+
+	sc, err := serviceConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build service config: %w", err)
+	}
+	opts = append(opts, grpc.WithDefaultServiceConfig(sc))
+
+	// The breaker sees a call once, however often it was retried
+	var breaker *Breaker
+	unary := append([]grpc.UnaryClientInterceptor{}, cfg.UnaryInterceptors...)
+	if len(cfg.StreamInterceptors) > 0 {
+		opts = append(opts, grpc.WithChainStreamInterceptor(cfg.StreamInterceptors...))
+	}
+	if cfg.Breaker.Enabled {
+		breaker = NewBreaker("model-registry", cfg.Breaker, cfg.OnBreakerStateChange)
+		unary = append(unary, breakerUnaryInterceptor(breaker))
+		opts = append(opts, grpc.WithChainStreamInterceptor(breakerStreamInterceptor(breaker)))
 	}
+	unary = append(unary, deadlineInterceptor(cfg.DefaultTimeout, cfg.Timeouts), serviceTokenUnaryInterceptor(cfg.Signer))
+	opts = append(opts,
+		grpc.WithChainUnaryInterceptor(unary...),
+		grpc.WithChainStreamInterceptor(serviceTokenStreamInterceptor(cfg.Signer)),
+	)
 
 	// Connect to server
-	conn, err := grpc.Dial(address, opts...)
+	conn, err := grpc.NewClient(target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial server: %w", err)
 	}
+	conn.Connect()
 
 	return &Client{
-		conn:   conn,
-		client: modelpb.NewModelServiceClient(conn),
+		conn:    conn,
+		client:  modelpb.NewModelServiceClient(conn),
+		audit:   modelpb.NewAuditServiceClient(conn),
+		webhook: modelpb.NewWebhookServiceClient(conn),
+		apiKey:  modelpb.NewAPIKeyServiceClient(conn),
+		user:    modelpb.NewUserServiceClient(conn),
+		usage:   modelpb.NewUsageServiceClient(conn),
+		billing: modelpb.NewBillingServiceClient(conn),
+		breaker: breaker,
 	}, nil
 }
 
+// State returns the connectivity state of the registry channel
+func (c *Client) State() connectivity.State {
+	return c.conn.GetState()
+}
+
+// Breaker returns the circuit breaker, or nil when it is disabled
+func (c *Client) Breaker() *Breaker {
+	return c.breaker
+}
+
 // Close closes the client connection
 func (c *Client) Close() error {
 	if c.conn != nil {
@@ -103,3 +189,259 @@ func (c *Client) SetModelMetadata(ctx context.Context, req *modelpb.SetModelMeta
 func (c *Client) GetModelMetadata(ctx context.Context, req *modelpb.GetModelMetadataRequest) (*modelpb.GetModelMetadataResponse, error) {
 	return c.client.GetModelMetadata(ctx, req)
 }
+
+// ListDeletedModels lists models in the trash via gRPC
+func (c *Client) ListDeletedModels(ctx context.Context, req *modelpb.ListDeletedModelsRequest) (*modelpb.ListModelsResponse, error) {
+	return c.client.ListDeletedModels(ctx, req)
+}
+
+// RestoreModel restores a model from the trash via gRPC
+func (c *Client) RestoreModel(ctx context.Context, req *modelpb.RestoreModelRequest) (*modelpb.RestoreModelResponse, error) {
+	return c.client.RestoreModel(ctx, req)
+}
+
+// PurgeModel permanently deletes a model in the trash via gRPC
+func (c *Client) PurgeModel(ctx context.Context, req *modelpb.PurgeModelRequest) error {
+	_, err := c.client.PurgeModel(ctx, req)
+	return err
+}
+
+// CreateModelVersion records a new model version via gRPC
+func (c *Client) CreateModelVersion(ctx context.Context, req *modelpb.CreateModelVersionRequest) (*modelpb.CreateModelVersionResponse, error) {
+	return c.client.CreateModelVersion(ctx, req)
+}
+
+// ListModelVersions lists the versions of a model via gRPC
+func (c *Client) ListModelVersions(ctx context.Context, req *modelpb.ListModelVersionsRequest) (*modelpb.ListModelVersionsResponse, error) {
+	return c.client.ListModelVersions(ctx, req)
+}
+
+// PromoteVersion makes a version the current one of its model via gRPC
+func (c *Client) PromoteVersion(ctx context.Context, req *modelpb.PromoteVersionRequest) (*modelpb.PromoteVersionResponse, error) {
+	return c.client.PromoteVersion(ctx, req)
+}
+
+// GetModelCard gets the model card of a version via gRPC
+func (c *Client) GetModelCard(ctx context.Context, req *modelpb.GetModelCardRequest) (*modelpb.ModelCardResponse, error) {
+	return c.client.GetModelCard(ctx, req)
+}
+
+// SetModelCard replaces the model card of a version via gRPC
+func (c *Client) SetModelCard(ctx context.Context, req *modelpb.SetModelCardRequest) (*modelpb.ModelCardResponse, error) {
+	return c.client.SetModelCard(ctx, req)
+}
+
+// RecordEvaluations records evaluation results against a version via gRPC
+func (c *Client) RecordEvaluations(ctx context.Context, req *modelpb.RecordEvaluationsRequest) (*modelpb.RecordEvaluationsResponse, error) {
+	return c.client.RecordEvaluations(ctx, req)
+}
+
+// ListEvaluations lists the evaluation results of a model via gRPC
+func (c *Client) ListEvaluations(ctx context.Context, req *modelpb.ListEvaluationsRequest) (*modelpb.ListEvaluationsResponse, error) {
+	return c.client.ListEvaluations(ctx, req)
+}
+
+// CompareVersions diffs two versions of a model via gRPC
+func (c *Client) CompareVersions(ctx context.Context, req *modelpb.CompareVersionsRequest) (*modelpb.CompareVersionsResponse, error) {
+	return c.client.CompareVersions(ctx, req)
+}
+
+// GetPromotionRules gets the promotion rules of a model via gRPC
+func (c *Client) GetPromotionRules(ctx context.Context, req *modelpb.GetPromotionRulesRequest) (*modelpb.PromotionRulesResponse, error) {
+	return c.client.GetPromotionRules(ctx, req)
+}
+
+// SetPromotionRules replaces the promotion rules of a model via gRPC
+func (c *Client) SetPromotionRules(ctx context.Context, req *modelpb.SetPromotionRulesRequest) (*modelpb.PromotionRulesResponse, error) {
+	return c.client.SetPromotionRules(ctx, req)
+}
+
+// AddLineageEdge records where a model came from via gRPC
+func (c *Client) AddLineageEdge(ctx context.Context, req *modelpb.AddLineageEdgeRequest) (*modelpb.LineageEdge, error) {
+	return c.client.AddLineageEdge(ctx, req)
+}
+
+// RemoveLineageEdge removes a lineage edge of a model via gRPC
+func (c *Client) RemoveLineageEdge(ctx context.Context, req *modelpb.RemoveLineageEdgeRequest) error {
+	_, err := c.client.RemoveLineageEdge(ctx, req)
+	return err
+}
+
+// GetLineage walks the lineage graph of a model via gRPC
+func (c *Client) GetLineage(ctx context.Context, req *modelpb.GetLineageRequest) (*modelpb.LineageResponse, error) {
+	return c.client.GetLineage(ctx, req)
+}
+
+// ListApprovalPolicies lists the approval policies of a tenant via gRPC
+func (c *Client) ListApprovalPolicies(ctx context.Context, req *modelpb.ListApprovalPoliciesRequest) (*modelpb.ListApprovalPoliciesResponse, error) {
+	return c.client.ListApprovalPolicies(ctx, req)
+}
+
+// SetApprovalPolicy makes an action of a tenant wait for approval via gRPC
+func (c *Client) SetApprovalPolicy(ctx context.Context, req *modelpb.ApprovalPolicy) (*modelpb.ApprovalPolicy, error) {
+	return c.client.SetApprovalPolicy(ctx, req)
+}
+
+// DeleteApprovalPolicy stops an action of a tenant waiting for approval via gRPC
+func (c *Client) DeleteApprovalPolicy(ctx context.Context, req *modelpb.DeleteApprovalPolicyRequest) error {
+	_, err := c.client.DeleteApprovalPolicy(ctx, req)
+	return err
+}
+
+// ListApprovalRequests lists approval requests via gRPC
+func (c *Client) ListApprovalRequests(ctx context.Context, req *modelpb.ListApprovalRequestsRequest) (*modelpb.ListApprovalRequestsResponse, error) {
+	return c.client.ListApprovalRequests(ctx, req)
+}
+
+// GetApprovalRequest gets an approval request via gRPC
+func (c *Client) GetApprovalRequest(ctx context.Context, req *modelpb.GetApprovalRequestRequest) (*modelpb.ApprovalRequest, error) {
+	return c.client.GetApprovalRequest(ctx, req)
+}
+
+// DecideApprovalRequest approves or rejects a pending approval request via gRPC
+func (c *Client) DecideApprovalRequest(ctx context.Context, req *modelpb.DecideApprovalRequestRequest) (*modelpb.ApprovalRequest, error) {
+	return c.client.DecideApprovalRequest(ctx, req)
+}
+
+// WatchModels streams model changes via gRPC
+func (c *Client) WatchModels(ctx context.Context, req *modelpb.WatchModelsRequest) (grpc.ServerStreamingClient[modelpb.ModelWatchEvent], error) {
+	return c.client.WatchModels(ctx, req)
+}
+
+// RecordAuditEvent records an audit event via gRPC
+func (c *Client) RecordAuditEvent(ctx context.Context, req *modelpb.RecordAuditEventRequest) error {
+	_, err := c.audit.RecordAuditEvent(ctx, req)
+	return err
+}
+
+// ListAuditEvents lists audit events via gRPC
+func (c *Client) ListAuditEvents(ctx context.Context, req *modelpb.ListAuditEventsRequest) (*modelpb.ListAuditEventsResponse, error) {
+	return c.audit.ListAuditEvents(ctx, req)
+}
+
+// ExportAuditEvents streams audit events via gRPC
+func (c *Client) ExportAuditEvents(ctx context.Context, req *modelpb.ListAuditEventsRequest) (grpc.ServerStreamingClient[modelpb.AuditEvent], error) {
+	return c.audit.ExportAuditEvents(ctx, req)
+}
+
+// CreateWebhook registers a webhook via gRPC
+func (c *Client) CreateWebhook(ctx context.Context, req *modelpb.CreateWebhookRequest) (*modelpb.CreateWebhookResponse, error) {
+	return c.webhook.CreateWebhook(ctx, req)
+}
+
+// GetWebhook gets a webhook via gRPC
+func (c *Client) GetWebhook(ctx context.Context, req *modelpb.GetWebhookRequest) (*modelpb.GetWebhookResponse, error) {
+	return c.webhook.GetWebhook(ctx, req)
+}
+
+// ListWebhooks lists webhooks via gRPC
+func (c *Client) ListWebhooks(ctx context.Context, req *modelpb.ListWebhooksRequest) (*modelpb.ListWebhooksResponse, error) {
+	return c.webhook.ListWebhooks(ctx, req)
+}
+
+// UpdateWebhook updates a webhook via gRPC
+func (c *Client) UpdateWebhook(ctx context.Context, req *modelpb.UpdateWebhookRequest) (*modelpb.UpdateWebhookResponse, error) {
+	return c.webhook.UpdateWebhook(ctx, req)
+}
+
+// DeleteWebhook deletes a webhook via gRPC
+func (c *Client) DeleteWebhook(ctx context.Context, req *modelpb.DeleteWebhookRequest) error {
+	_, err := c.webhook.DeleteWebhook(ctx, req)
+	return err
+}
+
+// ListWebhookDeliveries lists webhook deliveries via gRPC
+func (c *Client) ListWebhookDeliveries(ctx context.Context, req *modelpb.ListWebhookDeliveriesRequest) (*modelpb.ListWebhookDeliveriesResponse, error) {
+	return c.webhook.ListWebhookDeliveries(ctx, req)
+}
+
+// RedeliverWebhook queues a past delivery again via gRPC
+func (c *Client) RedeliverWebhook(ctx context.Context, req *modelpb.RedeliverWebhookRequest) (*modelpb.RedeliverWebhookResponse, error) {
+	return c.webhook.RedeliverWebhook(ctx, req)
+}
+
+// CreateAPIKey creates an API key via gRPC
+func (c *Client) CreateAPIKey(ctx context.Context, req *modelpb.CreateAPIKeyRequest) (*modelpb.CreateAPIKeyResponse, error) {
+	return c.apiKey.CreateAPIKey(ctx, req)
+}
+
+// GetAPIKey gets an API key via gRPC
+func (c *Client) GetAPIKey(ctx context.Context, req *modelpb.GetAPIKeyRequest) (*modelpb.GetAPIKeyResponse, error) {
+	return c.apiKey.GetAPIKey(ctx, req)
+}
+
+// ListAPIKeys lists API keys via gRPC
+func (c *Client) ListAPIKeys(ctx context.Context, req *modelpb.ListAPIKeysRequest) (*modelpb.ListAPIKeysResponse, error) {
+	return c.apiKey.ListAPIKeys(ctx, req)
+}
+
+// RotateAPIKey replaces the secret of an API key via gRPC
+func (c *Client) RotateAPIKey(ctx context.Context, req *modelpb.RotateAPIKeyRequest) (*modelpb.RotateAPIKeyResponse, error) {
+	return c.apiKey.RotateAPIKey(ctx, req)
+}
+
+// RevokeAPIKey revokes an API key via gRPC
+func (c *Client) RevokeAPIKey(ctx context.Context, req *modelpb.RevokeAPIKeyRequest) (*modelpb.RevokeAPIKeyResponse, error) {
+	return c.apiKey.RevokeAPIKey(ctx, req)
+}
+
+// VerifyAPIKey resolves an API key secret via gRPC
+func (c *Client) VerifyAPIKey(ctx context.Context, req *modelpb.VerifyAPIKeyRequest) (*modelpb.VerifyAPIKeyResponse, error) {
+	return c.apiKey.VerifyAPIKey(ctx, req)
+}
+
+// ProvisionUser finds or creates the user of an external identity via gRPC
+func (c *Client) ProvisionUser(ctx context.Context, req *modelpb.ProvisionUserRequest) (*modelpb.ProvisionUserResponse, error) {
+	return c.user.ProvisionUser(ctx, req)
+}
+
+// AuthenticateUser checks the password of a local user via gRPC
+func (c *Client) AuthenticateUser(ctx context.Context, req *modelpb.AuthenticateUserRequest) (*modelpb.AuthenticateUserResponse, error) {
+	return c.user.AuthenticateUser(ctx, req)
+}
+
+// RecordUsage adds metering records to the registry rollups via gRPC
+func (c *Client) RecordUsage(ctx context.Context, req *modelpb.RecordUsageRequest) (*modelpb.RecordUsageResponse, error) {
+	return c.usage.RecordUsage(ctx, req)
+}
+
+// ListUsage summarizes usage via gRPC
+func (c *Client) ListUsage(ctx context.Context, req *modelpb.ListUsageRequest) (*modelpb.ListUsageResponse, error) {
+	return c.usage.ListUsage(ctx, req)
+}
+
+// CreatePricingPlan creates a pricing plan via gRPC
+func (c *Client) CreatePricingPlan(ctx context.Context, req *modelpb.CreatePricingPlanRequest) (*modelpb.CreatePricingPlanResponse, error) {
+	return c.billing.CreatePricingPlan(ctx, req)
+}
+
+// UpdatePricingPlan replaces a pricing plan via gRPC
+func (c *Client) UpdatePricingPlan(ctx context.Context, req *modelpb.UpdatePricingPlanRequest) (*modelpb.UpdatePricingPlanResponse, error) {
+	return c.billing.UpdatePricingPlan(ctx, req)
+}
+
+// ListPricingPlans lists pricing plans via gRPC
+func (c *Client) ListPricingPlans(ctx context.Context, req *modelpb.ListPricingPlansRequest) (*modelpb.ListPricingPlansResponse, error) {
+	return c.billing.ListPricingPlans(ctx, req)
+}
+
+// SetTenantPlan attaches a pricing plan to a tenant via gRPC
+func (c *Client) SetTenantPlan(ctx context.Context, req *modelpb.SetTenantPlanRequest) error {
+	_, err := c.billing.SetTenantPlan(ctx, req)
+	return err
+}
+
+// GenerateInvoice generates a monthly invoice via gRPC
+func (c *Client) GenerateInvoice(ctx context.Context, req *modelpb.GenerateInvoiceRequest) (*modelpb.GenerateInvoiceResponse, error) {
+	return c.billing.GenerateInvoice(ctx, req)
+}
+
+// GetInvoice gets an invoice via gRPC
+func (c *Client) GetInvoice(ctx context.Context, req *modelpb.GetInvoiceRequest) (*modelpb.GetInvoiceResponse, error) {
+	return c.billing.GetInvoice(ctx, req)
+}
+
+// ListInvoices lists invoices via gRPC
+func (c *Client) ListInvoices(ctx context.Context, req *modelpb.ListInvoicesRequest) (*modelpb.ListInvoicesResponse, error) {
+	return c.billing.ListInvoices(ctx, req)
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"maas-platform/shared/servicetoken"
+)
+
+// Metadata keys used to propagate the caller identity to the registry
+const (
+	MetadataUserID    = "x-user-id"
+	MetadataTenantID  = "x-tenant-id"
+	MetadataRole      = "x-user-role"
+	MetadataRequestID = "x-request-id"
+	// MetadataScopes carries one value per scope when the caller uses an API key
+	MetadataScopes = "x-scopes"
+	// MetadataReadPrimary asks the registry to read from its primary database
+	MetadataReadPrimary = "x-read-primary"
+)
+
+// Caller identifies the user on whose behalf a call is made
+type Caller struct {
+	UserID    string
+	TenantID  string
+	Role      string
+	RequestID string
+	// Scopes restrict an API key caller; nil for interactive users
+	Scopes []string
+}
+
+// WithCaller attaches the caller identity to the outgoing context
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	pairs := make([]string, 0, 8)
+	for key, value := range map[string]string{
+		MetadataUserID:    caller.UserID,
+		MetadataTenantID:  caller.TenantID,
+		MetadataRole:      caller.Role,
+		MetadataRequestID: caller.RequestID,
+	} {
+		if value != "" {
+			pairs = append(pairs, key, value)
+		}
+	}
+	for _, scope := range caller.Scopes {
+		pairs = append(pairs, MetadataScopes, scope)
+	}
+	if len(pairs) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+// CallerFromContext returns the caller attached to the outgoing context, if any
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	get := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	caller := Caller{
+		UserID:    get(MetadataUserID),
+		TenantID:  get(MetadataTenantID),
+		Role:      get(MetadataRole),
+		RequestID: get(MetadataRequestID),
+		Scopes:    md.Get(MetadataScopes),
+	}
+	return caller, caller.UserID != ""
+}
+
+// WithoutCaller strips the caller identity from the outgoing context so the
+// call is made as the trusted gateway; the request ID is kept
+func WithoutCaller(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	md = md.Copy()
+	for _, key := range []string{MetadataUserID, MetadataTenantID, MetadataRole, MetadataScopes} {
+		md.Delete(key)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// WithPrimaryRead asks the registry to serve the call from its primary
+// database, so the result reflects every write committed before it
+func WithPrimaryRead(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataReadPrimary, "true")
+}
+
+// withServiceToken signs the caller attached to the outgoing context, or the
+// gateway itself when there is none, so the registry can verify both
+func withServiceToken(ctx context.Context, signer *servicetoken.Signer) context.Context {
+	caller, _ := CallerFromContext(ctx)
+	token := signer.Sign(servicetoken.Identity{
+		UserID:   caller.UserID,
+		TenantID: caller.TenantID,
+		Role:     caller.Role,
+		Scopes:   caller.Scopes,
+	}, time.Now())
+	return metadata.AppendToOutgoingContext(ctx, servicetoken.MetadataKey, token)
+}
+
+// serviceTokenUnaryInterceptor authenticates unary calls to the registry
+func serviceTokenUnaryInterceptor(signer *servicetoken.Signer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(withServiceToken(ctx, signer), method, req, reply, cc, opts...)
+	}
+}
+
+// serviceTokenStreamInterceptor authenticates streams to the registry
+func serviceTokenStreamInterceptor(signer *servicetoken.Signer) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(withServiceToken(ctx, signer), desc, cc, method, opts...)
+	}
+}
@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer/leastrequest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryConfig configures retries of idempotent reads
+type RetryConfig struct {
+	// MaxAttempts includes the first attempt; values below 2 disable retries
+	MaxAttempts       int
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+}
+
+// idempotentMethods are the read-only calls that are safe to retry, by service
+var idempotentMethods = map[string][]string{
+	"model.ModelService":   {"GetModel", "ListModels", "GetModelMetadata", "ListDeletedModels", "ListModelVersions", "GetModelCard", "ListEvaluations", "CompareVersions", "GetPromotionRules", "GetLineage", "ListApprovalPolicies", "ListApprovalRequests", "GetApprovalRequest"},
+	"model.AuditService":   {"ListAuditEvents"},
+	"model.WebhookService": {"GetWebhook", "ListWebhooks", "ListWebhookDeliveries"},
+	"model.APIKeyService":  {"GetAPIKey", "ListAPIKeys", "VerifyAPIKey"},
+	"model.UsageService":   {"ListUsage"},
+	"model.BillingService": {"ListPricingPlans", "GetInvoice", "ListInvoices"},
+}
+
+// Load balancing policies
+const (
+	BalancerRoundRobin   = "round_robin"
+	BalancerLeastRequest = "least_request"
+	BalancerPickFirst    = "pick_first"
+)
+
+// serviceConfig returns the gRPC service config covering load balancing,
+// health-checked endpoint ejection and retries of idempotent reads
+func serviceConfig(cfg ClientConfig) (string, error) {
+	var policy map[string]interface{}
+	switch cfg.LoadBalancing {
+	case BalancerRoundRobin, "":
+		policy = map[string]interface{}{"round_robin": map[string]interface{}{}}
+	case BalancerLeastRequest:
+		policy = map[string]interface{}{leastrequest.Name: map[string]interface{}{"choiceCount": 2}}
+	case BalancerPickFirst:
+		policy = map[string]interface{}{"pick_first": map[string]interface{}{}}
+	default:
+		return "", fmt.Errorf("unknown load balancing policy: %s", cfg.LoadBalancing)
+	}
+
+	config := map[string]interface{}{
+		"loadBalancingConfig": []map[string]interface{}{policy},
+	}
+	if cfg.HealthCheck {
+		// Replicas reporting NOT_SERVING on the health service stop receiving calls
+		config["healthCheckConfig"] = map[string]string{"serviceName": ""}
+	}
+	if retry := retryPolicy(cfg.Retry); retry != nil {
+		config["methodConfig"] = []map[string]interface{}{retry}
+	}
+
+	data, err := json.Marshal(config)
+	return string(data), err
+}
+
+// retryPolicy returns a method config retrying idempotent reads on UNAVAILABLE, or nil when disabled
+func retryPolicy(cfg RetryConfig) map[string]interface{} {
+	if cfg.MaxAttempts < 2 {
+		return nil
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = 100 * time.Millisecond
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = time.Second
+	}
+	if cfg.BackoffMultiplier < 1 {
+		cfg.BackoffMultiplier = 2
+	}
+
+	type methodName struct {
+		Service string `json:"service"`
+		Method  string `json:"method"`
+	}
+	names := make([]methodName, 0)
+	for service, methods := range idempotentMethods {
+		for _, method := range methods {
+			names = append(names, methodName{Service: service, Method: method})
+		}
+	}
+
+	return map[string]interface{}{
+		"name": names,
+		"retryPolicy": map[string]interface{}{
+			"maxAttempts":          cfg.MaxAttempts,
+			"initialBackoff":       durationJSON(cfg.InitialBackoff),
+			"maxBackoff":           durationJSON(cfg.MaxBackoff),
+			"backoffMultiplier":    cfg.BackoffMultiplier,
+			"retryableStatusCodes": []string{"UNAVAILABLE"},
+		},
+	}
+}
+
+// durationJSON formats a duration the way service configs expect
+func durationJSON(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// deadlineInterceptor applies per-method timeouts unless the caller already set a shorter deadline
+func deadlineInterceptor(defaultTimeout time.Duration, timeouts map[string]time.Duration) grpc.UnaryClientInterceptor {
+	// Config keys arrive lowercased, so methods are matched case-insensitively
+	byMethod := make(map[string]time.Duration, len(timeouts))
+	for method, timeout := range timeouts {
+		byMethod[strings.ToLower(method)] = timeout
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		timeout := defaultTimeout
+		if t, ok := byMethod[strings.ToLower(method[strings.LastIndex(method, "/")+1:])]; ok {
+			timeout = t
+		}
+		if timeout > 0 {
+			if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > timeout {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// breakerUnaryInterceptor fails fast while the breaker is open and records call outcomes
+func breakerUnaryInterceptor(b *Breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		done, err := b.Allow()
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "%s: %v", b.Name(), err)
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		done(callOutcome(ctx, err))
+		return err
+	}
+}
+
+// breakerStreamInterceptor rejects new streams while the breaker is open
+func breakerStreamInterceptor(b *Breaker) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if b.State() == BreakerOpen {
+			return nil, status.Errorf(codes.Unavailable, "%s: %v", b.Name(), ErrCircuitOpen)
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// callOutcome tells whether an error points at an unhealthy server, a bad
+// request or a caller that gave up
+func callOutcome(ctx context.Context, err error) CallOutcome {
+	if err == nil {
+		return CallSucceeded
+	}
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return CallAbandoned
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return CallFailed
+	}
+	return CallSucceeded
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// Resolvers for registry endpoints
+const (
+	ResolverDNS    = "dns"
+	ResolverStatic = "static"
+)
+
+// dialTarget builds the dial target for the endpoints. The dns resolver takes
+// a single name that may resolve to many replicas; the static resolver takes
+// a fixed list of addresses. Without an explicit resolver a single endpoint
+// uses dns and several use static.
+func dialTarget(endpoints []string, kind string) (string, []grpc.DialOption, error) {
+	addresses := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if address := normalizeEndpoint(endpoint); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return "", nil, fmt.Errorf("no registry endpoints configured")
+	}
+
+	if kind == "" {
+		kind = ResolverStatic
+		if len(addresses) == 1 {
+			kind = ResolverDNS
+		}
+	}
+
+	switch kind {
+	case ResolverDNS:
+		if len(addresses) > 1 {
+			return "", nil, fmt.Errorf("dns resolver takes a single endpoint, got %d", len(addresses))
+		}
+		if strings.HasPrefix(addresses[0], "dns:") {
+			return addresses[0], nil, nil
+		}
+		return "dns:///" + addresses[0], nil, nil
+
+	case ResolverStatic:
+		state := resolver.State{}
+		for _, address := range addresses {
+			if strings.Contains(address, "://") || strings.HasPrefix(address, "dns:") {
+				return "", nil, fmt.Errorf("static resolver takes host:port addresses, got %q", address)
+			}
+			state.Endpoints = append(state.Endpoints, resolver.Endpoint{
+				Addresses: []resolver.Address{{Addr: address}},
+			})
+		}
+		r := manual.NewBuilderWithScheme(ResolverStatic)
+		r.InitialState(state)
+		return ResolverStatic + ":///model-registry", []grpc.DialOption{grpc.WithResolvers(r)}, nil
+	}
+
+	return "", nil, fmt.Errorf("unknown resolver: %s", kind)
+}
+
+// normalizeEndpoint strips HTTP-style schemes that gRPC cannot dial, keeping dns targets intact
+func normalizeEndpoint(endpoint string) string {
+	endpoint = strings.TrimSpace(endpoint)
+	for _, scheme := range []string{"http://", "https://", "grpc://"} {
+		if strings.HasPrefix(endpoint, scheme) {
+			endpoint = strings.TrimPrefix(endpoint, scheme)
+			endpoint = strings.TrimSuffix(endpoint, "/")
+			break
+		}
+	}
+	return endpoint
+}
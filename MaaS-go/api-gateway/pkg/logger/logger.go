@@ -1,9 +1,11 @@
 package logger
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -164,6 +166,15 @@ func (l *Logger) WithContext(requestID string) *Logger {
 	return l.With("request_id", requestID)
 }
 
+// WithTrace creates a logger with the trace and span IDs of the span in ctx
+func (l *Logger) WithTrace(ctx context.Context) *Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return l
+	}
+	return l.With("trace_id", sc.TraceID().String(), "span_id", sc.SpanID().String())
+}
+
 // Debug logs a debug message
 func (l *Logger) Debug(msg string, keysAndValues ...interface{}) {
 	l.sugar.Debugw(msg, keysAndValues...)
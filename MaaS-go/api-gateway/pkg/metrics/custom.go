@@ -0,0 +1,147 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Custom metric errors
+var (
+	ErrUnknownMetric  = errors.New("custom metric not registered")
+	ErrMetricConflict = errors.New("custom metric already registered with a different definition")
+)
+
+// customKind is the type of a custom metric
+type customKind int
+
+const (
+	customCounter customKind = iota
+	customGauge
+	customHistogram
+)
+
+// customMetric is a metric registered at runtime by name
+type customMetric struct {
+	kind      customKind
+	labels    []string
+	counter   *prometheus.CounterVec
+	gauge     *prometheus.GaugeVec
+	histogram *prometheus.HistogramVec
+}
+
+// custom holds the custom metrics by name
+var custom = struct {
+	sync.RWMutex
+	metrics map[string]*customMetric
+}{metrics: make(map[string]*customMetric)}
+
+// RegisterCounter registers a custom counter; registering the same counter again is a no-op
+func RegisterCounter(name, help string, labels ...string) error {
+	return registerCustom(name, &customMetric{
+		kind:    customCounter,
+		labels:  labels,
+		counter: prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels),
+	})
+}
+
+// RegisterGauge registers a custom gauge; registering the same gauge again is a no-op
+func RegisterGauge(name, help string, labels ...string) error {
+	return registerCustom(name, &customMetric{
+		kind:   customGauge,
+		labels: labels,
+		gauge:  prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels),
+	})
+}
+
+// RegisterHistogram registers a custom histogram; nil buckets use the Prometheus defaults
+func RegisterHistogram(name, help string, buckets []float64, labels ...string) error {
+	return registerCustom(name, &customMetric{
+		kind:      customHistogram,
+		labels:    labels,
+		histogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels),
+	})
+}
+
+// RecordCustomMetric records a value for a registered custom metric: counters
+// are increased by it, gauges set to it and histograms observe it. Label
+// values are given in the order the labels were registered.
+func RecordCustomMetric(name string, value float64, labels ...string) error {
+	custom.RLock()
+	m, ok := custom.metrics[name]
+	custom.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownMetric, name)
+	}
+
+	switch m.kind {
+	case customCounter:
+		if value < 0 {
+			return fmt.Errorf("counter %s cannot decrease", name)
+		}
+		c, err := m.counter.GetMetricWithLabelValues(labels...)
+		if err != nil {
+			return err
+		}
+		c.Add(value)
+	case customGauge:
+		g, err := m.gauge.GetMetricWithLabelValues(labels...)
+		if err != nil {
+			return err
+		}
+		g.Set(value)
+	case customHistogram:
+		h, err := m.histogram.GetMetricWithLabelValues(labels...)
+		if err != nil {
+			return err
+		}
+		h.Observe(value)
+	}
+	return nil
+}
+
+// UnregisterCustomMetric removes a custom metric and its series
+func UnregisterCustomMetric(name string) bool {
+	custom.Lock()
+	defer custom.Unlock()
+
+	m, ok := custom.metrics[name]
+	if !ok {
+		return false
+	}
+	delete(custom.metrics, name)
+	return prometheus.Unregister(m.collector())
+}
+
+// registerCustom registers a custom metric with the default registry
+func registerCustom(name string, m *customMetric) error {
+	custom.Lock()
+	defer custom.Unlock()
+
+	if existing, ok := custom.metrics[name]; ok {
+		if existing.kind == m.kind && slices.Equal(existing.labels, m.labels) {
+			return nil
+		}
+		return fmt.Errorf("%w: %s", ErrMetricConflict, name)
+	}
+	// Also fails for invalid names and clashes with built-in metrics
+	if err := prometheus.Register(m.collector()); err != nil {
+		return fmt.Errorf("failed to register custom metric %s: %w", name, err)
+	}
+	custom.metrics[name] = m
+	return nil
+}
+
+// collector returns the underlying Prometheus collector
+func (m *customMetric) collector() prometheus.Collector {
+	switch m.kind {
+	case customCounter:
+		return m.counter
+	case customGauge:
+		return m.gauge
+	}
+	return m.histogram
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// GRPCClientHandled tracks completed gRPC calls to downstream services
+	GRPCClientHandled = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_client_handled_total",
+			Help: "Total number of gRPC calls completed by the client",
+		},
+		[]string{"service", "method", "code"},
+	)
+
+	// GRPCClientHandlingSeconds tracks gRPC call duration as seen by the client
+	GRPCClientHandlingSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_client_handling_seconds",
+			Help:    "gRPC call duration in seconds as seen by the client",
+			Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		},
+		[]string{"service", "method"},
+	)
+)
+
+// UnaryClientInterceptor records the rate, errors and duration of unary calls
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		recordClientCall(method, start, err)
+		return err
+	}
+}
+
+// StreamClientInterceptor records streams once they end
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			recordClientCall(method, start, err)
+			return nil, err
+		}
+		return &monitoredClientStream{ClientStream: stream, method: method, start: start}, nil
+	}
+}
+
+// monitoredClientStream records a stream when receiving from it fails or reaches the end
+type monitoredClientStream struct {
+	grpc.ClientStream
+	method string
+	start  time.Time
+	once   sync.Once
+}
+
+// RecvMsg receives a message and records the stream when it ends
+func (s *monitoredClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if errors.Is(err, io.EOF) {
+				recordClientCall(s.method, s.start, nil)
+			} else {
+				recordClientCall(s.method, s.start, err)
+			}
+		})
+	}
+	return err
+}
+
+// recordClientCall counts a finished call by its status code
+func recordClientCall(fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	GRPCClientHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	GRPCClientHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// splitMethod splits /package.Service/Method into service and method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
@@ -57,6 +58,70 @@ var (
 		},
 	)
 
+	// CacheHits tracks cache hits, including cached not-found results
+	CacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_hits_total",
+			Help: "Total number of cache hits",
+		},
+		[]string{"resource"},
+	)
+
+	// CacheMisses tracks cache misses
+	CacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_misses_total",
+			Help: "Total number of cache misses",
+		},
+		[]string{"resource"},
+	)
+
+	// CircuitBreakerState tracks circuit breaker state: 0 closed, 1 half-open, 2 open
+	CircuitBreakerState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "circuit_breaker_state",
+			Help: "Circuit breaker state (0 closed, 1 half-open, 2 open)",
+		},
+		[]string{"name"},
+	)
+
+	// UsageRecordsDropped tracks metering records a sink never received
+	UsageRecordsDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "usage_records_dropped_total",
+			Help: "Total number of metering records dropped before reaching a sink",
+		},
+		[]string{"sink"},
+	)
+
+	// UsageFlushes tracks metering batches sent to a sink by result
+	UsageFlushes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "usage_flushes_total",
+			Help: "Total number of metering batches sent to a sink",
+		},
+		[]string{"sink", "result"},
+	)
+
+	// InferenceRequests tracks inference calls by model and outcome
+	InferenceRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "inference_requests_total",
+			Help: "Total number of inference calls by model and status",
+		},
+		[]string{"model", "status"},
+	)
+
+	// InferenceDuration tracks inference latency by model
+	InferenceDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "inference_duration_seconds",
+			Help:    "Inference call duration in seconds",
+			Buckets: []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"model"},
+	)
+
 	// ServiceUp indicates if service is up
 	ServiceUp = prometheus.NewGauge(
 		prometheus.GaugeOpts{
@@ -82,6 +147,15 @@ func init() {
 	prometheus.MustRegister(HTTPRequestSize)
 	prometheus.MustRegister(HTTPResponseSize)
 	prometheus.MustRegister(ActiveConnections)
+	prometheus.MustRegister(CacheHits)
+	prometheus.MustRegister(CacheMisses)
+	prometheus.MustRegister(CircuitBreakerState)
+	prometheus.MustRegister(UsageRecordsDropped)
+	prometheus.MustRegister(UsageFlushes)
+	prometheus.MustRegister(InferenceRequests)
+	prometheus.MustRegister(InferenceDuration)
+	prometheus.MustRegister(GRPCClientHandled)
+	prometheus.MustRegister(GRPCClientHandlingSeconds)
 	prometheus.MustRegister(ServiceUp)
 	prometheus.MustRegister(ServiceInfo)
 }
@@ -140,8 +214,54 @@ func SetServiceInfo(version, environment string) {
 	ServiceInfo.WithLabelValues(version, environment).Set(1)
 }
 
-// RecordCustomMetric records a custom counter metric
-func RecordCustomMetric(name string, value float64, labels ...string) {
-	// This is a placeholder for custom metrics
-	// In production, you would define custom metrics and use them here
+// RecordCacheResult counts a cache lookup for a resource
+func RecordCacheResult(resource string, hit bool) {
+	if hit {
+		CacheHits.WithLabelValues(resource).Inc()
+	} else {
+		CacheMisses.WithLabelValues(resource).Inc()
+	}
+}
+
+// SetCircuitBreakerState sets circuit_breaker_state for a breaker
+func SetCircuitBreakerState(name string, state int) {
+	CircuitBreakerState.WithLabelValues(name).Set(float64(state))
+}
+
+// RecordUsageDropped counts metering records dropped for a sink
+func RecordUsageDropped(sink string, n int) {
+	UsageRecordsDropped.WithLabelValues(sink).Add(float64(n))
+}
+
+// RecordUsageFlush counts a metering batch sent to a sink
+func RecordUsageFlush(sink string, ok bool) {
+	result := "success"
+	if !ok {
+		result = "error"
+	}
+	UsageFlushes.WithLabelValues(sink, result).Inc()
+}
+
+// RecordInference counts an inference call and its latency for a model
+func RecordInference(model, status string, duration time.Duration) {
+	InferenceRequests.WithLabelValues(model, status).Inc()
+	InferenceDuration.WithLabelValues(model).Observe(duration.Seconds())
+}
+
+// RegisterQueueDepth exposes the current length of a queue as queue_depth{queue=name};
+// registering the same queue again keeps the first function
+func RegisterQueueDepth(name string, depth func() int) {
+	gauge := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "queue_depth",
+		Help:        "Number of items waiting in a queue",
+		ConstLabels: prometheus.Labels{"queue": name},
+	}, func() float64 {
+		return float64(depth())
+	})
+	if err := prometheus.Register(gauge); err != nil {
+		var exists prometheus.AlreadyRegisteredError
+		if !errors.As(err, &exists) {
+			panic(err)
+		}
+	}
 }
@@ -7,21 +7,35 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"gorm.io/gorm"
 
+	"maas-platform/model-registry/internal/auth"
 	"maas-platform/model-registry/internal/config"
+	"maas-platform/model-registry/internal/events"
 	rpcserver "maas-platform/model-registry/internal/grpc"
 	"maas-platform/model-registry/internal/handler"
 	"maas-platform/model-registry/internal/middleware"
+	"maas-platform/model-registry/internal/migrate"
 	"maas-platform/model-registry/internal/repository"
 	"maas-platform/model-registry/internal/router"
 	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/internal/storage"
 	"maas-platform/model-registry/pkg/logger"
+	"maas-platform/model-registry/pkg/metrics"
+	"maas-platform/model-registry/pkg/webhook"
 	modelpb "maas-platform/shared/proto"
+	"maas-platform/shared/servicetoken"
+	"maas-platform/shared/tracing"
 )
 
 func main() {
@@ -31,6 +45,11 @@ func main() {
 	// Initialize logger
 	log := logger.New(cfg.LogLevel)
 
+	// `migrate up|down [steps]|status` manages the schema and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(cfg, log, os.Args[2:]))
+	}
+
 	log.Info("Starting Model Registry Service",
 		"version", "1.0.0",
 		"environment", cfg.Environment,
@@ -38,37 +57,173 @@ func main() {
 		"grpc_port", 9090,
 	)
 
-	// Connect to database
-	db, err := repository.NewDatabase(repository.DatabaseConfig{
-		Host:     cfg.Database.Host,
-		Port:     cfg.Database.Port,
-		User:     cfg.Database.User,
-		Password: cfg.Database.Password,
-		Database: cfg.Database.Name,
-		SSLMode:  cfg.Database.SSLMode,
+	// Set up tracing before any instrumented client is created
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Exporter:       cfg.Tracing.Exporter,
+		Endpoint:       cfg.Tracing.Endpoint,
+		Insecure:       cfg.Tracing.Insecure,
+		SampleRatio:    cfg.Tracing.SampleRatio,
+		ServiceName:    "model-registry",
+		ServiceVersion: "1.0.0",
+		Environment:    cfg.Environment,
 	})
+	if err != nil {
+		log.Fatal("Failed to set up tracing", "error", err)
+	}
+
+	// Every API call must carry a token the gateway signed with this secret
+	signer, err := servicetoken.New(cfg.Auth.ServiceSecret, cfg.Auth.ServiceTokenTTL)
+	if err != nil {
+		log.Fatal("Invalid auth configuration", "error", err)
+	}
+
+	// Connect to database
+	db, err := repository.NewDatabase(databaseConfig(cfg))
 	if err != nil {
 		log.Fatal("Failed to connect to database", "error", err)
 	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatal("Failed to get database handle", "error", err)
+	}
 
-	// Run migrations
-	log.Info("Running database migrations...")
-	if err := repository.AutoMigrate(db); err != nil {
-		log.Fatal("Failed to migrate database", "error", err)
+	// Run migrations; replicas starting together serialize on an advisory lock.
+	// The memory backend starts empty every time, so it is always migrated.
+	if cfg.Database.AutoMigrate || cfg.Database.Backend == repository.BackendMemory {
+		log.Info("Running database migrations...")
+		migrator, err := migrate.New(sqlDB, db.Dialector.Name(), log)
+		if err != nil {
+			log.Fatal("Failed to load migrations", "error", err)
+		}
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			log.Fatal("Failed to migrate database", "error", err)
+		}
+		log.Info("Database migrations completed", "applied", applied)
 	}
-	log.Info("Database migrations completed")
+
+	metrics.RegisterDBStats(sqlDB, cfg.Database.Name)
+	metrics.SetServiceInfo("1.0.0", cfg.Environment)
 
 	// Initialize repository
 	modelRepo := repository.NewGormModelRepository(db)
+	auditRepo := repository.NewGormAuditRepository(db)
+	outboxRepo := repository.NewGormOutboxRepository(db)
+	webhookRepo := repository.NewGormWebhookRepository(db)
+	apiKeyRepo := repository.NewGormAPIKeyRepository(db)
+	userRepo := repository.NewGormUserRepository(db)
+	usageRepo := repository.NewGormUsageRepository(db)
+	billingRepo := repository.NewGormBillingRepository(db)
+	statsRepo := repository.NewGormStatsRepository(db)
+	evaluationRepo := repository.NewGormEvaluationRepository(db)
+	lineageRepo := repository.NewGormLineageRepository(db)
+	approvalRepo := repository.NewGormApprovalRepository(db)
+	if cfg.Database.Backend == repository.BackendMemory {
+		// Models and their events live in process memory, and stats and billing
+		// read models from there; the other tables stay in SQLite
+		store := repository.NewMemoryStore()
+		modelRepo = repository.NewMemoryModelRepository(store)
+		outboxRepo = repository.NewMemoryOutboxRepository(store)
+		statsRepo = repository.NewMemoryStatsRepository(store, statsRepo)
+		billingRepo = repository.NewMemoryBillingRepository(store, billingRepo)
+	}
 
 	// Initialize service
-	modelService := service.NewModelService(modelRepo, log)
+	blobStore := storage.NewLocalBlobStore(cfg.Storage.Root)
+	auditService := service.NewAuditService(auditRepo, log)
+	webhookGuard, err := webhook.NewGuard(cfg.Webhooks.AllowedCIDRs)
+	if err != nil {
+		log.Fatal("Invalid webhook configuration", "error", err)
+	}
+	webhookService := service.NewWebhookService(webhookRepo, webhookGuard, log)
+	modelService := service.NewModelService(modelRepo, evaluationRepo, lineageRepo, approvalRepo, repository.NewGormTransactor(db), blobStore, auditService, webhookService, log)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, userRepo, auditService, log)
+	userService := service.NewUserService(userRepo, auditService, log)
+	usageService := service.NewUsageService(usageRepo, log)
+	billingService := service.NewBillingService(billingRepo, usageRepo, auditService, log)
+
+	// Purge models whose retention period in the trash has expired
+	reaperCtx, stopReaper := context.WithCancel(context.Background())
+	defer stopReaper()
+	go service.NewReaper(modelService, cfg.Retention.DeletedModels, cfg.Retention.ReapInterval, log).Run(reaperCtx)
+
+	// Keep draft invoices current and finalize them once their month has ended
+	invoiceCtx, stopInvoices := context.WithCancel(context.Background())
+	defer stopInvoices()
+	go service.NewInvoiceScheduler(billingService, cfg.Billing.InvoiceInterval, log).Run(invoiceCtx)
+
+	// Refresh the business gauges behind /metrics
+	statsCtx, stopStats := context.WithCancel(context.Background())
+	defer stopStats()
+	go service.NewStatsCollector(statsRepo, cfg.Metrics.RefreshInterval, log).Run(statsCtx)
+
+	// Relay domain events from the outbox to the message broker
+	publisher, err := events.NewPublisher(events.Config{
+		Publisher: cfg.Events.Publisher,
+		Kafka: events.KafkaConfig{
+			RESTURL: cfg.Events.Kafka.RESTURL,
+			Topic:   cfg.Events.Kafka.Topic,
+			Timeout: cfg.Events.Kafka.Timeout,
+		},
+		NATS: events.NATSConfig{
+			URL:           cfg.Events.NATS.URL,
+			SubjectPrefix: cfg.Events.NATS.SubjectPrefix,
+		},
+	})
+	if err != nil {
+		log.Fatal("Failed to create event publisher", "error", err)
+	}
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	if publisher != nil {
+		defer publisher.Close()
+		relay := events.NewRelay(outboxRepo, publisher, events.RelayConfig{
+			PollInterval:    cfg.Events.PollInterval,
+			BatchSize:       cfg.Events.BatchSize,
+			Retention:       cfg.Events.Retention,
+			MaxAttempts:     cfg.Events.MaxAttempts,
+			RetryBackoff:    cfg.Events.RetryBackoff,
+			MaxRetryBackoff: cfg.Events.MaxRetryBackoff,
+			ClaimTimeout:    cfg.Events.ClaimTimeout,
+		}, log)
+		go relay.Run(relayCtx)
+		log.Info("Event relay started", "publisher", cfg.Events.Publisher)
+	} else {
+		log.Warn("Event publishing disabled; events accumulate in the outbox")
+	}
+
+	// Deliver queued webhooks
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	defer stopDispatcher()
+	go service.NewWebhookDispatcher(webhookRepo, webhookGuard, service.WebhookDispatcherConfig{
+		PollInterval:   cfg.Webhooks.PollInterval,
+		BatchSize:      cfg.Webhooks.BatchSize,
+		MaxAttempts:    cfg.Webhooks.MaxAttempts,
+		InitialBackoff: cfg.Webhooks.InitialBackoff,
+		MaxBackoff:     cfg.Webhooks.MaxBackoff,
+		Timeout:        cfg.Webhooks.Timeout,
+	}, log).Run(dispatcherCtx)
+
+	// Wake model watchers when new events land in the outbox
+	watchService := service.NewWatchService(outboxRepo, cfg.Watch.PollInterval, cfg.Watch.GapTimeout, log)
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+	go watchService.Run(watchCtx)
 
 	// Initialize handler
 	modelHandler := handler.NewModelHandler(modelService, log)
+	auditHandler := handler.NewAuditHandler(auditService, log)
+	webhookHandler := handler.NewWebhookHandler(webhookService, log)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService, log)
+
+	// Report readiness on the gRPC health service so clients can eject this replica
+	healthServer := health.NewServer()
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	go probeHealth(healthCtx, db, healthServer, log)
 
 	// Start gRPC server in a goroutine
-	go startGRPCServer(modelService, log)
+	go startGRPCServer(modelService, watchService, auditService, webhookService, apiKeyService, userService, usageService, billingService, healthServer, signer, log)
 
 	// Set gin mode
 	if cfg.Environment == "production" {
@@ -83,6 +238,8 @@ func main() {
 	r.Use(middleware.Logger(log))
 	r.Use(middleware.CORS())
 	r.Use(middleware.RequestID())
+	r.Use(tracing.Middleware("model-registry"))
+	r.Use(metrics.PrometheusMiddleware())
 
 	// Health check
 	r.GET("/health", func(c *gin.Context) {
@@ -104,9 +261,15 @@ func main() {
 		})
 	})
 
+	// Prometheus metrics
+	r.GET("/metrics", metrics.Handler())
+
 	// API routes
-	api := r.Group("/api/v1")
+	api := r.Group("/api/v1", auth.Middleware(signer))
 	router.RegisterRoutes(api, modelHandler)
+	router.RegisterAuditRoutes(api, auditHandler)
+	router.RegisterWebhookRoutes(api, webhookHandler)
+	router.RegisterAPIKeyRoutes(api, apiKeyHandler)
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -124,6 +287,14 @@ func main() {
 		<-sigChan
 
 		log.Info("Shutting down server...")
+		stopHealth()
+		healthServer.Shutdown()
+		stopReaper()
+		stopInvoices()
+		stopStats()
+		stopRelay()
+		stopDispatcher()
+		stopWatch()
 
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
@@ -139,19 +310,144 @@ func main() {
 		log.Fatal("Failed to start server", "error", err)
 	}
 
+	// Flush the spans still buffered in the batch processor
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		log.Error("Failed to flush traces", "error", err)
+	}
+
 	log.Info("Server exited")
 }
 
+// databaseConfig returns the repository database settings
+func databaseConfig(cfg *config.Config) repository.DatabaseConfig {
+	return repository.DatabaseConfig{
+		Backend:  cfg.Database.Backend,
+		Host:     cfg.Database.Host,
+		Port:     cfg.Database.Port,
+		User:     cfg.Database.User,
+		Password: cfg.Database.Password,
+		Database: cfg.Database.Name,
+		SSLMode:  cfg.Database.SSLMode,
+		Path:     cfg.Database.Path,
+
+		MaxOpenConns:       cfg.Database.MaxOpenConns,
+		MaxIdleConns:       cfg.Database.MaxIdleConns,
+		ConnMaxLifetime:    cfg.Database.ConnMaxLifetime,
+		ConnMaxIdleTime:    cfg.Database.ConnMaxIdleTime,
+		StatementTimeout:   cfg.Database.StatementTimeout,
+		SlowQueryThreshold: cfg.Database.SlowQueryThreshold,
+		LogLevel:           cfg.Database.LogLevel,
+		Replicas:           cfg.Database.Replicas,
+	}
+}
+
+// runMigrate runs a migrate subcommand and returns the process exit code
+func runMigrate(cfg *config.Config, log *logger.Logger, args []string) int {
+	usage := "usage: model-registry migrate up|down [steps]|status"
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, usage)
+		return 2
+	}
+
+	db, err := repository.NewDatabase(databaseConfig(cfg))
+	if err != nil {
+		log.Error("Failed to connect to database", "error", err)
+		return 1
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Error("Failed to get database handle", "error", err)
+		return 1
+	}
+	defer sqlDB.Close()
+
+	migrator, err := migrate.New(sqlDB, db.Dialector.Name(), log)
+	if err != nil {
+		log.Error("Failed to load migrations", "error", err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			log.Error("Failed to migrate database", "error", err)
+			return 1
+		}
+		fmt.Printf("Applied %d migration(s)\n", applied)
+	case "down":
+		// Rolling back is destructive, so only one migration is undone unless asked otherwise
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				fmt.Fprintln(os.Stderr, usage)
+				return 2
+			}
+		}
+		rolledBack, err := migrator.Down(ctx, steps)
+		if err != nil {
+			log.Error("Failed to roll back database", "error", err)
+			return 1
+		}
+		fmt.Printf("Rolled back %d migration(s)\n", rolledBack)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Error("Failed to read migration status", "error", err)
+			return 1
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, st := range statuses {
+			applied := "pending"
+			if st.AppliedAt != nil {
+				applied = st.AppliedAt.Format(time.RFC3339)
+				if st.Modified {
+					applied += " (modified)"
+				}
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", st.Version, st.Name, applied)
+		}
+		w.Flush()
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		return 2
+	}
+	return 0
+}
+
 // startGRPCServer starts the gRPC server
-func startGRPCServer(modelService service.ModelService, log *logger.Logger) {
+func startGRPCServer(modelService service.ModelService, watchService service.WatchService, auditService service.AuditService, webhookService service.WebhookService, apiKeyService service.APIKeyService, userService service.UserService, usageService service.UsageService, billingService service.BillingService, healthServer *health.Server, signer *servicetoken.Signer, log *logger.Logger) {
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), auth.UnaryServerInterceptor(signer), auth.UnaryAuthorizationInterceptor(), rpcserver.UnaryConsistencyInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor(), auth.StreamServerInterceptor(signer), auth.StreamAuthorizationInterceptor()),
+	)
 
 	// Create gRPC service implementation
-	grpcService := rpcserver.NewGRPCServer(modelService)
+	grpcService := rpcserver.NewGRPCServer(modelService, watchService)
+	auditGRPCService := rpcserver.NewAuditGRPCServer(auditService)
+	webhookGRPCService := rpcserver.NewWebhookGRPCServer(webhookService)
+	apiKeyGRPCService := rpcserver.NewAPIKeyGRPCServer(apiKeyService)
+	userGRPCService := rpcserver.NewUserGRPCServer(userService)
+	usageGRPCService := rpcserver.NewUsageGRPCServer(usageService)
+	billingGRPCService := rpcserver.NewBillingGRPCServer(billingService)
 
 	// Register service
 	modelpb.RegisterModelServiceServer(grpcServer, grpcService)
+	modelpb.RegisterAuditServiceServer(grpcServer, auditGRPCService)
+	modelpb.RegisterWebhookServiceServer(grpcServer, webhookGRPCService)
+	modelpb.RegisterAPIKeyServiceServer(grpcServer, apiKeyGRPCService)
+	modelpb.RegisterUserServiceServer(grpcServer, userGRPCService)
+	modelpb.RegisterUsageServiceServer(grpcServer, usageGRPCService)
+	modelpb.RegisterBillingServiceServer(grpcServer, billingGRPCService)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 
 	// Listen on port 9090
 	lis, err := net.Listen("tcp", ":9090")
@@ -166,3 +462,40 @@ func startGRPCServer(modelService service.ModelService, log *logger.Logger) {
 		log.Fatal("Failed to start gRPC server", "error", err)
 	}
 }
+
+// probeHealth marks the gRPC services NOT_SERVING while the database is unreachable
+func probeHealth(ctx context.Context, db *gorm.DB, healthServer *health.Server, log *logger.Logger) {
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	serving := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		if sqlDB, err := db.DB(); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		} else if err := sqlDB.PingContext(ctx); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		if status != serving {
+			for _, name := range []string{"", modelpb.ModelService_ServiceDesc.ServiceName, modelpb.AuditService_ServiceDesc.ServiceName, modelpb.WebhookService_ServiceDesc.ServiceName, modelpb.APIKeyService_ServiceDesc.ServiceName, modelpb.UserService_ServiceDesc.ServiceName, modelpb.UsageService_ServiceDesc.ServiceName, modelpb.BillingService_ServiceDesc.ServiceName} {
+				healthServer.SetServingStatus(name, status)
+			}
+			if status != healthpb.HealthCheckResponse_SERVING {
+				log.Warn("gRPC health changed", "status", status.String())
+			} else {
+				log.Info("gRPC health changed", "status", status.String())
+			}
+			serving = status
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
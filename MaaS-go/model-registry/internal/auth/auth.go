@@ -0,0 +1,242 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/shared/policy"
+	"maas-platform/shared/servicetoken"
+)
+
+// Metadata keys used to propagate the caller identity
+const (
+	MetadataUserID    = "x-user-id"
+	MetadataTenantID  = "x-tenant-id"
+	MetadataRole      = "x-user-role"
+	MetadataRequestID = "x-request-id"
+	// MetadataScopes carries one value per scope when the caller uses an API key
+	MetadataScopes = "x-scopes"
+)
+
+// Caller identifies the user on whose behalf a request is made
+type Caller struct {
+	UserID    string
+	TenantID  string
+	Role      model.UserRole
+	RequestID string
+	// Scopes restrict an API key caller; nil for interactive users
+	Scopes []string
+}
+
+// IsAdmin returns true if the caller has the admin role
+func (c Caller) IsAdmin() bool {
+	return c.Role == model.RoleAdmin
+}
+
+// Subject returns the caller as a policy subject
+func (c Caller) Subject() policy.Subject {
+	return policy.Subject{
+		UserID:   c.UserID,
+		TenantID: c.TenantID,
+		Role:     string(c.Role),
+		Scopes:   c.Scopes,
+	}
+}
+
+type callerKey struct{}
+
+// NewContext returns a copy of ctx carrying the caller
+func NewContext(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// FromContext returns the caller stored in ctx, if any
+func FromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok && caller.UserID != ""
+}
+
+// FromMetadata extracts the caller from incoming gRPC metadata
+func FromMetadata(md metadata.MD) Caller {
+	get := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	return Caller{
+		UserID:    get(MetadataUserID),
+		TenantID:  get(MetadataTenantID),
+		Role:      model.UserRole(get(MetadataRole)),
+		RequestID: get(MetadataRequestID),
+		Scopes:    md.Get(MetadataScopes),
+	}
+}
+
+// healthServicePrefix marks the gRPC health service, which load balancers
+// and probes call without a service token
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// authenticate verifies the service token in gRPC metadata and returns the
+// caller it vouches for
+func authenticate(ctx context.Context, signer *servicetoken.Signer, fullMethod string) (context.Context, error) {
+	if strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	var token string
+	if values := md.Get(servicetoken.MetadataKey); len(values) > 0 {
+		token = values[0]
+	}
+
+	caller, err := verify(signer, token, FromMetadata(md))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return NewContext(ctx, caller), nil
+}
+
+// verify checks that a service token was issued for exactly this caller and
+// that the caller's role is known
+func verify(signer *servicetoken.Signer, token string, caller Caller) (Caller, error) {
+	id := servicetoken.Identity{
+		UserID:   caller.UserID,
+		TenantID: caller.TenantID,
+		Role:     string(caller.Role),
+		Scopes:   caller.Scopes,
+	}
+	if err := signer.Verify(token, id, time.Now()); err != nil {
+		return Caller{}, err
+	}
+	if caller.UserID != "" && !caller.Role.Valid() {
+		return Caller{}, fmt.Errorf("unknown role %q", caller.Role)
+	}
+	return caller, nil
+}
+
+// UnaryServerInterceptor rejects calls without a valid service token and stores
+// the caller it vouches for in the request context. A verified call without a
+// user is the gateway acting on its own behalf.
+func UnaryServerInterceptor(signer *servicetoken.Signer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, signer, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streams without a valid service token and
+// stores the caller it vouches for in the stream context
+func StreamServerInterceptor(signer *servicetoken.Signer) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), signer, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextStream overrides the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the overridden context
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// UnaryAuthorizationInterceptor rejects calls whose caller lacks the permission
+// the method requires. Calls without a caller come from the authenticated gateway.
+func UnaryAuthorizationInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorizeMethod(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthorizationInterceptor rejects streams whose caller lacks the permission the method requires
+func StreamAuthorizationInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorizeMethod(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorizeMethod checks the caller in ctx against the policy of a gRPC method; unknown methods are denied to callers
+func authorizeMethod(ctx context.Context, fullMethod string) error {
+	caller, ok := FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	perm, known := policy.MethodPermission(fullMethod)
+	if !known || !policy.Allowed(caller.Subject(), perm) {
+		return status.Errorf(codes.PermissionDenied, "role %q may not call %s", caller.Role, fullMethod)
+	}
+	return nil
+}
+
+// Middleware rejects requests without a valid service token and stores the
+// caller it vouches for, taken from HTTP headers, in the request context
+func Middleware(signer *servicetoken.Signer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		caller := Caller{
+			UserID:    c.GetHeader("X-User-ID"),
+			TenantID:  c.GetHeader("X-Tenant-ID"),
+			Role:      model.UserRole(c.GetHeader("X-User-Role")),
+			RequestID: c.GetString("request_id"),
+		}
+		if scopes := c.GetHeader("X-Scopes"); scopes != "" {
+			caller.Scopes = strings.Split(scopes, ",")
+		}
+
+		caller, err := verify(signer, c.GetHeader(servicetoken.Header), caller)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.Request = c.Request.WithContext(NewContext(c.Request.Context(), caller))
+		c.Next()
+	}
+}
+
+// Authorize returns a middleware requiring read for GET requests and write for
+// the others; requests without a caller come from the authenticated gateway
+func Authorize(read, write policy.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		caller, ok := FromContext(c.Request.Context())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		perm := write
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			perm = read
+		}
+		if !policy.Allowed(caller.Subject(), perm) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "forbidden"})
+			return
+		}
+		c.Next()
+	}
+}
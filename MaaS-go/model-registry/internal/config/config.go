@@ -2,28 +2,57 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 // Config holds all configuration for the Model Registry
 type Config struct {
-	Environment string         `mapstructure:"environment"`
-	Port        int            `mapstructure:"port"`
-	LogLevel    string         `mapstructure:"log_level"`
-	Database    DatabaseConfig `mapstructure:"database"`
-	Redis       RedisConfig    `mapstructure:"redis"`
-	Services    ServiceConfig  `mapstructure:"services"`
+	Environment string          `mapstructure:"environment"`
+	Port        int             `mapstructure:"port"`
+	LogLevel    string          `mapstructure:"log_level"`
+	Database    DatabaseConfig  `mapstructure:"database"`
+	Redis       RedisConfig     `mapstructure:"redis"`
+	Services    ServiceConfig   `mapstructure:"services"`
+	Storage     StorageConfig   `mapstructure:"storage"`
+	Retention   RetentionConfig `mapstructure:"retention"`
+	Events      EventsConfig    `mapstructure:"events"`
+	Webhooks    WebhooksConfig  `mapstructure:"webhooks"`
+	Watch       WatchConfig     `mapstructure:"watch"`
+	Billing     BillingConfig   `mapstructure:"billing"`
+	Metrics     MetricsConfig   `mapstructure:"metrics"`
+	Tracing     TracingConfig   `mapstructure:"tracing"`
+	Auth        AuthConfig      `mapstructure:"auth"`
 }
 
 // DatabaseConfig holds database configuration
 type DatabaseConfig struct {
+	// Backend is postgres, sqlite or memory
+	Backend  string `mapstructure:"backend"`
 	Host     string `mapstructure:"host"`
 	Port     int    `mapstructure:"port"`
 	User     string `mapstructure:"user"`
 	Password string `mapstructure:"password"`
 	Name     string `mapstructure:"name"`
 	SSLMode  string `mapstructure:"ssl_mode"`
+	// Path is the SQLite database file
+	Path string `mapstructure:"path"`
+	// AutoMigrate applies pending migrations on startup; disable it to run `migrate up` as a deploy step
+	AutoMigrate bool `mapstructure:"auto_migrate"`
+
+	MaxOpenConns    int           `mapstructure:"max_open_conns"`
+	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"`
+	// StatementTimeout aborts Postgres statements running longer; 0 disables it
+	StatementTimeout time.Duration `mapstructure:"statement_timeout"`
+	// SlowQueryThreshold logs statements running longer; 0 disables it
+	SlowQueryThreshold time.Duration `mapstructure:"slow_query_threshold"`
+	// LogLevel is silent, error, warn or info; info logs every statement
+	LogLevel string `mapstructure:"log_level"`
+	// Replicas are Postgres read replicas (host or host:port) serving model reads
+	Replicas []string `mapstructure:"replicas"`
 }
 
 // RedisConfig holds Redis configuration
@@ -39,6 +68,99 @@ type ServiceConfig struct {
 	APIGateway string `mapstructure:"api_gateway"`
 }
 
+// StorageConfig holds artifact storage configuration
+type StorageConfig struct {
+	Root string `mapstructure:"root"`
+}
+
+// RetentionConfig holds retention periods for deleted data
+type RetentionConfig struct {
+	// DeletedModels is how long models stay in the trash before being purged; 0 keeps them forever
+	DeletedModels time.Duration `mapstructure:"deleted_models"`
+	ReapInterval  time.Duration `mapstructure:"reap_interval"`
+}
+
+// EventsConfig holds domain event publishing configuration
+type EventsConfig struct {
+	// Publisher is one of kafka, nats, memory or none
+	Publisher    string        `mapstructure:"publisher"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	// Retention is how long published and dead events stay in the outbox
+	Retention time.Duration `mapstructure:"retention"`
+	// MaxAttempts is how often an event is tried before it is given up on
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// RetryBackoff doubles after each failed attempt up to MaxRetryBackoff
+	RetryBackoff    time.Duration `mapstructure:"retry_backoff"`
+	MaxRetryBackoff time.Duration `mapstructure:"max_retry_backoff"`
+	// ClaimTimeout is how long a relay holds a batch it is publishing
+	ClaimTimeout time.Duration `mapstructure:"claim_timeout"`
+	Kafka        KafkaConfig   `mapstructure:"kafka"`
+	NATS         NATSConfig    `mapstructure:"nats"`
+}
+
+// KafkaConfig holds Kafka REST Proxy configuration
+type KafkaConfig struct {
+	RESTURL string        `mapstructure:"rest_url"`
+	Topic   string        `mapstructure:"topic"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// NATSConfig holds NATS configuration
+type NATSConfig struct {
+	URL           string `mapstructure:"url"`
+	SubjectPrefix string `mapstructure:"subject_prefix"`
+}
+
+// WebhooksConfig holds webhook delivery configuration
+type WebhooksConfig struct {
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	// MaxAttempts is the number of attempts before a delivery is dead-lettered
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+	Timeout        time.Duration `mapstructure:"timeout"`
+	// AllowedCIDRs lets webhooks reach internal ranges that are refused by default
+	AllowedCIDRs []string `mapstructure:"allowed_cidrs"`
+}
+
+// WatchConfig holds WatchModels streaming configuration
+type WatchConfig struct {
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// GapTimeout is how long a missing outbox sequence is waited for
+	GapTimeout time.Duration `mapstructure:"gap_timeout"`
+}
+
+// BillingConfig holds invoice generation configuration
+type BillingConfig struct {
+	// InvoiceInterval is how often invoices are regenerated; 0 disables the scheduler
+	InvoiceInterval time.Duration `mapstructure:"invoice_interval"`
+}
+
+// MetricsConfig holds Prometheus metrics configuration
+type MetricsConfig struct {
+	// RefreshInterval is how often the business gauges are recomputed; 0 disables them
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+// AuthConfig holds caller authentication configuration
+type AuthConfig struct {
+	// ServiceSecret verifies the tokens the gateway signs each call with
+	ServiceSecret string `mapstructure:"service_secret"`
+	// ServiceTokenTTL bounds the clock skew and replay window of a token
+	ServiceTokenTTL time.Duration `mapstructure:"service_token_ttl"`
+}
+
+// TracingConfig holds OpenTelemetry tracing configuration
+type TracingConfig struct {
+	// Exporter is one of otlp, stdout or none
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
 // Load returns the application configuration
 func Load() *Config {
 	viper.SetConfigName("config")
@@ -51,15 +173,56 @@ func Load() *Config {
 	viper.SetDefault("environment", "development")
 	viper.SetDefault("port", 8081)
 	viper.SetDefault("log_level", "info")
+	viper.SetDefault("database.backend", "postgres")
 	viper.SetDefault("database.host", "localhost")
 	viper.SetDefault("database.port", 5432)
 	viper.SetDefault("database.user", "postgres")
 	viper.SetDefault("database.password", "postgres")
 	viper.SetDefault("database.name", "maas_registry")
 	viper.SetDefault("database.ssl_mode", "disable")
+	viper.SetDefault("database.path", "./data/registry.db")
+	viper.SetDefault("database.auto_migrate", true)
+	viper.SetDefault("database.max_open_conns", 25)
+	viper.SetDefault("database.max_idle_conns", 10)
+	viper.SetDefault("database.conn_max_lifetime", "30m")
+	viper.SetDefault("database.conn_max_idle_time", "5m")
+	viper.SetDefault("database.statement_timeout", "30s")
+	viper.SetDefault("database.slow_query_threshold", "200ms")
+	viper.SetDefault("database.log_level", "warn")
 	viper.SetDefault("redis.host", "localhost")
 	viper.SetDefault("redis.port", 6379)
 	viper.SetDefault("redis.db", 0)
+	viper.SetDefault("storage.root", "./data/artifacts")
+	viper.SetDefault("retention.deleted_models", "720h")
+	viper.SetDefault("retention.reap_interval", "1h")
+	viper.SetDefault("events.publisher", "memory")
+	viper.SetDefault("events.poll_interval", "1s")
+	viper.SetDefault("events.batch_size", 100)
+	viper.SetDefault("events.retention", "168h")
+	viper.SetDefault("events.max_attempts", 10)
+	viper.SetDefault("events.retry_backoff", "1s")
+	viper.SetDefault("events.max_retry_backoff", "5m")
+	viper.SetDefault("events.claim_timeout", "1m")
+	viper.SetDefault("events.kafka.rest_url", "http://localhost:8082")
+	viper.SetDefault("events.kafka.topic", "maas.model-events")
+	viper.SetDefault("events.kafka.timeout", "10s")
+	viper.SetDefault("events.nats.url", "nats://localhost:4222")
+	viper.SetDefault("events.nats.subject_prefix", "maas.events")
+	viper.SetDefault("webhooks.poll_interval", "1s")
+	viper.SetDefault("webhooks.batch_size", 50)
+	viper.SetDefault("webhooks.max_attempts", 8)
+	viper.SetDefault("webhooks.initial_backoff", "10s")
+	viper.SetDefault("webhooks.max_backoff", "1h")
+	viper.SetDefault("webhooks.timeout", "10s")
+	viper.SetDefault("watch.poll_interval", "500ms")
+	viper.SetDefault("watch.gap_timeout", "10s")
+	viper.SetDefault("billing.invoice_interval", "1h")
+	viper.SetDefault("metrics.refresh_interval", "30s")
+	viper.SetDefault("tracing.exporter", "none")
+	viper.SetDefault("tracing.endpoint", "localhost:4317")
+	viper.SetDefault("tracing.insecure", true)
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+	viper.SetDefault("auth.service_token_ttl", "5m")
 
 	// Read from environment variables
 	viper.AutomaticEnv()
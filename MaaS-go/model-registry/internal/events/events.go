@@ -0,0 +1,73 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+// Publisher kinds accepted by NewPublisher
+const (
+	PublisherKafka  = "kafka"
+	PublisherNATS   = "nats"
+	PublisherMemory = "memory"
+	PublisherNone   = "none"
+)
+
+// Event is a domain event as delivered to subscribers.
+// Delivery is at-least-once, so consumers should deduplicate on ID.
+type Event struct {
+	ID         string          `json:"id"`
+	Sequence   int64           `json:"sequence"`
+	Type       model.EventType `json:"type"`
+	ModelID    string          `json:"model_id"`
+	TenantID   string          `json:"tenant_id"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// FromOutbox converts an outbox row to an event
+func FromOutbox(e *model.OutboxEvent) Event {
+	return Event{
+		ID:         e.EventID,
+		Sequence:   e.Sequence,
+		Type:       e.Type,
+		ModelID:    e.ModelID,
+		TenantID:   e.TenantID,
+		OccurredAt: e.OccurredAt,
+		Payload:    e.Payload,
+	}
+}
+
+// Publisher delivers events to a message broker.
+// Publish must return only once the broker has accepted the event.
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+	Close() error
+}
+
+// Config holds publisher configuration
+type Config struct {
+	Publisher string
+	Kafka     KafkaConfig
+	NATS      NATSConfig
+}
+
+// NewPublisher creates the publisher selected by the configuration
+func NewPublisher(cfg Config) (Publisher, error) {
+	switch cfg.Publisher {
+	case PublisherKafka:
+		return NewKafkaPublisher(cfg.Kafka)
+	case PublisherNATS:
+		return NewNATSPublisher(cfg.NATS)
+	case PublisherMemory:
+		return NewMemoryPublisher(), nil
+	case PublisherNone, "":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown event publisher: %s", cfg.Publisher)
+	}
+}
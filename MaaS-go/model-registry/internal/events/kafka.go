@@ -0,0 +1,111 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+// KafkaConfig holds Kafka publisher configuration
+type KafkaConfig struct {
+	// RESTURL is the base URL of the Kafka REST Proxy
+	RESTURL string
+	Topic   string
+	Timeout time.Duration
+}
+
+// KafkaPublisher publishes events to Kafka through the Kafka REST Proxy.
+// Records are keyed by model ID so all events of a model land on the same
+// partition and keep their order.
+type KafkaPublisher struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewKafkaPublisher creates a new Kafka publisher
+func NewKafkaPublisher(cfg KafkaConfig) (*KafkaPublisher, error) {
+	if cfg.RESTURL == "" || cfg.Topic == "" {
+		return nil, errors.New("kafka publisher requires a REST proxy URL and a topic")
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &KafkaPublisher{
+		endpoint: strings.TrimRight(cfg.RESTURL, "/") + "/topics/" + cfg.Topic,
+		client:   &http.Client{Timeout: timeout},
+	}, nil
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value Event  `json:"value"`
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		Partition int    `json:"partition"`
+		Offset    int64  `json:"offset"`
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+// Publish produces the event as a single record
+func (p *KafkaPublisher) Publish(ctx context.Context, event Event) error {
+	body, err := json.Marshal(kafkaProduceRequest{
+		Records: []kafkaRecord{{Key: event.ModelID, Value: event}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to publish to Kafka: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("kafka REST proxy returned %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	var produced kafkaProduceResponse
+	if err := json.Unmarshal(data, &produced); err != nil {
+		return fmt.Errorf("failed to decode Kafka REST proxy response: %w", err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("kafka rejected record: %s (code %d)", offset.Error, *offset.ErrorCode)
+		}
+	}
+	return nil
+}
+
+// Close releases idle connections
+func (p *KafkaPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
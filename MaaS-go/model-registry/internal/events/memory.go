@@ -0,0 +1,83 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryPublisher fans events out to in-process subscribers.
+// It is meant for single-instance deployments and tests.
+type MemoryPublisher struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	ch   chan Event
+	done chan struct{}
+	once sync.Once
+}
+
+func (s *subscriber) stop() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// NewMemoryPublisher creates a new in-memory publisher
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Subscribe registers a subscriber; the returned function unsubscribes it.
+// Publish waits while a subscriber's buffer is full, so subscribers must keep
+// up or unsubscribe. The channel is never closed.
+func (p *MemoryPublisher) Subscribe(buffer int) (<-chan Event, func()) {
+	sub := &subscriber{
+		ch:   make(chan Event, buffer),
+		done: make(chan struct{}),
+	}
+
+	p.mu.Lock()
+	p.subscribers[sub] = struct{}{}
+	p.mu.Unlock()
+
+	return sub.ch, func() {
+		sub.stop()
+		p.mu.Lock()
+		delete(p.subscribers, sub)
+		p.mu.Unlock()
+	}
+}
+
+// Publish delivers the event to every subscriber
+func (p *MemoryPublisher) Publish(ctx context.Context, event Event) error {
+	p.mu.RLock()
+	subs := make([]*subscriber, 0, len(p.subscribers))
+	for sub := range p.subscribers {
+		subs = append(subs, sub)
+	}
+	p.mu.RUnlock()
+
+	for _, sub := range subs {
+		select {
+		case sub.ch <- event:
+		case <-sub.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Close drops all subscribers
+func (p *MemoryPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for sub := range p.subscribers {
+		sub.stop()
+		delete(p.subscribers, sub)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSConfig holds NATS publisher configuration
+type NATSConfig struct {
+	URL string
+	// SubjectPrefix is prepended to the event type, e.g. "maas.events.model.created"
+	SubjectPrefix string
+}
+
+// NATSPublisher publishes events to NATS JetStream.
+// Every publish waits for the stream acknowledgement and carries the event ID
+// as Nats-Msg-Id so redeliveries inside the duplicate window are dropped.
+type NATSPublisher struct {
+	conn   *nats.Conn
+	js     nats.JetStreamContext
+	prefix string
+}
+
+// NewNATSPublisher connects to NATS and creates a new publisher
+func NewNATSPublisher(cfg NATSConfig) (*NATSPublisher, error) {
+	conn, err := nats.Connect(cfg.URL, nats.Name("model-registry"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open JetStream context: %w", err)
+	}
+
+	return &NATSPublisher{
+		conn:   conn,
+		js:     js,
+		prefix: cfg.SubjectPrefix,
+	}, nil
+}
+
+// Publish sends the event to the subject derived from its type
+func (p *NATSPublisher) Publish(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	subject := string(event.Type)
+	if p.prefix != "" {
+		subject = p.prefix + "." + subject
+	}
+
+	msg := nats.NewMsg(subject)
+	msg.Header.Set("Model-Id", event.ModelID)
+	msg.Data = data
+
+	if _, err := p.js.PublishMsg(msg, nats.MsgId(event.ID), nats.Context(ctx)); err != nil {
+		return fmt.Errorf("failed to publish to NATS: %w", err)
+	}
+	return nil
+}
+
+// Close drains the connection
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
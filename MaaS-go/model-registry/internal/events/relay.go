@@ -0,0 +1,210 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// RelayConfig holds outbox relay configuration
+type RelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// Retention is how long published and dead events are kept in the outbox
+	Retention time.Duration
+	// MaxAttempts is how often an event is tried before it is given up on
+	MaxAttempts int
+	// RetryBackoff is the wait after the first failure; it doubles with each
+	// further failure up to MaxRetryBackoff
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	// ClaimTimeout is how long a batch is held by the relay publishing it; a
+	// relay that dies mid-batch leaves the rest to be relayed after it
+	ClaimTimeout time.Duration
+}
+
+// Relay moves events from the outbox to the publisher.
+// An event is marked published only after the broker accepted it, so a crash
+// in between causes a redelivery rather than a loss. When an event fails, the
+// remaining events of the same model are held back until it succeeds or is
+// given up on after MaxAttempts, which keeps per-model ordering without one
+// rejected event blocking the model for ever. A batch is claimed under the
+// outbox lock and published after the lock is released, so broker calls never
+// hold a database transaction open.
+type Relay struct {
+	repo      repository.OutboxRepository
+	publisher Publisher
+	cfg       RelayConfig
+	logger    *logger.Logger
+}
+
+// NewRelay creates a new outbox relay
+func NewRelay(repo repository.OutboxRepository, publisher Publisher, cfg RelayConfig, logger *logger.Logger) *Relay {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 10
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = time.Second
+	}
+	if cfg.MaxRetryBackoff < cfg.RetryBackoff {
+		cfg.MaxRetryBackoff = 5 * time.Minute
+	}
+	if cfg.ClaimTimeout <= 0 {
+		cfg.ClaimTimeout = time.Minute
+	}
+	return &Relay{
+		repo:      repo,
+		publisher: publisher,
+		cfg:       cfg,
+		logger:    logger,
+	}
+}
+
+// Run relays events until ctx is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	lastPrune := time.Time{}
+	for {
+		// Keep draining while batches come back full
+		for {
+			n, err := r.relay(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					r.logger.Error("Failed to relay outbox events", "error", err)
+				}
+				break
+			}
+			if n < r.cfg.BatchSize {
+				break
+			}
+		}
+
+		if r.cfg.Retention > 0 && time.Since(lastPrune) > time.Hour {
+			r.prune(ctx)
+			lastPrune = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay publishes one batch of pending events and reports how many were fetched
+func (r *Relay) relay(ctx context.Context) (int, error) {
+	claimed := time.Now()
+	until := claimed.Add(r.cfg.ClaimTimeout)
+	var batch []*model.OutboxEvent
+	_, err := r.repo.Exclusive(ctx, func(repo repository.OutboxRepository) error {
+		pending, err := repo.Pending(ctx, r.cfg.BatchSize, claimed)
+		if err != nil {
+			return err
+		}
+		batch = pending
+		return repo.Claim(ctx, sequences(pending), until)
+	})
+	if err != nil || len(batch) == 0 {
+		return len(batch), err
+	}
+
+	// Stop with time to spare, so the claim never lapses while we still publish
+	stopAt := claimed.Add(r.cfg.ClaimTimeout / 2)
+	publishCtx, cancel := context.WithDeadline(ctx, until)
+	defer cancel()
+
+	blocked := make(map[string]struct{})
+	published := make([]int64, 0, len(batch))
+	var released []int64
+	for i, e := range batch {
+		if time.Now().After(stopAt) || ctx.Err() != nil {
+			released = append(released, sequences(batch[i:])...)
+			break
+		}
+		if _, ok := blocked[e.ModelID]; ok {
+			released = append(released, e.Sequence)
+			continue
+		}
+
+		if err := r.publisher.Publish(publishCtx, FromOutbox(e)); err != nil {
+			blocked[e.ModelID] = struct{}{}
+			if err := r.fail(ctx, e, err); err != nil {
+				return len(batch), err
+			}
+			continue
+		}
+		published = append(published, e.Sequence)
+	}
+
+	if err := r.repo.MarkPublished(ctx, published); err != nil {
+		return len(batch), err
+	}
+	return len(batch), r.repo.Release(ctx, released)
+}
+
+// fail records a failed attempt, backing off exponentially, and gives up on
+// the event after MaxAttempts
+func (r *Relay) fail(ctx context.Context, e *model.OutboxEvent, cause error) error {
+	attempts := e.Attempts + 1
+	if attempts >= r.cfg.MaxAttempts {
+		r.logger.Error("Giving up on event",
+			"event_id", e.EventID,
+			"type", e.Type,
+			"model_id", e.ModelID,
+			"attempts", attempts,
+			"error", cause,
+		)
+		return r.repo.MarkDead(ctx, e.Sequence, cause.Error())
+	}
+
+	backoff := r.cfg.RetryBackoff
+	for i := 1; i < attempts && backoff < r.cfg.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > r.cfg.MaxRetryBackoff {
+		backoff = r.cfg.MaxRetryBackoff
+	}
+	r.logger.Warn("Failed to publish event",
+		"event_id", e.EventID,
+		"type", e.Type,
+		"model_id", e.ModelID,
+		"attempts", attempts,
+		"retry_in", backoff,
+		"error", cause,
+	)
+	return r.repo.MarkFailed(ctx, e.Sequence, cause.Error(), time.Now().Add(backoff))
+}
+
+// sequences returns the sequences of events
+func sequences(events []*model.OutboxEvent) []int64 {
+	seqs := make([]int64, len(events))
+	for i, e := range events {
+		seqs[i] = e.Sequence
+	}
+	return seqs
+}
+
+// prune deletes published and dead events older than the retention period
+func (r *Relay) prune(ctx context.Context) {
+	cutoff := time.Now().Add(-r.cfg.Retention)
+	pruned, err := r.repo.PrunePublished(ctx, cutoff)
+	if err != nil {
+		r.logger.Error("Failed to prune outbox", "error", err)
+		return
+	}
+	if pruned > 0 {
+		r.logger.Info("Pruned published outbox events", "count", pruned)
+	}
+}
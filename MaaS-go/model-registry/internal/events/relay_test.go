@@ -0,0 +1,119 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// rejectingPublisher records published events and rejects the listed event IDs
+type rejectingPublisher struct {
+	outbox    repository.OutboxRepository
+	rejected  map[string]bool
+	published []Event
+	attempts  map[string]int
+	// locked is set when a publish ran while the outbox lock was held
+	locked bool
+}
+
+func (p *rejectingPublisher) Publish(ctx context.Context, e Event) error {
+	p.attempts[e.ID]++
+	ran, err := p.outbox.Exclusive(ctx, func(repository.OutboxRepository) error { return nil })
+	if err != nil || !ran {
+		p.locked = true
+	}
+	if p.rejected[e.ID] {
+		return errors.New("rejected by broker")
+	}
+	p.published = append(p.published, e)
+	return nil
+}
+
+func (p *rejectingPublisher) Close() error { return nil }
+
+// newRelayTest returns a relay over a memory outbox holding two events of one
+// model and one of another
+func newRelayTest(t *testing.T, cfg RelayConfig) (*Relay, *rejectingPublisher, []*model.OutboxEvent) {
+	t.Helper()
+	ctx := context.Background()
+	store := repository.NewMemoryStore()
+	models := repository.NewMemoryModelRepository(store)
+	outbox := repository.NewMemoryOutboxRepository(store)
+
+	tenant := uuid.New().String()
+	for _, version := range []string{"1.0.0", "2.0.0"} {
+		m := &model.Model{Name: "bert", Version: version, Framework: model.FrameworkPyTorch, OwnerID: uuid.New().String(), TenantID: tenant}
+		if err := models.Create(ctx, m); err != nil {
+			t.Fatalf("create model: %v", err)
+		}
+		if version == "1.0.0" {
+			if err := models.UpdateStatus(ctx, m.ID, model.ModelStatusReady); err != nil {
+				t.Fatalf("update status: %v", err)
+			}
+		}
+	}
+	events, err := outbox.Pending(ctx, 10, time.Now())
+	if err != nil || len(events) != 3 {
+		t.Fatalf("pending = %d events, %v; want 3", len(events), err)
+	}
+
+	p := &rejectingPublisher{outbox: outbox, rejected: map[string]bool{}, attempts: map[string]int{}}
+	return NewRelay(outbox, p, cfg, logger.New("error")), p, events
+}
+
+func TestRelayGivesUpOnRejectedEvent(t *testing.T) {
+	r, p, events := newRelayTest(t, RelayConfig{MaxAttempts: 3, RetryBackoff: time.Millisecond, MaxRetryBackoff: time.Millisecond})
+	poison, follower, other := events[0], events[1], events[2]
+	p.rejected[poison.EventID] = true
+
+	ctx := context.Background()
+	for i := 0; i < 20 && len(p.published) < 2; i++ {
+		if _, err := r.relay(ctx); err != nil {
+			t.Fatalf("relay: %v", err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	if got := p.attempts[poison.EventID]; got != 3 {
+		t.Errorf("rejected event tried %d times, want 3", got)
+	}
+	if len(p.published) != 2 || p.published[0].ID != other.EventID || p.published[1].ID != follower.EventID {
+		t.Fatalf("published %+v, want the other model's event, then the follower once the rejected one was given up on", p.published)
+	}
+	if p.locked {
+		t.Error("published while holding the outbox lock")
+	}
+
+	pending, err := r.repo.Pending(ctx, 10, time.Now())
+	if err != nil || len(pending) != 0 {
+		t.Errorf("pending after relaying = %d events, %v; want none", len(pending), err)
+	}
+}
+
+func TestRelayBacksOff(t *testing.T) {
+	r, p, events := newRelayTest(t, RelayConfig{MaxAttempts: 10, RetryBackoff: time.Hour})
+	p.rejected[events[0].EventID] = true
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if _, err := r.relay(ctx); err != nil {
+			t.Fatalf("relay: %v", err)
+		}
+	}
+	if got := p.attempts[events[0].EventID]; got != 1 {
+		t.Errorf("rejected event tried %d times within its backoff, want 1", got)
+	}
+	if p.attempts[events[1].EventID] != 0 {
+		t.Error("event published ahead of an earlier event of its model")
+	}
+	if len(p.published) != 1 || p.published[0].ID != events[2].EventID {
+		t.Errorf("published %+v, want only the other model's event", p.published)
+	}
+}
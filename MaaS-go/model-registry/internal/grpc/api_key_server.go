@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	modelpb "maas-platform/shared/proto"
+)
+
+// APIKeyGRPCServer implements the gRPC APIKeyService
+type APIKeyGRPCServer struct {
+	modelpb.UnimplementedAPIKeyServiceServer
+	service service.APIKeyService
+}
+
+// NewAPIKeyGRPCServer creates a new API key gRPC server
+func NewAPIKeyGRPCServer(svc service.APIKeyService) *APIKeyGRPCServer {
+	return &APIKeyGRPCServer{
+		service: svc,
+	}
+}
+
+// CreateAPIKey creates an API key via gRPC
+func (s *APIKeyGRPCServer) CreateAPIKey(ctx context.Context, req *modelpb.CreateAPIKeyRequest) (*modelpb.CreateAPIKeyResponse, error) {
+	createReq := service.CreateAPIKeyRequest{
+		TenantID: req.TenantId,
+		UserID:   req.UserId,
+		Role:     model.UserRole(req.Role),
+		Name:     req.Name,
+		Scopes:   convertScopes(req.Scopes),
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		createReq.ExpiresAt = &expiresAt
+	}
+
+	k, secret, err := s.service.CreateAPIKey(ctx, createReq)
+	if err != nil {
+		return nil, apiKeyError(err, "failed to create api key")
+	}
+
+	return &modelpb.CreateAPIKeyResponse{
+		ApiKey: convertAPIKeyToProto(k),
+		Secret: secret,
+	}, nil
+}
+
+// GetAPIKey retrieves an API key via gRPC
+func (s *APIKeyGRPCServer) GetAPIKey(ctx context.Context, req *modelpb.GetAPIKeyRequest) (*modelpb.GetAPIKeyResponse, error) {
+	k, err := s.service.GetAPIKey(ctx, req.Id)
+	if err != nil {
+		return nil, apiKeyError(err, "failed to get api key")
+	}
+	return &modelpb.GetAPIKeyResponse{ApiKey: convertAPIKeyToProto(k)}, nil
+}
+
+// ListAPIKeys lists API keys via gRPC
+func (s *APIKeyGRPCServer) ListAPIKeys(ctx context.Context, req *modelpb.ListAPIKeysRequest) (*modelpb.ListAPIKeysResponse, error) {
+	resp, err := s.service.ListAPIKeys(ctx, service.APIKeyQuery{
+		TenantID:       req.TenantId,
+		IncludeRevoked: req.IncludeRevoked,
+		Page:           int(req.Page),
+		Limit:          int(req.Limit),
+	})
+	if err != nil {
+		return nil, apiKeyError(err, "failed to list api keys")
+	}
+
+	keys := make([]*modelpb.APIKey, len(resp.Keys))
+	for i, k := range resp.Keys {
+		keys[i] = convertAPIKeyToProto(k)
+	}
+
+	return &modelpb.ListAPIKeysResponse{
+		ApiKeys: keys,
+		Total:   resp.Total,
+		Page:    int32(resp.Page),
+		Limit:   int32(resp.Limit),
+	}, nil
+}
+
+// RotateAPIKey replaces the secret of an API key via gRPC
+func (s *APIKeyGRPCServer) RotateAPIKey(ctx context.Context, req *modelpb.RotateAPIKeyRequest) (*modelpb.RotateAPIKeyResponse, error) {
+	k, secret, err := s.service.RotateAPIKey(ctx, req.Id)
+	if err != nil {
+		return nil, apiKeyError(err, "failed to rotate api key")
+	}
+	return &modelpb.RotateAPIKeyResponse{
+		ApiKey: convertAPIKeyToProto(k),
+		Secret: secret,
+	}, nil
+}
+
+// RevokeAPIKey revokes an API key via gRPC
+func (s *APIKeyGRPCServer) RevokeAPIKey(ctx context.Context, req *modelpb.RevokeAPIKeyRequest) (*modelpb.RevokeAPIKeyResponse, error) {
+	k, err := s.service.RevokeAPIKey(ctx, req.Id)
+	if err != nil {
+		return nil, apiKeyError(err, "failed to revoke api key")
+	}
+	return &modelpb.RevokeAPIKeyResponse{ApiKey: convertAPIKeyToProto(k)}, nil
+}
+
+// VerifyAPIKey resolves a secret to its API key via gRPC
+func (s *APIKeyGRPCServer) VerifyAPIKey(ctx context.Context, req *modelpb.VerifyAPIKeyRequest) (*modelpb.VerifyAPIKeyResponse, error) {
+	k, err := s.service.VerifyAPIKey(ctx, req.Key)
+	if err != nil {
+		return nil, apiKeyError(err, "failed to verify api key")
+	}
+	return &modelpb.VerifyAPIKeyResponse{ApiKey: convertAPIKeyToProto(k)}, nil
+}
+
+// apiKeyError maps API key service errors to gRPC status errors
+func apiKeyError(err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrAPIKeyNotFound):
+		return status.Errorf(codes.NotFound, "api key not found")
+	case errors.Is(err, service.ErrInvalidAPIKey):
+		return status.Errorf(codes.Unauthenticated, "%v", err)
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, service.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// convertScopes converts scope names
+func convertScopes(scopes []string) []model.APIKeyScope {
+	result := make([]model.APIKeyScope, len(scopes))
+	for i, scope := range scopes {
+		result[i] = model.APIKeyScope(scope)
+	}
+	return result
+}
+
+// convertAPIKeyToProto converts an internal API key to protobuf
+func convertAPIKeyToProto(k *model.APIKey) *modelpb.APIKey {
+	scopes := make([]string, len(k.Scopes))
+	for i, scope := range k.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	pb := &modelpb.APIKey{
+		Id:        k.ID,
+		TenantId:  k.TenantID,
+		UserId:    k.UserID,
+		Role:      string(k.Role),
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    scopes,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+	if k.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		pb.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+	if k.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(*k.RevokedAt)
+	}
+	return pb
+}
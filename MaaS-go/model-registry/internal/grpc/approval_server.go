@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	modelpb "maas-platform/shared/proto"
+)
+
+// ListApprovalPolicies lists the approval policies of a tenant via gRPC
+func (s *GRPCServer) ListApprovalPolicies(ctx context.Context, req *modelpb.ListApprovalPoliciesRequest) (*modelpb.ListApprovalPoliciesResponse, error) {
+	policies, err := s.service.ListApprovalPolicies(ctx, req.TenantId)
+	if err != nil {
+		return nil, approvalError("list approval policies", err)
+	}
+
+	resp := &modelpb.ListApprovalPoliciesResponse{Policies: make([]*modelpb.ApprovalPolicy, len(policies))}
+	for i, p := range policies {
+		resp.Policies[i] = convertPolicyToProto(p)
+	}
+	return resp, nil
+}
+
+// SetApprovalPolicy makes an action of a tenant wait for approval via gRPC
+func (s *GRPCServer) SetApprovalPolicy(ctx context.Context, req *modelpb.ApprovalPolicy) (*modelpb.ApprovalPolicy, error) {
+	p, err := s.service.SetApprovalPolicy(ctx, &model.ApprovalPolicy{
+		TenantID:          req.TenantId,
+		Action:            model.ApprovalAction(req.Action),
+		RequiredApprovals: int(req.RequiredApprovals),
+		ApproverRole:      model.UserRole(req.ApproverRole),
+		TimeoutSeconds:    req.TimeoutSeconds,
+	})
+	if err != nil {
+		return nil, approvalError("set approval policy", err)
+	}
+	return convertPolicyToProto(p), nil
+}
+
+// DeleteApprovalPolicy stops an action of a tenant waiting for approval via gRPC
+func (s *GRPCServer) DeleteApprovalPolicy(ctx context.Context, req *modelpb.DeleteApprovalPolicyRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteApprovalPolicy(ctx, req.TenantId, model.ApprovalAction(req.Action)); err != nil {
+		return nil, approvalError("delete approval policy", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListApprovalRequests lists approval requests via gRPC
+func (s *GRPCServer) ListApprovalRequests(ctx context.Context, req *modelpb.ListApprovalRequestsRequest) (*modelpb.ListApprovalRequestsResponse, error) {
+	resp, err := s.service.ListApprovalRequests(ctx, service.ListApprovalRequestsFilter{
+		TenantID: req.TenantId,
+		ModelID:  req.ModelId,
+		State:    model.ApprovalState(req.State),
+		Page:     int(req.Page),
+		Limit:    int(req.Limit),
+	})
+	if err != nil {
+		return nil, approvalError("list approval requests", err)
+	}
+
+	requests := make([]*modelpb.ApprovalRequest, len(resp.Requests))
+	for i, r := range resp.Requests {
+		requests[i] = convertApprovalToProto(r)
+	}
+	return &modelpb.ListApprovalRequestsResponse{
+		Requests: requests,
+		Total:    resp.Total,
+		Page:     int32(resp.Page),
+		Limit:    int32(resp.Limit),
+	}, nil
+}
+
+// GetApprovalRequest gets an approval request via gRPC
+func (s *GRPCServer) GetApprovalRequest(ctx context.Context, req *modelpb.GetApprovalRequestRequest) (*modelpb.ApprovalRequest, error) {
+	r, err := s.service.GetApprovalRequest(ctx, req.Id)
+	if err != nil {
+		return nil, approvalError("get approval request", err)
+	}
+	return convertApprovalToProto(r), nil
+}
+
+// DecideApprovalRequest approves or rejects a pending approval request via gRPC
+func (s *GRPCServer) DecideApprovalRequest(ctx context.Context, req *modelpb.DecideApprovalRequestRequest) (*modelpb.ApprovalRequest, error) {
+	r, err := s.service.DecideApprovalRequest(ctx, req.Id, req.Approve, req.Comment)
+	if err != nil {
+		return nil, approvalError("decide approval request", err)
+	}
+	return convertApprovalToProto(r), nil
+}
+
+// approvalError maps approval errors to gRPC statuses
+func approvalError(op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrPolicyNotFound), errors.Is(err, service.ErrApprovalNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, service.ErrDuplicateDecision):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, service.ErrApprovalClosed):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, service.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", op, err)
+}
+
+// approvalRequiredError reports a change held back for approval as
+// FailedPrecondition carrying the pending request as a detail
+func approvalRequiredError(err error) error {
+	st := status.New(codes.FailedPrecondition, err.Error())
+	var required *service.ApprovalRequiredError
+	if errors.As(err, &required) {
+		if detailed, derr := st.WithDetails(convertApprovalToProto(required.Request)); derr == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
+
+// convertPolicyToProto converts an approval policy to protobuf
+func convertPolicyToProto(p *model.ApprovalPolicy) *modelpb.ApprovalPolicy {
+	return &modelpb.ApprovalPolicy{
+		Id:                p.ID,
+		TenantId:          p.TenantID,
+		Action:            string(p.Action),
+		RequiredApprovals: int32(p.RequiredApprovals),
+		ApproverRole:      string(p.ApproverRole),
+		TimeoutSeconds:    p.TimeoutSeconds,
+		CreatedBy:         p.CreatedBy,
+		CreatedAt:         timestamppb.New(p.CreatedAt),
+		UpdatedAt:         timestamppb.New(p.UpdatedAt),
+	}
+}
+
+// convertApprovalToProto converts an approval request to protobuf
+func convertApprovalToProto(r *model.ApprovalRequest) *modelpb.ApprovalRequest {
+	pb := &modelpb.ApprovalRequest{
+		Id:                r.ID,
+		TenantId:          r.TenantID,
+		ModelId:           r.ModelID,
+		Action:            string(r.Action),
+		TargetStatus:      string(r.TargetStatus),
+		TargetVersion:     r.TargetVersion,
+		State:             string(r.State),
+		RequiredApprovals: int32(r.RequiredApprovals),
+		ApproverRole:      string(r.ApproverRole),
+		RequestedBy:       r.RequestedBy,
+		Error:             r.Error,
+		Decisions:         make([]*modelpb.ApprovalDecision, len(r.Decisions)),
+		ExpiresAt:         timestamppb.New(r.ExpiresAt),
+		CreatedAt:         timestamppb.New(r.CreatedAt),
+	}
+	if r.ResolvedAt != nil {
+		pb.ResolvedAt = timestamppb.New(*r.ResolvedAt)
+	}
+	for i, d := range r.Decisions {
+		pb.Decisions[i] = &modelpb.ApprovalDecision{
+			ApproverId: d.ApproverID,
+			Approve:    d.Approve,
+			Comment:    d.Comment,
+			CreatedAt:  timestamppb.New(d.CreatedAt),
+		}
+	}
+	return pb
+}
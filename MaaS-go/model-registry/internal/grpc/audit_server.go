@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	modelpb "maas-platform/shared/proto"
+)
+
+// AuditGRPCServer implements the gRPC AuditService
+type AuditGRPCServer struct {
+	modelpb.UnimplementedAuditServiceServer
+	service service.AuditService
+}
+
+// NewAuditGRPCServer creates a new audit gRPC server
+func NewAuditGRPCServer(svc service.AuditService) *AuditGRPCServer {
+	return &AuditGRPCServer{
+		service: svc,
+	}
+}
+
+// RecordAuditEvent records an event reported by another service
+func (s *AuditGRPCServer) RecordAuditEvent(ctx context.Context, req *modelpb.RecordAuditEventRequest) (*emptypb.Empty, error) {
+	if req.Action == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "action is required")
+	}
+
+	event := service.AuditEvent{
+		Action:     model.AuditAction(req.Action),
+		TargetType: req.TargetType,
+		TargetID:   req.TargetId,
+		Source:     req.Source,
+		ActorID:    req.ActorId,
+		TenantID:   req.TenantId,
+	}
+	if req.Before != "" {
+		event.Before = json.RawMessage(req.Before)
+	}
+	if req.After != "" {
+		event.After = json.RawMessage(req.After)
+	}
+
+	if err := s.service.Record(ctx, event); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record audit event: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListAuditEvents lists audit events via gRPC
+func (s *AuditGRPCServer) ListAuditEvents(ctx context.Context, req *modelpb.ListAuditEventsRequest) (*modelpb.ListAuditEventsResponse, error) {
+	resp, err := s.service.List(ctx, convertAuditQuery(req))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit events: %v", err)
+	}
+
+	events := make([]*modelpb.AuditEvent, len(resp.Entries))
+	for i, e := range resp.Entries {
+		events[i] = convertAuditEntryToProto(e)
+	}
+
+	return &modelpb.ListAuditEventsResponse{
+		Events: events,
+		Total:  resp.Total,
+		Page:   int32(resp.Page),
+		Limit:  int32(resp.Limit),
+	}, nil
+}
+
+// ExportAuditEvents streams all matching audit events via gRPC
+func (s *AuditGRPCServer) ExportAuditEvents(req *modelpb.ListAuditEventsRequest, stream modelpb.AuditService_ExportAuditEventsServer) error {
+	err := s.service.Export(stream.Context(), convertAuditQuery(req), func(e *model.AuditEntry) error {
+		return stream.Send(convertAuditEntryToProto(e))
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to export audit events: %v", err)
+	}
+	return nil
+}
+
+// convertAuditQuery converts a protobuf audit query to a service query
+func convertAuditQuery(req *modelpb.ListAuditEventsRequest) service.AuditQuery {
+	query := service.AuditQuery{
+		TargetID: req.TargetId,
+		ActorID:  req.ActorId,
+		TenantID: req.TenantId,
+		Action:   model.AuditAction(req.Action),
+		Page:     int(req.Page),
+		Limit:    int(req.Limit),
+	}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+	return query
+}
+
+// convertAuditEntryToProto converts an audit entry to protobuf
+func convertAuditEntryToProto(e *model.AuditEntry) *modelpb.AuditEvent {
+	return &modelpb.AuditEvent{
+		Id:            e.ID,
+		OccurredAt:    timestamppb.New(e.OccurredAt),
+		ActorId:       e.ActorID,
+		TenantId:      e.TenantID,
+		ActorTenantId: e.ActorTenantID,
+		RequestId:     e.RequestID,
+		Source:        e.Source,
+		Action:        string(e.Action),
+		TargetType:    e.TargetType,
+		TargetId:      e.TargetID,
+		Before:        string(e.Before),
+		After:         string(e.After),
+		Diff:          string(e.Diff),
+	}
+}
@@ -0,0 +1,226 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	modelpb "maas-platform/shared/proto"
+)
+
+// BillingGRPCServer implements the gRPC BillingService
+type BillingGRPCServer struct {
+	modelpb.UnimplementedBillingServiceServer
+	service service.BillingService
+}
+
+// NewBillingGRPCServer creates a new billing gRPC server
+func NewBillingGRPCServer(svc service.BillingService) *BillingGRPCServer {
+	return &BillingGRPCServer{
+		service: svc,
+	}
+}
+
+// CreatePricingPlan creates a pricing plan via gRPC
+func (s *BillingGRPCServer) CreatePricingPlan(ctx context.Context, req *modelpb.CreatePricingPlanRequest) (*modelpb.CreatePricingPlanResponse, error) {
+	if req.Plan == nil {
+		return nil, status.Error(codes.InvalidArgument, "plan is required")
+	}
+	p := convertProtoToPlan(req.Plan)
+	p.ID = ""
+
+	p, err := s.service.CreatePlan(ctx, p)
+	if err != nil {
+		return nil, billingError(err, "failed to create pricing plan")
+	}
+	return &modelpb.CreatePricingPlanResponse{Plan: convertPlanToProto(p)}, nil
+}
+
+// UpdatePricingPlan replaces a pricing plan via gRPC
+func (s *BillingGRPCServer) UpdatePricingPlan(ctx context.Context, req *modelpb.UpdatePricingPlanRequest) (*modelpb.UpdatePricingPlanResponse, error) {
+	if req.Plan == nil || req.Plan.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "plan id is required")
+	}
+
+	p, err := s.service.UpdatePlan(ctx, convertProtoToPlan(req.Plan))
+	if err != nil {
+		return nil, billingError(err, "failed to update pricing plan")
+	}
+	return &modelpb.UpdatePricingPlanResponse{Plan: convertPlanToProto(p)}, nil
+}
+
+// ListPricingPlans lists pricing plans via gRPC
+func (s *BillingGRPCServer) ListPricingPlans(ctx context.Context, req *modelpb.ListPricingPlansRequest) (*modelpb.ListPricingPlansResponse, error) {
+	plans, err := s.service.ListPlans(ctx)
+	if err != nil {
+		return nil, billingError(err, "failed to list pricing plans")
+	}
+
+	result := make([]*modelpb.PricingPlan, len(plans))
+	for i, p := range plans {
+		result[i] = convertPlanToProto(p)
+	}
+	return &modelpb.ListPricingPlansResponse{Plans: result}, nil
+}
+
+// SetTenantPlan attaches a pricing plan to a tenant via gRPC
+func (s *BillingGRPCServer) SetTenantPlan(ctx context.Context, req *modelpb.SetTenantPlanRequest) (*emptypb.Empty, error) {
+	if err := s.service.SetTenantPlan(ctx, req.TenantId, req.PlanId); err != nil {
+		return nil, billingError(err, "failed to set tenant plan")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GenerateInvoice generates the invoice of a tenant for one month via gRPC
+func (s *BillingGRPCServer) GenerateInvoice(ctx context.Context, req *modelpb.GenerateInvoiceRequest) (*modelpb.GenerateInvoiceResponse, error) {
+	if req.Month == nil {
+		return nil, status.Error(codes.InvalidArgument, "month is required")
+	}
+
+	inv, err := s.service.GenerateInvoice(ctx, req.TenantId, req.Month.AsTime())
+	if err != nil {
+		return nil, billingError(err, "failed to generate invoice")
+	}
+	return &modelpb.GenerateInvoiceResponse{Invoice: convertInvoiceToProto(inv)}, nil
+}
+
+// GetInvoice retrieves an invoice via gRPC
+func (s *BillingGRPCServer) GetInvoice(ctx context.Context, req *modelpb.GetInvoiceRequest) (*modelpb.GetInvoiceResponse, error) {
+	inv, err := s.service.GetInvoice(ctx, req.Id)
+	if err != nil {
+		return nil, billingError(err, "failed to get invoice")
+	}
+	return &modelpb.GetInvoiceResponse{Invoice: convertInvoiceToProto(inv)}, nil
+}
+
+// ListInvoices lists invoices via gRPC
+func (s *BillingGRPCServer) ListInvoices(ctx context.Context, req *modelpb.ListInvoicesRequest) (*modelpb.ListInvoicesResponse, error) {
+	query := service.InvoiceQuery{
+		TenantID: req.TenantId,
+		Page:     int(req.Page),
+		Limit:    int(req.Limit),
+	}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+
+	resp, err := s.service.ListInvoices(ctx, query)
+	if err != nil {
+		return nil, billingError(err, "failed to list invoices")
+	}
+
+	invoices := make([]*modelpb.Invoice, len(resp.Invoices))
+	for i, inv := range resp.Invoices {
+		invoices[i] = convertInvoiceToProto(inv)
+	}
+
+	return &modelpb.ListInvoicesResponse{
+		Invoices: invoices,
+		Total:    resp.Total,
+		Page:     int32(resp.Page),
+		Limit:    int32(resp.Limit),
+	}, nil
+}
+
+// billingError maps billing service errors to gRPC status errors
+func billingError(err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrPlanNotFound):
+		return status.Errorf(codes.NotFound, "pricing plan not found")
+	case errors.Is(err, service.ErrInvoiceNotFound):
+		return status.Errorf(codes.NotFound, "invoice not found")
+	case errors.Is(err, service.ErrDuplicatePlan):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, service.ErrNoPricingPlan), errors.Is(err, service.ErrInvoiceFinal):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, service.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// convertProtoToPlan converts a protobuf pricing plan to the internal model
+func convertProtoToPlan(pb *modelpb.PricingPlan) *model.PricingPlan {
+	tiers := make([]model.DiscountTier, len(pb.DiscountTiers))
+	for i, t := range pb.DiscountTiers {
+		tiers[i] = model.DiscountTier{FromMicros: t.FromMicros, Percent: t.Percent}
+	}
+	return &model.PricingPlan{
+		ID:                 pb.Id,
+		Name:               pb.Name,
+		Description:        pb.Description,
+		Currency:           pb.Currency,
+		RequestPriceMicros: pb.RequestPriceMicros,
+		TokenPriceMicros:   pb.TokenPriceMicros,
+		StoragePriceMicros: pb.StoragePriceMicros,
+		FreeRequests:       pb.FreeRequests,
+		FreeTokens:         pb.FreeTokens,
+		FreeStorageGBMonth: pb.FreeStorageGbMonth,
+		DiscountTiers:      tiers,
+	}
+}
+
+// convertPlanToProto converts an internal pricing plan to protobuf
+func convertPlanToProto(p *model.PricingPlan) *modelpb.PricingPlan {
+	tiers := make([]*modelpb.DiscountTier, len(p.DiscountTiers))
+	for i, t := range p.DiscountTiers {
+		tiers[i] = &modelpb.DiscountTier{FromMicros: t.FromMicros, Percent: t.Percent}
+	}
+	return &modelpb.PricingPlan{
+		Id:                 p.ID,
+		Name:               p.Name,
+		Description:        p.Description,
+		Currency:           p.Currency,
+		RequestPriceMicros: p.RequestPriceMicros,
+		TokenPriceMicros:   p.TokenPriceMicros,
+		StoragePriceMicros: p.StoragePriceMicros,
+		FreeRequests:       p.FreeRequests,
+		FreeTokens:         p.FreeTokens,
+		FreeStorageGbMonth: p.FreeStorageGBMonth,
+		DiscountTiers:      tiers,
+		CreatedAt:          timestamppb.New(p.CreatedAt),
+		UpdatedAt:          timestamppb.New(p.UpdatedAt),
+	}
+}
+
+// convertInvoiceToProto converts an internal invoice to protobuf
+func convertInvoiceToProto(inv *model.Invoice) *modelpb.Invoice {
+	items := make([]*modelpb.InvoiceLineItem, len(inv.LineItems))
+	for i, item := range inv.LineItems {
+		items[i] = &modelpb.InvoiceLineItem{
+			Kind:            item.Kind,
+			Description:     item.Description,
+			ModelId:         item.ModelID,
+			Quantity:        item.Quantity,
+			Unit:            item.Unit,
+			UnitPriceMicros: item.UnitPriceMicros,
+			AmountMicros:    item.AmountMicros,
+		}
+	}
+	return &modelpb.Invoice{
+		Id:             inv.ID,
+		TenantId:       inv.TenantID,
+		PeriodStart:    timestamppb.New(inv.PeriodStart),
+		PeriodEnd:      timestamppb.New(inv.PeriodEnd),
+		PlanId:         inv.PlanID,
+		PlanName:       inv.PlanName,
+		Currency:       inv.Currency,
+		LineItems:      items,
+		SubtotalMicros: inv.SubtotalMicros,
+		DiscountMicros: inv.DiscountMicros,
+		TotalMicros:    inv.TotalMicros,
+		Final:          inv.Final,
+		GeneratedAt:    timestamppb.New(inv.GeneratedAt),
+	}
+}
@@ -0,0 +1,26 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"maas-platform/model-registry/internal/service"
+)
+
+// MetadataReadPrimary asks for reads that observe every committed write,
+// which clients send when the result must not come from a lagging replica
+const MetadataReadPrimary = "x-read-primary"
+
+// UnaryConsistencyInterceptor pins the reads of calls that carry
+// MetadataReadPrimary to the primary database
+func UnaryConsistencyInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get(MetadataReadPrimary); len(values) > 0 && values[0] == "true" {
+			ctx = service.RequirePrimary(ctx)
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	modelpb "maas-platform/shared/proto"
+)
+
+// RecordEvaluations records evaluation results against a version via gRPC
+func (s *GRPCServer) RecordEvaluations(ctx context.Context, req *modelpb.RecordEvaluationsRequest) (*modelpb.RecordEvaluationsResponse, error) {
+	results := make([]service.EvaluationResult, len(req.Results))
+	for i, r := range req.Results {
+		results[i] = service.EvaluationResult{
+			Metric:  r.Metric,
+			Value:   r.Value,
+			Dataset: r.Dataset,
+			Split:   r.Split,
+		}
+		if r.EvaluatedAt != nil {
+			results[i].EvaluatedAt = r.EvaluatedAt.AsTime()
+		}
+	}
+
+	evaluations, err := s.service.RecordEvaluations(ctx, req.ModelId, req.Version, results)
+	if err != nil {
+		return nil, evaluationError("record evaluations", err)
+	}
+	return &modelpb.RecordEvaluationsResponse{Evaluations: convertEvaluationsToProto(evaluations)}, nil
+}
+
+// ListEvaluations lists the evaluation results of a model via gRPC
+func (s *GRPCServer) ListEvaluations(ctx context.Context, req *modelpb.ListEvaluationsRequest) (*modelpb.ListEvaluationsResponse, error) {
+	evaluations, err := s.service.ListEvaluations(ctx, req.ModelId, req.Version, req.Metric)
+	if err != nil {
+		return nil, evaluationError("list evaluations", err)
+	}
+	return &modelpb.ListEvaluationsResponse{Evaluations: convertEvaluationsToProto(evaluations)}, nil
+}
+
+// CompareVersions diffs two versions of a model via gRPC
+func (s *GRPCServer) CompareVersions(ctx context.Context, req *modelpb.CompareVersionsRequest) (*modelpb.CompareVersionsResponse, error) {
+	cmp, err := s.service.CompareVersions(ctx, req.ModelId, req.Base, req.Target)
+	if err != nil {
+		return nil, evaluationError("compare versions", err)
+	}
+
+	resp := &modelpb.CompareVersionsResponse{
+		ModelId:    cmp.ModelID,
+		Base:       convertVersionToProto(cmp.Base),
+		Target:     convertVersionToProto(cmp.Target),
+		Promotable: cmp.Promotable,
+	}
+	for _, d := range cmp.Metrics {
+		resp.Metrics = append(resp.Metrics, &modelpb.MetricDiff{
+			Metric:        d.Metric,
+			Dataset:       d.Dataset,
+			Split:         d.Split,
+			Base:          d.Base,
+			Target:        d.Target,
+			Delta:         d.Delta,
+			RelativeDelta: d.RelativeDelta,
+		})
+	}
+	for _, d := range cmp.Signature {
+		resp.Signature = append(resp.Signature, &modelpb.TensorDiff{Kind: d.Kind, Name: d.Name, Change: d.Change, Base: d.Base, Target: d.Target})
+	}
+	for _, d := range cmp.Fields {
+		resp.Fields = append(resp.Fields, &modelpb.FieldDiff{Field: d.Field, Base: d.Base, Target: d.Target})
+	}
+	for _, r := range cmp.Rules {
+		resp.Rules = append(resp.Rules, &modelpb.RuleCheck{Rule: convertRuleToProto(r.Rule), Passed: r.Passed, Message: r.Message})
+	}
+	return resp, nil
+}
+
+// GetPromotionRules gets the promotion rules of a model via gRPC
+func (s *GRPCServer) GetPromotionRules(ctx context.Context, req *modelpb.GetPromotionRulesRequest) (*modelpb.PromotionRulesResponse, error) {
+	rules, err := s.service.GetPromotionRules(ctx, req.ModelId)
+	if err != nil {
+		return nil, evaluationError("get promotion rules", err)
+	}
+	return convertRulesToProto(rules), nil
+}
+
+// SetPromotionRules replaces the promotion rules of a model via gRPC
+func (s *GRPCServer) SetPromotionRules(ctx context.Context, req *modelpb.SetPromotionRulesRequest) (*modelpb.PromotionRulesResponse, error) {
+	rules := make([]*model.PromotionRule, len(req.Rules))
+	for i, r := range req.Rules {
+		rules[i] = &model.PromotionRule{
+			Metric:        r.Metric,
+			Dataset:       r.Dataset,
+			Split:         r.Split,
+			Direction:     model.RuleDirection(r.Direction),
+			MaxRegression: r.MaxRegression,
+			Relative:      r.Relative,
+		}
+	}
+
+	rules, err := s.service.SetPromotionRules(ctx, req.ModelId, rules)
+	if err != nil {
+		return nil, evaluationError("set promotion rules", err)
+	}
+	return convertRulesToProto(rules), nil
+}
+
+// evaluationError maps evaluation and promotion rule errors to gRPC statuses
+func evaluationError(op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrModelNotFound):
+		return status.Errorf(codes.NotFound, "model not found")
+	case errors.Is(err, service.ErrVersionNotFound):
+		return status.Errorf(codes.NotFound, "model version not found")
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, service.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", op, err)
+}
+
+// convertEvaluationsToProto converts evaluation results to protobuf
+func convertEvaluationsToProto(evaluations []*model.Evaluation) []*modelpb.Evaluation {
+	pb := make([]*modelpb.Evaluation, len(evaluations))
+	for i, e := range evaluations {
+		pb[i] = &modelpb.Evaluation{
+			Id:          e.ID,
+			ModelId:     e.ModelID,
+			Version:     e.Version,
+			Metric:      e.Metric,
+			Value:       e.Value,
+			Dataset:     e.Dataset,
+			Split:       e.Split,
+			EvaluatedAt: timestamppb.New(e.EvaluatedAt),
+			RecordedBy:  e.RecordedBy,
+			CreatedAt:   timestamppb.New(e.CreatedAt),
+		}
+	}
+	return pb
+}
+
+// convertRulesToProto converts promotion rules to protobuf
+func convertRulesToProto(rules []*model.PromotionRule) *modelpb.PromotionRulesResponse {
+	resp := &modelpb.PromotionRulesResponse{Rules: make([]*modelpb.PromotionRule, len(rules))}
+	for i, r := range rules {
+		resp.Rules[i] = convertRuleToProto(r)
+	}
+	return resp
+}
+
+// convertRuleToProto converts a promotion rule to protobuf
+func convertRuleToProto(r *model.PromotionRule) *modelpb.PromotionRule {
+	return &modelpb.PromotionRule{
+		Id:            r.ID,
+		Metric:        r.Metric,
+		Dataset:       r.Dataset,
+		Split:         r.Split,
+		Direction:     string(r.Direction),
+		MaxRegression: r.MaxRegression,
+		Relative:      r.Relative,
+		CreatedBy:     r.CreatedBy,
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+	}
+}
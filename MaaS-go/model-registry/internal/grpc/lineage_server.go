@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	modelpb "maas-platform/shared/proto"
+)
+
+// AddLineageEdge records where a model came from via gRPC
+func (s *GRPCServer) AddLineageEdge(ctx context.Context, req *modelpb.AddLineageEdgeRequest) (*modelpb.LineageEdge, error) {
+	edge, err := s.service.AddLineageEdge(ctx, service.AddLineageEdgeRequest{
+		ModelID:       req.ModelId,
+		Version:       req.Version,
+		Relation:      model.LineageRelation(req.Relation),
+		ParentModelID: req.ParentModelId,
+		ParentVersion: req.ParentVersion,
+		DatasetName:   req.DatasetName,
+		DatasetURI:    req.DatasetUri,
+	})
+	if err != nil {
+		return nil, lineageError("add lineage edge", err)
+	}
+	return convertEdgeToProto(edge), nil
+}
+
+// RemoveLineageEdge removes a lineage edge of a model via gRPC
+func (s *GRPCServer) RemoveLineageEdge(ctx context.Context, req *modelpb.RemoveLineageEdgeRequest) (*emptypb.Empty, error) {
+	if err := s.service.RemoveLineageEdge(ctx, req.ModelId, req.EdgeId); err != nil {
+		return nil, lineageError("remove lineage edge", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetLineage walks the lineage graph of a model via gRPC
+func (s *GRPCServer) GetLineage(ctx context.Context, req *modelpb.GetLineageRequest) (*modelpb.LineageResponse, error) {
+	graph, err := s.service.GetLineage(ctx, req.ModelId, service.LineageDirection(req.Direction), int(req.Depth))
+	if err != nil {
+		return nil, lineageError("get lineage", err)
+	}
+
+	resp := &modelpb.LineageResponse{
+		ModelId:   graph.ModelID,
+		Direction: string(graph.Direction),
+		Depth:     int32(graph.Depth),
+		Truncated: graph.Truncated,
+	}
+	for _, n := range graph.Nodes {
+		resp.Nodes = append(resp.Nodes, &modelpb.LineageNode{
+			Kind:        n.Kind,
+			ModelId:     n.ModelID,
+			ModelName:   n.ModelName,
+			Version:     n.Version,
+			DatasetName: n.DatasetName,
+			DatasetUri:  n.DatasetURI,
+			Depth:       int32(n.Depth),
+		})
+	}
+	for _, e := range graph.Edges {
+		resp.Edges = append(resp.Edges, convertEdgeToProto(e))
+	}
+	return resp, nil
+}
+
+// lineageError maps lineage errors to gRPC statuses
+func lineageError(op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrModelNotFound), errors.Is(err, service.ErrVersionNotFound), errors.Is(err, service.ErrEdgeNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, service.ErrDuplicateEdge):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, service.ErrLineageCycle):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, service.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", op, err)
+}
+
+// convertEdgeToProto converts a lineage edge to protobuf
+func convertEdgeToProto(e *model.LineageEdge) *modelpb.LineageEdge {
+	return &modelpb.LineageEdge{
+		Id:            e.ID,
+		ModelId:       e.ModelID,
+		Version:       e.Version,
+		Relation:      string(e.Relation),
+		ParentModelId: e.ParentModelID,
+		ParentVersion: e.ParentVersion,
+		DatasetName:   e.DatasetName,
+		DatasetUri:    e.DatasetURI,
+		CreatedBy:     e.CreatedBy,
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+	}
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -10,19 +11,23 @@ import (
 
 	"maas-platform/model-registry/internal/model"
 	"maas-platform/model-registry/internal/service"
+	"maas-platform/shared/modelcard"
 	modelpb "maas-platform/shared/proto"
+	"maas-platform/shared/signature"
 )
 
 // GRPCServer implements the gRPC ModelService
 type GRPCServer struct {
 	modelpb.UnimplementedModelServiceServer
 	service service.ModelService
+	watch   service.WatchService
 }
 
 // NewGRPCServer creates a new gRPC server
-func NewGRPCServer(svc service.ModelService) *GRPCServer {
+func NewGRPCServer(svc service.ModelService, watch service.WatchService) *GRPCServer {
 	return &GRPCServer{
 		service: svc,
+		watch:   watch,
 	}
 }
 
@@ -38,10 +43,24 @@ func (s *GRPCServer) CreateModel(ctx context.Context, req *modelpb.CreateModelRe
 		OwnerID:     req.OwnerId,
 		TenantID:    req.TenantId,
 		IsPublic:    req.IsPublic,
+		Signature:   signature.FromProto(req.Signature),
 	}
 
 	m, err := s.service.CreateModel(ctx, createReq)
 	if err != nil {
+		if errors.Is(err, service.ErrDuplicateModel) || errors.Is(err, service.ErrModelInTrash) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+		if errors.Is(err, service.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrApprovalRequired) {
+			// The model exists, private, until the request to publish it is approved
+			return nil, approvalRequiredError(err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create model: %v", err)
 	}
 
@@ -57,6 +76,9 @@ func (s *GRPCServer) GetModel(ctx context.Context, req *modelpb.GetModelRequest)
 		if err == service.ErrModelNotFound {
 			return nil, status.Errorf(codes.NotFound, "model not found")
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to get model: %v", err)
 	}
 
@@ -74,10 +96,8 @@ func (s *GRPCServer) ListModels(ctx context.Context, req *modelpb.ListModelsRequ
 		OwnerID:  req.OwnerId,
 		TenantID: req.TenantId,
 		Tags:     req.Tags,
-	}
-	if req.IsPublic {
-		isPublic := true
-		filter.IsPublic = &isPublic
+		IsPublic: req.IsPublic,
+		Scope:    service.ListScope(req.Scope),
 	}
 
 	if req.Framework != "" {
@@ -89,6 +109,12 @@ func (s *GRPCServer) ListModels(ctx context.Context, req *modelpb.ListModelsRequ
 
 	resp, err := s.service.ListModels(ctx, filter)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to list models: %v", err)
 	}
 
@@ -128,6 +154,12 @@ func (s *GRPCServer) UpdateModel(ctx context.Context, req *modelpb.UpdateModelRe
 		if err == service.ErrModelNotFound {
 			return nil, status.Errorf(codes.NotFound, "model not found")
 		}
+		if errors.Is(err, service.ErrApprovalRequired) {
+			return nil, approvalRequiredError(err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update model: %v", err)
 	}
 
@@ -143,6 +175,9 @@ func (s *GRPCServer) AddModelTags(ctx context.Context, req *modelpb.AddModelTags
 		if err == service.ErrModelNotFound {
 			return nil, status.Errorf(codes.NotFound, "model not found")
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to add model tags: %v", err)
 	}
 
@@ -156,6 +191,9 @@ func (s *GRPCServer) RemoveModelTags(ctx context.Context, req *modelpb.RemoveMod
 		if err == service.ErrModelNotFound {
 			return nil, status.Errorf(codes.NotFound, "model not found")
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to remove model tags: %v", err)
 	}
 
@@ -169,6 +207,9 @@ func (s *GRPCServer) SetModelMetadata(ctx context.Context, req *modelpb.SetModel
 		if err == service.ErrModelNotFound {
 			return nil, status.Errorf(codes.NotFound, "model not found")
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to set model metadata: %v", err)
 	}
 
@@ -182,19 +223,36 @@ func (s *GRPCServer) GetModelMetadata(ctx context.Context, req *modelpb.GetModel
 		if err == service.ErrModelNotFound {
 			return nil, status.Errorf(codes.NotFound, "model not found")
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to get model metadata: %v", err)
 	}
 
-	return &modelpb.GetModelMetadataResponse{Metadata: metadata}, nil
+	resp := &modelpb.GetModelMetadataResponse{Metadata: metadata}
+	m, err := s.service.GetModel(ctx, req.ModelId)
+	switch {
+	case err == nil:
+		resp.Signature = signature.ToProto(m.Signature)
+	case !errors.Is(err, service.ErrModelNotFound):
+		return nil, status.Errorf(codes.Internal, "failed to get model signature: %v", err)
+	}
+	return resp, nil
 }
 
 // DeleteModel deletes a model via gRPC
 func (s *GRPCServer) DeleteModel(ctx context.Context, req *modelpb.DeleteModelRequest) (*emptypb.Empty, error) {
-	err := s.service.DeleteModel(ctx, req.Id)
+	err := s.service.DeleteModel(ctx, req.Id, req.Force)
 	if err != nil {
 		if err == service.ErrModelNotFound {
 			return nil, status.Errorf(codes.NotFound, "model not found")
 		}
+		if errors.Is(err, service.ErrModelHasDependents) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to delete model: %v", err)
 	}
 
@@ -208,11 +266,17 @@ func (s *GRPCServer) UpdateModelStatus(ctx context.Context, req *modelpb.UpdateM
 		if err == service.ErrModelNotFound {
 			return nil, status.Errorf(codes.NotFound, "model not found")
 		}
+		if errors.Is(err, service.ErrApprovalRequired) {
+			return nil, approvalRequiredError(err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update model status: %v", err)
 	}
 
 	// Get updated model
-	m, err := s.service.GetModel(ctx, req.Id)
+	m, err := s.service.GetModel(service.RequirePrimary(ctx), req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get updated model: %v", err)
 	}
@@ -222,9 +286,242 @@ func (s *GRPCServer) UpdateModelStatus(ctx context.Context, req *modelpb.UpdateM
 	}, nil
 }
 
+// ListDeletedModels lists models in the trash via gRPC
+func (s *GRPCServer) ListDeletedModels(ctx context.Context, req *modelpb.ListDeletedModelsRequest) (*modelpb.ListModelsResponse, error) {
+	filter := service.ListModelsFilter{
+		Name:     req.Name,
+		OwnerID:  req.OwnerId,
+		TenantID: req.TenantId,
+		Scope:    service.ListScope(req.Scope),
+		Page:     int(req.Page),
+		Limit:    int(req.Limit),
+	}
+
+	resp, err := s.service.ListDeletedModels(ctx, filter)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list deleted models: %v", err)
+	}
+
+	models := make([]*modelpb.Model, len(resp.Models))
+	for i, m := range resp.Models {
+		models[i] = convertModelToProto(m)
+	}
+
+	return &modelpb.ListModelsResponse{
+		Models: models,
+		Total:  resp.Total,
+		Page:   int32(resp.Page),
+		Limit:  int32(resp.Limit),
+	}, nil
+}
+
+// RestoreModel restores a model from the trash via gRPC
+func (s *GRPCServer) RestoreModel(ctx context.Context, req *modelpb.RestoreModelRequest) (*modelpb.RestoreModelResponse, error) {
+	m, err := s.service.RestoreModel(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, service.ErrModelNotFound) {
+			return nil, status.Errorf(codes.NotFound, "model not found in trash")
+		}
+		if errors.Is(err, service.ErrDuplicateModel) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore model: %v", err)
+	}
+
+	return &modelpb.RestoreModelResponse{
+		Model: convertModelToProto(m),
+	}, nil
+}
+
+// PurgeModel permanently deletes a model in the trash via gRPC
+func (s *GRPCServer) PurgeModel(ctx context.Context, req *modelpb.PurgeModelRequest) (*emptypb.Empty, error) {
+	err := s.service.PurgeModel(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, service.ErrModelNotFound) {
+			return nil, status.Errorf(codes.NotFound, "model not found in trash")
+		}
+		if errors.Is(err, service.ErrNotInTrash) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to purge model: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CreateModelVersion records a new model version via gRPC
+func (s *GRPCServer) CreateModelVersion(ctx context.Context, req *modelpb.CreateModelVersionRequest) (*modelpb.CreateModelVersionResponse, error) {
+	v, err := s.service.CreateModelVersion(ctx, service.CreateModelVersionRequest{
+		ModelID:     req.ModelId,
+		Version:     req.Version,
+		Size:        req.Size,
+		Checksum:    req.Checksum,
+		StoragePath: req.StoragePath,
+		DockerImage: req.DockerImage,
+		ChangeLog:   req.ChangeLog,
+		Signature:   signature.FromProto(req.Signature),
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrModelNotFound) {
+			return nil, status.Errorf(codes.NotFound, "model not found")
+		}
+		if errors.Is(err, service.ErrDuplicateVersion) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+		if errors.Is(err, service.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create model version: %v", err)
+	}
+
+	return &modelpb.CreateModelVersionResponse{
+		Version: convertVersionToProto(v),
+	}, nil
+}
+
+// ListModelVersions lists the versions of a model via gRPC
+func (s *GRPCServer) ListModelVersions(ctx context.Context, req *modelpb.ListModelVersionsRequest) (*modelpb.ListModelVersionsResponse, error) {
+	versions, err := s.service.ListModelVersions(ctx, req.ModelId)
+	if err != nil {
+		if errors.Is(err, service.ErrModelNotFound) {
+			return nil, status.Errorf(codes.NotFound, "model not found")
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list model versions: %v", err)
+	}
+
+	pbVersions := make([]*modelpb.ModelVersion, len(versions))
+	for i, v := range versions {
+		pbVersions[i] = convertVersionToProto(v)
+	}
+
+	return &modelpb.ListModelVersionsResponse{
+		Versions: pbVersions,
+	}, nil
+}
+
+// PromoteVersion makes a version the current one of its model via gRPC
+func (s *GRPCServer) PromoteVersion(ctx context.Context, req *modelpb.PromoteVersionRequest) (*modelpb.PromoteVersionResponse, error) {
+	m, err := s.service.PromoteVersion(ctx, req.ModelId, req.Version)
+	if err != nil {
+		if errors.Is(err, service.ErrModelNotFound) {
+			return nil, status.Errorf(codes.NotFound, "model not found")
+		}
+		if errors.Is(err, service.ErrVersionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "model version not found")
+		}
+		if errors.Is(err, service.ErrDuplicateModel) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+		if errors.Is(err, service.ErrPromotionBlocked) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if errors.Is(err, service.ErrApprovalRequired) {
+			return nil, approvalRequiredError(err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to promote model version: %v", err)
+	}
+
+	return &modelpb.PromoteVersionResponse{
+		Model: convertModelToProto(m),
+	}, nil
+}
+
+// GetModelCard gets the model card of a version via gRPC
+func (s *GRPCServer) GetModelCard(ctx context.Context, req *modelpb.GetModelCardRequest) (*modelpb.ModelCardResponse, error) {
+	card, err := s.service.GetModelCard(ctx, req.ModelId, req.Version)
+	if err != nil {
+		return nil, modelCardError("get", err)
+	}
+	return convertCardToProto(card), nil
+}
+
+// SetModelCard replaces the model card of a version via gRPC
+func (s *GRPCServer) SetModelCard(ctx context.Context, req *modelpb.SetModelCardRequest) (*modelpb.ModelCardResponse, error) {
+	card, err := s.service.SetModelCard(ctx, req.ModelId, req.Version, modelcard.FromProto(req.Card))
+	if err != nil {
+		return nil, modelCardError("set", err)
+	}
+	return convertCardToProto(card), nil
+}
+
+// modelCardError maps model card errors to gRPC statuses
+func modelCardError(op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrModelNotFound):
+		return status.Errorf(codes.NotFound, "model not found")
+	case errors.Is(err, service.ErrVersionNotFound):
+		return status.Errorf(codes.NotFound, "model version not found")
+	case errors.Is(err, service.ErrCardNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, service.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s model card: %v", op, err)
+}
+
+// WatchModels streams model changes via gRPC
+func (s *GRPCServer) WatchModels(req *modelpb.WatchModelsRequest, stream modelpb.ModelService_WatchModelsServer) error {
+	filter := service.WatchFilter{
+		TenantID:    req.TenantId,
+		ModelIDs:    req.ModelIds,
+		Names:       req.Names,
+		Tags:        req.Tags,
+		ResumeToken: req.ResumeToken,
+	}
+
+	err := s.watch.Watch(stream.Context(), filter, func(e service.WatchEvent) error {
+		return stream.Send(&modelpb.ModelWatchEvent{
+			Type:        string(e.Type),
+			Model:       convertModelToProto(e.Model),
+			ResumeToken: e.ResumeToken,
+			EventType:   string(e.EventType),
+			OccurredAt:  timestamppb.New(e.OccurredAt),
+		})
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidInput) {
+			return status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			return status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		if errors.Is(err, service.ErrResumeTokenExpired) {
+			return status.Errorf(codes.OutOfRange, "%v", err)
+		}
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to watch models: %v", err)
+	}
+	return nil
+}
+
 // convertModelToProto converts internal model to protobuf model
 func convertModelToProto(m *model.Model) *modelpb.Model {
-	return &modelpb.Model{
+	pb := &modelpb.Model{
 		Id:          m.ID,
 		Name:        m.Name,
 		Description: m.Description,
@@ -241,7 +538,12 @@ func convertModelToProto(m *model.Model) *modelpb.Model {
 		IsPublic:    m.IsPublic,
 		CreatedAt:   timestamppb.New(m.CreatedAt),
 		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+		Signature:   signature.ToProto(m.Signature),
+	}
+	if m.DeletedAt.Valid {
+		pb.DeletedAt = timestamppb.New(m.DeletedAt.Time)
 	}
+	return pb
 }
 
 // getTagNames extracts tag names from tags
@@ -252,3 +554,31 @@ func getTagNames(tags []model.Tag) []string {
 	}
 	return names
 }
+
+// convertVersionToProto converts an internal model version to protobuf
+func convertVersionToProto(v *model.ModelVersion) *modelpb.ModelVersion {
+	return &modelpb.ModelVersion{
+		Id:          v.ID,
+		ModelId:     v.ModelID,
+		Version:     v.Version,
+		Status:      string(v.Status),
+		Size:        v.Size,
+		Checksum:    v.Checksum,
+		StoragePath: v.StoragePath,
+		DockerImage: v.DockerImage,
+		ChangeLog:   v.ChangeLog,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   timestamppb.New(v.CreatedAt),
+		Signature:   signature.ToProto(v.Signature),
+	}
+}
+
+// convertCardToProto converts a model card to protobuf
+func convertCardToProto(c *service.ModelCard) *modelpb.ModelCardResponse {
+	return &modelpb.ModelCardResponse{
+		ModelId:   c.ModelID,
+		ModelName: c.ModelName,
+		Version:   c.Version,
+		Card:      modelcard.ToProto(c.Card),
+	}
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/service"
+	modelpb "maas-platform/shared/proto"
+)
+
+// UsageGRPCServer implements the gRPC UsageService
+type UsageGRPCServer struct {
+	modelpb.UnimplementedUsageServiceServer
+	service service.UsageService
+}
+
+// NewUsageGRPCServer creates a new usage gRPC server
+func NewUsageGRPCServer(svc service.UsageService) *UsageGRPCServer {
+	return &UsageGRPCServer{
+		service: svc,
+	}
+}
+
+// RecordUsage adds metering records reported by the gateway to the rollups
+func (s *UsageGRPCServer) RecordUsage(ctx context.Context, req *modelpb.RecordUsageRequest) (*modelpb.RecordUsageResponse, error) {
+	records := make([]service.UsageRecord, len(req.Records))
+	for i, r := range req.Records {
+		records[i] = service.UsageRecord{
+			EventID:          r.EventId,
+			TenantID:         r.TenantId,
+			ModelID:          r.ModelId,
+			Version:          r.Version,
+			Status:           model.UsageStatus(r.Status),
+			LatencyMs:        r.LatencyMs,
+			RequestBytes:     r.RequestBytes,
+			ResponseBytes:    r.ResponseBytes,
+			PromptTokens:     r.PromptTokens,
+			CompletionTokens: r.CompletionTokens,
+		}
+		if r.OccurredAt != nil {
+			records[i].OccurredAt = r.OccurredAt.AsTime()
+		}
+	}
+
+	accepted, err := s.service.Record(ctx, records)
+	if err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to record usage: %v", err)
+	}
+
+	return &modelpb.RecordUsageResponse{Accepted: int32(accepted)}, nil
+}
+
+// ListUsage summarizes usage via gRPC
+func (s *UsageGRPCServer) ListUsage(ctx context.Context, req *modelpb.ListUsageRequest) (*modelpb.ListUsageResponse, error) {
+	query := service.UsageQuery{
+		TenantID: req.TenantId,
+		ModelID:  req.ModelId,
+		GroupBy:  req.GroupBy,
+	}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+
+	summaries, err := s.service.Summarize(ctx, query)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidInput):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, service.ErrForbidden):
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list usage: %v", err)
+	}
+
+	items := make([]*modelpb.UsageSummary, len(summaries))
+	for i, u := range summaries {
+		items[i] = convertUsageSummaryToProto(u)
+	}
+	return &modelpb.ListUsageResponse{Items: items}, nil
+}
+
+// convertUsageSummaryToProto converts a usage summary to protobuf
+func convertUsageSummaryToProto(u *repository.UsageSummary) *modelpb.UsageSummary {
+	pb := &modelpb.UsageSummary{
+		TenantId:         u.TenantID,
+		ModelId:          u.ModelID,
+		Version:          u.Version,
+		Status:           string(u.Status),
+		Requests:         u.Requests,
+		TotalLatencyMs:   u.TotalLatencyMs,
+		MaxLatencyMs:     u.MaxLatencyMs,
+		RequestBytes:     u.RequestBytes,
+		ResponseBytes:    u.ResponseBytes,
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+	}
+	if u.PeriodStart != nil {
+		pb.PeriodStart = timestamppb.New(*u.PeriodStart)
+	}
+	return pb
+}
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	modelpb "maas-platform/shared/proto"
+)
+
+// UserGRPCServer implements the gRPC UserService
+type UserGRPCServer struct {
+	modelpb.UnimplementedUserServiceServer
+	service service.UserService
+}
+
+// NewUserGRPCServer creates a new user gRPC server
+func NewUserGRPCServer(svc service.UserService) *UserGRPCServer {
+	return &UserGRPCServer{
+		service: svc,
+	}
+}
+
+// ProvisionUser finds or creates the user of an external identity via gRPC
+func (s *UserGRPCServer) ProvisionUser(ctx context.Context, req *modelpb.ProvisionUserRequest) (*modelpb.ProvisionUserResponse, error) {
+	u, created, err := s.service.ProvisionExternalUser(ctx, service.ExternalIdentity{
+		Issuer:        req.Issuer,
+		Subject:       req.Subject,
+		Email:         req.Email,
+		EmailVerified: req.EmailVerified,
+		Username:      req.Username,
+		Role:          model.UserRole(req.Role),
+		TenantID:      req.TenantId,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidInput):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, service.ErrForbidden), errors.Is(err, service.ErrUserDisabled):
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		case errors.Is(err, service.ErrIdentityConflict), errors.Is(err, service.ErrDuplicateUser):
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to provision user: %v", err)
+	}
+
+	return &modelpb.ProvisionUserResponse{
+		User:    convertUserToProto(u),
+		Created: created,
+	}, nil
+}
+
+// AuthenticateUser checks the password of a local user via gRPC
+func (s *UserGRPCServer) AuthenticateUser(ctx context.Context, req *modelpb.AuthenticateUserRequest) (*modelpb.AuthenticateUserResponse, error) {
+	u, err := s.service.AuthenticateUser(ctx, req.Username, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidCredentials):
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		case errors.Is(err, service.ErrForbidden), errors.Is(err, service.ErrUserDisabled):
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to authenticate user: %v", err)
+	}
+	return &modelpb.AuthenticateUserResponse{User: convertUserToProto(u)}, nil
+}
+
+// convertUserToProto converts an internal user to protobuf
+func convertUserToProto(u *model.User) *modelpb.User {
+	pb := &modelpb.User{
+		Id:        u.ID,
+		Username:  u.Username,
+		Email:     u.Email,
+		Role:      string(u.Role),
+		Status:    string(u.Status),
+		TenantId:  u.TenantID,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+	}
+	if u.LastLoginAt != nil {
+		pb.LastLoginAt = timestamppb.New(*u.LastLoginAt)
+	}
+	return pb
+}
@@ -0,0 +1,220 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	modelpb "maas-platform/shared/proto"
+)
+
+// WebhookGRPCServer implements the gRPC WebhookService
+type WebhookGRPCServer struct {
+	modelpb.UnimplementedWebhookServiceServer
+	service service.WebhookService
+}
+
+// NewWebhookGRPCServer creates a new webhook gRPC server
+func NewWebhookGRPCServer(svc service.WebhookService) *WebhookGRPCServer {
+	return &WebhookGRPCServer{
+		service: svc,
+	}
+}
+
+// CreateWebhook registers a webhook via gRPC
+func (s *WebhookGRPCServer) CreateWebhook(ctx context.Context, req *modelpb.CreateWebhookRequest) (*modelpb.CreateWebhookResponse, error) {
+	w, secret, err := s.service.CreateWebhook(ctx, service.CreateWebhookRequest{
+		TenantID:    req.TenantId,
+		URL:         req.Url,
+		EventTypes:  convertEventTypes(req.EventTypes),
+		ModelID:     req.ModelId,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, webhookError(err, "failed to create webhook")
+	}
+
+	return &modelpb.CreateWebhookResponse{
+		Webhook: convertWebhookToProto(w),
+		Secret:  secret,
+	}, nil
+}
+
+// GetWebhook retrieves a webhook via gRPC
+func (s *WebhookGRPCServer) GetWebhook(ctx context.Context, req *modelpb.GetWebhookRequest) (*modelpb.GetWebhookResponse, error) {
+	w, err := s.service.GetWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, webhookError(err, "failed to get webhook")
+	}
+
+	return &modelpb.GetWebhookResponse{
+		Webhook: convertWebhookToProto(w),
+	}, nil
+}
+
+// ListWebhooks lists webhooks via gRPC
+func (s *WebhookGRPCServer) ListWebhooks(ctx context.Context, req *modelpb.ListWebhooksRequest) (*modelpb.ListWebhooksResponse, error) {
+	resp, err := s.service.ListWebhooks(ctx, req.TenantId, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, webhookError(err, "failed to list webhooks")
+	}
+
+	webhooks := make([]*modelpb.Webhook, len(resp.Webhooks))
+	for i, w := range resp.Webhooks {
+		webhooks[i] = convertWebhookToProto(w)
+	}
+
+	return &modelpb.ListWebhooksResponse{
+		Webhooks: webhooks,
+		Total:    resp.Total,
+		Page:     int32(resp.Page),
+		Limit:    int32(resp.Limit),
+	}, nil
+}
+
+// UpdateWebhook updates a webhook via gRPC
+func (s *WebhookGRPCServer) UpdateWebhook(ctx context.Context, req *modelpb.UpdateWebhookRequest) (*modelpb.UpdateWebhookResponse, error) {
+	updateReq := service.UpdateWebhookRequest{
+		URL:          req.Url,
+		ModelID:      req.ModelId,
+		Description:  req.Description,
+		Active:       req.Active,
+		RotateSecret: req.RotateSecret,
+	}
+	if req.SetEventTypes || len(req.EventTypes) > 0 {
+		updateReq.EventTypes = convertEventTypes(req.EventTypes)
+	}
+
+	w, secret, err := s.service.UpdateWebhook(ctx, req.Id, updateReq)
+	if err != nil {
+		return nil, webhookError(err, "failed to update webhook")
+	}
+
+	return &modelpb.UpdateWebhookResponse{
+		Webhook: convertWebhookToProto(w),
+		Secret:  secret,
+	}, nil
+}
+
+// DeleteWebhook deletes a webhook via gRPC
+func (s *WebhookGRPCServer) DeleteWebhook(ctx context.Context, req *modelpb.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteWebhook(ctx, req.Id); err != nil {
+		return nil, webhookError(err, "failed to delete webhook")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListWebhookDeliveries lists the delivery history via gRPC
+func (s *WebhookGRPCServer) ListWebhookDeliveries(ctx context.Context, req *modelpb.ListWebhookDeliveriesRequest) (*modelpb.ListWebhookDeliveriesResponse, error) {
+	resp, err := s.service.ListDeliveries(ctx, service.DeliveryQuery{
+		SubscriptionID: req.WebhookId,
+		Status:         model.DeliveryStatus(req.Status),
+		EventID:        req.EventId,
+		Page:           int(req.Page),
+		Limit:          int(req.Limit),
+	})
+	if err != nil {
+		return nil, webhookError(err, "failed to list webhook deliveries")
+	}
+
+	deliveries := make([]*modelpb.WebhookDelivery, len(resp.Deliveries))
+	for i, d := range resp.Deliveries {
+		deliveries[i] = convertDeliveryToProto(d)
+	}
+
+	return &modelpb.ListWebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		Total:      resp.Total,
+		Page:       int32(resp.Page),
+		Limit:      int32(resp.Limit),
+	}, nil
+}
+
+// RedeliverWebhook queues a past delivery again via gRPC
+func (s *WebhookGRPCServer) RedeliverWebhook(ctx context.Context, req *modelpb.RedeliverWebhookRequest) (*modelpb.RedeliverWebhookResponse, error) {
+	d, err := s.service.Redeliver(ctx, req.DeliveryId)
+	if err != nil {
+		return nil, webhookError(err, "failed to redeliver webhook")
+	}
+
+	return &modelpb.RedeliverWebhookResponse{
+		Delivery: convertDeliveryToProto(d),
+	}, nil
+}
+
+// webhookError maps webhook service errors to gRPC status errors
+func webhookError(err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrWebhookNotFound):
+		return status.Errorf(codes.NotFound, "webhook not found")
+	case errors.Is(err, service.ErrDeliveryNotFound):
+		return status.Errorf(codes.NotFound, "webhook delivery not found")
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, service.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// convertEventTypes converts event type names
+func convertEventTypes(types []string) []model.EventType {
+	result := make([]model.EventType, len(types))
+	for i, t := range types {
+		result[i] = model.EventType(t)
+	}
+	return result
+}
+
+// convertWebhookToProto converts an internal webhook subscription to protobuf
+func convertWebhookToProto(w *model.WebhookSubscription) *modelpb.Webhook {
+	eventTypes := make([]string, len(w.EventTypes))
+	for i, t := range w.EventTypes {
+		eventTypes[i] = string(t)
+	}
+
+	return &modelpb.Webhook{
+		Id:          w.ID,
+		TenantId:    w.TenantID,
+		Url:         w.URL,
+		EventTypes:  eventTypes,
+		ModelId:     w.ModelID,
+		Description: w.Description,
+		Active:      w.Active,
+		CreatedBy:   w.CreatedBy,
+		CreatedAt:   timestamppb.New(w.CreatedAt),
+		UpdatedAt:   timestamppb.New(w.UpdatedAt),
+	}
+}
+
+// convertDeliveryToProto converts an internal webhook delivery to protobuf
+func convertDeliveryToProto(d *model.WebhookDelivery) *modelpb.WebhookDelivery {
+	pb := &modelpb.WebhookDelivery{
+		Id:            d.ID,
+		WebhookId:     d.SubscriptionID,
+		EventId:       d.EventID,
+		EventType:     string(d.EventType),
+		ModelId:       d.ModelID,
+		Status:        string(d.Status),
+		Attempts:      int32(d.Attempts),
+		ResponseCode:  int32(d.ResponseCode),
+		LastError:     d.LastError,
+		RedeliveryOf:  d.RedeliveryOf,
+		Payload:       string(d.Payload),
+		NextAttemptAt: timestamppb.New(d.NextAttemptAt),
+		CreatedAt:     timestamppb.New(d.CreatedAt),
+	}
+	if d.LastAttemptAt != nil {
+		pb.LastAttemptAt = timestamppb.New(*d.LastAttemptAt)
+	}
+	if d.DeliveredAt != nil {
+		pb.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+	return pb
+}
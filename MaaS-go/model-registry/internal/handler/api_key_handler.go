@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// APIKeyHandler handles API key HTTP requests
+type APIKeyHandler struct {
+	service service.APIKeyService
+	logger  *logger.Logger
+}
+
+// NewAPIKeyHandler creates a new API key handler
+func NewAPIKeyHandler(s service.APIKeyService, logger *logger.Logger) *APIKeyHandler {
+	return &APIKeyHandler{
+		service: s,
+		logger:  logger,
+	}
+}
+
+// CreateAPIKeyRequest represents an API key creation request
+type CreateAPIKeyRequest struct {
+	TenantID  string              `json:"tenant_id"`
+	UserID    string              `json:"user_id"`
+	Role      model.UserRole      `json:"role"`
+	Name      string              `json:"name" binding:"required"`
+	Scopes    []model.APIKeyScope `json:"scopes" binding:"required"`
+	ExpiresAt *time.Time          `json:"expires_at"`
+}
+
+// CreateAPIKey handles API key creation; the secret is only returned here
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	var req CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	k, secret, err := h.service.CreateAPIKey(c.Request.Context(), service.CreateAPIKeyRequest{
+		TenantID:  req.TenantID,
+		UserID:    req.UserID,
+		Role:      req.Role,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		h.handleError(c, err, "Failed to create API key")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"api_key": k, "secret": secret})
+}
+
+// GetAPIKey handles retrieving an API key
+func (h *APIKeyHandler) GetAPIKey(c *gin.Context) {
+	k, err := h.service.GetAPIKey(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get API key")
+		return
+	}
+
+	c.JSON(http.StatusOK, k)
+}
+
+// ListAPIKeys handles listing API keys
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	includeRevoked, _ := strconv.ParseBool(c.Query("include_revoked"))
+
+	response, err := h.service.ListAPIKeys(c.Request.Context(), service.APIKeyQuery{
+		TenantID:       c.Query("tenant_id"),
+		IncludeRevoked: includeRevoked,
+		Page:           page,
+		Limit:          limit,
+	})
+	if err != nil {
+		h.handleError(c, err, "Failed to list API keys")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RotateAPIKey handles replacing the secret of an API key
+func (h *APIKeyHandler) RotateAPIKey(c *gin.Context) {
+	k, secret, err := h.service.RotateAPIKey(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to rotate API key")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"api_key": k, "secret": secret})
+}
+
+// RevokeAPIKey handles revoking an API key
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	k, err := h.service.RevokeAPIKey(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to revoke API key")
+		return
+	}
+
+	c.JSON(http.StatusOK, k)
+}
+
+// handleError maps API key service errors to HTTP responses
+func (h *APIKeyHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrAPIKeyNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "api key not found"})
+	case errors.Is(err, service.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		h.logger.Error(message, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
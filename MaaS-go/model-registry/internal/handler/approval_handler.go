@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+)
+
+// SetApprovalPolicyRequest represents the approval policy of a tenant for an action
+type SetApprovalPolicyRequest struct {
+	TenantID          string `json:"tenant_id"`
+	RequiredApprovals int    `json:"required_approvals" binding:"required"`
+	ApproverRole      string `json:"approver_role" binding:"required"`
+	TimeoutSeconds    int64  `json:"timeout_seconds"`
+}
+
+// DecideApprovalRequest represents an approver's verdict on a pending request
+type DecideApprovalRequest struct {
+	Approve *bool  `json:"approve" binding:"required"`
+	Comment string `json:"comment"`
+}
+
+// ListApprovalPolicies handles listing the approval policies of a tenant
+func (h *ModelHandler) ListApprovalPolicies(c *gin.Context) {
+	policies, err := h.service.ListApprovalPolicies(c.Request.Context(), c.Query("tenant_id"))
+	if err != nil {
+		h.approvalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"policies": policies})
+}
+
+// SetApprovalPolicy handles making an action of a tenant wait for approval
+func (h *ModelHandler) SetApprovalPolicy(c *gin.Context) {
+	var req SetApprovalPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	p, err := h.service.SetApprovalPolicy(c.Request.Context(), &model.ApprovalPolicy{
+		TenantID:          req.TenantID,
+		Action:            model.ApprovalAction(c.Param("action")),
+		RequiredApprovals: req.RequiredApprovals,
+		ApproverRole:      model.UserRole(req.ApproverRole),
+		TimeoutSeconds:    req.TimeoutSeconds,
+	})
+	if err != nil {
+		h.approvalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, p)
+}
+
+// DeleteApprovalPolicy handles stopping an action of a tenant waiting for approval
+func (h *ModelHandler) DeleteApprovalPolicy(c *gin.Context) {
+	action := model.ApprovalAction(c.Param("action"))
+	if err := h.service.DeleteApprovalPolicy(c.Request.Context(), c.Query("tenant_id"), action); err != nil {
+		h.approvalError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListApprovalRequests handles listing approval requests
+func (h *ModelHandler) ListApprovalRequests(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	response, err := h.service.ListApprovalRequests(c.Request.Context(), service.ListApprovalRequestsFilter{
+		TenantID: c.Query("tenant_id"),
+		ModelID:  c.Query("model_id"),
+		State:    model.ApprovalState(c.Query("state")),
+		Page:     page,
+		Limit:    limit,
+	})
+	if err != nil {
+		h.approvalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetApprovalRequest handles getting an approval request
+func (h *ModelHandler) GetApprovalRequest(c *gin.Context) {
+	r, err := h.service.GetApprovalRequest(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.approvalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
+
+// DecideApprovalRequest handles approving or rejecting a pending approval request
+func (h *ModelHandler) DecideApprovalRequest(c *gin.Context) {
+	var req DecideApprovalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	r, err := h.service.DecideApprovalRequest(c.Request.Context(), c.Param("id"), *req.Approve, req.Comment)
+	if err != nil {
+		h.approvalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
+
+// approvalError writes the response for a failed approval operation
+func (h *ModelHandler) approvalError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPolicyNotFound), errors.Is(err, service.ErrApprovalNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrDuplicateDecision), errors.Is(err, service.ErrApprovalClosed):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		h.logger.Error("Approval operation failed", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// approvalPending writes 202 Accepted with the pending request when a change
+// is held back for approval, and reports whether it did
+func approvalPending(c *gin.Context, err error) bool {
+	var required *service.ApprovalRequiredError
+	if !errors.As(err, &required) {
+		return false
+	}
+	c.JSON(http.StatusAccepted, gin.H{"message": err.Error(), "approval": required.Request})
+	return true
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// AuditHandler handles audit log HTTP requests
+type AuditHandler struct {
+	service service.AuditService
+	logger  *logger.Logger
+}
+
+// NewAuditHandler creates a new audit handler
+func NewAuditHandler(s service.AuditService, logger *logger.Logger) *AuditHandler {
+	return &AuditHandler{
+		service: s,
+		logger:  logger,
+	}
+}
+
+// ListAuditEntries handles querying the audit log
+func (h *AuditHandler) ListAuditEntries(c *gin.Context) {
+	query, err := parseAuditQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.service.List(c.Request.Context(), query)
+	if err != nil {
+		h.logger.Error("Failed to list audit entries", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ExportAuditEntries handles exporting the audit log as JSON Lines
+func (h *AuditHandler) ExportAuditEntries(c *gin.Context) {
+	query, err := parseAuditQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Content-Disposition", `attachment; filename="audit.jsonl"`)
+	c.Status(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Writer)
+	err = h.service.Export(c.Request.Context(), query, func(e *model.AuditEntry) error {
+		return encoder.Encode(e)
+	})
+	if err != nil {
+		// Headers are already sent, so the export can only be cut short
+		h.logger.Error("Failed to export audit entries", "error", err)
+		c.Error(err)
+	}
+}
+
+// parseAuditQuery reads audit filters from the query string
+func parseAuditQuery(c *gin.Context) (service.AuditQuery, error) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	query := service.AuditQuery{
+		TargetID: c.Query("target"),
+		ActorID:  c.Query("actor"),
+		TenantID: c.Query("tenant"),
+		Action:   model.AuditAction(c.Query("action")),
+		Page:     page,
+		Limit:    limit,
+	}
+
+	var err error
+	if query.From, err = parseTime(c.Query("from")); err != nil {
+		return query, fmt.Errorf("invalid from: %w", err)
+	}
+	if query.To, err = parseTime(c.Query("to")); err != nil {
+		return query, fmt.Errorf("invalid to: %w", err)
+	}
+	return query, nil
+}
+
+// parseTime parses an optional RFC 3339 timestamp
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
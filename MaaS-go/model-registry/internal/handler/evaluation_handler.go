@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/service"
+)
+
+// RecordEvaluationsRequest represents evaluation results to record against a version
+type RecordEvaluationsRequest struct {
+	Results []EvaluationResultRequest `json:"results" binding:"required"`
+}
+
+// EvaluationResultRequest represents one evaluation result; evaluated_at defaults to now
+type EvaluationResultRequest struct {
+	Metric      string     `json:"metric" binding:"required"`
+	Value       float64    `json:"value"`
+	Dataset     string     `json:"dataset"`
+	Split       string     `json:"split"`
+	EvaluatedAt *time.Time `json:"evaluated_at"`
+}
+
+// SetPromotionRulesRequest represents the promotion rules of a model
+type SetPromotionRulesRequest struct {
+	Rules []PromotionRuleRequest `json:"rules"`
+}
+
+// PromotionRuleRequest represents one promotion rule
+type PromotionRuleRequest struct {
+	Metric        string  `json:"metric" binding:"required"`
+	Dataset       string  `json:"dataset"`
+	Split         string  `json:"split"`
+	Direction     string  `json:"direction" binding:"required"`
+	MaxRegression float64 `json:"max_regression"`
+	Relative      bool    `json:"relative"`
+}
+
+// RecordEvaluations handles recording evaluation results against a version
+func (h *ModelHandler) RecordEvaluations(c *gin.Context) {
+	var req RecordEvaluationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results := make([]service.EvaluationResult, len(req.Results))
+	for i, r := range req.Results {
+		results[i] = service.EvaluationResult{
+			Metric:  r.Metric,
+			Value:   r.Value,
+			Dataset: r.Dataset,
+			Split:   r.Split,
+		}
+		if r.EvaluatedAt != nil {
+			results[i].EvaluatedAt = *r.EvaluatedAt
+		}
+	}
+
+	evaluations, err := h.service.RecordEvaluations(c.Request.Context(), c.Param("id"), c.Param("version"), results)
+	if err != nil {
+		h.evaluationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"evaluations": evaluations})
+}
+
+// ListEvaluations handles listing the evaluation results of a model or one of its versions
+func (h *ModelHandler) ListEvaluations(c *gin.Context) {
+	evaluations, err := h.service.ListEvaluations(c.Request.Context(), c.Param("id"), c.Param("version"), c.Query("metric"))
+	if err != nil {
+		h.evaluationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"evaluations": evaluations})
+}
+
+// CompareVersions handles diffing two versions of a model; without base it
+// compares against the current version
+func (h *ModelHandler) CompareVersions(c *gin.Context) {
+	target := c.Query("target")
+	if target == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "target is required"})
+		return
+	}
+
+	cmp, err := h.service.CompareVersions(c.Request.Context(), c.Param("id"), c.Query("base"), target)
+	if err != nil {
+		h.evaluationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cmp)
+}
+
+// GetPromotionRules handles getting the promotion rules of a model
+func (h *ModelHandler) GetPromotionRules(c *gin.Context) {
+	rules, err := h.service.GetPromotionRules(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.evaluationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"rules": rules})
+}
+
+// SetPromotionRules handles replacing the promotion rules of a model
+func (h *ModelHandler) SetPromotionRules(c *gin.Context) {
+	var req SetPromotionRulesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rules := make([]*model.PromotionRule, len(req.Rules))
+	for i, r := range req.Rules {
+		rules[i] = &model.PromotionRule{
+			Metric:        r.Metric,
+			Dataset:       r.Dataset,
+			Split:         r.Split,
+			Direction:     model.RuleDirection(r.Direction),
+			MaxRegression: r.MaxRegression,
+			Relative:      r.Relative,
+		}
+	}
+
+	rules, err := h.service.SetPromotionRules(c.Request.Context(), c.Param("id"), rules)
+	if err != nil {
+		h.evaluationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"rules": rules})
+}
+
+// evaluationError writes the response for a failed evaluation or promotion rule operation
+func (h *ModelHandler) evaluationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrModelNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "model not found"})
+	case errors.Is(err, service.ErrVersionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "model version not found"})
+	case errors.Is(err, service.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		h.logger.Error("Evaluation operation failed", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
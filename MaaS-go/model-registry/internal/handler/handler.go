@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -10,6 +11,9 @@ import (
 	"maas-platform/model-registry/internal/repository"
 	"maas-platform/model-registry/internal/service"
 	"maas-platform/model-registry/pkg/logger"
+	"maas-platform/shared/modelcard"
+	"maas-platform/shared/query"
+	"maas-platform/shared/signature"
 )
 
 // ModelHandler handles model-related HTTP requests
@@ -37,6 +41,8 @@ type CreateModelRequest struct {
 	IsPublic    bool              `json:"is_public"`
 	OwnerID     string            `json:"owner_id" binding:"required"`
 	TenantID    string            `json:"tenant_id" binding:"required"`
+	// Signature types the inputs and outputs of this first version
+	Signature *signature.Signature `json:"signature"`
 }
 
 // CreateModel handles model creation
@@ -57,10 +63,26 @@ func (h *ModelHandler) CreateModel(c *gin.Context) {
 		OwnerID:     req.OwnerID,
 		TenantID:    req.TenantID,
 		IsPublic:    req.IsPublic,
+		Signature:   req.Signature,
 	}
 
 	m, err := h.service.CreateModel(c.Request.Context(), createReq)
 	if err != nil {
+		if approvalPending(c, err) {
+			return
+		}
+		if errors.Is(err, service.ErrDuplicateModel) || errors.Is(err, service.ErrModelInTrash) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("Failed to create model", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -79,6 +101,10 @@ func (h *ModelHandler) GetModel(c *gin.Context) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "model not found"})
 			return
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("Failed to get model", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -92,10 +118,21 @@ func (h *ModelHandler) ListModels(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
 
+	isPublic, err := query.ParseOptionalBool(c.Query("is_public"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid is_public: " + err.Error()})
+		return
+	}
+
 	filter := service.ListModelsFilter{
-		Name:  c.Query("name"),
-		Page:  page,
-		Limit: limit,
+		Name:     c.Query("name"),
+		OwnerID:  c.Query("owner_id"),
+		TenantID: c.Query("tenant_id"),
+		Tags:     query.ParseList(c.QueryArray("tags")),
+		IsPublic: isPublic,
+		Scope:    service.ListScope(c.Query("scope")),
+		Page:     page,
+		Limit:    limit,
 	}
 
 	// Parse optional filters
@@ -108,6 +145,14 @@ func (h *ModelHandler) ListModels(c *gin.Context) {
 
 	response, err := h.service.ListModels(c.Request.Context(), filter)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("Failed to list models", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -128,10 +173,17 @@ func (h *ModelHandler) UpdateModel(c *gin.Context) {
 
 	m, err := h.service.UpdateModel(c.Request.Context(), id, req)
 	if err != nil {
+		if approvalPending(c, err) {
+			return
+		}
 		if err == repository.ErrModelNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "model not found"})
 			return
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("Failed to update model", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -143,12 +195,25 @@ func (h *ModelHandler) UpdateModel(c *gin.Context) {
 // DeleteModel handles deleting a model
 func (h *ModelHandler) DeleteModel(c *gin.Context) {
 	id := c.Param("id")
+	force, err := strconv.ParseBool(c.DefaultQuery("force", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid force parameter"})
+		return
+	}
 
-	if err := h.service.DeleteModel(c.Request.Context(), id); err != nil {
+	if err := h.service.DeleteModel(c.Request.Context(), id, force); err != nil {
 		if err == repository.ErrModelNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "model not found"})
 			return
 		}
+		if errors.Is(err, service.ErrModelHasDependents) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("Failed to delete model", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -170,10 +235,17 @@ func (h *ModelHandler) UpdateModelStatus(c *gin.Context) {
 	}
 
 	if err := h.service.UpdateModelStatus(c.Request.Context(), id, model.ModelStatus(req.Status)); err != nil {
+		if approvalPending(c, err) {
+			return
+		}
 		if err == repository.ErrModelNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "model not found"})
 			return
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
 		h.logger.Error("Failed to update model status", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -181,3 +253,246 @@ func (h *ModelHandler) UpdateModelStatus(c *gin.Context) {
 
 	c.Status(http.StatusOK)
 }
+
+// ListDeletedModels handles listing models in the trash
+func (h *ModelHandler) ListDeletedModels(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	filter := service.ListModelsFilter{
+		Name:     c.Query("name"),
+		OwnerID:  c.Query("owner_id"),
+		TenantID: c.Query("tenant_id"),
+		Scope:    service.ListScope(c.Query("scope")),
+		Page:     page,
+		Limit:    limit,
+	}
+
+	response, err := h.service.ListDeletedModels(c.Request.Context(), filter)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("Failed to list deleted models", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RestoreModel handles restoring a model from the trash
+func (h *ModelHandler) RestoreModel(c *gin.Context) {
+	id := c.Param("id")
+
+	m, err := h.service.RestoreModel(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrModelNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "model not found in trash"})
+			return
+		}
+		if errors.Is(err, service.ErrDuplicateModel) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("Failed to restore model", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, m)
+}
+
+// PurgeModel handles permanently deleting a model in the trash
+func (h *ModelHandler) PurgeModel(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.service.PurgeModel(c.Request.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrModelNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "model not found in trash"})
+			return
+		}
+		if errors.Is(err, service.ErrNotInTrash) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("Failed to purge model", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateModelVersionRequest represents a model version creation request
+type CreateModelVersionRequest struct {
+	Version     string `json:"version" binding:"required"`
+	Size        int64  `json:"size"`
+	Checksum    string `json:"checksum"`
+	StoragePath string `json:"storage_path"`
+	DockerImage string `json:"docker_image"`
+	ChangeLog   string `json:"change_log"`
+	CreatedBy   string `json:"created_by"`
+
+	Signature *signature.Signature `json:"signature"`
+}
+
+// CreateModelVersion handles recording a new model version
+func (h *ModelHandler) CreateModelVersion(c *gin.Context) {
+	var req CreateModelVersionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	v, err := h.service.CreateModelVersion(c.Request.Context(), service.CreateModelVersionRequest{
+		ModelID:     c.Param("id"),
+		Version:     req.Version,
+		Size:        req.Size,
+		Checksum:    req.Checksum,
+		StoragePath: req.StoragePath,
+		DockerImage: req.DockerImage,
+		ChangeLog:   req.ChangeLog,
+		CreatedBy:   req.CreatedBy,
+		Signature:   req.Signature,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrModelNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "model not found"})
+			return
+		}
+		if errors.Is(err, service.ErrDuplicateVersion) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("Failed to create model version", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v)
+}
+
+// ListModelVersions handles listing the versions of a model
+func (h *ModelHandler) ListModelVersions(c *gin.Context) {
+	versions, err := h.service.ListModelVersions(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, repository.ErrModelNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "model not found"})
+			return
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("Failed to list model versions", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"versions": versions})
+}
+
+// PromoteVersion handles making a version the current one of its model
+func (h *ModelHandler) PromoteVersion(c *gin.Context) {
+	m, err := h.service.PromoteVersion(c.Request.Context(), c.Param("id"), c.Param("version"))
+	if err != nil {
+		if approvalPending(c, err) {
+			return
+		}
+		if errors.Is(err, repository.ErrModelNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "model not found"})
+			return
+		}
+		if errors.Is(err, service.ErrVersionNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "model version not found"})
+			return
+		}
+		if errors.Is(err, service.ErrDuplicateModel) || errors.Is(err, service.ErrPromotionBlocked) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		h.logger.Error("Failed to promote model version", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, m)
+}
+
+// GetModelCard handles getting the model card of a version
+func (h *ModelHandler) GetModelCard(c *gin.Context) {
+	card, err := h.service.GetModelCard(c.Request.Context(), c.Param("id"), c.Param("version"))
+	if err != nil {
+		h.modelCardError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, card)
+}
+
+// SetModelCard handles replacing the model card of a version
+func (h *ModelHandler) SetModelCard(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	card, err := modelcard.Parse(body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.service.SetModelCard(c.Request.Context(), c.Param("id"), c.Param("version"), card)
+	if err != nil {
+		h.modelCardError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// modelCardError writes the response for a failed model card operation
+func (h *ModelHandler) modelCardError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrModelNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "model not found"})
+	case errors.Is(err, service.ErrVersionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "model version not found"})
+	case errors.Is(err, service.ErrCardNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		h.logger.Error("Model card operation failed", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/service"
+)
+
+// AddLineageEdgeRequest represents where a model, or one of its versions, came from
+type AddLineageEdgeRequest struct {
+	Version       string `json:"version"`
+	Relation      string `json:"relation" binding:"required"`
+	ParentModelID string `json:"parent_model_id"`
+	ParentVersion string `json:"parent_version"`
+	DatasetName   string `json:"dataset_name"`
+	DatasetURI    string `json:"dataset_uri"`
+}
+
+// AddLineageEdge handles recording where a model came from
+func (h *ModelHandler) AddLineageEdge(c *gin.Context) {
+	var req AddLineageEdgeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	edge, err := h.service.AddLineageEdge(c.Request.Context(), service.AddLineageEdgeRequest{
+		ModelID:       c.Param("id"),
+		Version:       req.Version,
+		Relation:      model.LineageRelation(req.Relation),
+		ParentModelID: req.ParentModelID,
+		ParentVersion: req.ParentVersion,
+		DatasetName:   req.DatasetName,
+		DatasetURI:    req.DatasetURI,
+	})
+	if err != nil {
+		h.lineageError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, edge)
+}
+
+// RemoveLineageEdge handles removing a lineage edge of a model
+func (h *ModelHandler) RemoveLineageEdge(c *gin.Context) {
+	if err := h.service.RemoveLineageEdge(c.Request.Context(), c.Param("id"), c.Param("edge_id")); err != nil {
+		h.lineageError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetLineage handles walking the ancestors or descendants of a model
+func (h *ModelHandler) GetLineage(c *gin.Context) {
+	depth := 0
+	if value := c.Query("depth"); value != "" {
+		var err error
+		if depth, err = strconv.Atoi(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid depth parameter"})
+			return
+		}
+	}
+
+	graph, err := h.service.GetLineage(c.Request.Context(), c.Param("id"), service.LineageDirection(c.Param("direction")), depth)
+	if err != nil {
+		h.lineageError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, graph)
+}
+
+// lineageError writes the response for a failed lineage operation
+func (h *ModelHandler) lineageError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrModelNotFound), errors.Is(err, service.ErrVersionNotFound), errors.Is(err, service.ErrEdgeNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrDuplicateEdge), errors.Is(err, service.ErrLineageCycle):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		h.logger.Error("Lineage operation failed", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
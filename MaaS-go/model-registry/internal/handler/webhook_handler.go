@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// WebhookHandler handles webhook subscription HTTP requests
+type WebhookHandler struct {
+	service service.WebhookService
+	logger  *logger.Logger
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(s service.WebhookService, logger *logger.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		service: s,
+		logger:  logger,
+	}
+}
+
+// CreateWebhookRequest represents a webhook registration request
+type CreateWebhookRequest struct {
+	TenantID    string            `json:"tenant_id"`
+	URL         string            `json:"url" binding:"required"`
+	EventTypes  []model.EventType `json:"event_types"`
+	ModelID     string            `json:"model_id"`
+	Description string            `json:"description"`
+}
+
+// UpdateWebhookRequest represents a webhook update request
+type UpdateWebhookRequest struct {
+	URL          *string           `json:"url"`
+	EventTypes   []model.EventType `json:"event_types"`
+	ModelID      *string           `json:"model_id"`
+	Description  *string           `json:"description"`
+	Active       *bool             `json:"active"`
+	RotateSecret bool              `json:"rotate_secret"`
+}
+
+// CreateWebhook handles webhook registration; the secret is only returned here
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	var req CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	w, secret, err := h.service.CreateWebhook(c.Request.Context(), service.CreateWebhookRequest{
+		TenantID:    req.TenantID,
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		ModelID:     req.ModelID,
+		Description: req.Description,
+	})
+	if err != nil {
+		h.handleError(c, err, "Failed to create webhook")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"webhook": w, "secret": secret})
+}
+
+// GetWebhook handles retrieving a webhook
+func (h *WebhookHandler) GetWebhook(c *gin.Context) {
+	w, err := h.service.GetWebhook(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get webhook")
+		return
+	}
+
+	c.JSON(http.StatusOK, w)
+}
+
+// ListWebhooks handles listing webhooks
+func (h *WebhookHandler) ListWebhooks(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	response, err := h.service.ListWebhooks(c.Request.Context(), c.Query("tenant_id"), page, limit)
+	if err != nil {
+		h.handleError(c, err, "Failed to list webhooks")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateWebhook handles updating a webhook
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	var req UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	w, secret, err := h.service.UpdateWebhook(c.Request.Context(), c.Param("id"), service.UpdateWebhookRequest{
+		URL:          req.URL,
+		EventTypes:   req.EventTypes,
+		ModelID:      req.ModelID,
+		Description:  req.Description,
+		Active:       req.Active,
+		RotateSecret: req.RotateSecret,
+	})
+	if err != nil {
+		h.handleError(c, err, "Failed to update webhook")
+		return
+	}
+
+	response := gin.H{"webhook": w}
+	if secret != "" {
+		response["secret"] = secret
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteWebhook handles deleting a webhook
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	if err := h.service.DeleteWebhook(c.Request.Context(), c.Param("id")); err != nil {
+		h.handleError(c, err, "Failed to delete webhook")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListDeliveries handles listing the delivery history, optionally of one webhook
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	response, err := h.service.ListDeliveries(c.Request.Context(), service.DeliveryQuery{
+		SubscriptionID: c.Param("id"),
+		Status:         model.DeliveryStatus(c.Query("status")),
+		EventID:        c.Query("event_id"),
+		Page:           page,
+		Limit:          limit,
+	})
+	if err != nil {
+		h.handleError(c, err, "Failed to list webhook deliveries")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Redeliver handles queueing a past delivery again
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	d, err := h.service.Redeliver(c.Request.Context(), c.Param("delivery_id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to redeliver webhook")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, d)
+}
+
+// handleError maps webhook service errors to HTTP responses
+func (h *WebhookHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrWebhookNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "webhook not found"})
+	case errors.Is(err, service.ErrDeliveryNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "webhook delivery not found"})
+	case errors.Is(err, service.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		h.logger.Error(message, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,323 @@
+// Package inspect identifies uploaded model artifacts. It recognizes the
+// serialization format from magic bytes and file layout, so the registry can
+// record what an artifact really is instead of trusting the declared framework.
+package inspect
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+// Format is the serialization format of an artifact
+type Format string
+
+const (
+	FormatONNX          Format = "onnx"
+	FormatPyTorchZip    Format = "pytorch-zip"
+	FormatPyTorchLegacy Format = "pytorch-legacy"
+	FormatSavedModel    Format = "tf-savedmodel"
+	FormatKerasH5       Format = "keras-h5"
+	FormatKeras         Format = "keras"
+	FormatXGBoostJSON   Format = "xgboost-json"
+	FormatXGBoostUBJ    Format = "xgboost-ubj"
+	FormatXGBoostBinary Format = "xgboost-binary"
+	FormatSKLearnPickle Format = "sklearn-pickle"
+	FormatPickle        Format = "pickle"
+	FormatUnknown       Format = "unknown"
+)
+
+// MetadataPrefix starts every metadata key written from a report
+const MetadataPrefix = "artifact."
+
+const (
+	// headSize is how much of a file is read to sniff its format
+	headSize = 64 << 10
+	// maxFiles caps how many entries of a directory artifact are walked
+	maxFiles = 10000
+	// maxSniffed caps how many files of a directory artifact are sniffed
+	maxSniffed = 50
+	// maxListedFiles caps the file list recorded as metadata
+	maxListedFiles = 100
+)
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	hdf5Magic = []byte("\x89HDF\r\n\x1a\n")
+	// torchMagic is the pickled magic number torch.save wrote before zip archives
+	torchMagic = []byte("\x8a\x0a\x6c\xfc\x9c\x46\xf9\x20\x6a\xa8\x50\x19")
+)
+
+// Report describes an inspected artifact
+type Report struct {
+	Format Format
+	// Framework is empty when the format does not identify one
+	Framework model.ModelFramework
+	Size      int64
+	// Files lists the regular files of the artifact relative to its root, sorted
+	Files []string
+	// Truncated is set when the artifact had more than maxFiles entries
+	Truncated bool
+	ONNX      *ONNXInfo
+}
+
+// Mismatch reports whether the artifact contradicts the declared framework.
+// Custom models and unrecognized artifacts never mismatch.
+func (r *Report) Mismatch(declared model.ModelFramework) bool {
+	return r.Framework != "" && declared != model.FrameworkCustom && declared != r.Framework
+}
+
+// Metadata flattens the report into model metadata under MetadataPrefix
+func (r *Report) Metadata() map[string]string {
+	files := r.Files
+	if len(files) > maxListedFiles {
+		files = files[:maxListedFiles]
+	}
+	count := strconv.Itoa(len(r.Files))
+	if r.Truncated {
+		count += "+"
+	}
+
+	md := map[string]string{
+		MetadataPrefix + "format":     string(r.Format),
+		MetadataPrefix + "framework":  string(r.Framework),
+		MetadataPrefix + "size":       strconv.FormatInt(r.Size, 10),
+		MetadataPrefix + "files":      strings.Join(files, ","),
+		MetadataPrefix + "file_count": count,
+	}
+	if r.ONNX != nil {
+		md[MetadataPrefix+"onnx.ir_version"] = strconv.FormatInt(r.ONNX.IRVersion, 10)
+		if r.ONNX.Producer != "" {
+			md[MetadataPrefix+"onnx.producer"] = r.ONNX.Producer
+		}
+		for domain, version := range r.ONNX.Opsets {
+			key := MetadataPrefix + "onnx.opset"
+			if domain != "" && domain != "ai.onnx" {
+				key += "." + domain
+			}
+			md[key] = strconv.FormatInt(version, 10)
+		}
+		if inputs, err := json.Marshal(r.ONNX.Inputs); err == nil {
+			md[MetadataPrefix+"onnx.inputs"] = string(inputs)
+		}
+		if outputs, err := json.Marshal(r.ONNX.Outputs); err == nil {
+			md[MetadataPrefix+"onnx.outputs"] = string(outputs)
+		}
+	}
+	return md
+}
+
+// Inspect examines the artifact at name in fsys, a single file or a directory
+func Inspect(fsys fs.FS, name string) (*Report, error) {
+	if !fs.ValidPath(name) || name == "." {
+		return nil, fmt.Errorf("invalid artifact path %q", name)
+	}
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		r := &Report{Size: info.Size(), Files: []string{path.Base(name)}}
+		if err := sniffFile(fsys, name, info.Size(), r); err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+
+	r := &Report{}
+	var sizes []int64
+	err = fs.WalkDir(fsys, name, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if len(r.Files) == maxFiles {
+			r.Truncated = true
+			return fs.SkipAll
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel := strings.TrimPrefix(p, name+"/")
+		r.Files = append(r.Files, rel)
+		sizes = append(sizes, fi.Size())
+		r.Size += fi.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(byPath{r.Files, sizes})
+
+	for _, f := range r.Files {
+		switch path.Base(f) {
+		case "saved_model.pb", "saved_model.pbtxt":
+			r.Format, r.Framework = FormatSavedModel, model.FrameworkTensorFlow
+			return r, nil
+		}
+	}
+
+	// Otherwise the directory is named after the first file that identifies a framework
+	r.Format = FormatUnknown
+	for i, f := range r.Files {
+		if i == maxSniffed {
+			break
+		}
+		file := &Report{}
+		if err := sniffFile(fsys, name+"/"+f, sizes[i], file); err != nil {
+			return nil, err
+		}
+		if file.Framework != "" {
+			r.Format, r.Framework, r.ONNX = file.Format, file.Framework, file.ONNX
+			break
+		}
+	}
+	return r, nil
+}
+
+// byPath sorts file paths together with their sizes
+type byPath struct {
+	paths []string
+	sizes []int64
+}
+
+func (b byPath) Len() int           { return len(b.paths) }
+func (b byPath) Less(i, j int) bool { return b.paths[i] < b.paths[j] }
+func (b byPath) Swap(i, j int) {
+	b.paths[i], b.paths[j] = b.paths[j], b.paths[i]
+	b.sizes[i], b.sizes[j] = b.sizes[j], b.sizes[i]
+}
+
+// sniffFile sets the format and framework of a single file
+func sniffFile(fsys fs.FS, name string, size int64, r *Report) error {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	br := bufio.NewReaderSize(f, headSize)
+	head, err := br.Peek(headSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	r.Format = FormatUnknown
+	switch {
+	case bytes.HasPrefix(head, zipMagic):
+		ra, ok := f.(io.ReaderAt)
+		if !ok {
+			return nil
+		}
+		r.Format, r.Framework = sniffZip(ra, size)
+	case bytes.HasPrefix(head, hdf5Magic):
+		r.Format, r.Framework = FormatKerasH5, model.FrameworkTensorFlow
+	case bytes.HasPrefix(head, []byte("binf")):
+		r.Format, r.Framework = FormatXGBoostBinary, model.FrameworkXGBoost
+	case isPickle(head):
+		r.Format, r.Framework = sniffPickle(head)
+	case isZlib(head) || isGzip(head):
+		// joblib compresses pickles with zlib or gzip
+		if inner := inflateHead(head); isPickle(inner) {
+			r.Format, r.Framework = sniffPickle(inner)
+		}
+	case isUBJSONObject(head):
+		if bytes.Contains(head, []byte("learner")) {
+			r.Format, r.Framework = FormatXGBoostUBJ, model.FrameworkXGBoost
+		}
+	case bytes.HasPrefix(bytes.TrimLeft(head, " \t\r\n"), []byte("{")):
+		if bytes.Contains(head, []byte(`"learner"`)) {
+			r.Format, r.Framework = FormatXGBoostJSON, model.FrameworkXGBoost
+		}
+	case len(head) > 0 && head[0] == 0x08 || strings.EqualFold(path.Ext(name), ".onnx"):
+		// ONNX has no magic number, but serializers write ir_version, field 1, first
+		if info, err := parseONNX(br, size); err == nil {
+			r.Format, r.Framework, r.ONNX = FormatONNX, model.FrameworkONNX, info
+		}
+	}
+	return nil
+}
+
+func isPickle(head []byte) bool {
+	return len(head) >= 2 && head[0] == 0x80 && head[1] >= 2 && head[1] <= 5
+}
+
+func isZlib(head []byte) bool {
+	return len(head) >= 2 && head[0] == 0x78 && (uint16(head[0])<<8|uint16(head[1]))%31 == 0
+}
+
+func isGzip(head []byte) bool {
+	return len(head) >= 2 && head[0] == 0x1f && head[1] == 0x8b
+}
+
+// isUBJSONObject reports whether head opens a UBJSON object, whose first key
+// starts with a length type marker rather than a quote
+func isUBJSONObject(head []byte) bool {
+	return len(head) >= 2 && head[0] == '{' && bytes.IndexByte([]byte("iUIlL$#"), head[1]) >= 0
+}
+
+// sniffPickle tells pickled models apart by the modules they reference
+func sniffPickle(head []byte) (Format, model.ModelFramework) {
+	switch {
+	case bytes.Contains(head[:min(len(head), 32)], torchMagic):
+		return FormatPyTorchLegacy, model.FrameworkPyTorch
+	case bytes.Contains(head, []byte("xgboost")):
+		// Checked first because the scikit-learn wrappers live in xgboost.sklearn
+		return FormatPickle, model.FrameworkXGBoost
+	case bytes.Contains(head, []byte("sklearn")):
+		return FormatSKLearnPickle, model.FrameworkSKLearn
+	}
+	return FormatPickle, ""
+}
+
+// inflateHead decompresses as much of a compressed head as it holds
+func inflateHead(head []byte) []byte {
+	var r io.Reader
+	var err error
+	if isGzip(head) {
+		r, err = gzip.NewReader(bytes.NewReader(head))
+	} else {
+		r, err = zlib.NewReader(bytes.NewReader(head))
+	}
+	if err != nil {
+		return nil
+	}
+	out, _ := io.ReadAll(io.LimitReader(r, headSize))
+	return out
+}
+
+// sniffZip recognizes the zip archives written by torch.save, TorchScript and Keras
+func sniffZip(ra io.ReaderAt, size int64) (Format, model.ModelFramework) {
+	zr, err := zip.NewReader(ra, size)
+	if err != nil {
+		return FormatUnknown, ""
+	}
+	names := make(map[string]bool, len(zr.File))
+	for _, f := range zr.File {
+		names[f.Name] = true
+		// torch.save archives hold <name>/data.pkl; TorchScript adds <name>/constants.pkl
+		if base := path.Base(f.Name); base == "data.pkl" || base == "constants.pkl" {
+			return FormatPyTorchZip, model.FrameworkPyTorch
+		}
+	}
+	if names["config.json"] && names["model.weights.h5"] {
+		return FormatKeras, model.FrameworkTensorFlow
+	}
+	return FormatUnknown, ""
+}
@@ -0,0 +1,313 @@
+package inspect
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"maas-platform/shared/signature"
+)
+
+// maxStringSize caps names read from an ONNX file
+const maxStringSize = 64 << 10
+
+var errNotONNX = errors.New("not an ONNX model")
+
+// ONNXInfo is what the registry reads from an ONNX ModelProto
+type ONNXInfo struct {
+	IRVersion int64
+	Producer  string
+	// Opsets maps operator set domains to versions; "" is the default ai.onnx domain
+	Opsets  map[string]int64
+	Inputs  []signature.TensorSpec
+	Outputs []signature.TensorSpec
+}
+
+// onnxDTypes maps TensorProto.DataType to signature dtypes; types without a
+// signature dtype keep their ONNX name
+var onnxDTypes = map[uint64]signature.DType{
+	1:  signature.Float32,
+	2:  signature.Uint8,
+	3:  signature.Int8,
+	4:  "uint16",
+	5:  signature.Int16,
+	6:  signature.Int32,
+	7:  signature.Int64,
+	8:  signature.String,
+	9:  signature.Bool,
+	10: signature.Float16,
+	11: signature.Float64,
+	12: "uint32",
+	13: "uint64",
+	14: "complex64",
+	15: "complex128",
+	16: "bfloat16",
+}
+
+// parseONNX reads the opsets and graph signature of a serialized ModelProto.
+// Weights are skipped as they stream past rather than held in memory.
+func parseONNX(r *bufio.Reader, size int64) (*ONNXInfo, error) {
+	d := &decoder{r: r}
+	info := &ONNXInfo{Opsets: make(map[string]int64)}
+	var graph *onnxGraph
+
+	// ModelProto: ir_version = 1, producer_name = 2, graph = 7, opset_import = 8
+	err := d.message(size, func(num protowire.Number, typ protowire.Type, v uint64) (bool, error) {
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			info.IRVersion = int64(v)
+		case num == 2 && typ == protowire.BytesType:
+			s, err := d.string(v)
+			info.Producer = s
+			return true, err
+		case num == 7 && typ == protowire.BytesType:
+			graph = &onnxGraph{initializers: make(map[string]bool)}
+			return true, d.graph(v, graph)
+		case num == 8 && typ == protowire.BytesType:
+			domain, version, err := d.opset(v)
+			info.Opsets[domain] = version
+			return true, err
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if info.IRVersion <= 0 || graph == nil || len(info.Opsets) == 0 {
+		return nil, errNotONNX
+	}
+
+	// Older exporters list initializers among the inputs; they are weights, not inputs
+	for _, in := range graph.inputs {
+		if !graph.initializers[in.Name] {
+			info.Inputs = append(info.Inputs, in)
+		}
+	}
+	info.Outputs = graph.outputs
+	return info, nil
+}
+
+// onnxGraph collects the parts of a GraphProto the registry records
+type onnxGraph struct {
+	inputs       []signature.TensorSpec
+	outputs      []signature.TensorSpec
+	initializers map[string]bool
+}
+
+// graph reads a GraphProto: initializer = 5, input = 11, output = 12
+func (d *decoder) graph(size uint64, g *onnxGraph) error {
+	return d.message(int64(size), func(num protowire.Number, typ protowire.Type, v uint64) (bool, error) {
+		if typ != protowire.BytesType {
+			return false, nil
+		}
+		switch num {
+		case 5:
+			// TensorProto: name = 8
+			return true, d.message(int64(v), func(num protowire.Number, typ protowire.Type, v uint64) (bool, error) {
+				if num != 8 || typ != protowire.BytesType {
+					return false, nil
+				}
+				name, err := d.string(v)
+				g.initializers[name] = true
+				return true, err
+			})
+		case 11:
+			spec, err := d.valueInfo(v)
+			g.inputs = append(g.inputs, spec)
+			return true, err
+		case 12:
+			spec, err := d.valueInfo(v)
+			g.outputs = append(g.outputs, spec)
+			return true, err
+		}
+		return false, nil
+	})
+}
+
+// valueInfo reads a ValueInfoProto: name = 1, type = 2
+func (d *decoder) valueInfo(size uint64) (signature.TensorSpec, error) {
+	var spec signature.TensorSpec
+	err := d.message(int64(size), func(num protowire.Number, typ protowire.Type, v uint64) (bool, error) {
+		if typ != protowire.BytesType {
+			return false, nil
+		}
+		switch num {
+		case 1:
+			s, err := d.string(v)
+			spec.Name = s
+			return true, err
+		case 2:
+			// TypeProto: tensor_type = 1
+			return true, d.message(int64(v), func(num protowire.Number, typ protowire.Type, v uint64) (bool, error) {
+				if num != 1 || typ != protowire.BytesType {
+					return false, nil
+				}
+				return true, d.tensorType(v, &spec)
+			})
+		}
+		return false, nil
+	})
+	return spec, err
+}
+
+// tensorType reads a TypeProto.Tensor: elem_type = 1, shape = 2
+func (d *decoder) tensorType(size uint64, spec *signature.TensorSpec) error {
+	return d.message(int64(size), func(num protowire.Number, typ protowire.Type, v uint64) (bool, error) {
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			spec.DType = onnxDTypes[v]
+		case num == 2 && typ == protowire.BytesType:
+			// TensorShapeProto: dim = 1
+			spec.Shape = []int64{}
+			return true, d.message(int64(v), func(num protowire.Number, typ protowire.Type, v uint64) (bool, error) {
+				if num != 1 || typ != protowire.BytesType {
+					return false, nil
+				}
+				dim, err := d.dimension(v)
+				spec.Shape = append(spec.Shape, dim)
+				return true, err
+			})
+		}
+		return false, nil
+	})
+}
+
+// dimension reads a TensorShapeProto.Dimension; symbolic and unset sizes are dynamic
+func (d *decoder) dimension(size uint64) (int64, error) {
+	dim := signature.Dynamic
+	err := d.message(int64(size), func(num protowire.Number, typ protowire.Type, v uint64) (bool, error) {
+		if num == 1 && typ == protowire.VarintType && int64(v) > 0 {
+			dim = int64(v)
+		}
+		return false, nil
+	})
+	return dim, err
+}
+
+// opset reads an OperatorSetIdProto: domain = 1, version = 2
+func (d *decoder) opset(size uint64) (string, int64, error) {
+	var domain string
+	var version int64
+	err := d.message(int64(size), func(num protowire.Number, typ protowire.Type, v uint64) (bool, error) {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			s, err := d.string(v)
+			domain = s
+			return true, err
+		case num == 2 && typ == protowire.VarintType:
+			version = int64(v)
+		}
+		return false, nil
+	})
+	return domain, version, err
+}
+
+// decoder walks protobuf wire format from a stream
+type decoder struct {
+	r   *bufio.Reader
+	off int64
+}
+
+// ReadByte lets binary.ReadUvarint count the bytes it consumes
+func (d *decoder) ReadByte() (byte, error) {
+	b, err := d.r.ReadByte()
+	if err == nil {
+		d.off++
+	}
+	return b, err
+}
+
+// message reads size bytes of fields, calling fn with each field's number,
+// wire type and value; for length-delimited fields the value is the length.
+// fn either consumes exactly that many bytes and returns true, or returns
+// false to have them skipped.
+func (d *decoder) message(size int64, fn func(num protowire.Number, typ protowire.Type, v uint64) (bool, error)) error {
+	end := d.off + size
+	for d.off < end {
+		key, err := binary.ReadUvarint(d)
+		if err != nil {
+			return errNotONNX
+		}
+		num, typ := protowire.DecodeTag(key)
+		if num < protowire.MinValidNumber {
+			return errNotONNX
+		}
+
+		var v uint64
+		switch typ {
+		case protowire.VarintType:
+			if v, err = binary.ReadUvarint(d); err != nil {
+				return errNotONNX
+			}
+		case protowire.Fixed32Type:
+			if err := d.skip(4); err != nil {
+				return err
+			}
+			continue
+		case protowire.Fixed64Type:
+			if err := d.skip(8); err != nil {
+				return err
+			}
+			continue
+		case protowire.BytesType:
+			if v, err = binary.ReadUvarint(d); err != nil || v > uint64(end-d.off) {
+				return errNotONNX
+			}
+		default:
+			// ONNX uses no groups, so anything else means this is not ONNX
+			return errNotONNX
+		}
+
+		start := d.off
+		handled, err := fn(num, typ, v)
+		if err != nil {
+			return err
+		}
+		if typ != protowire.BytesType {
+			continue
+		}
+		if !handled {
+			if err := d.skip(int64(v)); err != nil {
+				return err
+			}
+		} else if d.off != start+int64(v) {
+			return errNotONNX
+		}
+	}
+	if d.off != end {
+		return errNotONNX
+	}
+	return nil
+}
+
+// string reads a length-delimited string of n bytes
+func (d *decoder) string(n uint64) (string, error) {
+	if n > maxStringSize {
+		return "", fmt.Errorf("%w: %d byte name", errNotONNX, n)
+	}
+	buf := make([]byte, n)
+	read, err := io.ReadFull(d.r, buf)
+	d.off += int64(read)
+	if err != nil {
+		return "", errNotONNX
+	}
+	return string(buf), nil
+}
+
+// skip discards n bytes
+func (d *decoder) skip(n int64) error {
+	for n > 0 {
+		chunk := int(min(n, 1<<30))
+		discarded, err := d.r.Discard(chunk)
+		d.off += int64(discarded)
+		n -= int64(discarded)
+		if err != nil {
+			return errNotONNX
+		}
+	}
+	return nil
+}
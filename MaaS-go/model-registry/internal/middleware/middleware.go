@@ -59,6 +59,9 @@ func Logger(log *logger.Logger) gin.HandlerFunc {
 			"latency", latency,
 			"request_id", requestID,
 		}
+		if traceID := c.GetString("trace_id"); traceID != "" {
+			fields = append(fields, "trace_id", traceID)
+		}
 
 		if len(c.Errors) > 0 {
 			fields = append(fields, "error", c.Errors.String())
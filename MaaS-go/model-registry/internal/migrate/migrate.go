@@ -0,0 +1,366 @@
+// Package migrate applies the registry's versioned SQL migrations. Migrations
+// are embedded in the binary as <version>_<name>.up.sql and .down.sql pairs,
+// one directory per SQL dialect, and recorded in the schema_migrations table.
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"maas-platform/model-registry/pkg/logger"
+)
+
+//go:embed sql/postgres/*.sql sql/sqlite/*.sql
+var files embed.FS
+
+// lockKey is the Postgres advisory lock held while migrating, so that
+// replicas starting together apply each migration exactly once
+const lockKey int64 = 0x6d6161735f6d6967 // "maas_mig"
+
+var (
+	// ErrDirty is returned when an applied migration no longer matches its embedded file
+	ErrDirty = errors.New("applied migration was modified")
+	// ErrNoDown is returned when rolling back a migration without a down file
+	ErrNoDown = errors.New("migration has no down file")
+)
+
+// fileName matches 0001_create_extensions.up.sql
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is one versioned schema change
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// checksum identifies the up SQL that was applied
+func (m Migration) checksum() string {
+	sum := sha256.Sum256([]byte(m.Up))
+	return hex.EncodeToString(sum[:])
+}
+
+// Status is the state of one migration in the database
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+	// Modified is set when the embedded up SQL differs from what was applied
+	Modified bool
+}
+
+// Migrator applies and rolls back the embedded migrations
+type Migrator struct {
+	db         *sql.DB
+	dialect    string
+	migrations []Migration
+	logger     *logger.Logger
+}
+
+// New creates a migrator for the embedded migrations of a dialect, postgres or sqlite
+func New(db *sql.DB, dialect string, logger *logger.Logger) (*Migrator, error) {
+	switch dialect {
+	case "postgres", "sqlite":
+	default:
+		return nil, fmt.Errorf("no migrations for dialect %q", dialect)
+	}
+	migrations, err := load(files, "sql/"+dialect)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations, logger: logger}, nil
+}
+
+// load parses the migration files in dir, ordered by version
+func load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", entry.Name())
+		}
+		version, _ := strconv.ParseInt(match[1], 10, 64)
+		body, err := fs.ReadFile(fsys, dir+"/"+entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Up applies all pending migrations in order and returns how many were applied
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if checksum, ok := done[mig.Version]; ok {
+				if checksum != mig.checksum() {
+					return fmt.Errorf("%w: %d_%s", ErrDirty, mig.Version, mig.Name)
+				}
+				continue
+			}
+
+			start := time.Now()
+			if err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, mig.Up); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO schema_migrations (version, name, checksum, applied_at) VALUES ($1, $2, $3, $4)`,
+					mig.Version, mig.Name, mig.checksum(), time.Now().UTC())
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			m.logger.Info("Applied migration", "version", mig.Version, "name", mig.Name, "duration", time.Since(start))
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the last steps applied migrations, newest first
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	rolledBack := 0
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && rolledBack < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := done[mig.Version]; !ok {
+				continue
+			}
+			if mig.Down == "" {
+				return fmt.Errorf("%w: %d_%s", ErrNoDown, mig.Version, mig.Name)
+			}
+
+			if err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, mig.Down); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, mig.Version)
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to roll back migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			m.logger.Info("Rolled back migration", "version", mig.Version, "name", mig.Name)
+			rolledBack++
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// Status lists every embedded migration with the time it was applied
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := ensureTable(ctx, conn); err != nil {
+		return nil, err
+	}
+	rows, err := conn.QueryContext(ctx, `SELECT version, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	type record struct {
+		checksum  string
+		appliedAt appliedAt
+	}
+	done := make(map[int64]record)
+	for rows.Next() {
+		var version int64
+		var r record
+		if err := rows.Scan(&version, &r.checksum, &r.appliedAt); err != nil {
+			return nil, err
+		}
+		done[version] = r
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, len(m.migrations))
+	for i, mig := range m.migrations {
+		statuses[i] = Status{Version: mig.Version, Name: mig.Name}
+		if r, ok := done[mig.Version]; ok {
+			appliedAt := r.appliedAt.Time
+			statuses[i].AppliedAt = &appliedAt
+			statuses[i].Modified = r.checksum != mig.checksum()
+		}
+	}
+	return statuses, nil
+}
+
+// locked runs fn on a single connection holding the migration advisory lock
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if m.dialect != "postgres" {
+		// A SQLite database belongs to a single registry process
+		if err := ensureTable(ctx, conn); err != nil {
+			return err
+		}
+		return fn(conn)
+	}
+
+	// Waiting for the lock and building indexes can outlast the statement timeout
+	if _, err := conn.ExecContext(ctx, `SET statement_timeout = 0`); err != nil {
+		return fmt.Errorf("failed to disable statement timeout: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), `RESET statement_timeout`)
+
+	// Session-level locks are tied to this connection, so it must be the one doing the work
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		// The lock must be released even when ctx was cancelled mid-migration
+		if _, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockKey); err != nil {
+			m.logger.Error("Failed to release migration lock", "error", err)
+		}
+	}()
+
+	if err := ensureTable(ctx, conn); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+// appliedAt scans schema_migrations.applied_at, which SQLite returns as
+// text since it does not know the timestamptz type
+type appliedAt struct {
+	time.Time
+}
+
+// sqliteTimeLayouts are the formats the SQLite driver writes times in
+var sqliteTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+}
+
+// Scan reads a time or its SQLite text form
+func (t *appliedAt) Scan(src interface{}) error {
+	var text string
+	switch v := src.(type) {
+	case time.Time:
+		t.Time = v
+		return nil
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into applied_at", src)
+	}
+	for _, layout := range sqliteTimeLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot parse applied_at %q", text)
+}
+
+// ensureTable creates the schema_migrations table
+func ensureTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    bigint PRIMARY KEY,
+		name       varchar(255) NOT NULL,
+		checksum   varchar(64) NOT NULL,
+		applied_at timestamptz NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+// appliedVersions returns the checksum of every applied migration by version
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]string, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, checksum FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	done := make(map[int64]string)
+	for rows.Next() {
+		var version int64
+		var checksum string
+		if err := rows.Scan(&version, &checksum); err != nil {
+			return nil, err
+		}
+		done[version] = checksum
+	}
+	return done, rows.Err()
+}
+
+// inTx runs fn in a transaction on conn
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,233 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// newMigrator returns a migrator of the embedded SQLite migrations over a fresh database
+func newMigrator(t *testing.T) (*Migrator, *sql.DB) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "registry.db")), &gorm.Config{Logger: gormlogger.Discard})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("sql db: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	m, err := New(sqlDB, "sqlite", logger.New("error"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return m, sqlDB
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    fstest.MapFS
+		want     []int64
+		wantErr  string
+		wantDown map[int64]bool
+	}{
+		{
+			name: "ordered by version",
+			files: fstest.MapFS{
+				"m/0010_b.up.sql":   {Data: []byte("b")},
+				"m/0002_a.up.sql":   {Data: []byte("a")},
+				"m/0002_a.down.sql": {Data: []byte("undo a")},
+			},
+			want:     []int64{2, 10},
+			wantDown: map[int64]bool{2: true, 10: false},
+		},
+		{
+			name:    "bad file name",
+			files:   fstest.MapFS{"m/0001_Create.up.sql": {Data: []byte("x")}},
+			wantErr: "invalid migration file name",
+		},
+		{
+			name:    "down without up",
+			files:   fstest.MapFS{"m/0001_a.down.sql": {Data: []byte("x")}},
+			wantErr: "has no up file",
+		},
+		{
+			name: "two names for one version",
+			files: fstest.MapFS{
+				"m/0001_a.up.sql":   {Data: []byte("x")},
+				"m/0001_b.down.sql": {Data: []byte("x")},
+			},
+			wantErr: "has two names",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := load(tt.files, "m")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("load error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("load: %v", err)
+			}
+			var got []int64
+			for _, m := range migrations {
+				got = append(got, m.Version)
+				if hasDown := m.Down != ""; hasDown != tt.wantDown[m.Version] {
+					t.Errorf("migration %d has down %v, want %v", m.Version, hasDown, tt.wantDown[m.Version])
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("versions = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("versions = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewRejectsUnknownDialect(t *testing.T) {
+	if _, err := New(nil, "mysql", logger.New("error")); err == nil {
+		t.Error("New accepted the mysql dialect")
+	}
+}
+
+func TestEmbeddedMigrationsLoad(t *testing.T) {
+	for _, dialect := range []string{"postgres", "sqlite"} {
+		t.Run(dialect, func(t *testing.T) {
+			migrations, err := load(files, "sql/"+dialect)
+			if err != nil {
+				t.Fatalf("load: %v", err)
+			}
+			for _, m := range migrations {
+				if m.Down == "" {
+					t.Errorf("migration %d_%s has no down file", m.Version, m.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestUpDownRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	m, _ := newMigrator(t)
+	total := len(m.migrations)
+
+	steps := []struct {
+		name        string
+		run         func() (int, error)
+		wantCount   int
+		wantApplied int
+	}{
+		{"up applies everything", func() (int, error) { return m.Up(ctx) }, total, total},
+		{"up again is a no-op", func() (int, error) { return m.Up(ctx) }, 0, total},
+		{"down rolls back the newest", func() (int, error) { return m.Down(ctx, 1) }, 1, total - 1},
+		{"up reapplies it", func() (int, error) { return m.Up(ctx) }, 1, total},
+		{"down stops at the oldest", func() (int, error) { return m.Down(ctx, total+5) }, total, 0},
+		{"up from scratch", func() (int, error) { return m.Up(ctx) }, total, total},
+	}
+	for _, step := range steps {
+		count, err := step.run()
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if count != step.wantCount {
+			t.Errorf("%s: count = %d, want %d", step.name, count, step.wantCount)
+		}
+
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			t.Fatalf("%s: Status: %v", step.name, err)
+		}
+		applied := 0
+		for i, s := range statuses {
+			if s.AppliedAt != nil {
+				applied++
+			}
+			// Migrations are applied as a prefix of the list
+			if (s.AppliedAt != nil) != (i < step.wantApplied) {
+				t.Errorf("%s: migration %d_%s applied %v", step.name, s.Version, s.Name, s.AppliedAt != nil)
+			}
+			if s.Modified {
+				t.Errorf("%s: migration %d_%s reported modified", step.name, s.Version, s.Name)
+			}
+		}
+		if applied != step.wantApplied {
+			t.Errorf("%s: %d applied, want %d", step.name, applied, step.wantApplied)
+		}
+	}
+}
+
+func TestUpRefusesModifiedMigration(t *testing.T) {
+	ctx := context.Background()
+	m, db := newMigrator(t)
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+
+	last := m.migrations[len(m.migrations)-1]
+	if _, err := db.ExecContext(ctx, `UPDATE schema_migrations SET checksum = 'edited' WHERE version = $1`, last.Version); err != nil {
+		t.Fatalf("edit checksum: %v", err)
+	}
+	if _, err := m.Up(ctx); !errors.Is(err, ErrDirty) {
+		t.Errorf("Up = %v, want %v", err, ErrDirty)
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if got := statuses[len(statuses)-1]; !got.Modified {
+		t.Errorf("status of %d_%s is not modified", got.Version, got.Name)
+	}
+}
+
+func TestDownWithoutDownFile(t *testing.T) {
+	ctx := context.Background()
+	m, _ := newMigrator(t)
+	m.migrations = append(m.migrations, Migration{Version: 9999, Name: "irreversible", Up: "CREATE TABLE irreversible (id integer)"})
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if _, err := m.Down(ctx, 1); !errors.Is(err, ErrNoDown) {
+		t.Errorf("Down = %v, want %v", err, ErrNoDown)
+	}
+}
+
+func TestFailedMigrationIsNotRecorded(t *testing.T) {
+	ctx := context.Background()
+	m, db := newMigrator(t)
+	m.migrations = append(m.migrations, Migration{Version: 9999, Name: "broken", Up: "CREATE TABLE half (id integer); SELECT * FROM missing_table"})
+
+	applied, err := m.Up(ctx)
+	if err == nil || !strings.Contains(err.Error(), "9999_broken") {
+		t.Fatalf("Up = %v, want the broken migration to fail", err)
+	}
+	if want := len(m.migrations) - 1; applied != want {
+		t.Errorf("applied %d, want %d", applied, want)
+	}
+	var n int
+	if err := db.QueryRowContext(ctx, `SELECT count(*) FROM schema_migrations WHERE version = 9999`).Scan(&n); err != nil || n != 0 {
+		t.Errorf("broken migration recorded: %d rows, %v", n, err)
+	}
+	if err := db.QueryRowContext(ctx, `SELECT count(*) FROM sqlite_master WHERE name = 'half'`).Scan(&n); err != nil || n != 0 {
+		t.Errorf("broken migration left its table behind: %d, %v", n, err)
+	}
+}
@@ -0,0 +1,2 @@
+-- uuid_generate_v4() backs the id column defaults
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
@@ -0,0 +1,266 @@
+-- Baseline of the schema previously created by GORM AutoMigrate. Every
+-- statement is IF NOT EXISTS so that databases set up by AutoMigrate adopt
+-- this migration without changes.
+
+CREATE TABLE IF NOT EXISTS users (
+    id               uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    username         varchar(50)  NOT NULL,
+    email            varchar(100) NOT NULL,
+    password         varchar(255) NOT NULL,
+    role             varchar(20)  DEFAULT 'developer',
+    status           varchar(20)  DEFAULT 'active',
+    tenant_id        uuid,
+    created_at       timestamptz,
+    updated_at       timestamptz,
+    deleted_at       timestamptz,
+    external_issuer  varchar(255),
+    external_subject varchar(255),
+    last_login_at    timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username ON users (username);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE INDEX IF NOT EXISTS idx_users_tenant_id ON users (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_external_identity ON users (external_issuer, external_subject);
+
+CREATE TABLE IF NOT EXISTS tenants (
+    id                 uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name               varchar(100) NOT NULL,
+    description        text,
+    status             varchar(20) DEFAULT 'active',
+    max_models         bigint DEFAULT 10,
+    max_storage_gb     bigint DEFAULT 100,
+    max_inference_qps  bigint DEFAULT 100,
+    max_inference_conc bigint DEFAULT 10,
+    created_at         timestamptz,
+    updated_at         timestamptz,
+    deleted_at         timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_tenants_deleted_at ON tenants (deleted_at);
+
+CREATE TABLE IF NOT EXISTS models (
+    id           uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name         varchar(255) NOT NULL,
+    description  text,
+    version      varchar(50)  NOT NULL,
+    framework    varchar(50)  NOT NULL,
+    status       varchar(50)  DEFAULT 'pending',
+    size         bigint DEFAULT 0,
+    checksum     varchar(64),
+    storage_path varchar(512),
+    docker_image varchar(255),
+    owner_id     uuid NOT NULL,
+    tenant_id    uuid NOT NULL,
+    is_public    boolean DEFAULT false,
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    deleted_at   timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_models_name ON models (name);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_models_name_version ON models (name, version);
+CREATE INDEX IF NOT EXISTS idx_models_owner_id ON models (owner_id);
+CREATE INDEX IF NOT EXISTS idx_models_tenant_id ON models (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_models_deleted_at ON models (deleted_at);
+
+CREATE TABLE IF NOT EXISTS tags (
+    id         uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       varchar(50) NOT NULL,
+    created_at timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_name ON tags (name);
+
+CREATE TABLE IF NOT EXISTS model_tags (
+    model_id uuid NOT NULL,
+    tag_id   uuid NOT NULL,
+    PRIMARY KEY (model_id, tag_id),
+    CONSTRAINT fk_model_tags_model FOREIGN KEY (model_id) REFERENCES models (id),
+    CONSTRAINT fk_model_tags_tag FOREIGN KEY (tag_id) REFERENCES tags (id)
+);
+
+CREATE TABLE IF NOT EXISTS model_metadata (
+    id         uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    model_id   uuid NOT NULL,
+    key        varchar(100) NOT NULL,
+    value      varchar(500),
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_models_metadata FOREIGN KEY (model_id) REFERENCES models (id)
+);
+CREATE INDEX IF NOT EXISTS idx_model_metadata_model_id ON model_metadata (model_id);
+
+CREATE TABLE IF NOT EXISTS model_versions (
+    id           uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    model_id     uuid NOT NULL,
+    version      varchar(50) NOT NULL,
+    status       varchar(50) NOT NULL,
+    size         bigint,
+    checksum     varchar(64),
+    storage_path varchar(512),
+    docker_image varchar(255),
+    change_log   text,
+    created_by   uuid NOT NULL,
+    created_at   timestamptz,
+    CONSTRAINT fk_models_versions FOREIGN KEY (model_id) REFERENCES models (id)
+);
+CREATE INDEX IF NOT EXISTS idx_model_versions_model_id ON model_versions (model_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_model_versions_model_version ON model_versions (model_id, version);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    occurred_at timestamptz NOT NULL,
+    actor_id    varchar(255),
+    tenant_id   varchar(255),
+    request_id  varchar(64),
+    source      varchar(50),
+    action      varchar(50) NOT NULL,
+    target_type varchar(50),
+    target_id   varchar(255),
+    before      jsonb,
+    after       jsonb,
+    diff        jsonb
+);
+CREATE INDEX IF NOT EXISTS idx_audit_log_occurred_at ON audit_log (occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor_id ON audit_log (actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_log_tenant_id ON audit_log (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_audit_log_action ON audit_log (action);
+CREATE INDEX IF NOT EXISTS idx_audit_log_target_id ON audit_log (target_id);
+
+CREATE TABLE IF NOT EXISTS event_outbox (
+    sequence     bigserial PRIMARY KEY,
+    event_id     uuid NOT NULL,
+    type         varchar(50) NOT NULL,
+    model_id     uuid NOT NULL,
+    tenant_id    varchar(255),
+    payload      jsonb NOT NULL,
+    occurred_at  timestamptz NOT NULL,
+    published_at timestamptz,
+    attempts     bigint DEFAULT 0,
+    last_error   text
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_event_outbox_event_id ON event_outbox (event_id);
+CREATE INDEX IF NOT EXISTS idx_event_outbox_model_id ON event_outbox (model_id);
+CREATE INDEX IF NOT EXISTS idx_event_outbox_tenant_id ON event_outbox (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_event_outbox_published_at ON event_outbox (published_at);
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id   uuid NOT NULL,
+    created_by  uuid,
+    url         varchar(2048) NOT NULL,
+    secret      varchar(128) NOT NULL,
+    description text,
+    event_types jsonb,
+    model_id    varchar(36),
+    active      boolean DEFAULT true,
+    created_at  timestamptz,
+    updated_at  timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_tenant_id ON webhook_subscriptions (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_model_id ON webhook_subscriptions (model_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subscription_id uuid NOT NULL,
+    tenant_id       uuid NOT NULL,
+    event_id        uuid NOT NULL,
+    event_type      varchar(50) NOT NULL,
+    model_id        varchar(36),
+    payload         jsonb NOT NULL,
+    status          varchar(20) NOT NULL,
+    attempts        bigint DEFAULT 0,
+    next_attempt_at timestamptz,
+    last_attempt_at timestamptz,
+    response_code   bigint,
+    last_error      text,
+    redelivery_of   varchar(36),
+    delivered_at    timestamptz,
+    created_at      timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_tenant_id ON webhook_deliveries (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_event_id ON webhook_deliveries (event_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_model_id ON webhook_deliveries (model_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_status ON webhook_deliveries (status);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_next_attempt_at ON webhook_deliveries (next_attempt_at);
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id           uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id    varchar(255) NOT NULL,
+    user_id      varchar(255) NOT NULL,
+    role         varchar(20)  NOT NULL,
+    name         varchar(100) NOT NULL,
+    prefix       varchar(20)  NOT NULL,
+    hash         varchar(64)  NOT NULL,
+    scopes       jsonb,
+    expires_at   timestamptz,
+    last_used_at timestamptz,
+    revoked_at   timestamptz,
+    created_at   timestamptz,
+    updated_at   timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_api_keys_tenant_id ON api_keys (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_hash ON api_keys (hash);
+CREATE INDEX IF NOT EXISTS idx_api_keys_revoked_at ON api_keys (revoked_at);
+
+CREATE TABLE IF NOT EXISTS usage_rollups (
+    id                bigserial PRIMARY KEY,
+    hour_start        timestamptz  NOT NULL,
+    tenant_id         varchar(255) NOT NULL,
+    model_id          varchar(255) NOT NULL,
+    version           varchar(50)  NOT NULL,
+    status            varchar(20)  NOT NULL,
+    requests          bigint NOT NULL DEFAULT 0,
+    total_latency_ms  bigint NOT NULL DEFAULT 0,
+    max_latency_ms    bigint NOT NULL DEFAULT 0,
+    request_bytes     bigint NOT NULL DEFAULT 0,
+    response_bytes    bigint NOT NULL DEFAULT 0,
+    prompt_tokens     bigint NOT NULL DEFAULT 0,
+    completion_tokens bigint NOT NULL DEFAULT 0,
+    updated_at        timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_usage_rollup_key ON usage_rollups (hour_start, tenant_id, model_id, version, status);
+CREATE INDEX IF NOT EXISTS idx_usage_rollups_tenant_id ON usage_rollups (tenant_id);
+
+CREATE TABLE IF NOT EXISTS pricing_plans (
+    id                    uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name                  varchar(100) NOT NULL,
+    description           text,
+    currency              varchar(3) NOT NULL DEFAULT 'USD',
+    request_price_micros  bigint NOT NULL DEFAULT 0,
+    token_price_micros    bigint NOT NULL DEFAULT 0,
+    storage_price_micros  bigint NOT NULL DEFAULT 0,
+    free_requests         bigint NOT NULL DEFAULT 0,
+    free_tokens           bigint NOT NULL DEFAULT 0,
+    free_storage_gb_month decimal NOT NULL DEFAULT 0,
+    discount_tiers        jsonb,
+    created_at            timestamptz,
+    updated_at            timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pricing_plans_name ON pricing_plans (name);
+
+CREATE TABLE IF NOT EXISTS tenant_plans (
+    tenant_id  varchar(255) PRIMARY KEY,
+    plan_id    uuid NOT NULL,
+    updated_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_tenant_plans_plan_id ON tenant_plans (plan_id);
+
+CREATE TABLE IF NOT EXISTS invoices (
+    id              uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id       varchar(255) NOT NULL,
+    period_start    timestamptz  NOT NULL,
+    period_end      timestamptz  NOT NULL,
+    plan_id         uuid NOT NULL,
+    plan_name       varchar(100) NOT NULL,
+    currency        varchar(3)   NOT NULL,
+    line_items      jsonb,
+    subtotal_micros bigint NOT NULL,
+    discount_micros bigint NOT NULL,
+    total_micros    bigint NOT NULL,
+    final           boolean NOT NULL DEFAULT false,
+    generated_at    timestamptz NOT NULL,
+    created_at      timestamptz,
+    updated_at      timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invoices_tenant_period ON invoices (tenant_id, period_start);
@@ -0,0 +1,2 @@
+ALTER TABLE model_versions DROP COLUMN IF EXISTS signature;
+ALTER TABLE models DROP COLUMN IF EXISTS signature;
@@ -0,0 +1,3 @@
+-- Typed input and output signatures; a model carries the signature of its current version
+ALTER TABLE models ADD COLUMN IF NOT EXISTS signature jsonb;
+ALTER TABLE model_versions ADD COLUMN IF NOT EXISTS signature jsonb;
@@ -0,0 +1 @@
+ALTER TABLE model_versions DROP COLUMN IF EXISTS card;
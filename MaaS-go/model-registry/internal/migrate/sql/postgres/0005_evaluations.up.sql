@@ -0,0 +1,28 @@
+-- Evaluation results recorded against model versions, and the rules that gate promotion on them
+CREATE TABLE IF NOT EXISTS model_evaluations (
+    id           uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    model_id     varchar(36) NOT NULL,
+    version      varchar(50) NOT NULL,
+    metric       varchar(100) NOT NULL,
+    value        double precision NOT NULL,
+    dataset      varchar(200) NOT NULL DEFAULT '',
+    split        varchar(50) NOT NULL DEFAULT '',
+    evaluated_at timestamptz NOT NULL,
+    recorded_by  varchar(255),
+    created_at   timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_model_evaluations_version ON model_evaluations (model_id, version);
+
+CREATE TABLE IF NOT EXISTS promotion_rules (
+    id             uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    model_id       varchar(36) NOT NULL,
+    metric         varchar(100) NOT NULL,
+    dataset        varchar(200) NOT NULL DEFAULT '',
+    split          varchar(50) NOT NULL DEFAULT '',
+    direction      varchar(10) NOT NULL,
+    max_regression double precision NOT NULL,
+    relative       boolean NOT NULL,
+    created_by     varchar(255),
+    created_at     timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_promotion_rules_model_id ON promotion_rules (model_id);
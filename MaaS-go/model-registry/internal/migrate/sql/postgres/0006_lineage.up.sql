@@ -0,0 +1,17 @@
+-- Lineage edges from models and versions to the models and datasets they came from
+CREATE TABLE IF NOT EXISTS lineage_edges (
+    id              uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    model_id        varchar(36) NOT NULL,
+    version         varchar(50) NOT NULL DEFAULT '',
+    relation        varchar(30) NOT NULL,
+    parent_model_id varchar(36) NOT NULL DEFAULT '',
+    parent_version  varchar(50) NOT NULL DEFAULT '',
+    dataset_name    varchar(200) NOT NULL DEFAULT '',
+    dataset_uri     varchar(1024) NOT NULL DEFAULT '',
+    created_by      varchar(255),
+    created_at      timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_lineage_edges_model_id ON lineage_edges (model_id);
+CREATE INDEX IF NOT EXISTS idx_lineage_edges_parent_model_id ON lineage_edges (parent_model_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_lineage_edges_unique
+    ON lineage_edges (model_id, version, relation, parent_model_id, parent_version, dataset_name, dataset_uri);
@@ -0,0 +1,43 @@
+-- Per-tenant approval policies and the requests and decisions they produce
+CREATE TABLE IF NOT EXISTS approval_policies (
+    id                 uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id          varchar(36) NOT NULL,
+    action             varchar(30) NOT NULL,
+    required_approvals integer NOT NULL,
+    approver_role      varchar(20) NOT NULL,
+    timeout_seconds    bigint NOT NULL,
+    created_by         varchar(255),
+    created_at         timestamptz,
+    updated_at         timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_approval_policies_tenant_action ON approval_policies (tenant_id, action);
+
+CREATE TABLE IF NOT EXISTS approval_requests (
+    id                 uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id          varchar(36) NOT NULL,
+    model_id           varchar(36) NOT NULL,
+    action             varchar(30) NOT NULL,
+    target_status      varchar(50) NOT NULL DEFAULT '',
+    target_version     varchar(50) NOT NULL DEFAULT '',
+    state              varchar(20) NOT NULL,
+    required_approvals integer NOT NULL,
+    approver_role      varchar(20) NOT NULL,
+    requested_by       varchar(255),
+    error              text,
+    expires_at         timestamptz NOT NULL,
+    resolved_at        timestamptz,
+    created_at         timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_approval_requests_tenant_id ON approval_requests (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_approval_requests_model_id ON approval_requests (model_id);
+CREATE INDEX IF NOT EXISTS idx_approval_requests_state ON approval_requests (state);
+
+CREATE TABLE IF NOT EXISTS approval_decisions (
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    request_id  varchar(36) NOT NULL,
+    approver_id varchar(255) NOT NULL,
+    approve     boolean NOT NULL,
+    comment     text,
+    created_at  timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_approval_decisions_request_approver ON approval_decisions (request_id, approver_id);
@@ -0,0 +1,6 @@
+-- Metered calls already folded into usage rollups, so redelivered records are ignored
+CREATE TABLE IF NOT EXISTS usage_events (
+    event_id    varchar(64) PRIMARY KEY,
+    recorded_at timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_usage_events_recorded_at ON usage_events (recorded_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_event_outbox_unpublished;
+ALTER TABLE event_outbox DROP COLUMN IF EXISTS dead_at;
+ALTER TABLE event_outbox DROP COLUMN IF EXISTS next_attempt_at;
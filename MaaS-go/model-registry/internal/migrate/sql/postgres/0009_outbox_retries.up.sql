@@ -0,0 +1,4 @@
+-- Backoff and dead-lettering for outbox events the broker keeps rejecting
+ALTER TABLE event_outbox ADD COLUMN IF NOT EXISTS next_attempt_at timestamptz;
+ALTER TABLE event_outbox ADD COLUMN IF NOT EXISTS dead_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_event_outbox_unpublished ON event_outbox (model_id, sequence) WHERE published_at IS NULL;
@@ -0,0 +1,4 @@
+-- Audit entries belong to the tenant of their target; the actor's tenant is kept apart
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS actor_tenant_id varchar(255);
+-- Entries recorded so far carry the actor's tenant
+UPDATE audit_log SET actor_tenant_id = tenant_id WHERE actor_id IS NOT NULL AND actor_id <> '';
@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS tenant_plans;
+DROP TABLE IF EXISTS pricing_plans;
+DROP TABLE IF EXISTS usage_rollups;
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
+DROP TABLE IF EXISTS event_outbox;
+DROP TABLE IF EXISTS audit_log;
+DROP TABLE IF EXISTS model_versions;
+DROP TABLE IF EXISTS model_metadata;
+DROP TABLE IF EXISTS model_tags;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS models;
+DROP TABLE IF EXISTS tenants;
+DROP TABLE IF EXISTS users;
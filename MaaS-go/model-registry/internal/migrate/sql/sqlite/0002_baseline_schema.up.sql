@@ -0,0 +1,266 @@
+-- Baseline schema; versions match the Postgres migrations, which start with
+-- 0001_create_extensions that SQLite does not need. The application generates
+-- all uuids, so id columns have no defaults.
+
+CREATE TABLE users (
+    id               text PRIMARY KEY,
+    username         text NOT NULL,
+    email            text NOT NULL,
+    password         text NOT NULL,
+    role             text DEFAULT 'developer',
+    status           text DEFAULT 'active',
+    tenant_id        text,
+    created_at       datetime,
+    updated_at       datetime,
+    deleted_at       datetime,
+    external_issuer  text,
+    external_subject text,
+    last_login_at    datetime
+);
+CREATE UNIQUE INDEX idx_users_username ON users (username);
+CREATE UNIQUE INDEX idx_users_email ON users (email);
+CREATE INDEX idx_users_tenant_id ON users (tenant_id);
+CREATE INDEX idx_users_deleted_at ON users (deleted_at);
+CREATE UNIQUE INDEX idx_users_external_identity ON users (external_issuer, external_subject);
+
+CREATE TABLE tenants (
+    id                 text PRIMARY KEY,
+    name               text NOT NULL,
+    description        text,
+    status             text DEFAULT 'active',
+    max_models         integer DEFAULT 10,
+    max_storage_gb     integer DEFAULT 100,
+    max_inference_qps  integer DEFAULT 100,
+    max_inference_conc integer DEFAULT 10,
+    created_at         datetime,
+    updated_at         datetime,
+    deleted_at         datetime
+);
+CREATE INDEX idx_tenants_deleted_at ON tenants (deleted_at);
+
+CREATE TABLE models (
+    id           text PRIMARY KEY,
+    name         text NOT NULL,
+    description  text,
+    version      text NOT NULL,
+    framework    text NOT NULL,
+    status       text DEFAULT 'pending',
+    size         integer DEFAULT 0,
+    checksum     text,
+    storage_path text,
+    docker_image text,
+    owner_id     text NOT NULL,
+    tenant_id    text NOT NULL,
+    is_public    boolean DEFAULT false,
+    created_at   datetime,
+    updated_at   datetime,
+    deleted_at   datetime
+);
+CREATE INDEX idx_models_name ON models (name);
+CREATE UNIQUE INDEX idx_models_name_version ON models (name, version);
+CREATE INDEX idx_models_owner_id ON models (owner_id);
+CREATE INDEX idx_models_tenant_id ON models (tenant_id);
+CREATE INDEX idx_models_deleted_at ON models (deleted_at);
+
+CREATE TABLE tags (
+    id         text PRIMARY KEY,
+    name       text NOT NULL,
+    created_at datetime
+);
+CREATE UNIQUE INDEX idx_tags_name ON tags (name);
+
+CREATE TABLE model_tags (
+    model_id text NOT NULL,
+    tag_id   text NOT NULL,
+    PRIMARY KEY (model_id, tag_id),
+    CONSTRAINT fk_model_tags_model FOREIGN KEY (model_id) REFERENCES models (id),
+    CONSTRAINT fk_model_tags_tag FOREIGN KEY (tag_id) REFERENCES tags (id)
+);
+
+CREATE TABLE model_metadata (
+    id         text PRIMARY KEY,
+    model_id   text NOT NULL,
+    key        text NOT NULL,
+    value      text,
+    created_at datetime,
+    updated_at datetime,
+    CONSTRAINT fk_models_metadata FOREIGN KEY (model_id) REFERENCES models (id)
+);
+CREATE INDEX idx_model_metadata_model_id ON model_metadata (model_id);
+
+CREATE TABLE model_versions (
+    id           text PRIMARY KEY,
+    model_id     text NOT NULL,
+    version      text NOT NULL,
+    status       text NOT NULL,
+    size         integer,
+    checksum     text,
+    storage_path text,
+    docker_image text,
+    change_log   text,
+    created_by   text NOT NULL,
+    created_at   datetime,
+    CONSTRAINT fk_models_versions FOREIGN KEY (model_id) REFERENCES models (id)
+);
+CREATE INDEX idx_model_versions_model_id ON model_versions (model_id);
+CREATE UNIQUE INDEX idx_model_versions_model_version ON model_versions (model_id, version);
+
+CREATE TABLE audit_log (
+    id          text PRIMARY KEY,
+    occurred_at datetime NOT NULL,
+    actor_id    text,
+    tenant_id   text,
+    request_id  text,
+    source      text,
+    action      text NOT NULL,
+    target_type text,
+    target_id   text,
+    before      text,
+    after       text,
+    diff        text
+);
+CREATE INDEX idx_audit_log_occurred_at ON audit_log (occurred_at);
+CREATE INDEX idx_audit_log_actor_id ON audit_log (actor_id);
+CREATE INDEX idx_audit_log_tenant_id ON audit_log (tenant_id);
+CREATE INDEX idx_audit_log_action ON audit_log (action);
+CREATE INDEX idx_audit_log_target_id ON audit_log (target_id);
+
+CREATE TABLE event_outbox (
+    sequence     integer PRIMARY KEY AUTOINCREMENT,
+    event_id     text NOT NULL,
+    type         text NOT NULL,
+    model_id     text NOT NULL,
+    tenant_id    text,
+    payload      text NOT NULL,
+    occurred_at  datetime NOT NULL,
+    published_at datetime,
+    attempts     integer DEFAULT 0,
+    last_error   text
+);
+CREATE UNIQUE INDEX idx_event_outbox_event_id ON event_outbox (event_id);
+CREATE INDEX idx_event_outbox_model_id ON event_outbox (model_id);
+CREATE INDEX idx_event_outbox_tenant_id ON event_outbox (tenant_id);
+CREATE INDEX idx_event_outbox_published_at ON event_outbox (published_at);
+
+CREATE TABLE webhook_subscriptions (
+    id          text PRIMARY KEY,
+    tenant_id   text NOT NULL,
+    created_by  text,
+    url         text NOT NULL,
+    secret      text NOT NULL,
+    description text,
+    event_types text,
+    model_id    text,
+    active      boolean DEFAULT true,
+    created_at  datetime,
+    updated_at  datetime
+);
+CREATE INDEX idx_webhook_subscriptions_tenant_id ON webhook_subscriptions (tenant_id);
+CREATE INDEX idx_webhook_subscriptions_model_id ON webhook_subscriptions (model_id);
+
+CREATE TABLE webhook_deliveries (
+    id              text PRIMARY KEY,
+    subscription_id text NOT NULL,
+    tenant_id       text NOT NULL,
+    event_id        text NOT NULL,
+    event_type      text NOT NULL,
+    model_id        text,
+    payload         text NOT NULL,
+    status          text NOT NULL,
+    attempts        integer DEFAULT 0,
+    next_attempt_at datetime,
+    last_attempt_at datetime,
+    response_code   integer,
+    last_error      text,
+    redelivery_of   text,
+    delivered_at    datetime,
+    created_at      datetime
+);
+CREATE INDEX idx_webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id);
+CREATE INDEX idx_webhook_deliveries_tenant_id ON webhook_deliveries (tenant_id);
+CREATE INDEX idx_webhook_deliveries_event_id ON webhook_deliveries (event_id);
+CREATE INDEX idx_webhook_deliveries_model_id ON webhook_deliveries (model_id);
+CREATE INDEX idx_webhook_deliveries_status ON webhook_deliveries (status);
+CREATE INDEX idx_webhook_deliveries_next_attempt_at ON webhook_deliveries (next_attempt_at);
+
+CREATE TABLE api_keys (
+    id           text PRIMARY KEY,
+    tenant_id    text NOT NULL,
+    user_id      text NOT NULL,
+    role         text NOT NULL,
+    name         text NOT NULL,
+    prefix       text NOT NULL,
+    hash         text NOT NULL,
+    scopes       text,
+    expires_at   datetime,
+    last_used_at datetime,
+    revoked_at   datetime,
+    created_at   datetime,
+    updated_at   datetime
+);
+CREATE INDEX idx_api_keys_tenant_id ON api_keys (tenant_id);
+CREATE INDEX idx_api_keys_user_id ON api_keys (user_id);
+CREATE UNIQUE INDEX idx_api_keys_hash ON api_keys (hash);
+CREATE INDEX idx_api_keys_revoked_at ON api_keys (revoked_at);
+
+CREATE TABLE usage_rollups (
+    id                integer PRIMARY KEY AUTOINCREMENT,
+    hour_start        datetime NOT NULL,
+    tenant_id         text NOT NULL,
+    model_id          text NOT NULL,
+    version           text NOT NULL,
+    status            text NOT NULL,
+    requests          integer NOT NULL DEFAULT 0,
+    total_latency_ms  integer NOT NULL DEFAULT 0,
+    max_latency_ms    integer NOT NULL DEFAULT 0,
+    request_bytes     integer NOT NULL DEFAULT 0,
+    response_bytes    integer NOT NULL DEFAULT 0,
+    prompt_tokens     integer NOT NULL DEFAULT 0,
+    completion_tokens integer NOT NULL DEFAULT 0,
+    updated_at        datetime
+);
+CREATE UNIQUE INDEX idx_usage_rollup_key ON usage_rollups (hour_start, tenant_id, model_id, version, status);
+CREATE INDEX idx_usage_rollups_tenant_id ON usage_rollups (tenant_id);
+
+CREATE TABLE pricing_plans (
+    id                    text PRIMARY KEY,
+    name                  text NOT NULL,
+    description           text,
+    currency              text NOT NULL DEFAULT 'USD',
+    request_price_micros  integer NOT NULL DEFAULT 0,
+    token_price_micros    integer NOT NULL DEFAULT 0,
+    storage_price_micros  integer NOT NULL DEFAULT 0,
+    free_requests         integer NOT NULL DEFAULT 0,
+    free_tokens           integer NOT NULL DEFAULT 0,
+    free_storage_gb_month real NOT NULL DEFAULT 0,
+    discount_tiers        text,
+    created_at            datetime,
+    updated_at            datetime
+);
+CREATE UNIQUE INDEX idx_pricing_plans_name ON pricing_plans (name);
+
+CREATE TABLE tenant_plans (
+    tenant_id  text PRIMARY KEY,
+    plan_id    text NOT NULL,
+    updated_at datetime
+);
+CREATE INDEX idx_tenant_plans_plan_id ON tenant_plans (plan_id);
+
+CREATE TABLE invoices (
+    id              text PRIMARY KEY,
+    tenant_id       text NOT NULL,
+    period_start    datetime NOT NULL,
+    period_end      datetime NOT NULL,
+    plan_id         text NOT NULL,
+    plan_name       text NOT NULL,
+    currency        text NOT NULL,
+    line_items      text,
+    subtotal_micros integer NOT NULL,
+    discount_micros integer NOT NULL,
+    total_micros    integer NOT NULL,
+    final           boolean NOT NULL DEFAULT false,
+    generated_at    datetime NOT NULL,
+    created_at      datetime,
+    updated_at      datetime
+);
+CREATE UNIQUE INDEX idx_invoices_tenant_period ON invoices (tenant_id, period_start);
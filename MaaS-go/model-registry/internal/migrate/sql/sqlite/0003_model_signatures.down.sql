@@ -0,0 +1,2 @@
+ALTER TABLE model_versions DROP COLUMN signature;
+ALTER TABLE models DROP COLUMN signature;
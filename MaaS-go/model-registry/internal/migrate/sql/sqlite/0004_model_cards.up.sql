@@ -0,0 +1,2 @@
+-- Structured model cards documenting each version
+ALTER TABLE model_versions ADD COLUMN card text;
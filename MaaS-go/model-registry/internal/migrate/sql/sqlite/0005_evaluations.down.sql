@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS promotion_rules;
+DROP TABLE IF EXISTS model_evaluations;
@@ -0,0 +1,28 @@
+-- Evaluation results recorded against model versions, and the rules that gate promotion on them
+CREATE TABLE model_evaluations (
+    id           text PRIMARY KEY,
+    model_id     text NOT NULL,
+    version      text NOT NULL,
+    metric       text NOT NULL,
+    value        real NOT NULL,
+    dataset      text NOT NULL DEFAULT '',
+    split        text NOT NULL DEFAULT '',
+    evaluated_at datetime NOT NULL,
+    recorded_by  text,
+    created_at   datetime
+);
+CREATE INDEX idx_model_evaluations_version ON model_evaluations (model_id, version);
+
+CREATE TABLE promotion_rules (
+    id             text PRIMARY KEY,
+    model_id       text NOT NULL,
+    metric         text NOT NULL,
+    dataset        text NOT NULL DEFAULT '',
+    split          text NOT NULL DEFAULT '',
+    direction      text NOT NULL,
+    max_regression real NOT NULL,
+    relative       boolean NOT NULL,
+    created_by     text,
+    created_at     datetime
+);
+CREATE INDEX idx_promotion_rules_model_id ON promotion_rules (model_id);
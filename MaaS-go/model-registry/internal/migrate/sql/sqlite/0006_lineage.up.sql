@@ -0,0 +1,17 @@
+-- Lineage edges from models and versions to the models and datasets they came from
+CREATE TABLE lineage_edges (
+    id              text PRIMARY KEY,
+    model_id        text NOT NULL,
+    version         text NOT NULL DEFAULT '',
+    relation        text NOT NULL,
+    parent_model_id text NOT NULL DEFAULT '',
+    parent_version  text NOT NULL DEFAULT '',
+    dataset_name    text NOT NULL DEFAULT '',
+    dataset_uri     text NOT NULL DEFAULT '',
+    created_by      text,
+    created_at      datetime
+);
+CREATE INDEX idx_lineage_edges_model_id ON lineage_edges (model_id);
+CREATE INDEX idx_lineage_edges_parent_model_id ON lineage_edges (parent_model_id);
+CREATE UNIQUE INDEX idx_lineage_edges_unique
+    ON lineage_edges (model_id, version, relation, parent_model_id, parent_version, dataset_name, dataset_uri);
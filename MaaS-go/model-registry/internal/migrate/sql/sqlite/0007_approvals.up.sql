@@ -0,0 +1,43 @@
+-- Per-tenant approval policies and the requests and decisions they produce
+CREATE TABLE approval_policies (
+    id                 text PRIMARY KEY,
+    tenant_id          text NOT NULL,
+    action             text NOT NULL,
+    required_approvals integer NOT NULL,
+    approver_role      text NOT NULL,
+    timeout_seconds    integer NOT NULL,
+    created_by         text,
+    created_at         datetime,
+    updated_at         datetime
+);
+CREATE UNIQUE INDEX idx_approval_policies_tenant_action ON approval_policies (tenant_id, action);
+
+CREATE TABLE approval_requests (
+    id                 text PRIMARY KEY,
+    tenant_id          text NOT NULL,
+    model_id           text NOT NULL,
+    action             text NOT NULL,
+    target_status      text NOT NULL DEFAULT '',
+    target_version     text NOT NULL DEFAULT '',
+    state              text NOT NULL,
+    required_approvals integer NOT NULL,
+    approver_role      text NOT NULL,
+    requested_by       text,
+    error              text,
+    expires_at         datetime NOT NULL,
+    resolved_at        datetime,
+    created_at         datetime
+);
+CREATE INDEX idx_approval_requests_tenant_id ON approval_requests (tenant_id);
+CREATE INDEX idx_approval_requests_model_id ON approval_requests (model_id);
+CREATE INDEX idx_approval_requests_state ON approval_requests (state);
+
+CREATE TABLE approval_decisions (
+    id          text PRIMARY KEY,
+    request_id  text NOT NULL,
+    approver_id text NOT NULL,
+    approve     boolean NOT NULL,
+    comment     text,
+    created_at  datetime
+);
+CREATE UNIQUE INDEX idx_approval_decisions_request_approver ON approval_decisions (request_id, approver_id);
@@ -0,0 +1,6 @@
+-- Metered calls already folded into usage rollups, so redelivered records are ignored
+CREATE TABLE usage_events (
+    event_id    text PRIMARY KEY,
+    recorded_at datetime NOT NULL
+);
+CREATE INDEX idx_usage_events_recorded_at ON usage_events (recorded_at);
@@ -0,0 +1,4 @@
+-- Backoff and dead-lettering for outbox events the broker keeps rejecting
+ALTER TABLE event_outbox ADD COLUMN next_attempt_at datetime;
+ALTER TABLE event_outbox ADD COLUMN dead_at datetime;
+CREATE INDEX idx_event_outbox_unpublished ON event_outbox (model_id, sequence) WHERE published_at IS NULL;
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIKeyPrefix starts every API key secret
+const APIKeyPrefix = "maas_"
+
+// APIKeyScope limits what an API key may be used for
+type APIKeyScope string
+
+const (
+	ScopeModelsRead      APIKeyScope = "models:read"
+	ScopeModelsWrite     APIKeyScope = "models:write"
+	ScopeInferenceInvoke APIKeyScope = "inference:invoke"
+)
+
+// APIKey is a tenant-scoped credential for machine clients; only its hash is stored
+type APIKey struct {
+	ID       string `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	TenantID string `gorm:"type:varchar(255);not null;index" json:"tenant_id"`
+	// UserID is the user the key acts as
+	UserID string   `gorm:"type:varchar(255);not null;index" json:"user_id"`
+	Role   UserRole `gorm:"type:varchar(20);not null" json:"role"`
+	Name   string   `gorm:"type:varchar(100);not null" json:"name"`
+	// Prefix is the visible start of the secret that identifies the key in listings
+	Prefix     string        `gorm:"type:varchar(20);not null" json:"prefix"`
+	Hash       string        `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	Scopes     []APIKeyScope `gorm:"serializer:json;type:jsonb" json:"scopes"`
+	ExpiresAt  *time.Time    `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time    `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time    `gorm:"index" json:"revoked_at,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// BeforeCreate hook to generate UUID
+func (k *APIKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// Active reports whether the key is neither revoked nor expired
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// HasScope reports whether the key grants a scope
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ApprovalAction is a change a tenant may require approval for
+type ApprovalAction string
+
+const (
+	// ApprovalPromote covers moving a model to running and promoting a
+	// version of a running model
+	ApprovalPromote ApprovalAction = "promote"
+	// ApprovalPublish covers making a model public
+	ApprovalPublish ApprovalAction = "publish"
+)
+
+// ApprovalState is the lifecycle state of an approval request
+type ApprovalState string
+
+const (
+	ApprovalPending  ApprovalState = "pending"
+	ApprovalApproved ApprovalState = "approved"
+	ApprovalRejected ApprovalState = "rejected"
+	ApprovalExpired  ApprovalState = "expired"
+	// ApprovalFailed means the request was approved but applying the change failed
+	ApprovalFailed ApprovalState = "failed"
+)
+
+// ApprovalPolicy makes an action of a tenant wait for approval
+type ApprovalPolicy struct {
+	ID       string         `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	TenantID string         `gorm:"type:varchar(36);not null;uniqueIndex:idx_approval_policies_tenant_action" json:"tenant_id"`
+	Action   ApprovalAction `gorm:"type:varchar(30);not null;uniqueIndex:idx_approval_policies_tenant_action" json:"action"`
+	// RequiredApprovals distinct users holding ApproverRole must approve
+	RequiredApprovals int      `gorm:"not null" json:"required_approvals"`
+	ApproverRole      UserRole `gorm:"type:varchar(20);not null" json:"approver_role"`
+	// TimeoutSeconds is how long a request stays open before it expires
+	TimeoutSeconds int64     `gorm:"not null" json:"timeout_seconds"`
+	CreatedBy      string    `gorm:"type:varchar(255)" json:"created_by"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (ApprovalPolicy) TableName() string {
+	return "approval_policies"
+}
+
+// BeforeCreate hook to generate UUID
+func (p *ApprovalPolicy) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == "" {
+		p.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// ApprovalRequest is a change held back until enough approvers agree to it.
+// The policy in force when it was made is copied onto it.
+type ApprovalRequest struct {
+	ID       string         `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	TenantID string         `gorm:"type:varchar(36);not null;index" json:"tenant_id"`
+	ModelID  string         `gorm:"type:varchar(36);not null;index" json:"model_id"`
+	Action   ApprovalAction `gorm:"type:varchar(30);not null" json:"action"`
+	// TargetStatus or TargetVersion say what a promote request changes
+	TargetStatus      ModelStatus   `gorm:"type:varchar(50);not null;default:''" json:"target_status,omitempty"`
+	TargetVersion     string        `gorm:"type:varchar(50);not null;default:''" json:"target_version,omitempty"`
+	State             ApprovalState `gorm:"type:varchar(20);not null;index" json:"state"`
+	RequiredApprovals int           `gorm:"not null" json:"required_approvals"`
+	ApproverRole      UserRole      `gorm:"type:varchar(20);not null" json:"approver_role"`
+	RequestedBy       string        `gorm:"type:varchar(255)" json:"requested_by"`
+	// Error explains why applying an approved change failed
+	Error      string     `gorm:"type:text" json:"error,omitempty"`
+	ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+
+	Decisions []ApprovalDecision `gorm:"foreignKey:RequestID" json:"decisions"`
+}
+
+// TableName specifies the table name
+func (ApprovalRequest) TableName() string {
+	return "approval_requests"
+}
+
+// BeforeCreate hook to generate UUID
+func (r *ApprovalRequest) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// Approvals counts the decisions that approve the request
+func (r *ApprovalRequest) Approvals() int {
+	n := 0
+	for _, d := range r.Decisions {
+		if d.Approve {
+			n++
+		}
+	}
+	return n
+}
+
+// ApprovalDecision is one approver's verdict on a request
+type ApprovalDecision struct {
+	ID         string    `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	RequestID  string    `gorm:"type:varchar(36);not null;uniqueIndex:idx_approval_decisions_request_approver" json:"request_id"`
+	ApproverID string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_approval_decisions_request_approver" json:"approver_id"`
+	Approve    bool      `gorm:"not null" json:"approve"`
+	Comment    string    `gorm:"type:text" json:"comment,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name
+func (ApprovalDecision) TableName() string {
+	return "approval_decisions"
+}
+
+// BeforeCreate hook to generate UUID
+func (d *ApprovalDecision) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = uuid.New().String()
+	}
+	return nil
+}
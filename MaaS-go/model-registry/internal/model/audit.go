@@ -0,0 +1,79 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AuditAction identifies the kind of change an audit entry records
+type AuditAction string
+
+const (
+	AuditModelCreate     AuditAction = "model.create"
+	AuditModelUpdate     AuditAction = "model.update"
+	AuditModelDelete     AuditAction = "model.delete"
+	AuditModelRestore    AuditAction = "model.restore"
+	AuditModelPurge      AuditAction = "model.purge"
+	AuditModelStatus     AuditAction = "model.status"
+	AuditModelTagsAdd    AuditAction = "model.tags.add"
+	AuditModelTagsRemove AuditAction = "model.tags.remove"
+	AuditModelMetadata   AuditAction = "model.metadata"
+	AuditVersionCreate   AuditAction = "version.create"
+	AuditVersionPromote  AuditAction = "version.promote"
+	AuditVersionCard     AuditAction = "version.card"
+	AuditVersionEvaluate AuditAction = "version.evaluate"
+	AuditPromotionRules  AuditAction = "model.promotion_rules"
+	AuditLineageAdd      AuditAction = "lineage.add"
+	AuditLineageRemove   AuditAction = "lineage.remove"
+	AuditAliasSet        AuditAction = "alias.set"
+	AuditApprovalRequest AuditAction = "approval.request"
+	AuditApprovalApprove AuditAction = "approval.approve"
+	AuditApprovalReject  AuditAction = "approval.reject"
+	AuditApprovalExpire  AuditAction = "approval.expire"
+	AuditApprovalFail    AuditAction = "approval.fail"
+	AuditApprovalPolicy  AuditAction = "approval.policy"
+	AuditAuthLogin       AuditAction = "auth.login"
+	AuditAuthRegister    AuditAction = "auth.register"
+	AuditAPIKeyCreate    AuditAction = "apikey.create"
+	AuditAPIKeyRotate    AuditAction = "apikey.rotate"
+	AuditAPIKeyRevoke    AuditAction = "apikey.revoke"
+	AuditUserProvision   AuditAction = "user.provision"
+	AuditUserSync        AuditAction = "user.sync"
+	AuditPlanCreate      AuditAction = "plan.create"
+	AuditPlanUpdate      AuditAction = "plan.update"
+	AuditTenantPlan      AuditAction = "tenant.plan"
+	AuditInvoiceGenerate AuditAction = "invoice.generate"
+)
+
+// AuditEntry is an append-only record of a mutation
+type AuditEntry struct {
+	ID            string          `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	OccurredAt    time.Time       `gorm:"not null;index" json:"occurred_at"`
+	ActorID       string          `gorm:"type:varchar(255);index" json:"actor_id"`
+	TenantID      string          `gorm:"type:varchar(255);index" json:"tenant_id"`           // the target's tenant
+	ActorTenantID string          `gorm:"type:varchar(255)" json:"actor_tenant_id,omitempty"` // the actor's tenant
+	RequestID     string          `gorm:"type:varchar(64)" json:"request_id"`
+	Source        string          `gorm:"type:varchar(50)" json:"source"`
+	Action        AuditAction     `gorm:"type:varchar(50);not null;index" json:"action"`
+	TargetType    string          `gorm:"type:varchar(50)" json:"target_type"`
+	TargetID      string          `gorm:"type:varchar(255);index" json:"target_id"`
+	Before        json.RawMessage `gorm:"type:jsonb" json:"before,omitempty"`
+	After         json.RawMessage `gorm:"type:jsonb" json:"after,omitempty"`
+	Diff          json.RawMessage `gorm:"type:jsonb" json:"diff,omitempty"`
+}
+
+// TableName specifies the table name
+func (AuditEntry) TableName() string {
+	return "audit_log"
+}
+
+// BeforeCreate hook to generate UUID
+func (e *AuditEntry) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Invoice line item kinds
+const (
+	LineItemRequests = "requests"
+	LineItemTokens   = "tokens"
+	LineItemStorage  = "storage"
+	LineItemDiscount = "discount"
+)
+
+// PricingPlan prices metered usage for the tenants it is attached to. Prices
+// and amounts in billing are millionths of the currency unit (micros) so that
+// sums never drift with floating point rounding.
+type PricingPlan struct {
+	ID          string `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Name        string `gorm:"type:varchar(100);not null;uniqueIndex" json:"name"`
+	Description string `gorm:"type:text" json:"description"`
+	Currency    string `gorm:"type:varchar(3);not null;default:'USD'" json:"currency"`
+	// RequestPrice is per request, TokenPrice per 1,000 tokens and StoragePrice per GB-month
+	RequestPriceMicros int64 `gorm:"not null;default:0" json:"request_price_micros"`
+	TokenPriceMicros   int64 `gorm:"not null;default:0" json:"token_price_micros"`
+	StoragePriceMicros int64 `gorm:"not null;default:0" json:"storage_price_micros"`
+	// Free allowances are deducted from each month's usage before pricing
+	FreeRequests       int64   `gorm:"not null;default:0" json:"free_requests"`
+	FreeTokens         int64   `gorm:"not null;default:0" json:"free_tokens"`
+	FreeStorageGBMonth float64 `gorm:"not null;default:0" json:"free_storage_gb_month"`
+	// DiscountTiers discount the part of the subtotal above each threshold
+	DiscountTiers []DiscountTier `gorm:"serializer:json;type:jsonb" json:"discount_tiers"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// DiscountTier discounts the part of an invoice subtotal from a threshold up to the next tier
+type DiscountTier struct {
+	FromMicros int64   `json:"from_micros"`
+	Percent    float64 `json:"percent"`
+}
+
+// TableName specifies the table name
+func (PricingPlan) TableName() string {
+	return "pricing_plans"
+}
+
+// BeforeCreate hook to generate UUID
+func (p *PricingPlan) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == "" {
+		p.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TenantPlan attaches a pricing plan to a tenant
+type TenantPlan struct {
+	TenantID  string    `gorm:"type:varchar(255);primaryKey" json:"tenant_id"`
+	PlanID    string    `gorm:"type:uuid;not null;index" json:"plan_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (TenantPlan) TableName() string {
+	return "tenant_plans"
+}
+
+// Invoice charges a tenant for one calendar month; regenerating it replaces
+// its contents in place, so there is exactly one invoice per tenant and month
+type Invoice struct {
+	ID          string            `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	TenantID    string            `gorm:"type:varchar(255);not null;uniqueIndex:idx_invoices_tenant_period" json:"tenant_id"`
+	PeriodStart time.Time         `gorm:"not null;uniqueIndex:idx_invoices_tenant_period" json:"period_start"`
+	PeriodEnd   time.Time         `gorm:"not null" json:"period_end"`
+	PlanID      string            `gorm:"type:uuid;not null" json:"plan_id"`
+	PlanName    string            `gorm:"type:varchar(100);not null" json:"plan_name"`
+	Currency    string            `gorm:"type:varchar(3);not null" json:"currency"`
+	LineItems   []InvoiceLineItem `gorm:"serializer:json;type:jsonb" json:"line_items"`
+	// SubtotalMicros is before discounts; DiscountMicros is positive
+	SubtotalMicros int64 `gorm:"not null" json:"subtotal_micros"`
+	DiscountMicros int64 `gorm:"not null" json:"discount_micros"`
+	TotalMicros    int64 `gorm:"not null" json:"total_micros"`
+	// Final is set once the invoice was generated after its period ended
+	Final       bool      `gorm:"not null;default:false" json:"final"`
+	GeneratedAt time.Time `gorm:"not null" json:"generated_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// InvoiceLineItem is a single charge or discount on an invoice
+type InvoiceLineItem struct {
+	Kind            string  `json:"kind"`
+	Description     string  `json:"description"`
+	ModelID         string  `json:"model_id,omitempty"`
+	Quantity        float64 `json:"quantity"`
+	Unit            string  `json:"unit"`
+	UnitPriceMicros int64   `json:"unit_price_micros"`
+	AmountMicros    int64   `json:"amount_micros"`
+}
+
+// TableName specifies the table name
+func (Invoice) TableName() string {
+	return "invoices"
+}
+
+// BeforeCreate hook to generate UUID
+func (i *Invoice) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == "" {
+		i.ID = uuid.New().String()
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Evaluation is one evaluation result recorded against a model version
+type Evaluation struct {
+	ID      string  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ModelID string  `gorm:"type:varchar(36);not null;index:idx_model_evaluations_version" json:"model_id"`
+	Version string  `gorm:"type:varchar(50);not null;index:idx_model_evaluations_version" json:"version"`
+	Metric  string  `gorm:"type:varchar(100);not null" json:"metric"`
+	Value   float64 `gorm:"not null" json:"value"`
+	// Dataset and Split name what was evaluated; either may be empty
+	Dataset     string    `gorm:"type:varchar(200);not null;default:''" json:"dataset"`
+	Split       string    `gorm:"type:varchar(50);not null;default:''" json:"split"`
+	EvaluatedAt time.Time `gorm:"not null" json:"evaluated_at"`
+	RecordedBy  string    `gorm:"type:varchar(255)" json:"recorded_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name
+func (Evaluation) TableName() string {
+	return "model_evaluations"
+}
+
+// BeforeCreate hook to generate UUID
+func (e *Evaluation) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// RuleDirection says which way a metric improves
+type RuleDirection string
+
+const (
+	HigherIsBetter RuleDirection = "higher"
+	LowerIsBetter  RuleDirection = "lower"
+)
+
+// PromotionRule blocks promoting a version whose metric regresses too far
+// against the model's current version
+type PromotionRule struct {
+	ID        string        `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ModelID   string        `gorm:"type:varchar(36);not null;index" json:"model_id"`
+	Metric    string        `gorm:"type:varchar(100);not null" json:"metric"`
+	Dataset   string        `gorm:"type:varchar(200);not null;default:''" json:"dataset"`
+	Split     string        `gorm:"type:varchar(50);not null;default:''" json:"split"`
+	Direction RuleDirection `gorm:"type:varchar(10);not null" json:"direction"`
+	// MaxRegression is how far the metric may worsen: a fraction of the current
+	// value when Relative is set, otherwise an amount in the metric's units
+	MaxRegression float64   `gorm:"not null" json:"max_regression"`
+	Relative      bool      `gorm:"not null" json:"relative"`
+	CreatedBy     string    `gorm:"type:varchar(255)" json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name
+func (PromotionRule) TableName() string {
+	return "promotion_rules"
+}
+
+// BeforeCreate hook to generate UUID
+func (r *PromotionRule) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// LineageRelation says how a model came from its parent
+type LineageRelation string
+
+const (
+	RelationDerivedFrom      LineageRelation = "derived_from"
+	RelationFineTunedFrom    LineageRelation = "fine_tuned_from"
+	RelationQuantizedFrom    LineageRelation = "quantized_from"
+	RelationTrainedOnDataset LineageRelation = "trained_on_dataset"
+)
+
+// LineageEdge links a model, or one of its versions, to the model or external
+// dataset it came from. Dataset edges set DatasetName and leave ParentModelID
+// empty; model edges do the opposite. An empty version means the whole model.
+type LineageEdge struct {
+	ID            string          `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ModelID       string          `gorm:"type:varchar(36);not null;index;uniqueIndex:idx_lineage_edges_unique" json:"model_id"`
+	Version       string          `gorm:"type:varchar(50);not null;default:'';uniqueIndex:idx_lineage_edges_unique" json:"version,omitempty"`
+	Relation      LineageRelation `gorm:"type:varchar(30);not null;uniqueIndex:idx_lineage_edges_unique" json:"relation"`
+	ParentModelID string          `gorm:"type:varchar(36);not null;default:'';index;uniqueIndex:idx_lineage_edges_unique" json:"parent_model_id,omitempty"`
+	ParentVersion string          `gorm:"type:varchar(50);not null;default:'';uniqueIndex:idx_lineage_edges_unique" json:"parent_version,omitempty"`
+	DatasetName   string          `gorm:"type:varchar(200);not null;default:'';uniqueIndex:idx_lineage_edges_unique" json:"dataset_name,omitempty"`
+	DatasetURI    string          `gorm:"type:varchar(1024);not null;default:'';uniqueIndex:idx_lineage_edges_unique" json:"dataset_uri,omitempty"`
+	CreatedBy     string          `gorm:"type:varchar(255)" json:"created_by"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// TableName specifies the table name
+func (LineageEdge) TableName() string {
+	return "lineage_edges"
+}
+
+// BeforeCreate hook to generate UUID
+func (e *LineageEdge) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	return nil
+}
@@ -5,6 +5,9 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"maas-platform/shared/modelcard"
+	"maas-platform/shared/signature"
 )
 
 // ModelStatus represents model status
@@ -35,15 +38,17 @@ const (
 // Model represents a machine learning model
 type Model struct {
 	ID          string         `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	Name        string         `gorm:"type:varchar(255);not null;index" json:"name"`
+	Name        string         `gorm:"type:varchar(255);not null;index;uniqueIndex:idx_models_name_version" json:"name"`
 	Description string         `gorm:"type:text" json:"description"`
-	Version     string         `gorm:"type:varchar(50);not null" json:"version"`
+	Version     string         `gorm:"type:varchar(50);not null;uniqueIndex:idx_models_name_version" json:"version"`
 	Framework   ModelFramework `gorm:"type:varchar(50);not null" json:"framework"`
 	Status      ModelStatus    `gorm:"type:varchar(50);default:'pending'" json:"status"`
 	Size        int64          `gorm:"default:0" json:"size"`
 	Checksum    string         `gorm:"type:varchar(64)" json:"checksum"`
 	StoragePath string         `gorm:"type:varchar(512)" json:"storage_path"`
 	DockerImage string         `gorm:"type:varchar(255)" json:"docker_image"`
+	// Signature is the typed interface of the current version
+	Signature *signature.Signature `gorm:"type:jsonb" json:"signature,omitempty"`
 
 	// Relationships
 	Tags     []Tag          `gorm:"many2many:model_tags;" json:"tags"`
@@ -60,7 +65,7 @@ type Model struct {
 	// Timestamps
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
 }
 
 // TableName specifies the table name
@@ -122,8 +127,8 @@ func (Metadata) TableName() string {
 // ModelVersion represents a specific version of a model
 type ModelVersion struct {
 	ID          string      `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	ModelID     string      `gorm:"type:uuid;not null;index" json:"model_id"`
-	Version     string      `gorm:"type:varchar(50);not null" json:"version"`
+	ModelID     string      `gorm:"type:uuid;not null;index;uniqueIndex:idx_model_versions_model_version" json:"model_id"`
+	Version     string      `gorm:"type:varchar(50);not null;uniqueIndex:idx_model_versions_model_version" json:"version"`
 	Status      ModelStatus `gorm:"type:varchar(50);not null" json:"status"`
 	Size        int64       `json:"size"`
 	Checksum    string      `gorm:"type:varchar(64)" json:"checksum"`
@@ -132,6 +137,9 @@ type ModelVersion struct {
 	ChangeLog   string      `gorm:"type:text" json:"change_log"`
 	CreatedBy   string      `gorm:"type:uuid;not null" json:"created_by"`
 	CreatedAt   time.Time   `json:"created_at"`
+
+	Signature *signature.Signature `gorm:"type:jsonb" json:"signature,omitempty"`
+	Card      *modelcard.Card      `gorm:"type:jsonb" json:"card,omitempty"`
 }
 
 // BeforeCreate hook for ModelVersion
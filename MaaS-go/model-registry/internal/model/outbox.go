@@ -0,0 +1,50 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventType identifies a domain event
+type EventType string
+
+const (
+	EventModelCreated       EventType = "model.created"
+	EventModelUpdated       EventType = "model.updated"
+	EventModelStatusChanged EventType = "model.status_changed"
+	EventModelDeleted       EventType = "model.deleted"
+	EventModelRestored      EventType = "model.restored"
+	EventVersionPromoted    EventType = "version.promoted"
+)
+
+// OutboxEvent is a domain event waiting to be relayed to the message broker.
+// It is written in the same transaction as the change it describes.
+type OutboxEvent struct {
+	// Sequence orders events; it is strictly increasing per model
+	Sequence    int64           `gorm:"primaryKey;autoIncrement" json:"sequence"`
+	EventID     string          `gorm:"type:uuid;not null;uniqueIndex" json:"event_id"`
+	Type        EventType       `gorm:"type:varchar(50);not null" json:"type"`
+	ModelID     string          `gorm:"type:uuid;not null;index" json:"model_id"`
+	TenantID    string          `gorm:"type:varchar(255);index" json:"tenant_id"`
+	Payload     json.RawMessage `gorm:"type:jsonb;not null" json:"payload"`
+	OccurredAt  time.Time       `gorm:"not null" json:"occurred_at"`
+	PublishedAt *time.Time      `gorm:"index" json:"published_at,omitempty"`
+	Attempts    int             `gorm:"default:0" json:"attempts"`
+	LastError   string          `gorm:"type:text" json:"last_error,omitempty"`
+	// NextAttemptAt holds the event back while a relay publishes it or after a failure
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// DeadAt is set when the relay gave up on the event
+	DeadAt *time.Time `json:"dead_at,omitempty"`
+}
+
+// TableName specifies the table name
+func (OutboxEvent) TableName() string {
+	return "event_outbox"
+}
+
+// ModelEventPayload is the body of model and version events
+type ModelEventPayload struct {
+	Model          *Model        `json:"model"`
+	Version        *ModelVersion `json:"version,omitempty"`
+	PreviousStatus ModelStatus   `json:"previous_status,omitempty"`
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+)
+
+// UsageStatus classifies the outcome of a metered call
+type UsageStatus string
+
+const (
+	UsageSuccess     UsageStatus = "success"
+	UsageClientError UsageStatus = "client_error"
+	UsageServerError UsageStatus = "server_error"
+)
+
+// UsageRollup aggregates the metered calls of one hour; rows are unique per
+// hour, tenant, model, version and status and grow as records arrive
+type UsageRollup struct {
+	ID               uint        `gorm:"primaryKey" json:"-"`
+	HourStart        time.Time   `gorm:"not null;uniqueIndex:idx_usage_rollup_key,priority:1" json:"hour_start"`
+	TenantID         string      `gorm:"type:varchar(255);not null;uniqueIndex:idx_usage_rollup_key,priority:2;index" json:"tenant_id"`
+	ModelID          string      `gorm:"type:varchar(255);not null;uniqueIndex:idx_usage_rollup_key,priority:3" json:"model_id"`
+	Version          string      `gorm:"type:varchar(50);not null;uniqueIndex:idx_usage_rollup_key,priority:4" json:"version"`
+	Status           UsageStatus `gorm:"type:varchar(20);not null;uniqueIndex:idx_usage_rollup_key,priority:5" json:"status"`
+	Requests         int64       `gorm:"not null;default:0" json:"requests"`
+	TotalLatencyMs   int64       `gorm:"not null;default:0" json:"total_latency_ms"`
+	MaxLatencyMs     int64       `gorm:"not null;default:0" json:"max_latency_ms"`
+	RequestBytes     int64       `gorm:"not null;default:0" json:"request_bytes"`
+	ResponseBytes    int64       `gorm:"not null;default:0" json:"response_bytes"`
+	PromptTokens     int64       `gorm:"not null;default:0" json:"prompt_tokens"`
+	CompletionTokens int64       `gorm:"not null;default:0" json:"completion_tokens"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (UsageRollup) TableName() string {
+	return "usage_rollups"
+}
+
+// UsageEvent marks a metered call as folded into the rollups, so records
+// redelivered after a lost acknowledgement are not counted twice
+type UsageEvent struct {
+	EventID    string    `gorm:"type:varchar(64);primaryKey" json:"event_id"`
+	RecordedAt time.Time `gorm:"not null;index" json:"recorded_at"`
+}
+
+// TableName specifies the table name
+func (UsageEvent) TableName() string {
+	return "usage_events"
+}
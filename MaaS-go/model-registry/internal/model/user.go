@@ -16,6 +16,23 @@ const (
 	RoleViewer    UserRole = "viewer"
 )
 
+// roleRanks orders roles by the rights they carry
+var roleRanks = map[UserRole]int{
+	RoleViewer:    1,
+	RoleDeveloper: 2,
+	RoleAdmin:     3,
+}
+
+// Valid reports whether r is a known role
+func (r UserRole) Valid() bool {
+	return roleRanks[r] > 0
+}
+
+// Covers reports whether r carries at least the rights of other
+func (r UserRole) Covers(other UserRole) bool {
+	return other.Valid() && roleRanks[r] >= roleRanks[other]
+}
+
 // UserStatus represents user status
 type UserStatus string
 
@@ -37,6 +54,11 @@ type User struct {
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// External identity of users provisioned through single sign-on; nil for local users
+	ExternalIssuer  *string    `gorm:"type:varchar(255);uniqueIndex:idx_users_external_identity" json:"external_issuer,omitempty"`
+	ExternalSubject *string    `gorm:"type:varchar(255);uniqueIndex:idx_users_external_identity" json:"external_subject,omitempty"`
+	LastLoginAt     *time.Time `json:"last_login_at,omitempty"`
 }
 
 // TableName specifies the table name
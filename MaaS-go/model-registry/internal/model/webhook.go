@@ -0,0 +1,102 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DeliveryStatus represents the state of a webhook delivery
+type DeliveryStatus string
+
+const (
+	DeliveryPending    DeliveryStatus = "pending"
+	DeliveryRetrying   DeliveryStatus = "retrying"
+	DeliverySucceeded  DeliveryStatus = "succeeded"
+	DeliveryDeadLetter DeliveryStatus = "dead_letter"
+)
+
+// WebhookSubscription is a tenant's registration to receive model events over HTTP
+type WebhookSubscription struct {
+	ID          string `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	TenantID    string `gorm:"type:uuid;not null;index" json:"tenant_id"`
+	CreatedBy   string `gorm:"type:uuid" json:"created_by"`
+	URL         string `gorm:"type:varchar(2048);not null" json:"url"`
+	Secret      string `gorm:"type:varchar(128);not null" json:"-"`
+	Description string `gorm:"type:text" json:"description"`
+	// EventTypes restricts deliveries to these event types; empty means all
+	EventTypes []EventType `gorm:"serializer:json;type:jsonb" json:"event_types"`
+	// ModelID restricts deliveries to a single model; empty means all models of the tenant
+	ModelID   string    `gorm:"type:varchar(36);index" json:"model_id,omitempty"`
+	Active    bool      `gorm:"default:true" json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// BeforeCreate hook to generate UUID
+func (w *WebhookSubscription) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == "" {
+		w.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// Matches reports whether the subscription wants an event
+func (w *WebhookSubscription) Matches(eventType EventType, modelID string) bool {
+	if !w.Active {
+		return false
+	}
+	if w.ModelID != "" && w.ModelID != modelID {
+		return false
+	}
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is one event sent to one subscription, with its retry state
+type WebhookDelivery struct {
+	ID             string          `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	SubscriptionID string          `gorm:"type:uuid;not null;index" json:"subscription_id"`
+	TenantID       string          `gorm:"type:uuid;not null;index" json:"tenant_id"`
+	EventID        string          `gorm:"type:uuid;not null;index" json:"event_id"`
+	EventType      EventType       `gorm:"type:varchar(50);not null" json:"event_type"`
+	ModelID        string          `gorm:"type:varchar(36);index" json:"model_id"`
+	Payload        json.RawMessage `gorm:"type:jsonb;not null" json:"payload"`
+	Status         DeliveryStatus  `gorm:"type:varchar(20);not null;index" json:"status"`
+	Attempts       int             `gorm:"default:0" json:"attempts"`
+	NextAttemptAt  time.Time       `gorm:"index" json:"next_attempt_at"`
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	ResponseCode   int             `json:"response_code,omitempty"`
+	LastError      string          `gorm:"type:text" json:"last_error,omitempty"`
+	// RedeliveryOf points at the delivery this one was manually re-sent from
+	RedeliveryOf string     `gorm:"type:varchar(36)" json:"redelivery_of,omitempty"`
+	DeliveredAt  *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// TableName specifies the table name
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// BeforeCreate hook to generate UUID
+func (d *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = uuid.New().String()
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+var ErrAPIKeyNotFound = errors.New("api key not found")
+
+// APIKeyRepository defines access to API keys
+type APIKeyRepository interface {
+	Create(ctx context.Context, k *model.APIKey) error
+	GetByID(ctx context.Context, id string) (*model.APIKey, error)
+	GetByHash(ctx context.Context, hash string) (*model.APIKey, error)
+	List(ctx context.Context, tenantID, userID string, includeRevoked bool, pagination Pagination) ([]*model.APIKey, int64, error)
+	Update(ctx context.Context, k *model.APIKey) error
+	// TouchLastUsed records a use unless one was recorded after notBefore
+	TouchLastUsed(ctx context.Context, id string, at, notBefore time.Time) error
+}
+
+// GormAPIKeyRepository implements APIKeyRepository using GORM
+type GormAPIKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewGormAPIKeyRepository creates a new GORM API key repository
+func NewGormAPIKeyRepository(db *gorm.DB) APIKeyRepository {
+	return &GormAPIKeyRepository{db: db}
+}
+
+// Create creates an API key
+func (r *GormAPIKeyRepository) Create(ctx context.Context, k *model.APIKey) error {
+	return r.db.WithContext(ctx).Create(k).Error
+}
+
+// GetByID retrieves an API key by ID
+func (r *GormAPIKeyRepository) GetByID(ctx context.Context, id string) (*model.APIKey, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// GetByHash retrieves an API key by the hash of its secret
+func (r *GormAPIKeyRepository) GetByHash(ctx context.Context, hash string) (*model.APIKey, error) {
+	return r.first(ctx, "hash = ?", hash)
+}
+
+// first retrieves the first API key matching a condition
+func (r *GormAPIKeyRepository) first(ctx context.Context, query string, args ...interface{}) (*model.APIKey, error) {
+	var k model.APIKey
+	result := r.db.WithContext(ctx).Where(query, args...).First(&k)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrAPIKeyNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &k, nil
+}
+
+// List retrieves a paginated list of API keys, optionally for one tenant and owner
+func (r *GormAPIKeyRepository) List(ctx context.Context, tenantID, userID string, includeRevoked bool, pagination Pagination) ([]*model.APIKey, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.APIKey{})
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	if !includeRevoked {
+		query = query.Where("revoked_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var keys []*model.APIKey
+	result := query.
+		Offset(pagination.offset()).
+		Limit(pagination.Limit).
+		Order("created_at DESC").
+		Find(&keys)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return keys, total, nil
+}
+
+// Update saves an API key
+func (r *GormAPIKeyRepository) Update(ctx context.Context, k *model.APIKey) error {
+	return r.db.WithContext(ctx).Save(k).Error
+}
+
+// TouchLastUsed records a use, skipping the write when one was recorded recently
+func (r *GormAPIKeyRepository) TouchLastUsed(ctx context.Context, id string, at, notBefore time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, notBefore).
+		UpdateColumn("last_used_at", at).Error
+}
@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+// Approval errors
+var (
+	ErrPolicyNotFound    = errors.New("approval policy not found")
+	ErrApprovalNotFound  = errors.New("approval request not found")
+	ErrDuplicateDecision = errors.New("approver already decided on this request")
+)
+
+// ApprovalRepository defines access to approval policies, requests and decisions
+type ApprovalRepository interface {
+	GetPolicy(ctx context.Context, tenantID string, action model.ApprovalAction) (*model.ApprovalPolicy, error)
+	ListPolicies(ctx context.Context, tenantID string) ([]*model.ApprovalPolicy, error)
+	// SavePolicy inserts the policy of its tenant and action or replaces the
+	// existing one, and fills in the stored fields
+	SavePolicy(ctx context.Context, p *model.ApprovalPolicy) error
+	// DeletePolicy removes a policy and returns it
+	DeletePolicy(ctx context.Context, tenantID string, action model.ApprovalAction) (*model.ApprovalPolicy, error)
+
+	CreateRequest(ctx context.Context, r *model.ApprovalRequest) error
+	// GetRequest returns a request with its decisions
+	GetRequest(ctx context.Context, id string) (*model.ApprovalRequest, error)
+	// FindPending returns the pending request for the same change, if any
+	FindPending(ctx context.Context, r *model.ApprovalRequest) (*model.ApprovalRequest, error)
+	ListRequests(ctx context.Context, filter ApprovalFilter, pagination Pagination) ([]*model.ApprovalRequest, int64, error)
+	// ListExpired returns the pending requests of a tenant that expired before now;
+	// an empty tenant covers all tenants
+	ListExpired(ctx context.Context, tenantID string, now time.Time) ([]*model.ApprovalRequest, error)
+
+	// AddDecision records a decision; an approver decides once per request
+	AddDecision(ctx context.Context, d *model.ApprovalDecision) error
+	// Resolve moves a request from one state to another and reports whether it
+	// was still in the from state, so concurrent decisions resolve it only once
+	Resolve(ctx context.Context, id string, from, to model.ApprovalState, reason string) (bool, error)
+
+	// DeleteModel removes the requests and decisions of a purged model
+	DeleteModel(ctx context.Context, modelID string) error
+}
+
+// ApprovalFilter defines filter criteria for listing approval requests
+type ApprovalFilter struct {
+	TenantID string
+	ModelID  string
+	State    model.ApprovalState
+}
+
+// GormApprovalRepository implements ApprovalRepository using GORM
+type GormApprovalRepository struct {
+	db *gorm.DB
+}
+
+// NewGormApprovalRepository creates a new GORM approval repository
+func NewGormApprovalRepository(db *gorm.DB) ApprovalRepository {
+	return &GormApprovalRepository{db: db}
+}
+
+// GetPolicy retrieves the policy of a tenant for an action
+func (r *GormApprovalRepository) GetPolicy(ctx context.Context, tenantID string, action model.ApprovalAction) (*model.ApprovalPolicy, error) {
+	var p model.ApprovalPolicy
+	result := r.db.WithContext(ctx).First(&p, "tenant_id = ? AND action = ?", tenantID, action)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrPolicyNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &p, nil
+}
+
+// ListPolicies retrieves the policies of a tenant by action
+func (r *GormApprovalRepository) ListPolicies(ctx context.Context, tenantID string) ([]*model.ApprovalPolicy, error) {
+	var policies []*model.ApprovalPolicy
+	if err := reader(ctx, r.db).Where("tenant_id = ?", tenantID).Order("action").Find(&policies).Error; err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// SavePolicy upserts the policy of a tenant for an action
+func (r *GormApprovalRepository) SavePolicy(ctx context.Context, p *model.ApprovalPolicy) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "action"}},
+		DoUpdates: clause.AssignmentColumns([]string{"required_approvals", "approver_role", "timeout_seconds", "created_by", "updated_at"}),
+	}).Create(p).Error
+	if err != nil {
+		return err
+	}
+	stored, err := r.GetPolicy(ctx, p.TenantID, p.Action)
+	if err != nil {
+		return err
+	}
+	*p = *stored
+	return nil
+}
+
+// DeletePolicy removes the policy of a tenant for an action and returns it
+func (r *GormApprovalRepository) DeletePolicy(ctx context.Context, tenantID string, action model.ApprovalAction) (*model.ApprovalPolicy, error) {
+	var p model.ApprovalPolicy
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tenant_id = ? AND action = ?", tenantID, action).First(&p).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrPolicyNotFound
+			}
+			return err
+		}
+		return tx.Delete(&p).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// CreateRequest records an approval request
+func (r *GormApprovalRepository) CreateRequest(ctx context.Context, req *model.ApprovalRequest) error {
+	return r.db.WithContext(ctx).Create(req).Error
+}
+
+// GetRequest retrieves an approval request by ID with its decisions
+func (r *GormApprovalRepository) GetRequest(ctx context.Context, id string) (*model.ApprovalRequest, error) {
+	var req model.ApprovalRequest
+	result := reader(ctx, r.db).Preload("Decisions", orderDecisions).First(&req, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrApprovalNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &req, nil
+}
+
+// FindPending retrieves the pending request for the same model, action and target
+func (r *GormApprovalRepository) FindPending(ctx context.Context, req *model.ApprovalRequest) (*model.ApprovalRequest, error) {
+	var pending model.ApprovalRequest
+	result := r.db.WithContext(ctx).
+		Preload("Decisions", orderDecisions).
+		Where("model_id = ? AND action = ? AND state = ?", req.ModelID, req.Action, model.ApprovalPending).
+		Where("target_status = ? AND target_version = ?", req.TargetStatus, req.TargetVersion).
+		Order("created_at DESC").
+		First(&pending)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrApprovalNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &pending, nil
+}
+
+// ListRequests retrieves a paginated list of approval requests, newest first
+func (r *GormApprovalRepository) ListRequests(ctx context.Context, filter ApprovalFilter, pagination Pagination) ([]*model.ApprovalRequest, int64, error) {
+	query := reader(ctx, r.db).Model(&model.ApprovalRequest{})
+	if filter.TenantID != "" {
+		query = query.Where("tenant_id = ?", filter.TenantID)
+	}
+	if filter.ModelID != "" {
+		query = query.Where("model_id = ?", filter.ModelID)
+	}
+	if filter.State != "" {
+		query = query.Where("state = ?", filter.State)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var requests []*model.ApprovalRequest
+	result := query.
+		Preload("Decisions", orderDecisions).
+		Offset(pagination.offset()).
+		Limit(pagination.Limit).
+		Order("created_at DESC").
+		Order("id").
+		Find(&requests)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return requests, total, nil
+}
+
+// ListExpired retrieves the pending requests whose timeout has passed
+func (r *GormApprovalRepository) ListExpired(ctx context.Context, tenantID string, now time.Time) ([]*model.ApprovalRequest, error) {
+	query := r.db.WithContext(ctx).Where("state = ? AND expires_at <= ?", model.ApprovalPending, now)
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+	var requests []*model.ApprovalRequest
+	if err := query.Order("expires_at").Find(&requests).Error; err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// AddDecision records the decision of an approver
+func (r *GormApprovalRepository) AddDecision(ctx context.Context, d *model.ApprovalDecision) error {
+	if err := r.db.WithContext(ctx).Create(d).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrDuplicateDecision
+		}
+		return err
+	}
+	return nil
+}
+
+// Resolve conditionally moves a request to a new state
+func (r *GormApprovalRepository) Resolve(ctx context.Context, id string, from, to model.ApprovalState, reason string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.ApprovalRequest{}).
+		Where("id = ? AND state = ?", id, from).
+		Updates(map[string]interface{}{
+			"state":       to,
+			"error":       reason,
+			"resolved_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteModel removes the approval requests of a model and their decisions
+func (r *GormApprovalRepository) DeleteModel(ctx context.Context, modelID string) error {
+	return writer(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		requests := tx.Model(&model.ApprovalRequest{}).Select("id").Where("model_id = ?", modelID)
+		if err := tx.Where("request_id IN (?)", requests).Delete(&model.ApprovalDecision{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("model_id = ?", modelID).Delete(&model.ApprovalRequest{}).Error
+	})
+}
+
+// orderDecisions preloads decisions in the order they were made
+func orderDecisions(db *gorm.DB) *gorm.DB {
+	return db.Order("created_at").Order("id")
+}
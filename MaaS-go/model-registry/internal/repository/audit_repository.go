@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+// AuditRepository defines append-only access to the audit log
+type AuditRepository interface {
+	Append(ctx context.Context, e *model.AuditEntry) error
+	List(ctx context.Context, filter AuditFilter, pagination Pagination) ([]*model.AuditEntry, int64, error)
+	// Each calls fn for every matching entry in chronological order
+	Each(ctx context.Context, filter AuditFilter, fn func(*model.AuditEntry) error) error
+}
+
+// AuditFilter defines filter criteria for querying the audit log
+type AuditFilter struct {
+	TargetID string
+	ActorID  string
+	TenantID string
+	Action   model.AuditAction
+	From     time.Time
+	To       time.Time
+}
+
+// GormAuditRepository implements AuditRepository using GORM
+type GormAuditRepository struct {
+	db *gorm.DB
+}
+
+// NewGormAuditRepository creates a new GORM audit repository
+func NewGormAuditRepository(db *gorm.DB) AuditRepository {
+	return &GormAuditRepository{db: db}
+}
+
+// Append adds an entry to the audit log
+func (r *GormAuditRepository) Append(ctx context.Context, e *model.AuditEntry) error {
+	return r.db.WithContext(ctx).Create(e).Error
+}
+
+// List retrieves a paginated list of audit entries, newest first
+func (r *GormAuditRepository) List(ctx context.Context, filter AuditFilter, pagination Pagination) ([]*model.AuditEntry, int64, error) {
+	query := r.applyFilter(r.db.WithContext(ctx).Model(&model.AuditEntry{}), filter)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Page < 1 {
+		pagination.Page = 1
+	}
+	if pagination.Limit < 1 || pagination.Limit > 100 {
+		pagination.Limit = 20
+	}
+	offset := (pagination.Page - 1) * pagination.Limit
+
+	var entries []*model.AuditEntry
+	result := query.
+		Offset(offset).
+		Limit(pagination.Limit).
+		Order("occurred_at DESC").
+		Find(&entries)
+
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return entries, total, nil
+}
+
+// Each streams matching audit entries in batches, oldest first
+func (r *GormAuditRepository) Each(ctx context.Context, filter AuditFilter, fn func(*model.AuditEntry) error) error {
+	var batch []*model.AuditEntry
+	result := r.applyFilter(r.db.WithContext(ctx).Model(&model.AuditEntry{}), filter).
+		Order("occurred_at ASC").
+		FindInBatches(&batch, 500, func(tx *gorm.DB, _ int) error {
+			for _, e := range batch {
+				if err := fn(e); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	return result.Error
+}
+
+// applyFilter adds the filter criteria to an audit query
+func (r *GormAuditRepository) applyFilter(query *gorm.DB, filter AuditFilter) *gorm.DB {
+	if filter.TargetID != "" {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.TenantID != "" {
+		query = query.Where("tenant_id = ?", filter.TenantID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("occurred_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("occurred_at < ?", filter.To)
+	}
+	return query
+}
@@ -0,0 +1,97 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+
+	"maas-platform/model-registry/internal/migrate"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/repository/repotest"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+func TestMemoryBackend(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		// Deliveries, plans and invoices are not kept in memory, so the
+		// stats and billing repositories need no database beneath them here
+		store := repository.NewMemoryStore()
+		return repotest.Repositories{
+			Models:  repository.NewMemoryModelRepository(store),
+			Outbox:  repository.NewMemoryOutboxRepository(store),
+			Stats:   repository.NewMemoryStatsRepository(store, nil),
+			Billing: repository.NewMemoryBillingRepository(store, nil),
+		}
+	})
+}
+
+func TestSQLiteBackend(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		db, err := repository.NewDatabase(repository.DatabaseConfig{
+			Backend: repository.BackendSQLite,
+			Path:    filepath.Join(t.TempDir(), "registry.db"),
+		})
+		if err != nil {
+			t.Fatalf("open sqlite: %v", err)
+		}
+		db.Logger = gormlogger.Discard
+		migrateUp(t, db)
+		return gormRepositories(db)
+	})
+}
+
+// TestPostgresBackend runs against the database in MAAS_TEST_POSTGRES_DSN,
+// emptying the model and outbox tables before each test
+func TestPostgresBackend(t *testing.T) {
+	dsn := os.Getenv("MAAS_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("MAAS_TEST_POSTGRES_DSN not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger:         gormlogger.Discard,
+		TranslateError: true,
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+	})
+	if err != nil {
+		t.Fatalf("open postgres: %v", err)
+	}
+	migrateUp(t, db)
+
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		err := db.Exec("TRUNCATE model_tags, model_metadata, model_versions, tags, models, event_outbox").Error
+		if err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		return gormRepositories(db)
+	})
+}
+
+func gormRepositories(db *gorm.DB) repotest.Repositories {
+	return repotest.Repositories{
+		Models:  repository.NewGormModelRepository(db),
+		Outbox:  repository.NewGormOutboxRepository(db),
+		Stats:   repository.NewGormStatsRepository(db),
+		Billing: repository.NewGormBillingRepository(db),
+	}
+}
+
+func migrateUp(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("sql db: %v", err)
+	}
+	m, err := migrate.New(sqlDB, db.Dialector.Name(), logger.New("error"))
+	if err != nil {
+		t.Fatalf("migrator: %v", err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+}
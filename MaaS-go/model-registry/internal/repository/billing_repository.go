@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+var (
+	ErrPlanNotFound    = errors.New("pricing plan not found")
+	ErrDuplicatePlan   = errors.New("pricing plan already exists")
+	ErrInvoiceNotFound = errors.New("invoice not found")
+	// ErrInvoiceFinal is returned when replacing an invoice whose period was closed
+	ErrInvoiceFinal = errors.New("invoice is final")
+)
+
+// BillingRepository defines access to pricing plans, tenant plan assignments and invoices
+type BillingRepository interface {
+	CreatePlan(ctx context.Context, p *model.PricingPlan) error
+	GetPlan(ctx context.Context, id string) (*model.PricingPlan, error)
+	ListPlans(ctx context.Context) ([]*model.PricingPlan, error)
+	UpdatePlan(ctx context.Context, p *model.PricingPlan) error
+
+	// SetTenantPlan attaches a plan to a tenant, replacing any previous one
+	SetTenantPlan(ctx context.Context, tenantID, planID string) error
+	// GetTenantPlan returns the plan attached to a tenant
+	GetTenantPlan(ctx context.Context, tenantID string) (*model.PricingPlan, error)
+	// TenantPlans returns every tenant plan assignment
+	TenantPlans(ctx context.Context) ([]*model.TenantPlan, error)
+
+	// SaveInvoice inserts the invoice of its tenant and period or replaces the
+	// existing one in place, keeping its ID, and fills in the stored fields.
+	// Final invoices are never replaced.
+	SaveInvoice(ctx context.Context, inv *model.Invoice) error
+	GetInvoice(ctx context.Context, id string) (*model.Invoice, error)
+	GetInvoiceByPeriod(ctx context.Context, tenantID string, periodStart time.Time) (*model.Invoice, error)
+	ListInvoices(ctx context.Context, filter InvoiceFilter, pagination Pagination) ([]*model.Invoice, int64, error)
+
+	// TenantModels returns the models of a tenant that existed at any time in
+	// [from, to), including deleted ones, with their versions
+	TenantModels(ctx context.Context, tenantID string, from, to time.Time) ([]*model.Model, error)
+}
+
+// InvoiceFilter defines filter criteria for listing invoices
+type InvoiceFilter struct {
+	TenantID string
+	From     time.Time
+	To       time.Time
+}
+
+// GormBillingRepository implements BillingRepository using GORM
+type GormBillingRepository struct {
+	db *gorm.DB
+}
+
+// NewGormBillingRepository creates a new GORM billing repository
+func NewGormBillingRepository(db *gorm.DB) BillingRepository {
+	return &GormBillingRepository{db: db}
+}
+
+// CreatePlan creates a pricing plan
+func (r *GormBillingRepository) CreatePlan(ctx context.Context, p *model.PricingPlan) error {
+	if err := r.db.WithContext(ctx).Create(p).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrDuplicatePlan
+		}
+		return err
+	}
+	return nil
+}
+
+// GetPlan retrieves a pricing plan by ID
+func (r *GormBillingRepository) GetPlan(ctx context.Context, id string) (*model.PricingPlan, error) {
+	var p model.PricingPlan
+	result := r.db.WithContext(ctx).First(&p, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrPlanNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &p, nil
+}
+
+// ListPlans retrieves all pricing plans by name
+func (r *GormBillingRepository) ListPlans(ctx context.Context) ([]*model.PricingPlan, error) {
+	var plans []*model.PricingPlan
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&plans).Error; err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// UpdatePlan saves a pricing plan
+func (r *GormBillingRepository) UpdatePlan(ctx context.Context, p *model.PricingPlan) error {
+	result := r.db.WithContext(ctx).Save(p)
+	if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+		return ErrDuplicatePlan
+	}
+	return result.Error
+}
+
+// SetTenantPlan upserts the plan assignment of a tenant
+func (r *GormBillingRepository) SetTenantPlan(ctx context.Context, tenantID, planID string) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"plan_id", "updated_at"}),
+	}).Create(&model.TenantPlan{TenantID: tenantID, PlanID: planID}).Error
+}
+
+// GetTenantPlan retrieves the plan attached to a tenant
+func (r *GormBillingRepository) GetTenantPlan(ctx context.Context, tenantID string) (*model.PricingPlan, error) {
+	var p model.PricingPlan
+	result := r.db.WithContext(ctx).
+		Joins("JOIN tenant_plans ON tenant_plans.plan_id = pricing_plans.id").
+		Where("tenant_plans.tenant_id = ?", tenantID).
+		First(&p)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrPlanNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &p, nil
+}
+
+// TenantPlans retrieves every tenant plan assignment
+func (r *GormBillingRepository) TenantPlans(ctx context.Context) ([]*model.TenantPlan, error) {
+	var plans []*model.TenantPlan
+	if err := r.db.WithContext(ctx).Order("tenant_id ASC").Find(&plans).Error; err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// SaveInvoice upserts an invoice on its tenant and period. A final invoice is
+// never replaced; saving over one fails with ErrInvoiceFinal.
+func (r *GormBillingRepository) SaveInvoice(ctx context.Context, inv *model.Invoice) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "tenant_id"}, {Name: "period_start"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"period_end", "plan_id", "plan_name", "currency", "line_items",
+				"subtotal_micros", "discount_micros", "total_micros", "final", "generated_at", "updated_at",
+			}),
+			Where: clause.Where{Exprs: []clause.Expression{clause.Eq{Column: clause.Column{Table: "invoices", Name: "final"}, Value: false}}},
+		}).Create(inv)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvoiceFinal
+		}
+		// On conflict the generated ID was discarded, so reload the stored row
+		return tx.Where("tenant_id = ? AND period_start = ?", inv.TenantID, inv.PeriodStart).First(inv).Error
+	})
+}
+
+// GetInvoice retrieves an invoice by ID
+func (r *GormBillingRepository) GetInvoice(ctx context.Context, id string) (*model.Invoice, error) {
+	var inv model.Invoice
+	result := r.db.WithContext(ctx).First(&inv, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrInvoiceNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &inv, nil
+}
+
+// GetInvoiceByPeriod retrieves the invoice of a tenant for the period starting at periodStart
+func (r *GormBillingRepository) GetInvoiceByPeriod(ctx context.Context, tenantID string, periodStart time.Time) (*model.Invoice, error) {
+	var inv model.Invoice
+	result := r.db.WithContext(ctx).First(&inv, "tenant_id = ? AND period_start = ?", tenantID, periodStart)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrInvoiceNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &inv, nil
+}
+
+// ListInvoices retrieves a paginated list of invoices, newest period first
+func (r *GormBillingRepository) ListInvoices(ctx context.Context, filter InvoiceFilter, pagination Pagination) ([]*model.Invoice, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.Invoice{})
+	if filter.TenantID != "" {
+		query = query.Where("tenant_id = ?", filter.TenantID)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("period_start >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("period_start < ?", filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Page < 1 {
+		pagination.Page = 1
+	}
+	if pagination.Limit < 1 || pagination.Limit > 100 {
+		pagination.Limit = 20
+	}
+
+	var invoices []*model.Invoice
+	result := query.
+		Offset((pagination.Page - 1) * pagination.Limit).
+		Limit(pagination.Limit).
+		Order("period_start DESC, tenant_id ASC").
+		Find(&invoices)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return invoices, total, nil
+}
+
+// TenantModels retrieves the models of a tenant alive in [from, to) with their versions
+func (r *GormBillingRepository) TenantModels(ctx context.Context, tenantID string, from, to time.Time) ([]*model.Model, error) {
+	var models []*model.Model
+	result := r.db.WithContext(ctx).Unscoped().
+		Preload("Versions").
+		Where("tenant_id = ? AND created_at < ?", tenantID, to).
+		Where("deleted_at IS NULL OR deleted_at > ?", from).
+		Order("id ASC").
+		Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return models, nil
+}
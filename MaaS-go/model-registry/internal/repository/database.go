@@ -1,54 +1,244 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
 
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
+	"gorm.io/plugin/dbresolver"
 
 	"maas-platform/model-registry/internal/model"
 )
 
+// Storage backends
+const (
+	// BackendPostgres stores everything in PostgreSQL
+	BackendPostgres = "postgres"
+	// BackendSQLite stores everything in a single SQLite file, for single-node and edge installs
+	BackendSQLite = "sqlite"
+	// BackendMemory keeps models and the event outbox in process memory and the
+	// remaining tables in an in-memory SQLite database; stats and billing read
+	// models from memory too. Nothing survives a restart
+	BackendMemory = "memory"
+)
+
+// memoryDSN names the in-memory SQLite database shared by all pool connections
+const memoryDSN = "file:maas-registry?mode=memory&cache=shared&_foreign_keys=on"
+
 // DatabaseConfig holds database configuration
 type DatabaseConfig struct {
+	// Backend is postgres, sqlite or memory
+	Backend  string
 	Host     string
 	Port     int
 	User     string
 	Password string
 	Database string
 	SSLMode  string
+	// Path is the SQLite database file
+	Path string
+
+	// Connection pool; zero leaves the database/sql default
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// StatementTimeout aborts Postgres statements running longer; 0 disables it
+	StatementTimeout time.Duration
+	// SlowQueryThreshold logs statements running longer at warn level; 0 disables it
+	SlowQueryThreshold time.Duration
+	// LogLevel is silent, error, warn or info; info logs every statement
+	LogLevel string
+	// Replicas are Postgres read replicas as host or host:port; they share the
+	// primary's credentials and database name
+	Replicas []string
+}
+
+// replicaKey marks contexts whose model reads may be served by a replica
+type replicaKey struct{}
+
+// AllowReplica marks ctx so model reads made with it may be served by a read
+// replica, unless RequirePrimary already pinned it. Only pure reads should be
+// marked: reads that precede a write stay on the primary.
+func AllowReplica(ctx context.Context) context.Context {
+	if _, set := ctx.Value(replicaKey{}).(bool); set {
+		return ctx
+	}
+	return context.WithValue(ctx, replicaKey{}, true)
+}
+
+// RequirePrimary pins model reads made with ctx to the primary, for reads that
+// must observe a write the caller just made
+func RequirePrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaKey{}, false)
+}
+
+// reader returns db for a read, pinned to the primary unless ctx allows a
+// replica. Reads join the transaction ctx carries, if any.
+func reader(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	tx := db.WithContext(ctx)
+	if allowed, _ := ctx.Value(replicaKey{}).(bool); !allowed {
+		return tx.Clauses(dbresolver.Write)
+	}
+	return tx
 }
 
 // NewDatabase creates a new database connection
 func NewDatabase(cfg DatabaseConfig) (*gorm.DB, error) {
-	dsn := fmt.Sprintf(
-		"host=%s user=%s password=%s dbname=%s port=%d sslmode=%s",
-		cfg.Host, cfg.User, cfg.Password, cfg.Database, cfg.Port, cfg.SSLMode,
-	)
-
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+	level, err := parseLogLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+	gormConfig := &gorm.Config{
+		Logger: logger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), logger.Config{
+			SlowThreshold:             cfg.SlowQueryThreshold,
+			LogLevel:                  level,
+			IgnoreRecordNotFoundError: true,
+		}),
+		TranslateError: true,
 		NamingStrategy: schema.NamingStrategy{
 			SingularTable: true,
 		},
-	})
+	}
+
+	var dialector gorm.Dialector
+	switch cfg.Backend {
+	case BackendPostgres, "":
+		dialector = postgres.Open(postgresDSN(cfg, cfg.Host, cfg.Port))
+	case BackendSQLite:
+		if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create database directory: %w", err)
+		}
+		dialector = sqlite.Open(fmt.Sprintf("file:%s?_foreign_keys=on&_journal_mode=WAL&_busy_timeout=5000", cfg.Path))
+	case BackendMemory:
+		dialector = sqlite.Open(memoryDSN)
+	default:
+		return nil, fmt.Errorf("unknown database backend %q", cfg.Backend)
+	}
+	if dialector.Name() == "sqlite" {
+		if len(cfg.Replicas) > 0 {
+			return nil, fmt.Errorf("read replicas require the %s backend", BackendPostgres)
+		}
+		// SQLite compares timestamps as text, which only orders correctly within one zone
+		gormConfig.NowFunc = func() time.Time { return time.Now().UTC() }
+	}
+
+	db, err := gorm.Open(dialector, gormConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if dialector.Name() == "sqlite" {
+		// SQLite has a single writer; one connection turns lock contention into
+		// queueing, and keeps a shared in-memory database alive
+		sqlDB.SetMaxOpenConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+	} else {
+		configurePool(sqlDB, cfg)
+	}
+
+	if len(cfg.Replicas) > 0 {
+		replicas := make([]gorm.Dialector, 0, len(cfg.Replicas))
+		for _, addr := range cfg.Replicas {
+			host, port, err := splitHostPort(addr, cfg.Port)
+			if err != nil {
+				return nil, err
+			}
+			replicas = append(replicas, postgres.Open(postgresDSN(cfg, host, port)))
+		}
+		// Only the model tables are routed; every other read stays on the primary
+		resolver := dbresolver.Register(dbresolver.Config{
+			Replicas: replicas,
+			Policy:   dbresolver.RandomPolicy{},
+		}, &model.Model{}, &model.Tag{}, &model.Metadata{}, &model.ModelVersion{}, "model_tags")
+		resolver.Call(func(pool gorm.ConnPool) error {
+			if replicaDB, ok := pool.(*sql.DB); ok {
+				configurePool(replicaDB, cfg)
+			}
+			return nil
+		})
+		if err := db.Use(resolver); err != nil {
+			return nil, fmt.Errorf("failed to connect to read replicas: %w", err)
+		}
+	}
+	if err := db.Use(NewTracingPlugin()); err != nil {
+		return nil, fmt.Errorf("failed to install tracing plugin: %w", err)
+	}
 
 	return db, nil
 }
 
-// AutoMigrate runs database migrations
-func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
-		&model.User{},
-		&model.Tenant{},
-		&model.Model{},
-		&model.Tag{},
-		&model.Metadata{},
-		&model.ModelVersion{},
+// postgresDSN returns the connection string for a Postgres server
+func postgresDSN(cfg DatabaseConfig, host string, port int) string {
+	dsn := fmt.Sprintf(
+		"host=%s user=%s password=%s dbname=%s port=%d sslmode=%s",
+		host, cfg.User, cfg.Password, cfg.Database, port, cfg.SSLMode,
 	)
+	if cfg.StatementTimeout > 0 {
+		// Unknown keys are sent as session parameters
+		dsn += fmt.Sprintf(" statement_timeout=%d", cfg.StatementTimeout.Milliseconds())
+	}
+	return dsn
+}
+
+// configurePool applies the pool settings that are set
+func configurePool(sqlDB *sql.DB, cfg DatabaseConfig) {
+	if cfg.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	}
+	if cfg.ConnMaxIdleTime > 0 {
+		sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	}
+}
+
+// splitHostPort parses a replica address, defaulting to the primary's port
+func splitHostPort(addr string, defaultPort int) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr, defaultPort, nil
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid replica address %q", addr)
+	}
+	return host, port, nil
+}
+
+// parseLogLevel converts a configured SQL log level, defaulting to warn
+func parseLogLevel(level string) (logger.LogLevel, error) {
+	switch level {
+	case "silent":
+		return logger.Silent, nil
+	case "error":
+		return logger.Error, nil
+	case "warn", "":
+		return logger.Warn, nil
+	case "info":
+		return logger.Info, nil
+	default:
+		return 0, fmt.Errorf("unknown database log level %q", level)
+	}
 }
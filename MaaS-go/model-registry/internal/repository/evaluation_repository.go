@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+// EvaluationRepository defines access to evaluation results and promotion rules
+type EvaluationRepository interface {
+	CreateEvaluations(ctx context.Context, evaluations []*model.Evaluation) error
+	// ListEvaluations returns the results of a model version, newest first; an
+	// empty version covers all versions and an empty metric all metrics
+	ListEvaluations(ctx context.Context, modelID, version, metric string) ([]*model.Evaluation, error)
+
+	ListRules(ctx context.Context, modelID string) ([]*model.PromotionRule, error)
+	// ReplaceRules replaces every promotion rule of a model
+	ReplaceRules(ctx context.Context, modelID string, rules []*model.PromotionRule) error
+
+	// DeleteModel removes the results and rules of a purged model
+	DeleteModel(ctx context.Context, modelID string) error
+}
+
+// GormEvaluationRepository implements EvaluationRepository using GORM
+type GormEvaluationRepository struct {
+	db *gorm.DB
+}
+
+// NewGormEvaluationRepository creates a new GORM evaluation repository
+func NewGormEvaluationRepository(db *gorm.DB) EvaluationRepository {
+	return &GormEvaluationRepository{db: db}
+}
+
+// CreateEvaluations records evaluation results in one transaction
+func (r *GormEvaluationRepository) CreateEvaluations(ctx context.Context, evaluations []*model.Evaluation) error {
+	if len(evaluations) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(evaluations).Error
+}
+
+// ListEvaluations retrieves evaluation results, newest first
+func (r *GormEvaluationRepository) ListEvaluations(ctx context.Context, modelID, version, metric string) ([]*model.Evaluation, error) {
+	query := reader(ctx, r.db).Where("model_id = ?", modelID)
+	if version != "" {
+		query = query.Where("version = ?", version)
+	}
+	if metric != "" {
+		query = query.Where("metric = ?", metric)
+	}
+
+	var evaluations []*model.Evaluation
+	if err := query.Order("evaluated_at DESC").Order("created_at DESC").Find(&evaluations).Error; err != nil {
+		return nil, err
+	}
+	return evaluations, nil
+}
+
+// ListRules retrieves the promotion rules of a model in the order they were set
+func (r *GormEvaluationRepository) ListRules(ctx context.Context, modelID string) ([]*model.PromotionRule, error) {
+	var rules []*model.PromotionRule
+	if err := reader(ctx, r.db).
+		Where("model_id = ?", modelID).
+		Order("created_at").
+		Order("metric").
+		Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// ReplaceRules replaces the promotion rules of a model
+func (r *GormEvaluationRepository) ReplaceRules(ctx context.Context, modelID string, rules []*model.PromotionRule) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("model_id = ?", modelID).Delete(&model.PromotionRule{}).Error; err != nil {
+			return err
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+		return tx.Create(rules).Error
+	})
+}
+
+// DeleteModel removes the evaluation results and promotion rules of a model
+func (r *GormEvaluationRepository) DeleteModel(ctx context.Context, modelID string) error {
+	return writer(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("model_id = ?", modelID).Delete(&model.Evaluation{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("model_id = ?", modelID).Delete(&model.PromotionRule{}).Error
+	})
+}
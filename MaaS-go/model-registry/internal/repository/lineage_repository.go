@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+// lineageLockKey is the advisory lock serializing lineage edge changes
+const lineageLockKey int64 = 0x6d6161735f6c696e
+
+// Lineage errors
+var (
+	ErrEdgeNotFound  = errors.New("lineage edge not found")
+	ErrDuplicateEdge = errors.New("lineage edge already exists")
+)
+
+// LineageRepository defines access to lineage edges
+type LineageRepository interface {
+	// LockGraph holds off other lineage changes until the transaction ctx
+	// carries ends, so a cycle check stays true until the edge is written
+	LockGraph(ctx context.Context) error
+	CreateEdge(ctx context.Context, edge *model.LineageEdge) error
+	// DeleteEdge removes an edge of the given model
+	DeleteEdge(ctx context.Context, modelID, id string) (*model.LineageEdge, error)
+
+	// ListParents returns the edges from the given models to what they came from
+	ListParents(ctx context.Context, modelIDs []string) ([]*model.LineageEdge, error)
+	// ListChildren returns the edges from other models to the given models
+	ListChildren(ctx context.Context, modelIDs []string) ([]*model.LineageEdge, error)
+
+	// DeleteModel removes the edges of a purged model to its parents; edges
+	// from its children stay as a record of where they came from
+	DeleteModel(ctx context.Context, modelID string) error
+}
+
+// GormLineageRepository implements LineageRepository using GORM
+type GormLineageRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLineageRepository creates a new GORM lineage repository
+func NewGormLineageRepository(db *gorm.DB) LineageRepository {
+	return &GormLineageRepository{db: db}
+}
+
+// LockGraph takes a transaction-scoped advisory lock on Postgres
+func (r *GormLineageRepository) LockGraph(ctx context.Context) error {
+	tx := writer(ctx, r.db)
+	if tx.Dialector.Name() != "postgres" {
+		// SQLite runs one transaction at a time over its single connection
+		return nil
+	}
+	return tx.Exec("SELECT pg_advisory_xact_lock(?)", lineageLockKey).Error
+}
+
+// CreateEdge records a lineage edge
+func (r *GormLineageRepository) CreateEdge(ctx context.Context, edge *model.LineageEdge) error {
+	if err := writer(ctx, r.db).Create(edge).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrDuplicateEdge
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteEdge removes a lineage edge and returns it
+func (r *GormLineageRepository) DeleteEdge(ctx context.Context, modelID, id string) (*model.LineageEdge, error) {
+	var edge model.LineageEdge
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ? AND model_id = ?", id, modelID).First(&edge).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrEdgeNotFound
+			}
+			return err
+		}
+		return tx.Delete(&edge).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &edge, nil
+}
+
+// ListParents retrieves the edges of the given models to their parents
+func (r *GormLineageRepository) ListParents(ctx context.Context, modelIDs []string) ([]*model.LineageEdge, error) {
+	return r.list(ctx, "model_id IN ?", modelIDs)
+}
+
+// ListChildren retrieves the edges of other models to the given models
+func (r *GormLineageRepository) ListChildren(ctx context.Context, modelIDs []string) ([]*model.LineageEdge, error) {
+	return r.list(ctx, "parent_model_id IN ?", modelIDs)
+}
+
+func (r *GormLineageRepository) list(ctx context.Context, query string, modelIDs []string) ([]*model.LineageEdge, error) {
+	if len(modelIDs) == 0 {
+		return nil, nil
+	}
+	var edges []*model.LineageEdge
+	if err := reader(ctx, r.db).Where(query, modelIDs).Order("created_at").Order("id").Find(&edges).Error; err != nil {
+		return nil, err
+	}
+	return edges, nil
+}
+
+// DeleteModel removes the edges of a model to its parents
+func (r *GormLineageRepository) DeleteModel(ctx context.Context, modelID string) error {
+	return writer(ctx, r.db).Where("model_id = ?", modelID).Delete(&model.LineageEdge{}).Error
+}
@@ -0,0 +1,892 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/shared/modelcard"
+)
+
+// MemoryStore holds models, their versions and the event outbox in process
+// memory. The model and outbox repositories built on one store share it the
+// way the GORM ones share a database, so model changes and their events are
+// written atomically.
+type MemoryStore struct {
+	mu sync.RWMutex
+	// models holds live and trashed models without their associations
+	models   map[string]*model.Model
+	tags     map[string]model.Tag
+	modelTag map[string][]string
+	metadata map[string][]model.Metadata
+	versions map[string][]model.ModelVersion
+	events   []*model.OutboxEvent
+	sequence int64
+
+	// relay guards OutboxRepository.Exclusive
+	relay sync.Mutex
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		models:   make(map[string]*model.Model),
+		tags:     make(map[string]model.Tag),
+		modelTag: make(map[string][]string),
+		metadata: make(map[string][]model.Metadata),
+		versions: make(map[string][]model.ModelVersion),
+	}
+}
+
+// MemoryModelRepository implements ModelRepository in process memory
+type MemoryModelRepository struct {
+	store *MemoryStore
+}
+
+// NewMemoryModelRepository creates a model repository on an in-memory store
+func NewMemoryModelRepository(store *MemoryStore) ModelRepository {
+	return &MemoryModelRepository{store: store}
+}
+
+// Create creates a new model together with its tags and metadata
+func (r *MemoryModelRepository) Create(ctx context.Context, m *model.Model) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing := s.findByNameAndVersion(m.Name, m.Version); existing != nil {
+		if existing.DeletedAt.Valid {
+			return ErrModelInTrash
+		}
+		return ErrDuplicateModel
+	}
+
+	stored := *m
+	if stored.ID == "" {
+		stored.ID = uuid.New().String()
+	}
+	if stored.Status == "" {
+		stored.Status = model.ModelStatusPending
+	}
+	now := time.Now()
+	if stored.CreatedAt.IsZero() {
+		stored.CreatedAt = now
+	}
+	stored.UpdatedAt = now
+	stored.Tags, stored.Metadata, stored.Versions = nil, nil, nil
+	s.models[stored.ID] = &stored
+
+	names := make([]string, 0, len(m.Tags))
+	for _, tag := range m.Tags {
+		names = append(names, tag.Name)
+	}
+	s.addTags(stored.ID, names)
+	for _, md := range m.Metadata {
+		s.metadata[stored.ID] = append(s.metadata[stored.ID], s.newMetadata(stored.ID, md.Key, md.Value))
+	}
+
+	created, err := s.enqueueModelEvent(model.EventModelCreated, stored.ID, model.ModelEventPayload{})
+	if err != nil {
+		return err
+	}
+	*m = *created
+	return nil
+}
+
+// GetByID retrieves a live model by ID
+func (r *MemoryModelRepository) GetByID(ctx context.Context, id string) (*model.Model, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.loadModel(id)
+}
+
+// GetByNameAndVersion retrieves a live model by name and version
+func (r *MemoryModelRepository) GetByNameAndVersion(ctx context.Context, name, version string) (*model.Model, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	m := s.findByNameAndVersion(name, version)
+	if m == nil || m.DeletedAt.Valid {
+		return nil, ErrModelNotFound
+	}
+	return s.loadModel(m.ID)
+}
+
+// List retrieves a page of live models, newest first
+func (r *MemoryModelRepository) List(ctx context.Context, filter ModelFilter, pagination Pagination) ([]*model.Model, int64, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := s.filter(filter, false)
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].CreatedAt.After(matched[j].CreatedAt)
+		}
+		return matched[i].ID < matched[j].ID
+	})
+	return s.page(matched, pagination), int64(len(matched)), nil
+}
+
+// ListDeleted retrieves a page of trashed models, longest deleted first
+func (r *MemoryModelRepository) ListDeleted(ctx context.Context, filter ModelFilter, pagination Pagination) ([]*model.Model, int64, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := s.filter(filter, true)
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := matched[i].DeletedAt.Time, matched[j].DeletedAt.Time
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return matched[i].ID < matched[j].ID
+	})
+	return s.page(matched, pagination), int64(len(matched)), nil
+}
+
+// Update updates a live model and applies the tag and metadata changes,
+// announcing them as a single event
+func (r *MemoryModelRepository) Update(ctx context.Context, m *model.Model, changes ModelChanges) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.models[m.ID]
+	if !ok || current.DeletedAt.Valid {
+		return ErrModelNotFound
+	}
+	if other := s.findByNameAndVersion(m.Name, m.Version); other != nil && other.ID != m.ID {
+		return ErrDuplicateModel
+	}
+
+	m.UpdatedAt = time.Now()
+	stored := *m
+	stored.Tags, stored.Metadata, stored.Versions = nil, nil, nil
+	stored.DeletedAt = gorm.DeletedAt{}
+	s.models[m.ID] = &stored
+	if changes.Tags != nil {
+		s.modelTag[m.ID] = nil
+		s.addTags(m.ID, changes.Tags)
+	}
+	if changes.Metadata != nil {
+		s.replaceMetadata(m.ID, changes.Metadata)
+	}
+
+	_, err := s.enqueueModelEvent(model.EventModelUpdated, m.ID, model.ModelEventPayload{})
+	return err
+}
+
+// Delete moves a live model to the trash
+func (r *MemoryModelRepository) Delete(ctx context.Context, id string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, err := s.loadModel(id)
+	if err != nil {
+		return err
+	}
+	s.models[id].DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	return s.enqueue(model.EventModelDeleted, m, model.ModelEventPayload{Model: m})
+}
+
+// UpdateStatus updates the status of a live model
+func (r *MemoryModelRepository) UpdateStatus(ctx context.Context, id string, status model.ModelStatus) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.models[id]
+	if !ok || m.DeletedAt.Valid {
+		return ErrModelNotFound
+	}
+	previous := m.Status
+	m.Status = status
+	m.UpdatedAt = time.Now()
+
+	_, err := s.enqueueModelEvent(model.EventModelStatusChanged, id, model.ModelEventPayload{PreviousStatus: previous})
+	return err
+}
+
+// GetDeletedByID retrieves a trashed model with its tags and versions
+func (r *MemoryModelRepository) GetDeletedByID(ctx context.Context, id string) (*model.Model, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	m, ok := s.models[id]
+	if !ok || !m.DeletedAt.Valid {
+		return nil, ErrModelNotFound
+	}
+	result := *m
+	result.Tags = s.tagsOf(id)
+	result.Versions = s.versionsOf(id)
+	return &result, nil
+}
+
+// Restore moves a trashed model out of the trash
+func (r *MemoryModelRepository) Restore(ctx context.Context, id string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.models[id]
+	if !ok || !m.DeletedAt.Valid {
+		return ErrModelNotFound
+	}
+	m.DeletedAt = gorm.DeletedAt{}
+	m.UpdatedAt = time.Now()
+
+	_, err := s.enqueueModelEvent(model.EventModelRestored, id, model.ModelEventPayload{})
+	return err
+}
+
+// Purge permanently deletes a trashed model with its tags, metadata and versions
+func (r *MemoryModelRepository) Purge(ctx context.Context, id string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.models[id]
+	if !ok || !m.DeletedAt.Valid {
+		return ErrModelNotFound
+	}
+	delete(s.models, id)
+	delete(s.modelTag, id)
+	delete(s.metadata, id)
+	delete(s.versions, id)
+	return nil
+}
+
+// AddTags adds tags to a live model
+func (r *MemoryModelRepository) AddTags(ctx context.Context, modelID string, tagNames []string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.loadModel(modelID); err != nil {
+		return err
+	}
+	s.addTags(modelID, tagNames)
+
+	_, err := s.enqueueModelEvent(model.EventModelUpdated, modelID, model.ModelEventPayload{})
+	return err
+}
+
+// RemoveTags removes tags from a live model
+func (r *MemoryModelRepository) RemoveTags(ctx context.Context, modelID string, tagNames []string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.loadModel(modelID); err != nil {
+		return err
+	}
+	remove := make(map[string]bool, len(tagNames))
+	for _, name := range tagNames {
+		remove[name] = true
+	}
+	kept := s.modelTag[modelID][:0]
+	for _, name := range s.modelTag[modelID] {
+		if !remove[name] {
+			kept = append(kept, name)
+		}
+	}
+	s.modelTag[modelID] = kept
+
+	_, err := s.enqueueModelEvent(model.EventModelUpdated, modelID, model.ModelEventPayload{})
+	return err
+}
+
+// SetMetadata replaces the metadata of a live model
+func (r *MemoryModelRepository) SetMetadata(ctx context.Context, modelID string, metadata map[string]string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.loadModel(modelID); err != nil {
+		return err
+	}
+	s.replaceMetadata(modelID, metadata)
+
+	_, err := s.enqueueModelEvent(model.EventModelUpdated, modelID, model.ModelEventPayload{})
+	return err
+}
+
+// GetMetadata retrieves metadata for a model
+func (r *MemoryModelRepository) GetMetadata(ctx context.Context, modelID string) (map[string]string, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make(map[string]string)
+	for _, md := range s.metadata[modelID] {
+		result[md.Key] = md.Value
+	}
+	return result, nil
+}
+
+// CreateVersion records a new version of a live model
+func (r *MemoryModelRepository) CreateVersion(ctx context.Context, v *model.ModelVersion) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.loadModel(v.ModelID); err != nil {
+		return err
+	}
+	for _, existing := range s.versions[v.ModelID] {
+		if existing.Version == v.Version {
+			return ErrDuplicateVersion
+		}
+	}
+
+	if v.ID == "" {
+		v.ID = uuid.New().String()
+	}
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = time.Now()
+	}
+	s.versions[v.ModelID] = append(s.versions[v.ModelID], *v)
+	return nil
+}
+
+// GetVersion retrieves a single version of a model
+func (r *MemoryModelRepository) GetVersion(ctx context.Context, modelID, version string) (*model.ModelVersion, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, v := range s.versions[modelID] {
+		if v.Version == version {
+			result := v
+			return &result, nil
+		}
+	}
+	return nil, ErrVersionNotFound
+}
+
+// ListVersions retrieves all versions of a model, newest first
+func (r *MemoryModelRepository) ListVersions(ctx context.Context, modelID string) ([]*model.ModelVersion, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	versions := s.versionsOf(modelID)
+	result := make([]*model.ModelVersion, len(versions))
+	for i := range versions {
+		result[i] = &versions[i]
+	}
+	return result, nil
+}
+
+// PromoteVersion makes a version the current one of its model by copying its
+// artifact fields onto the model
+func (r *MemoryModelRepository) PromoteVersion(ctx context.Context, modelID, version string) (*model.Model, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.loadModel(modelID); err != nil {
+		return nil, err
+	}
+	var v *model.ModelVersion
+	for i := range s.versions[modelID] {
+		if s.versions[modelID][i].Version == version {
+			found := s.versions[modelID][i]
+			v = &found
+			break
+		}
+	}
+	if v == nil {
+		return nil, ErrVersionNotFound
+	}
+
+	m := s.models[modelID]
+	if other := s.findByNameAndVersion(m.Name, v.Version); other != nil && other.ID != modelID {
+		return nil, ErrDuplicateModel
+	}
+	m.Version = v.Version
+	m.Size = v.Size
+	m.Checksum = v.Checksum
+	m.StoragePath = v.StoragePath
+	m.DockerImage = v.DockerImage
+	m.Signature = v.Signature
+	m.UpdatedAt = time.Now()
+
+	return s.enqueueModelEvent(model.EventVersionPromoted, modelID, model.ModelEventPayload{Version: v})
+}
+
+// SetVersionCard replaces the model card of a version of a live model
+func (r *MemoryModelRepository) SetVersionCard(ctx context.Context, modelID, version string, card *modelcard.Card) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.loadModel(modelID); err != nil {
+		return err
+	}
+	for i := range s.versions[modelID] {
+		if s.versions[modelID][i].Version == version {
+			s.versions[modelID][i].Card = card
+			_, err := s.enqueueModelEvent(model.EventModelUpdated, modelID, model.ModelEventPayload{})
+			return err
+		}
+	}
+	return ErrVersionNotFound
+}
+
+// findByNameAndVersion returns the live or trashed model with a name and version
+func (s *MemoryStore) findByNameAndVersion(name, version string) *model.Model {
+	for _, m := range s.models {
+		if m.Name == name && m.Version == version {
+			return m
+		}
+	}
+	return nil
+}
+
+// loadModel returns a copy of a live model with its tags and metadata
+func (s *MemoryStore) loadModel(id string) (*model.Model, error) {
+	m, ok := s.models[id]
+	if !ok || m.DeletedAt.Valid {
+		return nil, ErrModelNotFound
+	}
+	result := *m
+	result.Tags = s.tagsOf(id)
+	result.Metadata = append([]model.Metadata{}, s.metadata[id]...)
+	return &result, nil
+}
+
+// filter returns the live or trashed models matching filter
+func (s *MemoryStore) filter(filter ModelFilter, trashed bool) []*model.Model {
+	want := uniqueStrings(filter.Tags)
+	var matched []*model.Model
+	for _, m := range s.models {
+		if m.DeletedAt.Valid != trashed {
+			continue
+		}
+		if trashed && !filter.DeletedBefore.IsZero() && !m.DeletedAt.Time.Before(filter.DeletedBefore) {
+			continue
+		}
+		if filter.Name != "" && !strings.Contains(m.Name, filter.Name) {
+			continue
+		}
+		if filter.Framework != "" && m.Framework != filter.Framework {
+			continue
+		}
+		if filter.Status != "" && m.Status != filter.Status {
+			continue
+		}
+		if filter.OwnerID != "" && m.OwnerID != filter.OwnerID {
+			continue
+		}
+		if filter.TenantID != "" && m.TenantID != filter.TenantID {
+			continue
+		}
+		if filter.IsPublic != nil && m.IsPublic != *filter.IsPublic {
+			continue
+		}
+		if filter.VisibleToTenant != "" && m.TenantID != filter.VisibleToTenant && !m.IsPublic {
+			continue
+		}
+		if !s.hasTags(m.ID, want) {
+			continue
+		}
+		matched = append(matched, m)
+	}
+	return matched
+}
+
+// page returns copies of one page of sorted models with their tags
+func (s *MemoryStore) page(models []*model.Model, pagination Pagination) []*model.Model {
+	offset := pagination.offset()
+	if offset > len(models) {
+		offset = len(models)
+	}
+	end := offset + pagination.Limit
+	if end > len(models) {
+		end = len(models)
+	}
+
+	result := make([]*model.Model, 0, end-offset)
+	for _, m := range models[offset:end] {
+		c := *m
+		c.Tags = s.tagsOf(m.ID)
+		result = append(result, &c)
+	}
+	return result
+}
+
+// hasTags reports whether a model carries every named tag
+func (s *MemoryStore) hasTags(modelID string, names []string) bool {
+	for _, name := range names {
+		found := false
+		for _, t := range s.modelTag[modelID] {
+			if t == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// addTags gets or creates the named tags and associates them with a model
+func (s *MemoryStore) addTags(modelID string, names []string) {
+	for _, name := range uniqueStrings(names) {
+		if _, ok := s.tags[name]; !ok {
+			s.tags[name] = model.Tag{ID: uuid.New().String(), Name: name, CreatedAt: time.Now()}
+		}
+		if !s.hasTags(modelID, []string{name}) {
+			s.modelTag[modelID] = append(s.modelTag[modelID], name)
+		}
+	}
+}
+
+// replaceMetadata swaps the metadata of a model for the given entries
+func (s *MemoryStore) replaceMetadata(modelID string, metadata map[string]string) {
+	entries := make([]model.Metadata, 0, len(metadata))
+	for key, value := range metadata {
+		entries = append(entries, s.newMetadata(modelID, key, value))
+	}
+	s.metadata[modelID] = entries
+}
+
+// tagsOf returns the tags of a model
+func (s *MemoryStore) tagsOf(modelID string) []model.Tag {
+	tags := make([]model.Tag, 0, len(s.modelTag[modelID]))
+	for _, name := range s.modelTag[modelID] {
+		tags = append(tags, s.tags[name])
+	}
+	return tags
+}
+
+// versionsOf returns copies of the versions of a model, newest first
+func (s *MemoryStore) versionsOf(modelID string) []model.ModelVersion {
+	stored := s.versions[modelID]
+	versions := make([]model.ModelVersion, len(stored))
+	for i, v := range stored {
+		versions[len(stored)-1-i] = v
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].CreatedAt.After(versions[j].CreatedAt)
+	})
+	return versions
+}
+
+// newMetadata creates a metadata entry
+func (s *MemoryStore) newMetadata(modelID, key, value string) model.Metadata {
+	now := time.Now()
+	return model.Metadata{
+		ID:        uuid.New().String(),
+		ModelID:   modelID,
+		Key:       key,
+		Value:     value,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// enqueueModelEvent reloads a model and appends an event carrying its new
+// state to the outbox, returning the reloaded model
+func (s *MemoryStore) enqueueModelEvent(eventType model.EventType, modelID string, payload model.ModelEventPayload) (*model.Model, error) {
+	m, err := s.loadModel(modelID)
+	if err != nil {
+		return nil, err
+	}
+	payload.Model = m
+	if err := s.enqueue(eventType, m, payload); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// enqueue appends an event to the outbox
+func (s *MemoryStore) enqueue(eventType model.EventType, m *model.Model, payload model.ModelEventPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", eventType, err)
+	}
+
+	s.sequence++
+	s.events = append(s.events, &model.OutboxEvent{
+		Sequence:   s.sequence,
+		EventID:    uuid.New().String(),
+		Type:       eventType,
+		ModelID:    m.ID,
+		TenantID:   m.TenantID,
+		Payload:    body,
+		OccurredAt: time.Now().UTC(),
+	})
+	return nil
+}
+
+// MemoryOutboxRepository implements OutboxRepository on an in-memory store
+type MemoryOutboxRepository struct {
+	store *MemoryStore
+}
+
+// NewMemoryOutboxRepository creates an outbox repository on an in-memory store
+func NewMemoryOutboxRepository(store *MemoryStore) OutboxRepository {
+	return &MemoryOutboxRepository{store: store}
+}
+
+// Exclusive runs fn unless another relay of this process is running
+func (r *MemoryOutboxRepository) Exclusive(ctx context.Context, fn func(repo OutboxRepository) error) (bool, error) {
+	if !r.store.relay.TryLock() {
+		return false, nil
+	}
+	defer r.store.relay.Unlock()
+	return true, fn(r)
+}
+
+// Pending returns unpublished events due at now, oldest first
+func (r *MemoryOutboxRepository) Pending(ctx context.Context, limit int, now time.Time) ([]*model.OutboxEvent, error) {
+	held := make(map[string]bool)
+	return r.collect(limit, func(e *model.OutboxEvent) bool {
+		if e.PublishedAt != nil || e.DeadAt != nil || held[e.ModelID] {
+			return false
+		}
+		if e.NextAttemptAt != nil && e.NextAttemptAt.After(now) {
+			held[e.ModelID] = true
+			return false
+		}
+		return true
+	}), nil
+}
+
+// Claim holds events back until the given time
+func (r *MemoryOutboxRepository) Claim(ctx context.Context, sequences []int64, until time.Time) error {
+	until = until.UTC()
+	r.update(sequences, func(e *model.OutboxEvent) { e.NextAttemptAt = &until })
+	return nil
+}
+
+// Release makes claimed events due again
+func (r *MemoryOutboxRepository) Release(ctx context.Context, sequences []int64) error {
+	r.update(sequences, func(e *model.OutboxEvent) { e.NextAttemptAt = nil })
+	return nil
+}
+
+// MarkPublished flags events as delivered to the broker
+func (r *MemoryOutboxRepository) MarkPublished(ctx context.Context, sequences []int64) error {
+	now := time.Now().UTC()
+	r.update(sequences, func(e *model.OutboxEvent) {
+		e.PublishedAt = &now
+		e.Attempts++
+		e.LastError = ""
+		e.NextAttemptAt = nil
+	})
+	return nil
+}
+
+// MarkFailed records a failed delivery attempt
+func (r *MemoryOutboxRepository) MarkFailed(ctx context.Context, sequence int64, reason string, retryAt time.Time) error {
+	retryAt = retryAt.UTC()
+	r.update([]int64{sequence}, func(e *model.OutboxEvent) {
+		e.Attempts++
+		e.LastError = reason
+		e.NextAttemptAt = &retryAt
+	})
+	return nil
+}
+
+// MarkDead records a final failed delivery attempt
+func (r *MemoryOutboxRepository) MarkDead(ctx context.Context, sequence int64, reason string) error {
+	now := time.Now().UTC()
+	r.update([]int64{sequence}, func(e *model.OutboxEvent) {
+		e.Attempts++
+		e.LastError = reason
+		e.NextAttemptAt = nil
+		e.DeadAt = &now
+	})
+	return nil
+}
+
+// update applies fn to the listed events
+func (r *MemoryOutboxRepository) update(sequences []int64, fn func(e *model.OutboxEvent)) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	listed := make(map[int64]bool, len(sequences))
+	for _, seq := range sequences {
+		listed[seq] = true
+	}
+	for _, e := range s.events {
+		if listed[e.Sequence] {
+			fn(e)
+		}
+	}
+}
+
+// PrunePublished deletes events published or given up on before the given time
+func (r *MemoryOutboxRepository) PrunePublished(ctx context.Context, before time.Time) (int64, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.events[:0]
+	var pruned int64
+	for _, e := range s.events {
+		if (e.PublishedAt != nil && e.PublishedAt.Before(before)) || (e.DeadAt != nil && e.DeadAt.Before(before)) {
+			pruned++
+			continue
+		}
+		kept = append(kept, e)
+	}
+	s.events = kept
+	return pruned, nil
+}
+
+// Since returns events after a sequence plus the listed earlier sequences
+func (r *MemoryOutboxRepository) Since(ctx context.Context, after int64, include []int64, limit int) ([]*model.OutboxEvent, error) {
+	included := make(map[int64]bool, len(include))
+	for _, seq := range include {
+		included[seq] = true
+	}
+	return r.collect(limit, func(e *model.OutboxEvent) bool {
+		return e.Sequence > after || included[e.Sequence]
+	}), nil
+}
+
+// SequenceRange returns the lowest and highest retained sequence
+func (r *MemoryOutboxRepository) SequenceRange(ctx context.Context) (int64, int64, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.events) == 0 {
+		return 0, 0, nil
+	}
+	return s.events[0].Sequence, s.events[len(s.events)-1].Sequence, nil
+}
+
+// collect returns copies of up to limit matching events in sequence order
+func (r *MemoryOutboxRepository) collect(limit int, match func(e *model.OutboxEvent) bool) []*model.OutboxEvent {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var events []*model.OutboxEvent
+	for _, e := range s.events {
+		if limit > 0 && len(events) >= limit {
+			break
+		}
+		if match(e) {
+			c := *e
+			events = append(events, &c)
+		}
+	}
+	return events
+}
+
+// MemoryStatsRepository counts models and events of an in-memory store; webhook
+// deliveries are not kept in memory, so their counts come from deliveries
+type MemoryStatsRepository struct {
+	store      *MemoryStore
+	deliveries StatsRepository
+}
+
+// NewMemoryStatsRepository creates a stats repository on an in-memory store
+func NewMemoryStatsRepository(store *MemoryStore, deliveries StatsRepository) StatsRepository {
+	return &MemoryStatsRepository{store: store, deliveries: deliveries}
+}
+
+// ModelCounts groups live models by status and framework
+func (r *MemoryStatsRepository) ModelCounts(ctx context.Context) ([]*ModelCount, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type key struct {
+		status    model.ModelStatus
+		framework model.ModelFramework
+	}
+	counts := make(map[key]*ModelCount)
+	var result []*ModelCount
+	for _, m := range s.models {
+		if m.DeletedAt.Valid {
+			continue
+		}
+		k := key{m.Status, m.Framework}
+		c, ok := counts[k]
+		if !ok {
+			c = &ModelCount{Status: m.Status, Framework: m.Framework}
+			counts[k] = c
+			result = append(result, c)
+		}
+		c.Count++
+	}
+	return result, nil
+}
+
+// UnpublishedEvents counts outbox events without a publish time
+func (r *MemoryStatsRepository) UnpublishedEvents(ctx context.Context) (int64, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var n int64
+	for _, e := range s.events {
+		if e.PublishedAt == nil {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// DeliveryCounts groups unfinished and dead-lettered webhook deliveries by status
+func (r *MemoryStatsRepository) DeliveryCounts(ctx context.Context) (map[model.DeliveryStatus]int64, error) {
+	return r.deliveries.DeliveryCounts(ctx)
+}
+
+// MemoryBillingRepository reads the models billed for storage from an
+// in-memory store; plans, assignments and invoices are kept by billing
+type MemoryBillingRepository struct {
+	BillingRepository
+	store *MemoryStore
+}
+
+// NewMemoryBillingRepository creates a billing repository on an in-memory store
+func NewMemoryBillingRepository(store *MemoryStore, billing BillingRepository) BillingRepository {
+	return &MemoryBillingRepository{BillingRepository: billing, store: store}
+}
+
+// TenantModels retrieves the models of a tenant alive in [from, to) with their versions
+func (r *MemoryBillingRepository) TenantModels(ctx context.Context, tenantID string, from, to time.Time) ([]*model.Model, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var models []*model.Model
+	for _, m := range s.models {
+		if m.TenantID != tenantID || !m.CreatedAt.Before(to) {
+			continue
+		}
+		if m.DeletedAt.Valid && !m.DeletedAt.Time.After(from) {
+			continue
+		}
+		result := *m
+		result.Versions = s.versionsOf(m.ID)
+		models = append(models, &result)
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].ID < models[j].ID })
+	return models, nil
+}
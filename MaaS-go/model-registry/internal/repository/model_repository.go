@@ -2,18 +2,27 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"maas-platform/model-registry/internal/model"
+	"maas-platform/shared/modelcard"
 )
 
 var (
 	ErrModelNotFound  = errors.New("model not found")
 	ErrDuplicateModel = errors.New("model with this name and version already exists")
+	ErrModelInTrash   = errors.New("model with this name and version is in the trash; restore or purge it first")
 	ErrInvalidFilter  = errors.New("invalid filter parameters")
+
+	ErrVersionNotFound  = errors.New("model version not found")
+	ErrDuplicateVersion = errors.New("model version already exists")
 )
 
 // ModelRepository defines the interface for model data access
@@ -22,10 +31,16 @@ type ModelRepository interface {
 	GetByID(ctx context.Context, id string) (*model.Model, error)
 	GetByNameAndVersion(ctx context.Context, name, version string) (*model.Model, error)
 	List(ctx context.Context, filter ModelFilter, pagination Pagination) ([]*model.Model, int64, error)
-	Update(ctx context.Context, m *model.Model) error
+	Update(ctx context.Context, m *model.Model, changes ModelChanges) error
 	Delete(ctx context.Context, id string) error
 	UpdateStatus(ctx context.Context, id string, status model.ModelStatus) error
 
+	// Trash operations
+	GetDeletedByID(ctx context.Context, id string) (*model.Model, error)
+	ListDeleted(ctx context.Context, filter ModelFilter, pagination Pagination) ([]*model.Model, int64, error)
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, id string) error
+
 	// Tag operations
 	AddTags(ctx context.Context, modelID string, tags []string) error
 	RemoveTags(ctx context.Context, modelID string, tags []string) error
@@ -33,6 +48,13 @@ type ModelRepository interface {
 	// Metadata operations
 	SetMetadata(ctx context.Context, modelID string, metadata map[string]string) error
 	GetMetadata(ctx context.Context, modelID string) (map[string]string, error)
+
+	// Version operations
+	CreateVersion(ctx context.Context, v *model.ModelVersion) error
+	GetVersion(ctx context.Context, modelID, version string) (*model.ModelVersion, error)
+	ListVersions(ctx context.Context, modelID string) ([]*model.ModelVersion, error)
+	PromoteVersion(ctx context.Context, modelID, version string) (*model.Model, error)
+	SetVersionCard(ctx context.Context, modelID, version string, card *modelcard.Card) error
 }
 
 // ModelFilter defines filter criteria for listing models
@@ -44,6 +66,20 @@ type ModelFilter struct {
 	TenantID  string
 	Tags      []string
 	IsPublic  *bool
+	// VisibleToTenant limits results to the tenant's models and public ones
+	VisibleToTenant string
+
+	// DeletedBefore only applies to trash listings
+	DeletedBefore time.Time
+}
+
+// ModelChanges carries the tag and metadata changes written together with a
+// model update
+type ModelChanges struct {
+	// Tags replaces the model's tags when non-nil
+	Tags []string
+	// Metadata replaces the model's metadata when non-nil
+	Metadata map[string]string
 }
 
 // Pagination defines pagination parameters
@@ -52,6 +88,17 @@ type Pagination struct {
 	Limit int
 }
 
+// offset clamps the page and limit to their defaults and returns the row offset
+func (p *Pagination) offset() int {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 20
+	}
+	return (p.Page - 1) * p.Limit
+}
+
 // GormModelRepository implements ModelRepository using GORM
 type GormModelRepository struct {
 	db *gorm.DB
@@ -62,29 +109,59 @@ func NewGormModelRepository(db *gorm.DB) ModelRepository {
 	return &GormModelRepository{db: db}
 }
 
-// Create creates a new model
+// Create creates a new model together with its tags and metadata.
+// A name and version stay reserved while a model using them is in the trash
+// and are released once that model is purged.
 func (r *GormModelRepository) Create(ctx context.Context, m *model.Model) error {
-	// Check for duplicate
-	var existing model.Model
-	result := r.db.WithContext(ctx).
-		Where("name = ? AND version = ?", m.Name, m.Version).
-		First(&existing)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Check for duplicate, including soft-deleted models
+		var existing model.Model
+		result := tx.Unscoped().
+			Where("name = ? AND version = ?", m.Name, m.Version).
+			First(&existing)
+
+		if result.Error == nil {
+			if existing.DeletedAt.Valid {
+				return ErrModelInTrash
+			}
+			return ErrDuplicateModel
+		}
 
-	if result.Error == nil {
-		return ErrDuplicateModel
-	}
+		if !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return result.Error
+		}
 
-	if !errors.Is(result.Error, gorm.ErrRecordNotFound) {
-		return result.Error
-	}
+		tags, metadata := m.Tags, m.Metadata
+		if err := tx.Omit(clause.Associations).Create(m).Error; err != nil {
+			return translateError(err)
+		}
+
+		names := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			names = append(names, tag.Name)
+		}
+		if err := addTags(tx, m.ID, names); err != nil {
+			return err
+		}
+		for _, md := range metadata {
+			if err := tx.Create(&model.Metadata{ModelID: m.ID, Key: md.Key, Value: md.Value}).Error; err != nil {
+				return err
+			}
+		}
 
-	return r.db.WithContext(ctx).Create(m).Error
+		created, err := enqueueModelEvent(tx, model.EventModelCreated, m.ID, model.ModelEventPayload{})
+		if err != nil {
+			return err
+		}
+		*m = *created
+		return nil
+	})
 }
 
 // GetByID retrieves a model by ID
 func (r *GormModelRepository) GetByID(ctx context.Context, id string) (*model.Model, error) {
 	var m model.Model
-	result := r.db.WithContext(ctx).
+	result := reader(ctx, r.db).
 		Preload("Tags").
 		Preload("Metadata").
 		First(&m, "id = ?", id)
@@ -103,7 +180,7 @@ func (r *GormModelRepository) GetByID(ctx context.Context, id string) (*model.Mo
 // GetByNameAndVersion retrieves a model by name and version
 func (r *GormModelRepository) GetByNameAndVersion(ctx context.Context, name, version string) (*model.Model, error) {
 	var m model.Model
-	result := r.db.WithContext(ctx).
+	result := reader(ctx, r.db).
 		Preload("Tags").
 		Preload("Metadata").
 		Where("name = ? AND version = ?", name, version).
@@ -122,9 +199,23 @@ func (r *GormModelRepository) GetByNameAndVersion(ctx context.Context, name, ver
 
 // List retrieves a paginated list of models with optional filtering
 func (r *GormModelRepository) List(ctx context.Context, filter ModelFilter, pagination Pagination) ([]*model.Model, int64, error) {
-	query := r.db.WithContext(ctx).Model(&model.Model{})
+	query := r.applyFilter(reader(ctx, r.db).Model(&model.Model{}), filter)
+	return r.paginate(query, pagination, "created_at DESC")
+}
+
+// ListDeleted retrieves a paginated list of soft-deleted models with optional filtering
+func (r *GormModelRepository) ListDeleted(ctx context.Context, filter ModelFilter, pagination Pagination) ([]*model.Model, int64, error) {
+	query := reader(ctx, r.db).Unscoped().Model(&model.Model{}).
+		Where("models.deleted_at IS NOT NULL")
+	if !filter.DeletedBefore.IsZero() {
+		query = query.Where("models.deleted_at < ?", filter.DeletedBefore)
+	}
+	query = r.applyFilter(query, filter)
+	return r.paginate(query, pagination, "deleted_at ASC")
+}
 
-	// Apply filters
+// applyFilter adds the filter criteria to a models query
+func (r *GormModelRepository) applyFilter(query *gorm.DB, filter ModelFilter) *gorm.DB {
 	if filter.Name != "" {
 		query = query.Where("name LIKE ?", fmt.Sprintf("%%%s%%", filter.Name))
 	}
@@ -143,12 +234,24 @@ func (r *GormModelRepository) List(ctx context.Context, filter ModelFilter, pagi
 	if filter.IsPublic != nil {
 		query = query.Where("is_public = ?", *filter.IsPublic)
 	}
+	if filter.VisibleToTenant != "" {
+		query = query.Where("(tenant_id = ? OR is_public = ?)", filter.VisibleToTenant, true)
+	}
 	if len(filter.Tags) > 0 {
-		query = query.Joins("JOIN model_tags ON models.id = model_tags.model_id").
+		// Models must carry every requested tag
+		tagged := r.db.Table("model_tags").
+			Select("model_tags.model_id").
 			Joins("JOIN tags ON model_tags.tag_id = tags.id").
-			Where("tags.name IN ?", filter.Tags)
+			Where("tags.name IN ?", filter.Tags).
+			Group("model_tags.model_id").
+			Having("COUNT(DISTINCT tags.name) = ?", len(uniqueStrings(filter.Tags)))
+		query = query.Where("models.id IN (?)", tagged)
 	}
+	return query
+}
 
+// paginate counts the matching models and loads the requested page
+func (r *GormModelRepository) paginate(query *gorm.DB, pagination Pagination, order string) ([]*model.Model, int64, error) {
 	// Get total count
 	var total int64
 	if err := query.Count(&total).Error; err != nil {
@@ -168,7 +271,7 @@ func (r *GormModelRepository) List(ctx context.Context, filter ModelFilter, pagi
 	result := query.Preload("Tags").
 		Offset(offset).
 		Limit(pagination.Limit).
-		Order("created_at DESC").
+		Order(order).
 		Find(&models)
 
 	if result.Error != nil {
@@ -178,77 +281,346 @@ func (r *GormModelRepository) List(ctx context.Context, filter ModelFilter, pagi
 	return models, total, nil
 }
 
-// Update updates a model
-func (r *GormModelRepository) Update(ctx context.Context, m *model.Model) error {
-	result := r.db.WithContext(ctx).Save(m)
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return ErrModelNotFound
-	}
-	return nil
+// Update updates a model and applies the tag and metadata changes in the same
+// transaction, announcing them as a single event
+func (r *GormModelRepository) Update(ctx context.Context, m *model.Model, changes ModelChanges) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit(clause.Associations).Save(m)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrModelNotFound
+		}
+		if changes.Tags != nil {
+			if err := tx.Model(&model.Model{ID: m.ID}).Association("Tags").Clear(); err != nil {
+				return err
+			}
+			if err := addTags(tx, m.ID, changes.Tags); err != nil {
+				return err
+			}
+		}
+		if changes.Metadata != nil {
+			if err := replaceMetadata(tx, m.ID, changes.Metadata); err != nil {
+				return err
+			}
+		}
+		_, err := enqueueModelEvent(tx, model.EventModelUpdated, m.ID, model.ModelEventPayload{})
+		return err
+	})
 }
 
 // Delete soft-deletes a model
 func (r *GormModelRepository) Delete(ctx context.Context, id string) error {
-	result := r.db.WithContext(ctx).Delete(&model.Model{}, "id = ?", id)
-	if result.Error != nil {
-		return result.Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		m, err := loadModel(tx, id)
+		if err != nil {
+			return err
+		}
+
+		result := tx.Delete(&model.Model{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrModelNotFound
+		}
+		return enqueue(tx, model.EventModelDeleted, m, model.ModelEventPayload{Model: m})
+	})
+}
+
+// GetDeletedByID retrieves a soft-deleted model by ID
+func (r *GormModelRepository) GetDeletedByID(ctx context.Context, id string) (*model.Model, error) {
+	var m model.Model
+	result := reader(ctx, r.db).
+		Unscoped().
+		Preload("Tags").
+		Preload("Versions").
+		Where("deleted_at IS NOT NULL").
+		First(&m, "id = ?", id)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrModelNotFound
 	}
-	if result.RowsAffected == 0 {
-		return ErrModelNotFound
+
+	if result.Error != nil {
+		return nil, result.Error
 	}
-	return nil
+
+	return &m, nil
+}
+
+// Restore moves a soft-deleted model out of the trash
+func (r *GormModelRepository) Restore(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().
+			Model(&model.Model{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrModelNotFound
+		}
+		_, err := enqueueModelEvent(tx, model.EventModelRestored, id, model.ModelEventPayload{})
+		return err
+	})
+}
+
+// Purge permanently deletes a soft-deleted model with its tags, metadata and versions
+func (r *GormModelRepository) Purge(ctx context.Context, id string) error {
+	return writer(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var trashed int64
+		if err := tx.Unscoped().Model(&model.Model{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Count(&trashed).Error; err != nil {
+			return err
+		}
+		if trashed == 0 {
+			return ErrModelNotFound
+		}
+
+		// Children go first so the foreign keys hold throughout
+		if err := tx.Exec("DELETE FROM model_tags WHERE model_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("model_id = ?", id).Delete(&model.Metadata{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("model_id = ?", id).Delete(&model.ModelVersion{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", id).Delete(&model.Model{}).Error
+	})
 }
 
 // UpdateStatus updates the status of a model
 func (r *GormModelRepository) UpdateStatus(ctx context.Context, id string, status model.ModelStatus) error {
-	result := r.db.WithContext(ctx).
-		Model(&model.Model{}).
-		Where("id = ?", id).
-		Update("status", status)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var previous model.Model
+		if err := tx.Select("status").First(&previous, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrModelNotFound
+			}
+			return err
+		}
+
+		result := tx.Model(&model.Model{}).
+			Where("id = ?", id).
+			Update("status", status)
+
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrModelNotFound
+		}
+		_, err := enqueueModelEvent(tx, model.EventModelStatusChanged, id, model.ModelEventPayload{
+			PreviousStatus: previous.Status,
+		})
+		return err
+	})
+}
+
+// AddTags adds tags to a model
+func (r *GormModelRepository) AddTags(ctx context.Context, modelID string, tagNames []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := addTags(tx, modelID, tagNames); err != nil {
+			return err
+		}
+		_, err := enqueueModelEvent(tx, model.EventModelUpdated, modelID, model.ModelEventPayload{})
+		return err
+	})
+}
+
+// RemoveTags removes tags from a model
+func (r *GormModelRepository) RemoveTags(ctx context.Context, modelID string, tagNames []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tags []model.Tag
+		if err := tx.Where("name IN ?", tagNames).Find(&tags).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&model.Model{ID: modelID}).Association("Tags").Delete(tags); err != nil {
+			return err
+		}
+		_, err := enqueueModelEvent(tx, model.EventModelUpdated, modelID, model.ModelEventPayload{})
+		return err
+	})
+}
+
+// SetMetadata sets metadata for a model
+func (r *GormModelRepository) SetMetadata(ctx context.Context, modelID string, metadata map[string]string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := replaceMetadata(tx, modelID, metadata); err != nil {
+			return err
+		}
+		_, err := enqueueModelEvent(tx, model.EventModelUpdated, modelID, model.ModelEventPayload{})
+		return err
+	})
+}
+
+// GetMetadata retrieves metadata for a model
+func (r *GormModelRepository) GetMetadata(ctx context.Context, modelID string) (map[string]string, error) {
+	var metadata []model.Metadata
+	if err := reader(ctx, r.db).Where("model_id = ?", modelID).Find(&metadata).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, m := range metadata {
+		result[m.Key] = m.Value
+	}
+
+	return result, nil
+}
+
+// CreateVersion records a new version of a model
+func (r *GormModelRepository) CreateVersion(ctx context.Context, v *model.ModelVersion) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := loadModel(tx, v.ModelID); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&model.ModelVersion{}).
+			Where("model_id = ? AND version = ?", v.ModelID, v.Version).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrDuplicateVersion
+		}
+
+		if err := tx.Create(v).Error; err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return ErrDuplicateVersion
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// GetVersion retrieves a single version of a model
+func (r *GormModelRepository) GetVersion(ctx context.Context, modelID, version string) (*model.ModelVersion, error) {
+	var v model.ModelVersion
+	result := reader(ctx, r.db).
+		Where("model_id = ? AND version = ?", modelID, version).
+		First(&v)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrVersionNotFound
+	}
 
 	if result.Error != nil {
-		return result.Error
+		return nil, result.Error
 	}
-	if result.RowsAffected == 0 {
-		return ErrModelNotFound
+
+	return &v, nil
+}
+
+// ListVersions retrieves all versions of a model, newest first
+func (r *GormModelRepository) ListVersions(ctx context.Context, modelID string) ([]*model.ModelVersion, error) {
+	var versions []*model.ModelVersion
+	if err := reader(ctx, r.db).
+		Where("model_id = ?", modelID).
+		Order("created_at DESC").
+		Find(&versions).Error; err != nil {
+		return nil, err
 	}
-	return nil
+	return versions, nil
 }
 
-// AddTags adds tags to a model
-func (r *GormModelRepository) AddTags(ctx context.Context, modelID string, tagNames []string) error {
-	// Get or create tags
-	var tags []model.Tag
-	for _, name := range tagNames {
-		var tag model.Tag
-		result := r.db.WithContext(ctx).Where("name = ?", name).FirstOrCreate(&tag, model.Tag{Name: name})
+// PromoteVersion makes a version the current one of its model by copying its
+// artifact fields onto the model
+func (r *GormModelRepository) PromoteVersion(ctx context.Context, modelID, version string) (*model.Model, error) {
+	var promoted *model.Model
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := loadModel(tx, modelID); err != nil {
+			return err
+		}
+
+		var v model.ModelVersion
+		if err := tx.Where("model_id = ? AND version = ?", modelID, version).First(&v).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrVersionNotFound
+			}
+			return err
+		}
+
+		result := tx.Model(&model.Model{ID: modelID}).Updates(map[string]interface{}{
+			"version":      v.Version,
+			"size":         v.Size,
+			"checksum":     v.Checksum,
+			"storage_path": v.StoragePath,
+			"docker_image": v.DockerImage,
+			"signature":    v.Signature,
+		})
 		if result.Error != nil {
-			return result.Error
+			return translateError(result.Error)
 		}
-		tags = append(tags, tag)
+
+		m, err := enqueueModelEvent(tx, model.EventVersionPromoted, modelID, model.ModelEventPayload{Version: &v})
+		if err != nil {
+			return err
+		}
+		promoted = m
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return promoted, nil
+}
+
+// SetVersionCard replaces the model card of a version of a live model
+func (r *GormModelRepository) SetVersionCard(ctx context.Context, modelID, version string, card *modelcard.Card) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := loadModel(tx, modelID); err != nil {
+			return err
+		}
 
-	// Associate tags with model
-	return r.db.WithContext(ctx).Model(&model.Model{ID: modelID}).Association("Tags").Append(tags)
+		result := tx.Model(&model.ModelVersion{}).
+			Where("model_id = ? AND version = ?", modelID, version).
+			Update("card", card)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrVersionNotFound
+		}
+
+		_, err := enqueueModelEvent(tx, model.EventModelUpdated, modelID, model.ModelEventPayload{})
+		return err
+	})
 }
 
-// RemoveTags removes tags from a model
-func (r *GormModelRepository) RemoveTags(ctx context.Context, modelID string, tagNames []string) error {
+// addTags gets or creates the named tags and associates them with a model
+func addTags(tx *gorm.DB, modelID string, tagNames []string) error {
+	if len(tagNames) == 0 {
+		return nil
+	}
+
 	var tags []model.Tag
-	if err := r.db.WithContext(ctx).Where("name IN ?", tagNames).Find(&tags).Error; err != nil {
-		return err
+	for _, name := range uniqueStrings(tagNames) {
+		var tag model.Tag
+		result := tx.Where("name = ?", name).FirstOrCreate(&tag, model.Tag{Name: name})
+		if result.Error != nil {
+			return result.Error
+		}
+		tags = append(tags, tag)
 	}
 
-	return r.db.WithContext(ctx).Model(&model.Model{ID: modelID}).Association("Tags").Delete(tags)
+	return tx.Model(&model.Model{ID: modelID}).Association("Tags").Append(tags)
 }
 
-// SetMetadata sets metadata for a model
-func (r *GormModelRepository) SetMetadata(ctx context.Context, modelID string, metadata map[string]string) error {
+// replaceMetadata swaps the metadata of a model for the given entries
+func replaceMetadata(tx *gorm.DB, modelID string, metadata map[string]string) error {
 	// Delete existing metadata
-	if err := r.db.WithContext(ctx).Where("model_id = ?", modelID).Delete(&model.Metadata{}).Error; err != nil {
+	if err := tx.Where("model_id = ?", modelID).Delete(&model.Metadata{}).Error; err != nil {
 		return err
 	}
 
@@ -259,25 +631,75 @@ func (r *GormModelRepository) SetMetadata(ctx context.Context, modelID string, m
 			Key:     key,
 			Value:   value,
 		}
-		if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		if err := tx.Create(m).Error; err != nil {
 			return err
 		}
 	}
-
 	return nil
 }
 
-// GetMetadata retrieves metadata for a model
-func (r *GormModelRepository) GetMetadata(ctx context.Context, modelID string) (map[string]string, error) {
-	var metadata []model.Metadata
-	if err := r.db.WithContext(ctx).Where("model_id = ?", modelID).Find(&metadata).Error; err != nil {
+// loadModel reads a live model with its tags and metadata inside a transaction
+func loadModel(tx *gorm.DB, id string) (*model.Model, error) {
+	var m model.Model
+	result := tx.Preload("Tags").Preload("Metadata").First(&m, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrModelNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &m, nil
+}
+
+// enqueueModelEvent reloads a model and writes an event carrying its new
+// state to the outbox, returning the reloaded model
+func enqueueModelEvent(tx *gorm.DB, eventType model.EventType, modelID string, payload model.ModelEventPayload) (*model.Model, error) {
+	m, err := loadModel(tx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	payload.Model = m
+	if err := enqueue(tx, eventType, m, payload); err != nil {
 		return nil, err
 	}
+	return m, nil
+}
 
-	result := make(map[string]string)
-	for _, m := range metadata {
-		result[m.Key] = m.Value
+// enqueue writes an event to the outbox within the caller's transaction
+func enqueue(tx *gorm.DB, eventType model.EventType, m *model.Model, payload model.ModelEventPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", eventType, err)
 	}
 
-	return result, nil
+	return tx.Create(&model.OutboxEvent{
+		EventID:    uuid.New().String(),
+		Type:       eventType,
+		ModelID:    m.ID,
+		TenantID:   m.TenantID,
+		Payload:    body,
+		OccurredAt: time.Now().UTC(),
+	}).Error
+}
+
+// uniqueStrings returns the distinct values of s in their original order
+func uniqueStrings(s []string) []string {
+	seen := make(map[string]struct{}, len(s))
+	result := make([]string, 0, len(s))
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// translateError maps driver-level constraint violations to repository errors
+func translateError(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrDuplicateModel
+	}
+	return err
 }
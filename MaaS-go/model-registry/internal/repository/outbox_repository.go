@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+// outboxLockKey is the advisory lock held by the relay that owns the outbox
+const outboxLockKey int64 = 0x6d6161735f6f7574
+
+// OutboxRepository defines access to the transactional event outbox
+type OutboxRepository interface {
+	// Exclusive runs fn while holding the outbox relay lock so only one
+	// registry instance relays at a time; it reports false without calling
+	// fn when another instance holds the lock
+	Exclusive(ctx context.Context, fn func(repo OutboxRepository) error) (bool, error)
+	// Pending returns unpublished events due at now in sequence order. Dead
+	// events are skipped; a model's events are held back from its first
+	// event that is not yet due, so each model's events stay in order
+	Pending(ctx context.Context, limit int, now time.Time) ([]*model.OutboxEvent, error)
+	// Claim holds events back until the given time while a relay publishes them
+	Claim(ctx context.Context, sequences []int64, until time.Time) error
+	// Release makes claimed events due again
+	Release(ctx context.Context, sequences []int64) error
+	MarkPublished(ctx context.Context, sequences []int64) error
+	// MarkFailed records a failed delivery attempt and holds the event back until retryAt
+	MarkFailed(ctx context.Context, sequence int64, reason string, retryAt time.Time) error
+	// MarkDead records a final failed attempt; the model's later events are relayed without it
+	MarkDead(ctx context.Context, sequence int64, reason string) error
+	// PrunePublished deletes events published or given up on before the given time
+	PrunePublished(ctx context.Context, before time.Time) (int64, error)
+
+	// Since returns events after a sequence plus the listed earlier sequences,
+	// in sequence order, regardless of their publish state
+	Since(ctx context.Context, after int64, include []int64, limit int) ([]*model.OutboxEvent, error)
+	// SequenceRange returns the lowest and highest retained sequence, or zeros when empty
+	SequenceRange(ctx context.Context) (oldest, latest int64, err error)
+}
+
+// GormOutboxRepository implements OutboxRepository using GORM
+type GormOutboxRepository struct {
+	db *gorm.DB
+}
+
+// NewGormOutboxRepository creates a new GORM outbox repository
+func NewGormOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &GormOutboxRepository{db: db}
+}
+
+// Exclusive runs fn in a transaction guarded by a transaction-scoped advisory lock on Postgres
+func (r *GormOutboxRepository) Exclusive(ctx context.Context, fn func(repo OutboxRepository) error) (bool, error) {
+	acquired := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() != "postgres" {
+			// SQLite serves a single registry instance, so there is no one to exclude
+			acquired = true
+		} else if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", outboxLockKey).Scan(&acquired).Error; err != nil {
+			return err
+		}
+		if !acquired {
+			return nil
+		}
+		return fn(&GormOutboxRepository{db: tx})
+	})
+	return acquired, err
+}
+
+// Pending returns unpublished events due at now, oldest first
+func (r *GormOutboxRepository) Pending(ctx context.Context, limit int, now time.Time) ([]*model.OutboxEvent, error) {
+	var events []*model.OutboxEvent
+	if err := r.db.WithContext(ctx).
+		Where("published_at IS NULL AND dead_at IS NULL").
+		Where(`NOT EXISTS (SELECT 1 FROM event_outbox held
+			WHERE held.model_id = event_outbox.model_id AND held.sequence <= event_outbox.sequence
+			AND held.published_at IS NULL AND held.dead_at IS NULL AND held.next_attempt_at > ?)`, now.UTC()).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Claim holds events back until the given time
+func (r *GormOutboxRepository) Claim(ctx context.Context, sequences []int64, until time.Time) error {
+	if len(sequences) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&model.OutboxEvent{}).
+		Where("sequence IN ?", sequences).
+		Update("next_attempt_at", until.UTC()).Error
+}
+
+// Release makes claimed events due again
+func (r *GormOutboxRepository) Release(ctx context.Context, sequences []int64) error {
+	if len(sequences) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&model.OutboxEvent{}).
+		Where("sequence IN ?", sequences).
+		Update("next_attempt_at", nil).Error
+}
+
+// MarkPublished flags events as delivered to the broker
+func (r *GormOutboxRepository) MarkPublished(ctx context.Context, sequences []int64) error {
+	if len(sequences) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&model.OutboxEvent{}).
+		Where("sequence IN ?", sequences).
+		Updates(map[string]interface{}{
+			"published_at":    time.Now().UTC(),
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_error":      "",
+			"next_attempt_at": nil,
+		}).Error
+}
+
+// MarkFailed records a failed delivery attempt
+func (r *GormOutboxRepository) MarkFailed(ctx context.Context, sequence int64, reason string, retryAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.OutboxEvent{}).
+		Where("sequence = ?", sequence).
+		Updates(map[string]interface{}{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_error":      reason,
+			"next_attempt_at": retryAt.UTC(),
+		}).Error
+}
+
+// MarkDead records a final failed delivery attempt
+func (r *GormOutboxRepository) MarkDead(ctx context.Context, sequence int64, reason string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.OutboxEvent{}).
+		Where("sequence = ?", sequence).
+		Updates(map[string]interface{}{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_error":      reason,
+			"next_attempt_at": nil,
+			"dead_at":         time.Now().UTC(),
+		}).Error
+}
+
+// PrunePublished deletes events published or given up on before the given time
+func (r *GormOutboxRepository) PrunePublished(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("published_at < ? OR dead_at < ?", before, before).
+		Delete(&model.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
+
+// Since returns events after a sequence plus the listed earlier sequences
+func (r *GormOutboxRepository) Since(ctx context.Context, after int64, include []int64, limit int) ([]*model.OutboxEvent, error) {
+	query := r.db.WithContext(ctx).Where("sequence > ?", after)
+	if len(include) > 0 {
+		query = r.db.WithContext(ctx).Where("sequence > ? OR sequence IN ?", after, include)
+	}
+
+	var events []*model.OutboxEvent
+	if err := query.Order("sequence ASC").Limit(limit).Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// SequenceRange returns the lowest and highest retained sequence
+func (r *GormOutboxRepository) SequenceRange(ctx context.Context) (int64, int64, error) {
+	var bounds struct {
+		Oldest int64
+		Latest int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&model.OutboxEvent{}).
+		Select("COALESCE(MIN(sequence), 0) AS oldest, COALESCE(MAX(sequence), 0) AS latest").
+		Scan(&bounds).Error
+	return bounds.Oldest, bounds.Latest, err
+}
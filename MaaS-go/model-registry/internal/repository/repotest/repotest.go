@@ -0,0 +1,841 @@
+// Package repotest holds the behavioral tests every repository backend must
+// pass, so Postgres, SQLite and the in-memory store stay interchangeable
+package repotest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/shared/modelcard"
+	"maas-platform/shared/signature"
+)
+
+// Repositories are the repositories a backend keeps models in, or reads them from
+type Repositories struct {
+	Models repository.ModelRepository
+	Outbox repository.OutboxRepository
+	// Stats and Billing must see the models written through Models
+	Stats   repository.StatsRepository
+	Billing repository.BillingRepository
+}
+
+// Backend opens fresh, empty repositories sharing one store
+type Backend func(t *testing.T) Repositories
+
+// Run runs the conformance suite against a backend
+func Run(t *testing.T, open Backend) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r Repositories)
+	}{
+		{"CreateAndGet", testCreateAndGet},
+		{"Duplicates", testDuplicates},
+		{"List", testList},
+		{"Update", testUpdate},
+		{"Trash", testTrash},
+		{"Tags", testTags},
+		{"Metadata", testMetadata},
+		{"Versions", testVersions},
+		{"Cards", testCards},
+		{"Outbox", testOutbox},
+		{"OutboxRetries", testOutboxRetries},
+		{"Stats", testStats},
+		{"BillingModels", testBillingModels},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, open(t))
+		})
+	}
+}
+
+func newModel(name, version, tenantID string) *model.Model {
+	return &model.Model{
+		Name:      name,
+		Version:   version,
+		Framework: model.FrameworkPyTorch,
+		OwnerID:   uuid.New().String(),
+		TenantID:  tenantID,
+	}
+}
+
+func mustCreate(t *testing.T, repo repository.ModelRepository, m *model.Model) *model.Model {
+	t.Helper()
+	if err := repo.Create(context.Background(), m); err != nil {
+		t.Fatalf("Create(%s:%s): %v", m.Name, m.Version, err)
+	}
+	return m
+}
+
+func tagNames(m *model.Model) []string {
+	names := make([]string, 0, len(m.Tags))
+	for _, tag := range m.Tags {
+		names = append(names, tag.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func modelNames(models []*model.Model) []string {
+	names := make([]string, 0, len(models))
+	for _, m := range models {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func testCreateAndGet(t *testing.T, r Repositories) {
+	repo := r.Models
+	ctx := context.Background()
+	m := newModel("resnet", "1.0.0", uuid.New().String())
+	m.Tags = []model.Tag{{Name: "vision"}, {Name: "cnn"}, {Name: "vision"}}
+	m.Metadata = []model.Metadata{{Key: "dataset", Value: "imagenet"}}
+	mustCreate(t, repo, m)
+
+	if m.ID == "" {
+		t.Fatal("Create did not assign an ID")
+	}
+	if m.Status != model.ModelStatusPending {
+		t.Errorf("status = %q, want %q", m.Status, model.ModelStatusPending)
+	}
+	if m.CreatedAt.IsZero() || m.UpdatedAt.IsZero() {
+		t.Error("Create did not set timestamps")
+	}
+
+	got, err := repo.GetByID(ctx, m.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got.Name != "resnet" || got.Version != "1.0.0" || got.TenantID != m.TenantID {
+		t.Errorf("GetByID = %s:%s tenant %s", got.Name, got.Version, got.TenantID)
+	}
+	if names := tagNames(got); !equal(names, []string{"cnn", "vision"}) {
+		t.Errorf("tags = %v, want [cnn vision]", names)
+	}
+	if len(got.Metadata) != 1 || got.Metadata[0].Key != "dataset" || got.Metadata[0].Value != "imagenet" {
+		t.Errorf("metadata = %+v", got.Metadata)
+	}
+
+	byName, err := repo.GetByNameAndVersion(ctx, "resnet", "1.0.0")
+	if err != nil {
+		t.Fatalf("GetByNameAndVersion: %v", err)
+	}
+	if byName.ID != m.ID {
+		t.Errorf("GetByNameAndVersion ID = %s, want %s", byName.ID, m.ID)
+	}
+
+	if _, err := repo.GetByID(ctx, uuid.New().String()); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("GetByID(unknown) error = %v, want ErrModelNotFound", err)
+	}
+	if _, err := repo.GetByNameAndVersion(ctx, "resnet", "9.9.9"); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("GetByNameAndVersion(unknown) error = %v, want ErrModelNotFound", err)
+	}
+}
+
+func testDuplicates(t *testing.T, r Repositories) {
+	repo := r.Models
+	ctx := context.Background()
+	tenant := uuid.New().String()
+	m := mustCreate(t, repo, newModel("bert", "1.0.0", tenant))
+
+	if err := repo.Create(ctx, newModel("bert", "1.0.0", tenant)); !errors.Is(err, repository.ErrDuplicateModel) {
+		t.Errorf("Create(duplicate) error = %v, want ErrDuplicateModel", err)
+	}
+
+	if err := repo.Delete(ctx, m.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := repo.Create(ctx, newModel("bert", "1.0.0", tenant)); !errors.Is(err, repository.ErrModelInTrash) {
+		t.Errorf("Create(trashed) error = %v, want ErrModelInTrash", err)
+	}
+
+	if err := repo.Purge(ctx, m.ID); err != nil {
+		t.Fatalf("Purge: %v", err)
+	}
+	mustCreate(t, repo, newModel("bert", "1.0.0", tenant))
+}
+
+func testList(t *testing.T, r Repositories) {
+	repo := r.Models
+	ctx := context.Background()
+	tenantA, tenantB := uuid.New().String(), uuid.New().String()
+	base := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	fixtures := []struct {
+		name      string
+		tenant    string
+		framework model.ModelFramework
+		public    bool
+		tags      []string
+	}{
+		{"alpha", tenantA, model.FrameworkPyTorch, false, []string{"nlp", "small"}},
+		{"beta", tenantA, model.FrameworkONNX, false, []string{"nlp"}},
+		{"gamma", tenantB, model.FrameworkPyTorch, true, []string{"vision", "small"}},
+		{"delta", tenantB, model.FrameworkONNX, false, nil},
+	}
+	for i, f := range fixtures {
+		m := newModel(f.name, "1.0.0", f.tenant)
+		m.Framework = f.framework
+		m.IsPublic = f.public
+		m.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		for _, tag := range f.tags {
+			m.Tags = append(m.Tags, model.Tag{Name: tag})
+		}
+		mustCreate(t, repo, m)
+	}
+
+	public := true
+	cases := []struct {
+		name   string
+		filter repository.ModelFilter
+		want   []string
+	}{
+		{"all newest first", repository.ModelFilter{}, []string{"delta", "gamma", "beta", "alpha"}},
+		{"name substring", repository.ModelFilter{Name: "lph"}, []string{"alpha"}},
+		{"framework", repository.ModelFilter{Framework: model.FrameworkONNX}, []string{"delta", "beta"}},
+		{"tenant", repository.ModelFilter{TenantID: tenantA}, []string{"beta", "alpha"}},
+		{"public", repository.ModelFilter{IsPublic: &public}, []string{"gamma"}},
+		{"visible to tenant", repository.ModelFilter{VisibleToTenant: tenantA}, []string{"gamma", "beta", "alpha"}},
+		{"single tag", repository.ModelFilter{Tags: []string{"small"}}, []string{"gamma", "alpha"}},
+		{"every tag", repository.ModelFilter{Tags: []string{"nlp", "small", "nlp"}}, []string{"alpha"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			models, total, err := repo.List(ctx, c.filter, repository.Pagination{Page: 1, Limit: 10})
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if got := modelNames(models); !equal(got, c.want) {
+				t.Errorf("List = %v, want %v", got, c.want)
+			}
+			if total != int64(len(c.want)) {
+				t.Errorf("total = %d, want %d", total, len(c.want))
+			}
+		})
+	}
+
+	models, total, err := repo.List(ctx, repository.ModelFilter{}, repository.Pagination{Page: 2, Limit: 3})
+	if err != nil {
+		t.Fatalf("List(page 2): %v", err)
+	}
+	if got := modelNames(models); !equal(got, []string{"alpha"}) || total != 4 {
+		t.Errorf("List(page 2) = %v total %d, want [alpha] total 4", got, total)
+	}
+	for _, m := range models {
+		if names := tagNames(m); !equal(names, []string{"nlp", "small"}) {
+			t.Errorf("listed tags = %v, want [nlp small]", names)
+		}
+	}
+}
+
+func testUpdate(t *testing.T, r Repositories) {
+	repo := r.Models
+	ctx := context.Background()
+	tenant := uuid.New().String()
+	m := mustCreate(t, repo, newModel("whisper", "1.0.0", tenant))
+	mustCreate(t, repo, newModel("whisper", "2.0.0", tenant))
+
+	m.Description = "speech recognition"
+	if err := repo.Update(ctx, m, repository.ModelChanges{}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	got, err := repo.GetByID(ctx, m.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got.Description != "speech recognition" {
+		t.Errorf("description = %q", got.Description)
+	}
+
+	// Tag and metadata changes land with the update as one event
+	before, err := r.Outbox.Pending(ctx, 100, time.Now())
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	m.Tags = got.Tags
+	changes := repository.ModelChanges{Tags: []string{"asr", "audio"}, Metadata: map[string]string{"lang": "en"}}
+	if err := repo.Update(ctx, m, changes); err != nil {
+		t.Fatalf("Update(tags, metadata): %v", err)
+	}
+	got, err = repo.GetByID(ctx, m.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if names := tagNames(got); !equal(names, []string{"asr", "audio"}) {
+		t.Errorf("tags after update = %v, want [asr audio]", names)
+	}
+	if md, _ := repo.GetMetadata(ctx, m.ID); len(md) != 1 || md["lang"] != "en" {
+		t.Errorf("metadata after update = %v", md)
+	}
+	after, err := r.Outbox.Pending(ctx, 100, time.Now())
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	if n := len(after) - len(before); n != 1 {
+		t.Errorf("update wrote %d events, want 1", n)
+	}
+
+	m.Version = "2.0.0"
+	if err := repo.Update(ctx, m, repository.ModelChanges{}); !errors.Is(err, repository.ErrDuplicateModel) {
+		t.Errorf("Update(clashing version) error = %v, want ErrDuplicateModel", err)
+	}
+
+	if err := repo.UpdateStatus(ctx, m.ID, model.ModelStatusReady); err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+	if got, _ := repo.GetByID(ctx, m.ID); got == nil || got.Status != model.ModelStatusReady {
+		t.Errorf("status after UpdateStatus = %v", got)
+	}
+	if err := repo.UpdateStatus(ctx, uuid.New().String(), model.ModelStatusReady); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("UpdateStatus(unknown) error = %v, want ErrModelNotFound", err)
+	}
+}
+
+func testTrash(t *testing.T, r Repositories) {
+	repo := r.Models
+	ctx := context.Background()
+	tenant := uuid.New().String()
+	first := mustCreate(t, repo, newModel("llama", "1.0.0", tenant))
+	second := mustCreate(t, repo, newModel("llama", "2.0.0", tenant))
+	if err := repo.AddTags(ctx, first.ID, []string{"llm"}); err != nil {
+		t.Fatalf("AddTags: %v", err)
+	}
+	if err := repo.CreateVersion(ctx, &model.ModelVersion{
+		ModelID: first.ID, Version: "1.0.1", Status: model.ModelStatusReady, CreatedBy: first.OwnerID,
+	}); err != nil {
+		t.Fatalf("CreateVersion: %v", err)
+	}
+	if err := repo.SetMetadata(ctx, first.ID, map[string]string{"license": "custom"}); err != nil {
+		t.Fatalf("SetMetadata: %v", err)
+	}
+
+	if err := repo.Delete(ctx, first.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := repo.Delete(ctx, first.ID); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("Delete(trashed) error = %v, want ErrModelNotFound", err)
+	}
+	if _, err := repo.GetByID(ctx, first.ID); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("GetByID(trashed) error = %v, want ErrModelNotFound", err)
+	}
+	if _, err := repo.GetDeletedByID(ctx, second.ID); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("GetDeletedByID(live) error = %v, want ErrModelNotFound", err)
+	}
+
+	deleted, err := repo.GetDeletedByID(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("GetDeletedByID: %v", err)
+	}
+	if !deleted.DeletedAt.Valid {
+		t.Error("trashed model has no deletion time")
+	}
+	if names := tagNames(deleted); !equal(names, []string{"llm"}) {
+		t.Errorf("trashed tags = %v, want [llm]", names)
+	}
+	if len(deleted.Versions) != 1 || deleted.Versions[0].Version != "1.0.1" {
+		t.Errorf("trashed versions = %+v", deleted.Versions)
+	}
+
+	if err := repo.Delete(ctx, second.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	trashed, total, err := repo.ListDeleted(ctx, repository.ModelFilter{}, repository.Pagination{Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListDeleted: %v", err)
+	}
+	if total != 2 || len(trashed) != 2 || trashed[0].ID != first.ID || trashed[1].ID != second.ID {
+		t.Errorf("ListDeleted = %v total %d, want the first deletion first", modelNames(trashed), total)
+	}
+	if _, total, _ := repo.List(ctx, repository.ModelFilter{TenantID: tenant}, repository.Pagination{}); total != 0 {
+		t.Errorf("List counts %d trashed models", total)
+	}
+	expired, total, err := repo.ListDeleted(ctx, repository.ModelFilter{DeletedBefore: time.Now().Add(-time.Hour)}, repository.Pagination{})
+	if err != nil || total != 0 || len(expired) != 0 {
+		t.Errorf("ListDeleted(DeletedBefore an hour ago) = %d models, err %v", total, err)
+	}
+
+	if err := repo.Restore(ctx, second.ID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if err := repo.Restore(ctx, second.ID); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("Restore(live) error = %v, want ErrModelNotFound", err)
+	}
+	if _, err := repo.GetByID(ctx, second.ID); err != nil {
+		t.Errorf("GetByID(restored): %v", err)
+	}
+
+	if err := repo.Purge(ctx, second.ID); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("Purge(live) error = %v, want ErrModelNotFound", err)
+	}
+	if err := repo.Purge(ctx, first.ID); err != nil {
+		t.Fatalf("Purge: %v", err)
+	}
+	if _, err := repo.GetDeletedByID(ctx, first.ID); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("GetDeletedByID(purged) error = %v, want ErrModelNotFound", err)
+	}
+	if versions, err := repo.ListVersions(ctx, first.ID); err != nil || len(versions) != 0 {
+		t.Errorf("ListVersions(purged) = %d versions, err %v", len(versions), err)
+	}
+	if metadata, err := repo.GetMetadata(ctx, first.ID); err != nil || len(metadata) != 0 {
+		t.Errorf("GetMetadata(purged) = %v, err %v", metadata, err)
+	}
+}
+
+func testTags(t *testing.T, r Repositories) {
+	repo := r.Models
+	ctx := context.Background()
+	m := mustCreate(t, repo, newModel("t5", "1.0.0", uuid.New().String()))
+
+	if err := repo.AddTags(ctx, m.ID, []string{"nlp", "seq2seq"}); err != nil {
+		t.Fatalf("AddTags: %v", err)
+	}
+	if err := repo.AddTags(ctx, m.ID, []string{"nlp", "google"}); err != nil {
+		t.Fatalf("AddTags(again): %v", err)
+	}
+	got, _ := repo.GetByID(ctx, m.ID)
+	if names := tagNames(got); !equal(names, []string{"google", "nlp", "seq2seq"}) {
+		t.Errorf("tags = %v, want [google nlp seq2seq]", names)
+	}
+
+	if err := repo.RemoveTags(ctx, m.ID, []string{"seq2seq", "unknown"}); err != nil {
+		t.Fatalf("RemoveTags: %v", err)
+	}
+	got, _ = repo.GetByID(ctx, m.ID)
+	if names := tagNames(got); !equal(names, []string{"google", "nlp"}) {
+		t.Errorf("tags after RemoveTags = %v, want [google nlp]", names)
+	}
+
+	if err := repo.AddTags(ctx, uuid.New().String(), nil); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("AddTags(unknown) error = %v, want ErrModelNotFound", err)
+	}
+}
+
+func testMetadata(t *testing.T, r Repositories) {
+	repo := r.Models
+	ctx := context.Background()
+	m := newModel("gpt2", "1.0.0", uuid.New().String())
+	m.Metadata = []model.Metadata{{Key: "license", Value: "mit"}}
+	mustCreate(t, repo, m)
+
+	if err := repo.SetMetadata(ctx, m.ID, map[string]string{"params": "124M", "context": "1024"}); err != nil {
+		t.Fatalf("SetMetadata: %v", err)
+	}
+	metadata, err := repo.GetMetadata(ctx, m.ID)
+	if err != nil {
+		t.Fatalf("GetMetadata: %v", err)
+	}
+	if len(metadata) != 2 || metadata["params"] != "124M" || metadata["context"] != "1024" {
+		t.Errorf("metadata = %v, want the replacement set", metadata)
+	}
+
+	unknown, err := repo.GetMetadata(ctx, uuid.New().String())
+	if err != nil || unknown == nil || len(unknown) != 0 {
+		t.Errorf("GetMetadata(unknown) = %v, err %v, want an empty map", unknown, err)
+	}
+}
+
+func testVersions(t *testing.T, r Repositories) {
+	repo := r.Models
+	ctx := context.Background()
+	tenant := uuid.New().String()
+	m := mustCreate(t, repo, newModel("mistral", "1.0.0", tenant))
+	mustCreate(t, repo, newModel("mistral", "3.0.0", tenant))
+	base := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	sig := &signature.Signature{
+		Inputs:  []signature.TensorSpec{{Name: "tokens", DType: signature.Int64, Shape: []int64{signature.Dynamic, 512}}},
+		Outputs: []signature.TensorSpec{{Name: "logits", DType: signature.Float32, Shape: []int64{signature.Dynamic, 32000}}},
+	}
+	for i, version := range []string{"2.0.0", "3.0.0"} {
+		v := &model.ModelVersion{
+			ModelID:     m.ID,
+			Version:     version,
+			Status:      model.ModelStatusReady,
+			Size:        int64(100 * (i + 1)),
+			Checksum:    "sum-" + version,
+			StoragePath: "/models/mistral/" + version,
+			CreatedBy:   m.OwnerID,
+			CreatedAt:   base.Add(time.Duration(i) * time.Minute),
+		}
+		if version == "2.0.0" {
+			v.Signature = sig
+		}
+		if err := repo.CreateVersion(ctx, v); err != nil {
+			t.Fatalf("CreateVersion(%s): %v", version, err)
+		}
+		if v.ID == "" {
+			t.Error("CreateVersion did not assign an ID")
+		}
+	}
+
+	dup := &model.ModelVersion{ModelID: m.ID, Version: "2.0.0", Status: model.ModelStatusReady, CreatedBy: m.OwnerID}
+	if err := repo.CreateVersion(ctx, dup); !errors.Is(err, repository.ErrDuplicateVersion) {
+		t.Errorf("CreateVersion(duplicate) error = %v, want ErrDuplicateVersion", err)
+	}
+	orphan := &model.ModelVersion{ModelID: uuid.New().String(), Version: "1.0.0", Status: model.ModelStatusReady, CreatedBy: m.OwnerID}
+	if err := repo.CreateVersion(ctx, orphan); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("CreateVersion(unknown model) error = %v, want ErrModelNotFound", err)
+	}
+
+	versions, err := repo.ListVersions(ctx, m.ID)
+	if err != nil {
+		t.Fatalf("ListVersions: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != "3.0.0" || versions[1].Version != "2.0.0" {
+		t.Errorf("ListVersions returned %d versions, want newest first", len(versions))
+	}
+
+	v, err := repo.GetVersion(ctx, m.ID, "2.0.0")
+	if err != nil {
+		t.Fatalf("GetVersion: %v", err)
+	}
+	if v.Checksum != "sum-2.0.0" || v.Size != 100 {
+		t.Errorf("GetVersion = %+v", v)
+	}
+	if !reflect.DeepEqual(v.Signature, sig) {
+		t.Errorf("GetVersion signature = %+v, want %+v", v.Signature, sig)
+	}
+	if v, _ := repo.GetVersion(ctx, m.ID, "3.0.0"); v == nil || v.Signature != nil {
+		t.Errorf("GetVersion(unsigned) signature = %+v, want nil", v)
+	}
+	if current, _ := repo.GetByID(ctx, m.ID); current == nil || current.Signature != nil {
+		t.Errorf("unsigned model signature = %+v, want nil", current)
+	}
+	if _, err := repo.GetVersion(ctx, m.ID, "9.9.9"); !errors.Is(err, repository.ErrVersionNotFound) {
+		t.Errorf("GetVersion(unknown) error = %v, want ErrVersionNotFound", err)
+	}
+
+	promoted, err := repo.PromoteVersion(ctx, m.ID, "2.0.0")
+	if err != nil {
+		t.Fatalf("PromoteVersion: %v", err)
+	}
+	if promoted.Version != "2.0.0" || promoted.Size != 100 || promoted.StoragePath != "/models/mistral/2.0.0" {
+		t.Errorf("PromoteVersion = %s size %d path %s", promoted.Version, promoted.Size, promoted.StoragePath)
+	}
+	if !reflect.DeepEqual(promoted.Signature, sig) {
+		t.Errorf("promoted signature = %+v, want %+v", promoted.Signature, sig)
+	}
+	if _, err := repo.PromoteVersion(ctx, m.ID, "3.0.0"); !errors.Is(err, repository.ErrDuplicateModel) {
+		t.Errorf("PromoteVersion(clashing version) error = %v, want ErrDuplicateModel", err)
+	}
+	if _, err := repo.PromoteVersion(ctx, m.ID, "9.9.9"); !errors.Is(err, repository.ErrVersionNotFound) {
+		t.Errorf("PromoteVersion(unknown) error = %v, want ErrVersionNotFound", err)
+	}
+}
+
+func testCards(t *testing.T, r Repositories) {
+	repo := r.Models
+	ctx := context.Background()
+	m := mustCreate(t, repo, newModel("whisper", "1.0.0", uuid.New().String()))
+	v := &model.ModelVersion{ModelID: m.ID, Version: "1.1.0", Status: model.ModelStatusReady, CreatedBy: m.OwnerID}
+	if err := repo.CreateVersion(ctx, v); err != nil {
+		t.Fatalf("CreateVersion: %v", err)
+	}
+
+	updatedAt := time.Now().UTC().Truncate(time.Second)
+	card := &modelcard.Card{
+		IntendedUse:  "Transcribing English speech",
+		Limitations:  []string{"Accuracy drops on accented speech"},
+		TrainingData: []modelcard.DataReference{{Name: "LibriSpeech", URI: "https://www.openslr.org/12"}},
+		Metrics:      []modelcard.Metric{{Name: "wer", Value: 0.042, Dataset: "test-clean"}},
+		License:      "MIT",
+		Contact:      "speech@example.com",
+		UpdatedBy:    m.OwnerID,
+		UpdatedAt:    &updatedAt,
+	}
+	if err := repo.SetVersionCard(ctx, m.ID, "1.1.0", card); err != nil {
+		t.Fatalf("SetVersionCard: %v", err)
+	}
+	got, err := repo.GetVersion(ctx, m.ID, "1.1.0")
+	if err != nil {
+		t.Fatalf("GetVersion: %v", err)
+	}
+	if got.Card == nil || got.Card.UpdatedAt == nil || !got.Card.UpdatedAt.Equal(updatedAt) {
+		t.Fatalf("GetVersion card = %+v, want updated at %s", got.Card, updatedAt)
+	}
+	got.Card.UpdatedAt = card.UpdatedAt
+	if !reflect.DeepEqual(got.Card, card) {
+		t.Errorf("GetVersion card = %+v, want %+v", got.Card, card)
+	}
+
+	if err := repo.SetVersionCard(ctx, m.ID, "9.9.9", card); !errors.Is(err, repository.ErrVersionNotFound) {
+		t.Errorf("SetVersionCard(unknown version) error = %v, want ErrVersionNotFound", err)
+	}
+	if err := repo.SetVersionCard(ctx, uuid.New().String(), "1.1.0", card); !errors.Is(err, repository.ErrModelNotFound) {
+		t.Errorf("SetVersionCard(unknown model) error = %v, want ErrModelNotFound", err)
+	}
+}
+
+func testOutbox(t *testing.T, r Repositories) {
+	repo, outbox := r.Models, r.Outbox
+	ctx := context.Background()
+	m := mustCreate(t, repo, newModel("yolo", "1.0.0", uuid.New().String()))
+	if err := repo.UpdateStatus(ctx, m.ID, model.ModelStatusReady); err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+	if err := repo.Delete(ctx, m.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	pending, err := outbox.Pending(ctx, 10, time.Now())
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	want := []model.EventType{model.EventModelCreated, model.EventModelStatusChanged, model.EventModelDeleted}
+	if len(pending) != len(want) {
+		t.Fatalf("Pending returned %d events, want %d", len(pending), len(want))
+	}
+	for i, e := range pending {
+		if e.Type != want[i] || e.ModelID != m.ID || e.TenantID != m.TenantID {
+			t.Errorf("event %d = %s for %s, want %s for %s", i, e.Type, e.ModelID, want[i], m.ID)
+		}
+		if i > 0 && e.Sequence <= pending[i-1].Sequence {
+			t.Errorf("event %d sequence %d does not follow %d", i, e.Sequence, pending[i-1].Sequence)
+		}
+	}
+
+	var payload model.ModelEventPayload
+	if err := json.Unmarshal(pending[1].Payload, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.PreviousStatus != model.ModelStatusPending || payload.Model == nil || payload.Model.Status != model.ModelStatusReady {
+		t.Errorf("status change payload = %+v", payload)
+	}
+
+	first, last, err := outbox.SequenceRange(ctx)
+	if err != nil {
+		t.Fatalf("SequenceRange: %v", err)
+	}
+	if first != pending[0].Sequence || last != pending[2].Sequence {
+		t.Errorf("SequenceRange = %d..%d, want %d..%d", first, last, pending[0].Sequence, pending[2].Sequence)
+	}
+
+	ran, err := outbox.Exclusive(ctx, func(locked repository.OutboxRepository) error {
+		if err := locked.MarkPublished(ctx, []int64{pending[0].Sequence}); err != nil {
+			return err
+		}
+		return locked.MarkFailed(ctx, pending[1].Sequence, "broker down", time.Now().Add(-time.Second))
+	})
+	if err != nil || !ran {
+		t.Fatalf("Exclusive = %v, %v", ran, err)
+	}
+
+	pending, err = outbox.Pending(ctx, 10, time.Now())
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	if len(pending) != 2 || pending[0].Attempts != 1 || pending[0].LastError != "broker down" {
+		t.Errorf("Pending after a publish and a failure = %+v", pending)
+	}
+	if limited, _ := outbox.Pending(ctx, 1, time.Now()); len(limited) != 1 {
+		t.Errorf("Pending(1) returned %d events", len(limited))
+	}
+
+	since, err := outbox.Since(ctx, pending[1].Sequence, []int64{first}, 10)
+	if err != nil {
+		t.Fatalf("Since: %v", err)
+	}
+	if len(since) != 1 || since[0].Sequence != first || since[0].PublishedAt == nil {
+		t.Errorf("Since returned %d events, want the included published one", len(since))
+	}
+
+	pruned, err := outbox.PrunePublished(ctx, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("PrunePublished: %v", err)
+	}
+	if pruned != 1 {
+		t.Errorf("PrunePublished = %d, want 1", pruned)
+	}
+	if first, _, _ := outbox.SequenceRange(ctx); first != pending[0].Sequence {
+		t.Errorf("SequenceRange starts at %d after pruning, want %d", first, pending[0].Sequence)
+	}
+}
+
+func testStats(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	tenant := uuid.New().String()
+	for _, version := range []string{"1.0.0", "2.0.0"} {
+		mustCreate(t, r.Models, newModel("bert", version, tenant))
+	}
+	ready := mustCreate(t, r.Models, newModel("bert", "3.0.0", tenant))
+	if err := r.Models.UpdateStatus(ctx, ready.ID, model.ModelStatusReady); err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+	trashed := mustCreate(t, r.Models, newModel("bert", "4.0.0", tenant))
+	if err := r.Models.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	counts, err := r.Stats.ModelCounts(ctx)
+	if err != nil {
+		t.Fatalf("ModelCounts: %v", err)
+	}
+	got := make(map[model.ModelStatus]int64)
+	for _, c := range counts {
+		if c.Framework != model.FrameworkPyTorch {
+			t.Errorf("ModelCounts framework = %s, want %s", c.Framework, model.FrameworkPyTorch)
+		}
+		got[c.Status] += c.Count
+	}
+	want := map[model.ModelStatus]int64{model.ModelStatusPending: 2, model.ModelStatusReady: 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ModelCounts = %v, want %v", got, want)
+	}
+
+	pending, err := r.Outbox.Pending(ctx, 100, time.Now())
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	unpublished, err := r.Stats.UnpublishedEvents(ctx)
+	if err != nil {
+		t.Fatalf("UnpublishedEvents: %v", err)
+	}
+	if unpublished != int64(len(pending)) || unpublished == 0 {
+		t.Errorf("UnpublishedEvents = %d, want %d", unpublished, len(pending))
+	}
+}
+
+func testBillingModels(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	tenant := uuid.New().String()
+	live := mustCreate(t, r.Models, newModel("whisper", "1.0.0", tenant))
+	if err := r.Models.CreateVersion(ctx, &model.ModelVersion{
+		ModelID: live.ID, Version: "1.0.1", Status: model.ModelStatusReady, Size: 1 << 30, CreatedBy: live.OwnerID,
+	}); err != nil {
+		t.Fatalf("CreateVersion: %v", err)
+	}
+	trashed := mustCreate(t, r.Models, newModel("whisper", "2.0.0", tenant))
+	if err := r.Models.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	mustCreate(t, r.Models, newModel("whisper", "3.0.0", uuid.New().String()))
+
+	now := time.Now()
+	models, err := r.Billing.TenantModels(ctx, tenant, now.Add(-time.Hour), now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("TenantModels: %v", err)
+	}
+	wantIDs := []string{live.ID, trashed.ID}
+	sort.Strings(wantIDs)
+	gotIDs := make([]string, 0, len(models))
+	for _, m := range models {
+		gotIDs = append(gotIDs, m.ID)
+		if m.ID == live.ID && (len(m.Versions) != 1 || m.Versions[0].Size != 1<<30) {
+			t.Errorf("TenantModels versions of %s = %+v, want the 1 GiB version", m.ID, m.Versions)
+		}
+	}
+	if !equal(gotIDs, wantIDs) {
+		t.Errorf("TenantModels = %v, want %v in ID order", gotIDs, wantIDs)
+	}
+
+	// Models trashed before the period and created after it are not billed
+	later, err := r.Billing.TenantModels(ctx, tenant, now.Add(time.Hour), now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("TenantModels(later): %v", err)
+	}
+	if len(later) != 1 || later[0].ID != live.ID {
+		t.Errorf("TenantModels(later) = %v, want only %s", modelNames(later), live.ID)
+	}
+	earlier, err := r.Billing.TenantModels(ctx, tenant, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("TenantModels(earlier): %v", err)
+	}
+	if len(earlier) != 0 {
+		t.Errorf("TenantModels(earlier) = %v, want none", modelNames(earlier))
+	}
+}
+
+func testOutboxRetries(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	tenant := uuid.New().String()
+	a := mustCreate(t, r.Models, newModel("resnet", "1.0.0", tenant))
+	if err := r.Models.UpdateStatus(ctx, a.ID, model.ModelStatusReady); err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+	b := mustCreate(t, r.Models, newModel("resnet", "2.0.0", tenant))
+
+	now := time.Now()
+	pending, err := r.Outbox.Pending(ctx, 10, now)
+	if err != nil || len(pending) != 3 {
+		t.Fatalf("Pending = %d events, %v; want 3", len(pending), err)
+	}
+	a1, a2, b1 := pending[0], pending[1], pending[2]
+	if a1.ModelID != a.ID || a2.ModelID != a.ID || b1.ModelID != b.ID {
+		t.Fatalf("Pending returned events of %s, %s, %s", a1.ModelID, a2.ModelID, b1.ModelID)
+	}
+
+	due := func(at time.Time, want ...*model.OutboxEvent) {
+		t.Helper()
+		got, err := r.Outbox.Pending(ctx, 10, at)
+		if err != nil {
+			t.Fatalf("Pending: %v", err)
+		}
+		var gotSeqs, wantSeqs []int64
+		for _, e := range got {
+			gotSeqs = append(gotSeqs, e.Sequence)
+		}
+		for _, e := range want {
+			wantSeqs = append(wantSeqs, e.Sequence)
+		}
+		if !reflect.DeepEqual(gotSeqs, wantSeqs) {
+			t.Errorf("Pending(%s) = %v, want %v", at.Sub(now), gotSeqs, wantSeqs)
+		}
+	}
+
+	// A failed event holds back the later events of its model, not of others
+	if err := r.Outbox.MarkFailed(ctx, a1.Sequence, "rejected", now.Add(time.Hour)); err != nil {
+		t.Fatalf("MarkFailed: %v", err)
+	}
+	due(now, b1)
+	due(now.Add(2*time.Hour), a1, a2, b1)
+
+	// A claimed event is held back until released
+	if err := r.Outbox.Claim(ctx, []int64{b1.Sequence}, now.Add(time.Minute)); err != nil {
+		t.Fatalf("Claim: %v", err)
+	}
+	due(now)
+	if err := r.Outbox.Release(ctx, []int64{b1.Sequence}); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	due(now, b1)
+
+	// A dead event no longer holds back its model
+	if err := r.Outbox.MarkDead(ctx, a1.Sequence, "rejected for good"); err != nil {
+		t.Fatalf("MarkDead: %v", err)
+	}
+	due(now, a2, b1)
+	since, err := r.Outbox.Since(ctx, 0, nil, 10)
+	if err != nil || len(since) != 3 {
+		t.Fatalf("Since = %d events, %v; want 3", len(since), err)
+	}
+	if dead := since[0]; dead.DeadAt == nil || dead.Attempts != 2 || dead.LastError != "rejected for good" {
+		t.Errorf("dead event = %+v", dead)
+	}
+
+	// Dead events are pruned like published ones
+	pruned, err := r.Outbox.PrunePublished(ctx, time.Now().Add(time.Minute))
+	if err != nil || pruned != 1 {
+		t.Errorf("PrunePublished = %d, %v; want 1", pruned, err)
+	}
+}
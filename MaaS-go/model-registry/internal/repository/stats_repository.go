@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+// StatsRepository computes the aggregate figures behind the business metrics
+type StatsRepository interface {
+	// ModelCounts counts live models by status and framework
+	ModelCounts(ctx context.Context) ([]*ModelCount, error)
+	// UnpublishedEvents counts outbox events not yet relayed to the broker
+	UnpublishedEvents(ctx context.Context) (int64, error)
+	// DeliveryCounts counts webhook deliveries by status, except succeeded ones
+	DeliveryCounts(ctx context.Context) (map[model.DeliveryStatus]int64, error)
+}
+
+// ModelCount is the number of models with one status and framework
+type ModelCount struct {
+	Status    model.ModelStatus
+	Framework model.ModelFramework
+	Count     int64
+}
+
+// GormStatsRepository implements StatsRepository using GORM
+type GormStatsRepository struct {
+	db *gorm.DB
+}
+
+// NewGormStatsRepository creates a new GORM stats repository
+func NewGormStatsRepository(db *gorm.DB) StatsRepository {
+	return &GormStatsRepository{db: db}
+}
+
+// ModelCounts groups live models by status and framework
+func (r *GormStatsRepository) ModelCounts(ctx context.Context) ([]*ModelCount, error) {
+	var counts []*ModelCount
+	result := r.db.WithContext(ctx).Model(&model.Model{}).
+		Select("status, framework, COUNT(*) AS count").
+		Group("status, framework").
+		Scan(&counts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return counts, nil
+}
+
+// UnpublishedEvents counts outbox events without a publish time
+func (r *GormStatsRepository) UnpublishedEvents(ctx context.Context) (int64, error) {
+	var n int64
+	err := r.db.WithContext(ctx).Model(&model.OutboxEvent{}).Where("published_at IS NULL").Count(&n).Error
+	return n, err
+}
+
+// DeliveryCounts groups unfinished and dead-lettered webhook deliveries by status
+func (r *GormStatsRepository) DeliveryCounts(ctx context.Context) (map[model.DeliveryStatus]int64, error) {
+	var rows []struct {
+		Status model.DeliveryStatus
+		Count  int64
+	}
+	result := r.db.WithContext(ctx).Model(&model.WebhookDelivery{}).
+		Select("status, COUNT(*) AS count").
+		Where("status <> ?", model.DeliverySucceeded).
+		Group("status").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	counts := make(map[model.DeliveryStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
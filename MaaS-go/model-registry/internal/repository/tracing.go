@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// tracingSpanKey stores the span of a statement between its callbacks
+const tracingSpanKey = "otel:span"
+
+// TracingPlugin records a client span for every GORM statement
+type TracingPlugin struct {
+	tracer trace.Tracer
+}
+
+// NewTracingPlugin creates a GORM plugin tracing queries with the global tracer provider
+func NewTracingPlugin() *TracingPlugin {
+	return &TracingPlugin{tracer: otel.Tracer("model-registry/gorm")}
+}
+
+// Name implements gorm.Plugin
+func (p *TracingPlugin) Name() string {
+	return "otel-tracing"
+}
+
+// Initialize registers the span callbacks around each GORM processor
+func (p *TracingPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	type register func(name string, fn func(*gorm.DB)) error
+	hooks := []struct {
+		op            string
+		before, after register
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"select", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+	for _, h := range hooks {
+		if err := h.before("otel:before_"+h.op, p.before(h.op)); err != nil {
+			return err
+		}
+		if err := h.after("otel:after_"+h.op, p.after); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// before starts the span of a statement
+func (p *TracingPlugin) before(op string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx := db.Statement.Context
+		if ctx == nil || !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+			// Queries outside a traced request would only produce orphan root spans
+			return
+		}
+		name := "gorm." + op
+		if table := db.Statement.Table; table != "" {
+			name += " " + table
+		}
+		system := semconv.DBSystemNamePostgreSQL
+		if db.Dialector.Name() == "sqlite" {
+			system = semconv.DBSystemNameSQLite
+		}
+		ctx, span := p.tracer.Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(system, semconv.DBOperationName(op)),
+		)
+		db.Statement.Context = ctx
+		db.InstanceSet(tracingSpanKey, span)
+	}
+}
+
+// after ends the span of a statement with its SQL, table and outcome
+func (p *TracingPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	if table := db.Statement.Table; table != "" {
+		span.SetAttributes(semconv.DBCollectionName(table))
+	}
+	if sql := db.Statement.SQL.String(); sql != "" {
+		// Bound variables are left out so that no user data reaches the trace backend
+		span.SetAttributes(semconv.DBQueryText(sql))
+	}
+	span.SetAttributes(attribute.Int64("db.rows_affected", db.Statement.RowsAffected))
+	if err := db.Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Transactor runs work spanning several repositories in one transaction.
+// GORM reads, the Purge and DeleteModel methods and lineage writes join it;
+// other writes do not yet.
+type Transactor interface {
+	// InTransaction calls fn with a context whose joining repository writes
+	// share one transaction, committed when fn returns nil and rolled back
+	// otherwise. Called within a transaction, fn joins it.
+	InTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// txKey carries the transaction repository writes join
+type txKey struct{}
+
+// GormTransactor implements Transactor using GORM
+type GormTransactor struct {
+	db *gorm.DB
+}
+
+// NewGormTransactor creates a transactor over the database the GORM repositories use
+func NewGormTransactor(db *gorm.DB) Transactor {
+	return &GormTransactor{db: db}
+}
+
+// InTransaction runs fn in a database transaction
+func (t *GormTransactor) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// writer returns db for a write, joined to the transaction ctx carries, if any
+func writer(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
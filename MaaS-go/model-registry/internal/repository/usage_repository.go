@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+// Usage grouping dimensions
+const (
+	UsageByHour    = "hour"
+	UsageByDay     = "day"
+	UsageByMonth   = "month"
+	UsageByTenant  = "tenant"
+	UsageByModel   = "model"
+	UsageByVersion = "version"
+	UsageByStatus  = "status"
+)
+
+// usageDimensions maps grouping dimensions to the expressions they select
+var usageDimensions = map[string]struct{ expr, alias string }{
+	UsageByHour:    {"hour_start", "period_start"},
+	UsageByDay:     {"date_trunc('day', hour_start)", "period_start"},
+	UsageByMonth:   {"date_trunc('month', hour_start)", "period_start"},
+	UsageByTenant:  {"tenant_id", "tenant_id"},
+	UsageByModel:   {"model_id", "model_id"},
+	UsageByVersion: {"version", "version"},
+	UsageByStatus:  {"status", "status"},
+}
+
+// sqliteUsagePeriods replaces date_trunc on SQLite, which returns the period as
+// text that is parsed after scanning
+var sqliteUsagePeriods = map[string]struct{ expr, alias string }{
+	UsageByDay:   {"strftime('%Y-%m-%d 00:00:00', hour_start)", "period_text"},
+	UsageByMonth: {"strftime('%Y-%m-01 00:00:00', hour_start)", "period_text"},
+}
+
+// sqlitePeriodLayout is the layout of the SQLite period text
+const sqlitePeriodLayout = "2006-01-02 15:04:05"
+
+// ErrDuplicateUsageEvent is returned when an event was recorded concurrently
+var ErrDuplicateUsageEvent = errors.New("usage event already recorded")
+
+// UsageRepository defines access to hourly usage rollups
+type UsageRepository interface {
+	// RecordedEvents returns which of the event IDs have been recorded already
+	RecordedEvents(ctx context.Context, eventIDs []string) (map[string]bool, error)
+	// Add merges rollups into the stored ones, summing counters per key, and
+	// records the events they were folded from in the same transaction
+	Add(ctx context.Context, events []*model.UsageEvent, rollups []*model.UsageRollup) error
+	// PruneEvents forgets events recorded before the cutoff
+	PruneEvents(ctx context.Context, before time.Time) (int64, error)
+	Summarize(ctx context.Context, filter UsageFilter, groupBy []string) ([]*UsageSummary, error)
+}
+
+// UsageFilter defines filter criteria for summarizing usage
+type UsageFilter struct {
+	TenantID string
+	ModelID  string
+	From     time.Time
+	To       time.Time
+}
+
+// UsageSummary aggregates rollups over one group; dimensions not grouped by are empty
+type UsageSummary struct {
+	PeriodStart      *time.Time
+	TenantID         string
+	ModelID          string
+	Version          string
+	Status           model.UsageStatus
+	Requests         int64
+	TotalLatencyMs   int64
+	MaxLatencyMs     int64
+	RequestBytes     int64
+	ResponseBytes    int64
+	PromptTokens     int64
+	CompletionTokens int64
+}
+
+// GormUsageRepository implements UsageRepository using GORM
+type GormUsageRepository struct {
+	db *gorm.DB
+}
+
+// NewGormUsageRepository creates a new GORM usage repository
+func NewGormUsageRepository(db *gorm.DB) UsageRepository {
+	return &GormUsageRepository{db: db}
+}
+
+// RecordedEvents returns which of the event IDs have been recorded already
+func (r *GormUsageRepository) RecordedEvents(ctx context.Context, eventIDs []string) (map[string]bool, error) {
+	recorded := make(map[string]bool)
+	if len(eventIDs) == 0 {
+		return recorded, nil
+	}
+
+	var ids []string
+	err := r.db.WithContext(ctx).Model(&model.UsageEvent{}).
+		Where("event_id IN ?", eventIDs).
+		Pluck("event_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		recorded[id] = true
+	}
+	return recorded, nil
+}
+
+// Add upserts rollups, which must be unique per key, and inserts the events.
+// Callers should pass rollups in a stable order so that concurrent batches
+// lock rows in the same order. If another batch recorded one of the events
+// first, nothing is stored and ErrDuplicateUsageEvent is returned.
+func (r *GormUsageRepository) Add(ctx context.Context, events []*model.UsageEvent, rollups []*model.UsageRollup) error {
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(events) > 0 {
+			if err := tx.Create(&events).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "hour_start"}, {Name: "tenant_id"}, {Name: "model_id"}, {Name: "version"}, {Name: "status"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"requests":          gorm.Expr("usage_rollups.requests + excluded.requests"),
+				"total_latency_ms":  gorm.Expr("usage_rollups.total_latency_ms + excluded.total_latency_ms"),
+				"max_latency_ms":    gorm.Expr(r.greatest("usage_rollups.max_latency_ms", "excluded.max_latency_ms")),
+				"request_bytes":     gorm.Expr("usage_rollups.request_bytes + excluded.request_bytes"),
+				"response_bytes":    gorm.Expr("usage_rollups.response_bytes + excluded.response_bytes"),
+				"prompt_tokens":     gorm.Expr("usage_rollups.prompt_tokens + excluded.prompt_tokens"),
+				"completion_tokens": gorm.Expr("usage_rollups.completion_tokens + excluded.completion_tokens"),
+				"updated_at":        gorm.Expr("excluded.updated_at"),
+			}),
+		}).Create(&rollups).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrDuplicateUsageEvent
+	}
+	return err
+}
+
+// PruneEvents deletes events recorded before the cutoff
+func (r *GormUsageRepository) PruneEvents(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("recorded_at < ?", before).Delete(&model.UsageEvent{})
+	return result.RowsAffected, result.Error
+}
+
+// Summarize sums the rollups matching filter per group, ordered by the group dimensions
+func (r *GormUsageRepository) Summarize(ctx context.Context, filter UsageFilter, groupBy []string) ([]*UsageSummary, error) {
+	sqlite := r.db.Dialector.Name() == "sqlite"
+	selects := make([]string, 0, len(groupBy)+8)
+	groups := make([]string, 0, len(groupBy))
+	for _, dim := range groupBy {
+		d, ok := usageDimensions[dim]
+		if !ok {
+			return nil, fmt.Errorf("unknown usage dimension: %s", dim)
+		}
+		if p, ok := sqliteUsagePeriods[dim]; ok && sqlite {
+			d = p
+		}
+		selects = append(selects, d.expr+" AS "+d.alias)
+		groups = append(groups, d.alias)
+	}
+	selects = append(selects,
+		"COALESCE(SUM(requests), 0) AS requests",
+		"COALESCE(SUM(total_latency_ms), 0) AS total_latency_ms",
+		"COALESCE(MAX(max_latency_ms), 0) AS max_latency_ms",
+		"COALESCE(SUM(request_bytes), 0) AS request_bytes",
+		"COALESCE(SUM(response_bytes), 0) AS response_bytes",
+		"COALESCE(SUM(prompt_tokens), 0) AS prompt_tokens",
+		"COALESCE(SUM(completion_tokens), 0) AS completion_tokens",
+	)
+
+	query := r.db.WithContext(ctx).Model(&model.UsageRollup{}).Select(strings.Join(selects, ", "))
+	if filter.TenantID != "" {
+		query = query.Where("tenant_id = ?", filter.TenantID)
+	}
+	if filter.ModelID != "" {
+		query = query.Where("model_id = ?", filter.ModelID)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("hour_start >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("hour_start < ?", filter.To)
+	}
+	if len(groups) > 0 {
+		query = query.Group(strings.Join(groups, ", ")).Order(strings.Join(groups, ", "))
+	}
+
+	var rows []*usageRow
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*UsageSummary, len(rows))
+	for i, row := range rows {
+		if row.PeriodText != "" {
+			period, err := time.ParseInLocation(sqlitePeriodLayout, row.PeriodText, time.UTC)
+			if err != nil {
+				return nil, fmt.Errorf("invalid usage period %q: %w", row.PeriodText, err)
+			}
+			row.PeriodStart = &period
+		}
+		summaries[i] = &row.UsageSummary
+	}
+	return summaries, nil
+}
+
+// usageRow is a scanned summary; PeriodText carries the period on SQLite
+type usageRow struct {
+	UsageSummary `gorm:"embedded"`
+	PeriodText   string
+}
+
+// greatest returns the SQL for the larger of two values
+func (r *GormUsageRepository) greatest(a, b string) string {
+	if r.db.Dialector.Name() == "sqlite" {
+		// SQLite's scalar max takes any number of arguments
+		return "MAX(" + a + ", " + b + ")"
+	}
+	return "GREATEST(" + a + ", " + b + ")"
+}
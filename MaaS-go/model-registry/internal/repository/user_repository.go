@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+var (
+	ErrUserNotFound  = errors.New("user not found")
+	ErrDuplicateUser = errors.New("user with this username or email already exists")
+)
+
+// UserRepository defines access to platform users
+type UserRepository interface {
+	Create(ctx context.Context, u *model.User) error
+	GetByID(ctx context.Context, id string) (*model.User, error)
+	GetByExternalID(ctx context.Context, issuer, subject string) (*model.User, error)
+	GetByEmail(ctx context.Context, email string) (*model.User, error)
+	GetByUsername(ctx context.Context, username string) (*model.User, error)
+	UsernameExists(ctx context.Context, username string) (bool, error)
+	Update(ctx context.Context, u *model.User) error
+}
+
+// GormUserRepository implements UserRepository using GORM
+type GormUserRepository struct {
+	db *gorm.DB
+}
+
+// NewGormUserRepository creates a new GORM user repository
+func NewGormUserRepository(db *gorm.DB) UserRepository {
+	return &GormUserRepository{db: db}
+}
+
+// Create creates a user
+func (r *GormUserRepository) Create(ctx context.Context, u *model.User) error {
+	err := r.db.WithContext(ctx).Create(u).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrDuplicateUser
+	}
+	return err
+}
+
+// GetByID retrieves a user by ID
+func (r *GormUserRepository) GetByID(ctx context.Context, id string) (*model.User, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// GetByExternalID retrieves the user linked to an external identity
+func (r *GormUserRepository) GetByExternalID(ctx context.Context, issuer, subject string) (*model.User, error) {
+	return r.first(ctx, "external_issuer = ? AND external_subject = ?", issuer, subject)
+}
+
+// GetByEmail retrieves a user by email
+func (r *GormUserRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	return r.first(ctx, "email = ?", email)
+}
+
+// GetByUsername retrieves a user by username
+func (r *GormUserRepository) GetByUsername(ctx context.Context, username string) (*model.User, error) {
+	return r.first(ctx, "username = ?", username)
+}
+
+// UsernameExists reports whether a username is taken, including by deleted users
+func (r *GormUserRepository) UsernameExists(ctx context.Context, username string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&model.User{}).Where("username = ?", username).Count(&count).Error
+	return count > 0, err
+}
+
+// first retrieves the first user matching a condition
+func (r *GormUserRepository) first(ctx context.Context, query string, args ...interface{}) (*model.User, error) {
+	var u model.User
+	result := r.db.WithContext(ctx).Where(query, args...).First(&u)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrUserNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &u, nil
+}
+
+// Update saves a user
+func (r *GormUserRepository) Update(ctx context.Context, u *model.User) error {
+	err := r.db.WithContext(ctx).Save(u).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrDuplicateUser
+	}
+	return err
+}
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"maas-platform/model-registry/internal/model"
+)
+
+var (
+	ErrWebhookNotFound  = errors.New("webhook subscription not found")
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+// WebhookRepository defines access to webhook subscriptions and deliveries
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, w *model.WebhookSubscription) error
+	GetSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, tenantID string, pagination Pagination) ([]*model.WebhookSubscription, int64, error)
+	UpdateSubscription(ctx context.Context, w *model.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id string) error
+	// ActiveSubscriptions returns the active subscriptions of a tenant
+	ActiveSubscriptions(ctx context.Context, tenantID string) ([]*model.WebhookSubscription, error)
+
+	CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error
+	GetDelivery(ctx context.Context, id string) (*model.WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, filter DeliveryFilter, pagination Pagination) ([]*model.WebhookDelivery, int64, error)
+	UpdateDelivery(ctx context.Context, d *model.WebhookDelivery) error
+	// ClaimDue locks up to limit deliveries that are due and pushes their next
+	// attempt out by lease so concurrent dispatchers do not pick them up twice
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.WebhookDelivery, error)
+}
+
+// DeliveryFilter defines filter criteria for listing deliveries
+type DeliveryFilter struct {
+	SubscriptionID string
+	TenantID       string
+	Status         model.DeliveryStatus
+	EventID        string
+}
+
+// GormWebhookRepository implements WebhookRepository using GORM
+type GormWebhookRepository struct {
+	db *gorm.DB
+}
+
+// NewGormWebhookRepository creates a new GORM webhook repository
+func NewGormWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &GormWebhookRepository{db: db}
+}
+
+// CreateSubscription creates a webhook subscription
+func (r *GormWebhookRepository) CreateSubscription(ctx context.Context, w *model.WebhookSubscription) error {
+	return r.db.WithContext(ctx).Create(w).Error
+}
+
+// GetSubscription retrieves a webhook subscription by ID
+func (r *GormWebhookRepository) GetSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	var w model.WebhookSubscription
+	result := r.db.WithContext(ctx).First(&w, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrWebhookNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &w, nil
+}
+
+// ListSubscriptions retrieves a paginated list of subscriptions, optionally for one tenant
+func (r *GormWebhookRepository) ListSubscriptions(ctx context.Context, tenantID string, pagination Pagination) ([]*model.WebhookSubscription, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.WebhookSubscription{})
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var subscriptions []*model.WebhookSubscription
+	result := query.
+		Offset(pagination.offset()).
+		Limit(pagination.Limit).
+		Order("created_at DESC").
+		Find(&subscriptions)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return subscriptions, total, nil
+}
+
+// UpdateSubscription updates a webhook subscription
+func (r *GormWebhookRepository) UpdateSubscription(ctx context.Context, w *model.WebhookSubscription) error {
+	result := r.db.WithContext(ctx).Save(w)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// DeleteSubscription deletes a webhook subscription; its delivery history is kept
+func (r *GormWebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&model.WebhookSubscription{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// ActiveSubscriptions returns the active subscriptions of a tenant
+func (r *GormWebhookRepository) ActiveSubscriptions(ctx context.Context, tenantID string) ([]*model.WebhookSubscription, error) {
+	var subscriptions []*model.WebhookSubscription
+	if err := r.db.WithContext(ctx).
+		Where("tenant_id = ? AND active = ?", tenantID, true).
+		Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// CreateDeliveries queues deliveries
+func (r *GormWebhookRepository) CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&deliveries).Error
+}
+
+// GetDelivery retrieves a delivery by ID
+func (r *GormWebhookRepository) GetDelivery(ctx context.Context, id string) (*model.WebhookDelivery, error) {
+	var d model.WebhookDelivery
+	result := r.db.WithContext(ctx).First(&d, "id = ?", id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrDeliveryNotFound
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &d, nil
+}
+
+// ListDeliveries retrieves a paginated delivery history, newest first
+func (r *GormWebhookRepository) ListDeliveries(ctx context.Context, filter DeliveryFilter, pagination Pagination) ([]*model.WebhookDelivery, int64, error) {
+	query := r.db.WithContext(ctx).Model(&model.WebhookDelivery{})
+	if filter.SubscriptionID != "" {
+		query = query.Where("subscription_id = ?", filter.SubscriptionID)
+	}
+	if filter.TenantID != "" {
+		query = query.Where("tenant_id = ?", filter.TenantID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.EventID != "" {
+		query = query.Where("event_id = ?", filter.EventID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var deliveries []*model.WebhookDelivery
+	result := query.
+		Offset(pagination.offset()).
+		Limit(pagination.Limit).
+		Order("created_at DESC").
+		Find(&deliveries)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return deliveries, total, nil
+}
+
+// UpdateDelivery saves the state of a delivery
+func (r *GormWebhookRepository) UpdateDelivery(ctx context.Context, d *model.WebhookDelivery) error {
+	return r.db.WithContext(ctx).Save(d).Error
+}
+
+// ClaimDue locks due deliveries with SKIP LOCKED and leases them to the caller
+func (r *GormWebhookRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status IN ? AND next_attempt_at <= ?",
+				[]model.DeliveryStatus{model.DeliveryPending, model.DeliveryRetrying}, now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&deliveries).Error; err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]string, len(deliveries))
+		for i, d := range deliveries {
+			ids[i] = d.ID
+		}
+		return tx.Model(&model.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
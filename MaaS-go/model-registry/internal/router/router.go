@@ -3,19 +3,88 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 
+	"maas-platform/model-registry/internal/auth"
 	"maas-platform/model-registry/internal/handler"
+	"maas-platform/shared/policy"
 )
 
 // RegisterRoutes registers all routes
 func RegisterRoutes(r *gin.RouterGroup, h *handler.ModelHandler) {
 	// Model routes
-	models := r.Group("/models")
+	models := r.Group("/models", auth.Authorize(policy.ModelsRead, policy.ModelsWrite))
 	{
 		models.POST("", h.CreateModel)
 		models.GET("", h.ListModels)
+		models.GET("/trash", h.ListDeletedModels)
 		models.GET("/:id", h.GetModel)
 		models.PUT("/:id", h.UpdateModel)
 		models.DELETE("/:id", h.DeleteModel)
 		models.PATCH("/:id/status", h.UpdateModelStatus)
+		models.POST("/:id/restore", h.RestoreModel)
+		models.DELETE("/:id/purge", h.PurgeModel)
+		models.POST("/:id/versions", h.CreateModelVersion)
+		models.GET("/:id/versions", h.ListModelVersions)
+		models.POST("/:id/versions/:version/promote", h.PromoteVersion)
+		models.GET("/:id/versions/:version/card", h.GetModelCard)
+		models.PUT("/:id/versions/:version/card", h.SetModelCard)
+		models.POST("/:id/versions/:version/evaluations", h.RecordEvaluations)
+		models.GET("/:id/versions/:version/evaluations", h.ListEvaluations)
+		models.GET("/:id/evaluations", h.ListEvaluations)
+		models.GET("/:id/compare", h.CompareVersions)
+		models.GET("/:id/promotion-rules", h.GetPromotionRules)
+		models.PUT("/:id/promotion-rules", h.SetPromotionRules)
+		models.POST("/:id/lineage", h.AddLineageEdge)
+		models.DELETE("/:id/lineage/:edge_id", h.RemoveLineageEdge)
+		models.GET("/:id/lineage/:direction", h.GetLineage)
+	}
+
+	// Approval routes; only admins change approval policies
+	policies := r.Group("/approval-policies", auth.Authorize(policy.ModelsRead, policy.ApprovalsManage))
+	{
+		policies.GET("", h.ListApprovalPolicies)
+		policies.PUT("/:action", h.SetApprovalPolicy)
+		policies.DELETE("/:action", h.DeleteApprovalPolicy)
+	}
+	approvals := r.Group("", auth.Authorize(policy.ModelsRead, policy.ModelsWrite))
+	{
+		approvals.GET("/approvals", h.ListApprovalRequests)
+		approvals.GET("/approvals/:id", h.GetApprovalRequest)
+		approvals.POST("/approvals/:id/decision", h.DecideApprovalRequest)
+	}
+}
+
+// RegisterAuditRoutes registers audit log routes
+func RegisterAuditRoutes(r *gin.RouterGroup, h *handler.AuditHandler) {
+	audit := r.Group("/audit", auth.Authorize(policy.AuditRead, policy.AuditWrite))
+	{
+		audit.GET("", h.ListAuditEntries)
+		audit.GET("/export", h.ExportAuditEntries)
+	}
+}
+
+// RegisterWebhookRoutes registers webhook subscription routes
+func RegisterWebhookRoutes(r *gin.RouterGroup, h *handler.WebhookHandler) {
+	webhooks := r.Group("/webhooks", auth.Authorize(policy.WebhooksManage, policy.WebhooksManage))
+	{
+		webhooks.POST("", h.CreateWebhook)
+		webhooks.GET("", h.ListWebhooks)
+		webhooks.GET("/deliveries", h.ListDeliveries)
+		webhooks.POST("/deliveries/:delivery_id/redeliver", h.Redeliver)
+		webhooks.GET("/:id", h.GetWebhook)
+		webhooks.PUT("/:id", h.UpdateWebhook)
+		webhooks.DELETE("/:id", h.DeleteWebhook)
+		webhooks.GET("/:id/deliveries", h.ListDeliveries)
+	}
+}
+
+// RegisterAPIKeyRoutes registers API key routes
+func RegisterAPIKeyRoutes(r *gin.RouterGroup, h *handler.APIKeyHandler) {
+	keys := r.Group("/api-keys", auth.Authorize(policy.APIKeysManage, policy.APIKeysManage))
+	{
+		keys.POST("", h.CreateAPIKey)
+		keys.GET("", h.ListAPIKeys)
+		keys.GET("/:id", h.GetAPIKey)
+		keys.POST("/:id/rotate", h.RotateAPIKey)
+		keys.DELETE("/:id", h.RevokeAPIKey)
 	}
 }
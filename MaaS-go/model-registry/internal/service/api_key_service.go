@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// API key errors
+var (
+	ErrAPIKeyNotFound = repository.ErrAPIKeyNotFound
+	// ErrInvalidAPIKey covers unknown, revoked and expired keys alike
+	ErrInvalidAPIKey = errors.New("invalid api key")
+)
+
+// lastUsedResolution bounds how often a key's last use is written
+const lastUsedResolution = time.Minute
+
+// APIKeyService manages tenant-scoped API keys
+type APIKeyService interface {
+	// CreateAPIKey creates a key and returns it with its secret, which is not stored
+	CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*model.APIKey, string, error)
+	GetAPIKey(ctx context.Context, id string) (*model.APIKey, error)
+	ListAPIKeys(ctx context.Context, query APIKeyQuery) (*ListAPIKeysResponse, error)
+	// RotateAPIKey replaces the secret of a key and returns the new one
+	RotateAPIKey(ctx context.Context, id string) (*model.APIKey, string, error)
+	RevokeAPIKey(ctx context.Context, id string) (*model.APIKey, error)
+	// VerifyAPIKey resolves a secret to its active key and records the use
+	VerifyAPIKey(ctx context.Context, secret string) (*model.APIKey, error)
+}
+
+// CreateAPIKeyRequest represents a request to create an API key
+type CreateAPIKeyRequest struct {
+	TenantID string
+	// UserID and Role default to the caller
+	UserID    string
+	Role      model.UserRole
+	Name      string
+	Scopes    []model.APIKeyScope
+	ExpiresAt *time.Time
+}
+
+// APIKeyQuery selects API keys
+type APIKeyQuery struct {
+	TenantID       string
+	IncludeRevoked bool
+	Page           int
+	Limit          int
+}
+
+// ListAPIKeysResponse represents a page of API keys
+type ListAPIKeysResponse struct {
+	Keys  []*model.APIKey `json:"api_keys"`
+	Total int64           `json:"total"`
+	Page  int             `json:"page"`
+	Limit int             `json:"limit"`
+}
+
+// apiKeyService implements APIKeyService
+type apiKeyService struct {
+	repo   repository.APIKeyRepository
+	users  repository.UserRepository
+	audit  AuditService
+	logger *logger.Logger
+}
+
+// NewAPIKeyService creates a new API key service; users resolves key owners at verification
+func NewAPIKeyService(repo repository.APIKeyRepository, users repository.UserRepository, audit AuditService, logger *logger.Logger) APIKeyService {
+	return &apiKeyService{
+		repo:   repo,
+		users:  users,
+		audit:  audit,
+		logger: logger,
+	}
+}
+
+// CreateAPIKey creates an API key acting as the caller, or as the given user for admins and trusted services
+func (s *apiKeyService) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*model.APIKey, string, error) {
+	k := &model.APIKey{
+		TenantID:  req.TenantID,
+		UserID:    req.UserID,
+		Role:      req.Role,
+		Name:      strings.TrimSpace(req.Name),
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if caller, ok := auth.FromContext(ctx); ok {
+		if k.TenantID == "" {
+			k.TenantID = caller.TenantID
+		}
+		if k.UserID == "" || k.UserID == caller.UserID {
+			// A key never carries more rights than the user creating it
+			k.UserID = caller.UserID
+			k.Role = caller.Role
+		} else if !caller.IsAdmin() {
+			return nil, "", ErrForbidden
+		}
+	}
+	if k.Role == "" {
+		k.Role = model.RoleDeveloper
+	}
+	if k.TenantID == "" || k.UserID == "" {
+		return nil, "", fmt.Errorf("%w: tenant_id and user_id are required", ErrInvalidInput)
+	}
+	if !k.Role.Valid() {
+		return nil, "", fmt.Errorf("%w: unknown role %q", ErrInvalidInput, k.Role)
+	}
+	if !grantorRole(ctx).Covers(k.Role) {
+		return nil, "", ErrForbidden
+	}
+	if err := authorizeTenant(ctx, k.TenantID); err != nil {
+		return nil, "", err
+	}
+	if err := validateAPIKey(k); err != nil {
+		return nil, "", err
+	}
+
+	secret, err := s.assignSecret(k)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.repo.Create(ctx, k); err != nil {
+		s.logger.Error("Failed to create API key", "error", err)
+		return nil, "", err
+	}
+
+	s.logger.Info("API key created", "api_key_id", k.ID, "tenant_id", k.TenantID, "prefix", k.Prefix)
+	s.record(ctx, model.AuditAPIKeyCreate, k, nil, k)
+	return k, secret, nil
+}
+
+// GetAPIKey retrieves an API key; only its owner and admins may see it
+func (s *apiKeyService) GetAPIKey(ctx context.Context, id string) (*model.APIKey, error) {
+	k, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeKeyOwner(ctx, k); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// ListAPIKeys lists API keys; non-admin callers only see their own, like GetAPIKey
+func (s *apiKeyService) ListAPIKeys(ctx context.Context, query APIKeyQuery) (*ListAPIKeysResponse, error) {
+	var userID string
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		query.TenantID = caller.TenantID
+		userID = caller.UserID
+	}
+
+	pagination := repository.Pagination{Page: query.Page, Limit: query.Limit}
+	keys, total, err := s.repo.List(ctx, query.TenantID, userID, query.IncludeRevoked, pagination)
+	if err != nil {
+		s.logger.Error("Failed to list API keys", "error", err)
+		return nil, err
+	}
+
+	return &ListAPIKeysResponse{
+		Keys:  keys,
+		Total: total,
+		Page:  query.Page,
+		Limit: query.Limit,
+	}, nil
+}
+
+// RotateAPIKey replaces the secret of an active key
+func (s *apiKeyService) RotateAPIKey(ctx context.Context, id string) (*model.APIKey, string, error) {
+	k, err := s.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if k.RevokedAt != nil {
+		return nil, "", fmt.Errorf("%w: api key is revoked", ErrInvalidInput)
+	}
+
+	before := *k
+	secret, err := s.assignSecret(k)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.repo.Update(ctx, k); err != nil {
+		s.logger.Error("Failed to rotate API key", "api_key_id", id, "error", err)
+		return nil, "", err
+	}
+
+	s.logger.Info("API key rotated", "api_key_id", id, "prefix", k.Prefix)
+	s.record(ctx, model.AuditAPIKeyRotate, k, &before, k)
+	return k, secret, nil
+}
+
+// RevokeAPIKey revokes a key; revoking it again is a no-op
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, id string) (*model.APIKey, error) {
+	k, err := s.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if k.RevokedAt != nil {
+		return k, nil
+	}
+
+	before := *k
+	now := time.Now().UTC()
+	k.RevokedAt = &now
+	if err := s.repo.Update(ctx, k); err != nil {
+		s.logger.Error("Failed to revoke API key", "api_key_id", id, "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("API key revoked", "api_key_id", id)
+	s.record(ctx, model.AuditAPIKeyRevoke, k, &before, k)
+	return k, nil
+}
+
+// VerifyAPIKey resolves a secret to its key; only trusted services may call it.
+// Keys of deleted or disabled users are rejected, and a key's role is capped
+// at its owner's current role.
+func (s *apiKeyService) VerifyAPIKey(ctx context.Context, secret string) (*model.APIKey, error) {
+	if _, ok := auth.FromContext(ctx); ok {
+		return nil, ErrForbidden
+	}
+	if !strings.HasPrefix(secret, model.APIKeyPrefix) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	k, err := s.repo.GetByHash(ctx, hashAPIKey(secret))
+	if errors.Is(err, ErrAPIKeyNotFound) {
+		return nil, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if !k.Active(now) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	// A key acts for its owner as they are now, not as they were when it was created
+	owner, err := s.users.GetByID(ctx, k.UserID)
+	if errors.Is(err, ErrUserNotFound) {
+		return nil, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	if owner.Status != model.UserStatusActive {
+		return nil, ErrInvalidAPIKey
+	}
+	if !owner.Role.Covers(k.Role) {
+		k.Role = owner.Role
+	}
+
+	if err := s.repo.TouchLastUsed(ctx, k.ID, now, now.Add(-lastUsedResolution)); err != nil {
+		s.logger.Warn("Failed to record API key use", "api_key_id", k.ID, "error", err)
+	}
+	k.LastUsedAt = &now
+	return k, nil
+}
+
+// assignSecret generates a new secret for a key and stores its prefix and hash
+func (s *apiKeyService) assignSecret(k *model.APIKey) (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	secret := model.APIKeyPrefix + hex.EncodeToString(b)
+	k.Prefix = secret[:len(model.APIKeyPrefix)+8]
+	k.Hash = hashAPIKey(secret)
+	return secret, nil
+}
+
+// record writes an API key change to the audit log
+func (s *apiKeyService) record(ctx context.Context, action model.AuditAction, k *model.APIKey, before, after interface{}) {
+	err := s.audit.Record(ctx, AuditEvent{
+		Action:     action,
+		TargetType: "api_key",
+		TargetID:   k.ID,
+		Before:     before,
+		After:      after,
+		TenantID:   k.TenantID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to record audit entry", "action", action, "api_key_id", k.ID, "error", err)
+	}
+}
+
+// authorizeKeyOwner allows a key's owner, admins and trusted services to act on
+// it. Other members of the tenant may not, even with api_keys:manage, since
+// rotating a key hands its new secret to the caller.
+func authorizeKeyOwner(ctx context.Context, k *model.APIKey) error {
+	caller, ok := auth.FromContext(ctx)
+	if !ok || caller.IsAdmin() || caller.UserID == k.UserID {
+		return nil
+	}
+	return ErrForbidden
+}
+
+// grantorRole is the highest role the caller may grant to a key. Trusted
+// services act on behalf of others and never mint admin keys.
+func grantorRole(ctx context.Context) model.UserRole {
+	if caller, ok := auth.FromContext(ctx); ok {
+		return caller.Role
+	}
+	return model.RoleDeveloper
+}
+
+// hashAPIKey returns the stored form of a secret; keys are random enough that a fast hash suffices
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// validateAPIKey checks the name, scopes and expiry of a key
+func validateAPIKey(k *model.APIKey) error {
+	if k.Name == "" || len(k.Name) > 100 {
+		return fmt.Errorf("%w: name is required and at most 100 characters", ErrInvalidInput)
+	}
+	if len(k.Scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidInput)
+	}
+
+	seen := make(map[model.APIKeyScope]bool, len(k.Scopes))
+	scopes := k.Scopes[:0]
+	for _, scope := range k.Scopes {
+		if !isValidScope(scope) {
+			return fmt.Errorf("%w: unknown scope %q", ErrInvalidInput, scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	k.Scopes = scopes
+
+	if k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("%w: expires_at must be in the future", ErrInvalidInput)
+	}
+	return nil
+}
+
+// isValidScope checks if a scope can be granted to a key
+func isValidScope(scope model.APIKeyScope) bool {
+	switch scope {
+	case model.ScopeModelsRead, model.ScopeModelsWrite, model.ScopeInferenceInvoke:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// newAPIKeyService returns an API key service over a fresh SQLite database
+func newAPIKeyService(t *testing.T) (service.APIKeyService, repository.UserRepository) {
+	t.Helper()
+	db := newDB(t)
+	log := logger.New("error")
+	users := repository.NewGormUserRepository(db)
+	audit := service.NewAuditService(repository.NewGormAuditRepository(db), log)
+	return service.NewAPIKeyService(repository.NewGormAPIKeyRepository(db), users, audit, log), users
+}
+
+// createUser creates an active user with a role in tenant-a
+func createUser(t *testing.T, users repository.UserRepository, username string, role model.UserRole) *model.User {
+	t.Helper()
+	u := &model.User{
+		Username: username,
+		Email:    username + "@example.com",
+		Password: "-",
+		Role:     role,
+		Status:   model.UserStatusActive,
+		TenantID: "tenant-a",
+	}
+	if err := users.Create(context.Background(), u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return u
+}
+
+// as returns a context calling as the user
+func as(u *model.User) context.Context {
+	return auth.NewContext(context.Background(), auth.Caller{UserID: u.ID, TenantID: u.TenantID, Role: u.Role})
+}
+
+// createKey creates a key for the user, acting as the user
+func createKey(t *testing.T, svc service.APIKeyService, u *model.User) (*model.APIKey, string) {
+	t.Helper()
+	k, secret, err := svc.CreateAPIKey(as(u), service.CreateAPIKeyRequest{
+		Name:   u.Username + " key",
+		Scopes: []model.APIKeyScope{model.ScopeModelsRead},
+	})
+	if err != nil {
+		t.Fatalf("create key for %s: %v", u.Username, err)
+	}
+	return k, secret
+}
+
+func TestVerifyAPIKeyFollowsOwner(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   func(u *model.User)
+		wantRole model.UserRole
+		wantErr  error
+	}{
+		{"unchanged owner", func(u *model.User) {}, model.RoleAdmin, nil},
+		{"demoted owner", func(u *model.User) { u.Role = model.RoleViewer }, model.RoleViewer, nil},
+		{"banned owner", func(u *model.User) { u.Status = model.UserStatusBanned }, "", service.ErrInvalidAPIKey},
+		{"inactive owner", func(u *model.User) { u.Status = model.UserStatusInactive }, "", service.ErrInvalidAPIKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, users := newAPIKeyService(t)
+			owner := createUser(t, users, "owner", model.RoleAdmin)
+			_, secret := createKey(t, svc, owner)
+
+			tt.change(owner)
+			if err := users.Update(context.Background(), owner); err != nil {
+				t.Fatalf("update owner: %v", err)
+			}
+
+			k, err := svc.VerifyAPIKey(context.Background(), secret)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && k.Role != tt.wantRole {
+				t.Errorf("role = %s, want %s", k.Role, tt.wantRole)
+			}
+		})
+	}
+}
+
+func TestVerifyAPIKeyRejectsUnknownOwner(t *testing.T) {
+	svc, _ := newAPIKeyService(t)
+	// Trusted services may create keys for any user ID
+	_, secret, err := svc.CreateAPIKey(context.Background(), service.CreateAPIKeyRequest{
+		TenantID: "tenant-a",
+		UserID:   "6c1f2a3b-0000-4000-8000-000000000000",
+		Name:     "orphan",
+		Scopes:   []model.APIKeyScope{model.ScopeModelsRead},
+	})
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	if _, err := svc.VerifyAPIKey(context.Background(), secret); !errors.Is(err, service.ErrInvalidAPIKey) {
+		t.Fatalf("err = %v, want ErrInvalidAPIKey", err)
+	}
+}
+
+func TestListAPIKeysShowsOwnKeys(t *testing.T) {
+	svc, users := newAPIKeyService(t)
+	alice := createUser(t, users, "alice", model.RoleDeveloper)
+	bob := createUser(t, users, "bob", model.RoleDeveloper)
+	admin := createUser(t, users, "admin", model.RoleAdmin)
+	aliceKey, _ := createKey(t, svc, alice)
+	createKey(t, svc, bob)
+
+	tests := []struct {
+		name   string
+		caller *model.User
+		want   int64
+	}{
+		{"member sees own keys", alice, 1},
+		{"admin sees all keys", admin, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := svc.ListAPIKeys(as(tt.caller), service.APIKeyQuery{Page: 1, Limit: 10})
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			if resp.Total != tt.want || int64(len(resp.Keys)) != tt.want {
+				t.Fatalf("listed %d of %d keys, want %d", len(resp.Keys), resp.Total, tt.want)
+			}
+			if tt.caller == alice && resp.Keys[0].ID != aliceKey.ID {
+				t.Errorf("listed key %s, want %s", resp.Keys[0].ID, aliceKey.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,452 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+)
+
+// Approval errors
+var (
+	ErrPolicyNotFound    = repository.ErrPolicyNotFound
+	ErrApprovalNotFound  = repository.ErrApprovalNotFound
+	ErrDuplicateDecision = repository.ErrDuplicateDecision
+	ErrApprovalRequired  = errors.New("approval required")
+	ErrApprovalClosed    = errors.New("approval request is no longer pending")
+)
+
+const (
+	// defaultApprovalTimeout is how long a request stays open when the policy sets no timeout
+	defaultApprovalTimeout = 72 * time.Hour
+	// maxApprovalTimeout caps how long a request may stay open
+	maxApprovalTimeout = 30 * 24 * time.Hour
+	// maxRequiredApprovals caps how many approvers a policy may require
+	maxRequiredApprovals = 10
+)
+
+// ApprovalRequiredError is returned when a change waits for approval; the
+// change is applied once the pending request it carries is approved
+type ApprovalRequiredError struct {
+	Request *model.ApprovalRequest
+}
+
+// Error describes the pending request
+func (e *ApprovalRequiredError) Error() string {
+	return fmt.Sprintf("%s of model %s requires approval: request %s is pending", e.Request.Action, e.Request.ModelID, e.Request.ID)
+}
+
+// Is makes the error match ErrApprovalRequired
+func (e *ApprovalRequiredError) Is(target error) bool {
+	return target == ErrApprovalRequired
+}
+
+// ListApprovalRequestsFilter represents filters for listing approval requests
+type ListApprovalRequestsFilter struct {
+	TenantID string
+	ModelID  string
+	State    model.ApprovalState
+	Page     int
+	Limit    int
+}
+
+// ListApprovalRequestsResponse represents the response for listing approval requests
+type ListApprovalRequestsResponse struct {
+	Requests []*model.ApprovalRequest
+	Total    int64
+	Page     int
+	Limit    int
+}
+
+type approvedKey struct{}
+
+// withApproval marks ctx as applying the change an approved request was made for
+func withApproval(ctx context.Context, r *model.ApprovalRequest) context.Context {
+	return context.WithValue(ctx, approvedKey{}, r)
+}
+
+// approvedFor reports whether ctx applies an approved change to the model
+func approvedFor(ctx context.Context, modelID string) bool {
+	r, ok := ctx.Value(approvedKey{}).(*model.ApprovalRequest)
+	return ok && r.ModelID == modelID
+}
+
+// ListApprovalPolicies lists the approval policies of a tenant; callers other
+// than admins only see their own tenant's
+func (s *modelService) ListApprovalPolicies(ctx context.Context, tenantID string) ([]*model.ApprovalPolicy, error) {
+	tenantID, err := approvalTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return s.approvals.ListPolicies(repository.AllowReplica(ctx), tenantID)
+}
+
+// SetApprovalPolicy makes an action of a tenant wait for approval, replacing
+// the tenant's previous policy for it; only admins manage policies
+func (s *modelService) SetApprovalPolicy(ctx context.Context, p *model.ApprovalPolicy) (*model.ApprovalPolicy, error) {
+	caller, ok := auth.FromContext(ctx)
+	if ok && !caller.IsAdmin() {
+		return nil, ErrForbidden
+	}
+	if p.TenantID == "" && ok {
+		p.TenantID = caller.TenantID
+	}
+	if p.TenantID == "" {
+		return nil, fmt.Errorf("%w: tenant_id is required", ErrInvalidInput)
+	}
+	if err := validateApprovalAction(p.Action); err != nil {
+		return nil, err
+	}
+	if p.RequiredApprovals < 1 || p.RequiredApprovals > maxRequiredApprovals {
+		return nil, fmt.Errorf("%w: required_approvals must be between 1 and %d", ErrInvalidInput, maxRequiredApprovals)
+	}
+	if p.ApproverRole != model.RoleAdmin && p.ApproverRole != model.RoleDeveloper {
+		return nil, fmt.Errorf("%w: approver_role must be %s or %s", ErrInvalidInput, model.RoleAdmin, model.RoleDeveloper)
+	}
+	if p.TimeoutSeconds == 0 {
+		p.TimeoutSeconds = int64(defaultApprovalTimeout / time.Second)
+	}
+	if p.TimeoutSeconds < 60 || p.TimeoutSeconds > int64(maxApprovalTimeout/time.Second) {
+		return nil, fmt.Errorf("%w: timeout_seconds must be between 60 and %d", ErrInvalidInput, int64(maxApprovalTimeout/time.Second))
+	}
+
+	before, err := s.approvals.GetPolicy(ctx, p.TenantID, p.Action)
+	if err != nil && !errors.Is(err, ErrPolicyNotFound) {
+		return nil, err
+	}
+	p.ID = ""
+	if ok {
+		p.CreatedBy = caller.UserID
+	}
+	if err := s.approvals.SavePolicy(ctx, p); err != nil {
+		s.logger.Error("Failed to save approval policy", "tenant_id", p.TenantID, "action", p.Action, "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("Approval policy set", "tenant_id", p.TenantID, "action", p.Action, "required_approvals", p.RequiredApprovals)
+	s.recordPolicy(ctx, p, before, p)
+	return p, nil
+}
+
+// DeleteApprovalPolicy stops an action of a tenant waiting for approval;
+// requests already pending stay open
+func (s *modelService) DeleteApprovalPolicy(ctx context.Context, tenantID string, action model.ApprovalAction) error {
+	caller, ok := auth.FromContext(ctx)
+	if ok && !caller.IsAdmin() {
+		return ErrForbidden
+	}
+	if tenantID == "" && ok {
+		tenantID = caller.TenantID
+	}
+	if err := validateApprovalAction(action); err != nil {
+		return err
+	}
+
+	p, err := s.approvals.DeletePolicy(ctx, tenantID, action)
+	if err != nil {
+		if !errors.Is(err, ErrPolicyNotFound) {
+			s.logger.Error("Failed to delete approval policy", "tenant_id", tenantID, "action", action, "error", err)
+		}
+		return err
+	}
+
+	s.logger.Info("Approval policy deleted", "tenant_id", tenantID, "action", action)
+	s.recordPolicy(ctx, p, p, nil)
+	return nil
+}
+
+// ListApprovalRequests retrieves a paginated list of approval requests, newest
+// first; callers other than admins only see their own tenant's
+func (s *modelService) ListApprovalRequests(ctx context.Context, filter ListApprovalRequestsFilter) (*ListApprovalRequestsResponse, error) {
+	tenantID, err := approvalTenant(ctx, filter.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	s.expireApprovals(ctx, tenantID)
+
+	requests, total, err := s.approvals.ListRequests(ctx, repository.ApprovalFilter{
+		TenantID: tenantID,
+		ModelID:  filter.ModelID,
+		State:    filter.State,
+	}, repository.Pagination{Page: filter.Page, Limit: filter.Limit})
+	if err != nil {
+		s.logger.Error("Failed to list approval requests", "error", err)
+		return nil, err
+	}
+
+	return &ListApprovalRequestsResponse{
+		Requests: requests,
+		Total:    total,
+		Page:     filter.Page,
+		Limit:    filter.Limit,
+	}, nil
+}
+
+// GetApprovalRequest retrieves an approval request with its decisions
+func (s *modelService) GetApprovalRequest(ctx context.Context, id string) (*model.ApprovalRequest, error) {
+	r, err := s.approvalRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.State == model.ApprovalPending && !time.Now().Before(r.ExpiresAt) {
+		s.expire(ctx, r)
+		return s.approvals.GetRequest(ctx, id)
+	}
+	return r, nil
+}
+
+// DecideApprovalRequest records the caller's approval or rejection of a
+// pending request. One rejection rejects it; once enough approvers agree the
+// change is applied on behalf of the requester, and the request fails if
+// applying it does.
+func (s *modelService) DecideApprovalRequest(ctx context.Context, id string, approve bool, comment string) (*model.ApprovalRequest, error) {
+	caller, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: deciding on a request requires a caller", ErrForbidden)
+	}
+	if len(comment) > 1000 {
+		return nil, fmt.Errorf("%w: comment is too long", ErrInvalidInput)
+	}
+
+	r, err := s.approvalRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.State != model.ApprovalPending {
+		return nil, fmt.Errorf("%w: request is %s", ErrApprovalClosed, r.State)
+	}
+	if !time.Now().Before(r.ExpiresAt) {
+		s.expire(ctx, r)
+		return nil, fmt.Errorf("%w: request is %s", ErrApprovalClosed, model.ApprovalExpired)
+	}
+	if caller.UserID == r.RequestedBy {
+		return nil, fmt.Errorf("%w: requesters cannot decide on their own request", ErrForbidden)
+	}
+	if !caller.IsAdmin() && caller.Role != r.ApproverRole {
+		return nil, fmt.Errorf("%w: request needs approvers with role %s", ErrForbidden, r.ApproverRole)
+	}
+
+	d := &model.ApprovalDecision{
+		RequestID:  r.ID,
+		ApproverID: caller.UserID,
+		Approve:    approve,
+		Comment:    comment,
+	}
+	if err := s.approvals.AddDecision(ctx, d); err != nil {
+		if !errors.Is(err, ErrDuplicateDecision) {
+			s.logger.Error("Failed to record approval decision", "request_id", id, "error", err)
+		}
+		return nil, err
+	}
+
+	action := model.AuditApprovalApprove
+	if !approve {
+		action = model.AuditApprovalReject
+	}
+	s.logger.Info("Approval decision recorded", "request_id", id, "approver_id", caller.UserID, "approve", approve)
+	s.recordRequest(ctx, action, r, d)
+
+	switch {
+	case !approve:
+		if _, err := s.approvals.Resolve(ctx, r.ID, model.ApprovalPending, model.ApprovalRejected, ""); err != nil {
+			return nil, err
+		}
+	default:
+		// Count the decisions recorded so far, not just the ones loaded above,
+		// so that the last of several concurrent approvers resolves the request
+		current, err := s.approvals.GetRequest(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if current.Approvals() < current.RequiredApprovals {
+			break
+		}
+		resolved, err := s.approvals.Resolve(ctx, r.ID, model.ApprovalPending, model.ApprovalApproved, "")
+		if err != nil {
+			return nil, err
+		}
+		if resolved {
+			s.applyApproved(ctx, r)
+		}
+	}
+	return s.approvals.GetRequest(ctx, id)
+}
+
+// requireApproval holds back a change the model's tenant requires approval
+// for by opening a request, or returning the one already pending for the
+// same change. Trusted calls and changes applied after approval pass.
+func (s *modelService) requireApproval(ctx context.Context, m *model.Model, action model.ApprovalAction, targetStatus model.ModelStatus, targetVersion string) error {
+	caller, ok := auth.FromContext(ctx)
+	if !ok || approvedFor(ctx, m.ID) || m.TenantID == "" {
+		return nil
+	}
+	p, err := s.approvals.GetPolicy(ctx, m.TenantID, action)
+	if errors.Is(err, ErrPolicyNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	r := &model.ApprovalRequest{
+		TenantID:          m.TenantID,
+		ModelID:           m.ID,
+		Action:            action,
+		TargetStatus:      targetStatus,
+		TargetVersion:     targetVersion,
+		State:             model.ApprovalPending,
+		RequiredApprovals: p.RequiredApprovals,
+		ApproverRole:      p.ApproverRole,
+		RequestedBy:       caller.UserID,
+		ExpiresAt:         time.Now().Add(time.Duration(p.TimeoutSeconds) * time.Second),
+	}
+	pending, err := s.approvals.FindPending(ctx, r)
+	switch {
+	case err == nil && time.Now().Before(pending.ExpiresAt):
+		return &ApprovalRequiredError{Request: pending}
+	case err == nil:
+		s.expire(ctx, pending)
+	case !errors.Is(err, ErrApprovalNotFound):
+		return err
+	}
+
+	if err := s.approvals.CreateRequest(ctx, r); err != nil {
+		s.logger.Error("Failed to open approval request", "model_id", m.ID, "action", action, "error", err)
+		return err
+	}
+	r.Decisions = []model.ApprovalDecision{}
+
+	s.logger.Info("Approval requested", "request_id", r.ID, "model_id", m.ID, "action", action)
+	s.recordRequest(ctx, model.AuditApprovalRequest, r, r)
+	return &ApprovalRequiredError{Request: r}
+}
+
+// applyApproved applies the change of an approved request as its requester
+// would have, and marks the request failed if that does not work
+func (s *modelService) applyApproved(ctx context.Context, r *model.ApprovalRequest) {
+	requester := auth.Caller{UserID: r.RequestedBy, TenantID: r.TenantID}
+	if caller, ok := auth.FromContext(ctx); ok {
+		requester.RequestID = caller.RequestID
+	}
+	applyCtx := withApproval(auth.NewContext(context.WithoutCancel(ctx), requester), r)
+
+	var err error
+	switch {
+	case r.Action == model.ApprovalPublish:
+		isPublic := true
+		_, err = s.UpdateModel(applyCtx, r.ModelID, UpdateModelRequest{IsPublic: &isPublic})
+	case r.TargetVersion != "":
+		_, err = s.PromoteVersion(applyCtx, r.ModelID, r.TargetVersion)
+	default:
+		err = s.UpdateModelStatus(applyCtx, r.ModelID, r.TargetStatus)
+	}
+	if err == nil {
+		s.logger.Info("Approved change applied", "request_id", r.ID, "model_id", r.ModelID, "action", r.Action)
+		return
+	}
+
+	s.logger.Warn("Failed to apply approved change", "request_id", r.ID, "model_id", r.ModelID, "error", err)
+	if _, rerr := s.approvals.Resolve(ctx, r.ID, model.ApprovalApproved, model.ApprovalFailed, err.Error()); rerr != nil {
+		s.logger.Error("Failed to mark approval request failed", "request_id", r.ID, "error", rerr)
+		return
+	}
+	s.recordRequest(ctx, model.AuditApprovalFail, r, map[string]string{"request_id": r.ID, "error": err.Error()})
+}
+
+// approvalRequest loads a request the caller may see; requests of other
+// tenants are reported as not found
+func (s *modelService) approvalRequest(ctx context.Context, id string) (*model.ApprovalRequest, error) {
+	r, err := s.approvals.GetRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() && caller.TenantID != r.TenantID {
+		return nil, ErrApprovalNotFound
+	}
+	return r, nil
+}
+
+// expireApprovals expires the pending requests of a tenant whose timeout has
+// passed; failures are logged, not returned
+func (s *modelService) expireApprovals(ctx context.Context, tenantID string) {
+	expired, err := s.approvals.ListExpired(ctx, tenantID, time.Now())
+	if err != nil {
+		s.logger.Error("Failed to list expired approval requests", "tenant_id", tenantID, "error", err)
+		return
+	}
+	for _, r := range expired {
+		s.expire(ctx, r)
+	}
+}
+
+// expire marks a pending request expired and records it once
+func (s *modelService) expire(ctx context.Context, r *model.ApprovalRequest) {
+	expired, err := s.approvals.Resolve(ctx, r.ID, model.ApprovalPending, model.ApprovalExpired, "")
+	if err != nil {
+		s.logger.Error("Failed to expire approval request", "request_id", r.ID, "error", err)
+		return
+	}
+	if expired {
+		s.logger.Info("Approval request expired", "request_id", r.ID, "model_id", r.ModelID)
+		s.recordRequest(ctx, model.AuditApprovalExpire, r, map[string]string{"request_id": r.ID})
+	}
+}
+
+// recordPolicy writes an approval policy change to the audit log; failures are logged, not returned
+func (s *modelService) recordPolicy(ctx context.Context, p *model.ApprovalPolicy, before, after interface{}) {
+	err := s.audit.Record(ctx, AuditEvent{
+		Action:     model.AuditApprovalPolicy,
+		TargetType: "approval_policy",
+		TargetID:   p.ID,
+		Before:     before,
+		After:      after,
+		TenantID:   p.TenantID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to record audit entry", "action", model.AuditApprovalPolicy, "policy_id", p.ID, "error", err)
+	}
+}
+
+// recordRequest writes a step of an approval request to the audit log under
+// the request's model; failures are logged, not returned
+func (s *modelService) recordRequest(ctx context.Context, action model.AuditAction, r *model.ApprovalRequest, after interface{}) {
+	err := s.audit.Record(ctx, AuditEvent{
+		Action:     action,
+		TargetType: "model",
+		TargetID:   r.ModelID,
+		After:      after,
+		TenantID:   r.TenantID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to record audit entry", "action", action, "request_id", r.ID, "error", err)
+	}
+}
+
+// approvalTenant resolves the tenant an approval listing covers: admins and
+// trusted calls pick any, other callers get their own
+func approvalTenant(ctx context.Context, tenantID string) (string, error) {
+	caller, ok := auth.FromContext(ctx)
+	if !ok || caller.IsAdmin() {
+		if tenantID == "" && ok {
+			tenantID = caller.TenantID
+		}
+		return tenantID, nil
+	}
+	if tenantID != "" && tenantID != caller.TenantID {
+		return "", ErrForbidden
+	}
+	return caller.TenantID, nil
+}
+
+// validateApprovalAction checks an action can require approval
+func validateApprovalAction(action model.ApprovalAction) error {
+	switch action {
+	case model.ApprovalPromote, model.ApprovalPublish:
+		return nil
+	}
+	return fmt.Errorf("%w: action must be %s or %s", ErrInvalidInput, model.ApprovalPromote, model.ApprovalPublish)
+}
@@ -0,0 +1,317 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// racingApprovals records another approver's decision right before the next
+// decision, as if that approver decided concurrently
+type racingApprovals struct {
+	repository.ApprovalRepository
+	concurrent *model.ApprovalDecision
+}
+
+func (r *racingApprovals) AddDecision(ctx context.Context, d *model.ApprovalDecision) error {
+	if c := r.concurrent; c != nil {
+		r.concurrent = nil
+		c.RequestID = d.RequestID
+		if err := r.ApprovalRepository.AddDecision(ctx, c); err != nil {
+			return err
+		}
+	}
+	return r.ApprovalRepository.AddDecision(ctx, d)
+}
+
+// flakyModels fails model reads with err while it is set
+type flakyModels struct {
+	repository.ModelRepository
+	err error
+}
+
+func (r *flakyModels) GetByID(ctx context.Context, id string) (*model.Model, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.ModelRepository.GetByID(ctx, id)
+}
+
+// approvalTest holds a model service whose tenant needs two developers to
+// approve running a model, and the users involved
+type approvalTest struct {
+	svc       service.ModelService
+	models    repository.ModelRepository
+	flaky     *flakyModels
+	approvals *racingApprovals
+	model     *model.Model
+	owner     *model.User
+	approvers []*model.User
+	viewer    *model.User
+}
+
+func newApprovalTest(t *testing.T) *approvalTest {
+	t.Helper()
+	db := newDB(t)
+	log := logger.New("error")
+	users := repository.NewGormUserRepository(db)
+	models := repository.NewGormModelRepository(db)
+	flaky := &flakyModels{ModelRepository: models}
+	approvals := &racingApprovals{ApprovalRepository: repository.NewGormApprovalRepository(db)}
+	audit := service.NewAuditService(repository.NewGormAuditRepository(db), log)
+	svc := newModelService(t, db, flaky, approvals, audit)
+
+	ctx := context.Background()
+	if _, err := svc.SetApprovalPolicy(ctx, &model.ApprovalPolicy{
+		TenantID:          "tenant-a",
+		Action:            model.ApprovalPromote,
+		RequiredApprovals: 2,
+		ApproverRole:      model.RoleDeveloper,
+	}); err != nil {
+		t.Fatalf("set policy: %v", err)
+	}
+
+	at := &approvalTest{
+		svc:       svc,
+		models:    models,
+		flaky:     flaky,
+		approvals: approvals,
+		owner:     createUser(t, users, "owner", model.RoleDeveloper),
+		approvers: []*model.User{createUser(t, users, "dev1", model.RoleDeveloper), createUser(t, users, "dev2", model.RoleDeveloper)},
+		viewer:    createUser(t, users, "viewer", model.RoleViewer),
+	}
+	at.model = &model.Model{
+		Name:      "bert",
+		Version:   "1.0.0",
+		Framework: model.FrameworkPyTorch,
+		Status:    model.ModelStatusReady,
+		OwnerID:   at.owner.ID,
+		TenantID:  "tenant-a",
+	}
+	if err := models.Create(ctx, at.model); err != nil {
+		t.Fatalf("create model: %v", err)
+	}
+	return at
+}
+
+// request asks to run the model as its owner and returns the pending request
+func (at *approvalTest) request(t *testing.T) *model.ApprovalRequest {
+	t.Helper()
+	err := at.svc.UpdateModelStatus(as(at.owner), at.model.ID, model.ModelStatusRunning)
+	var required *service.ApprovalRequiredError
+	if !errors.As(err, &required) {
+		t.Fatalf("UpdateModelStatus error = %v, want ApprovalRequiredError", err)
+	}
+	return required.Request
+}
+
+// status returns the stored status of the model
+func (at *approvalTest) status(t *testing.T) model.ModelStatus {
+	t.Helper()
+	m, err := at.models.GetByID(context.Background(), at.model.ID)
+	if err != nil {
+		t.Fatalf("get model: %v", err)
+	}
+	return m.Status
+}
+
+func TestDecideApprovalRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		// decide makes the decisions and returns the last result
+		decide     func(at *approvalTest, id string) (*model.ApprovalRequest, error)
+		wantErr    error
+		wantState  model.ApprovalState
+		wantStatus model.ModelStatus
+	}{
+		{
+			name: "one of two approvals",
+			decide: func(at *approvalTest, id string) (*model.ApprovalRequest, error) {
+				return at.svc.DecideApprovalRequest(as(at.approvers[0]), id, true, "")
+			},
+			wantState:  model.ApprovalPending,
+			wantStatus: model.ModelStatusReady,
+		},
+		{
+			name: "two approvals",
+			decide: func(at *approvalTest, id string) (*model.ApprovalRequest, error) {
+				if _, err := at.svc.DecideApprovalRequest(as(at.approvers[0]), id, true, ""); err != nil {
+					return nil, err
+				}
+				return at.svc.DecideApprovalRequest(as(at.approvers[1]), id, true, "looks good")
+			},
+			wantState:  model.ApprovalApproved,
+			wantStatus: model.ModelStatusRunning,
+		},
+		{
+			name: "rejection",
+			decide: func(at *approvalTest, id string) (*model.ApprovalRequest, error) {
+				return at.svc.DecideApprovalRequest(as(at.approvers[0]), id, false, "not yet")
+			},
+			wantState:  model.ApprovalRejected,
+			wantStatus: model.ModelStatusReady,
+		},
+		{
+			name: "decision after rejection",
+			decide: func(at *approvalTest, id string) (*model.ApprovalRequest, error) {
+				if _, err := at.svc.DecideApprovalRequest(as(at.approvers[0]), id, false, ""); err != nil {
+					return nil, err
+				}
+				return at.svc.DecideApprovalRequest(as(at.approvers[1]), id, true, "")
+			},
+			wantErr:    service.ErrApprovalClosed,
+			wantState:  model.ApprovalRejected,
+			wantStatus: model.ModelStatusReady,
+		},
+		{
+			name: "second decision by the same approver",
+			decide: func(at *approvalTest, id string) (*model.ApprovalRequest, error) {
+				if _, err := at.svc.DecideApprovalRequest(as(at.approvers[0]), id, true, ""); err != nil {
+					return nil, err
+				}
+				return at.svc.DecideApprovalRequest(as(at.approvers[0]), id, true, "")
+			},
+			wantErr:    service.ErrDuplicateDecision,
+			wantState:  model.ApprovalPending,
+			wantStatus: model.ModelStatusReady,
+		},
+		{
+			name: "requester approving",
+			decide: func(at *approvalTest, id string) (*model.ApprovalRequest, error) {
+				return at.svc.DecideApprovalRequest(as(at.owner), id, true, "")
+			},
+			wantErr:    service.ErrForbidden,
+			wantState:  model.ApprovalPending,
+			wantStatus: model.ModelStatusReady,
+		},
+		{
+			name: "approver without the role",
+			decide: func(at *approvalTest, id string) (*model.ApprovalRequest, error) {
+				return at.svc.DecideApprovalRequest(as(at.viewer), id, true, "")
+			},
+			wantErr:    service.ErrForbidden,
+			wantState:  model.ApprovalPending,
+			wantStatus: model.ModelStatusReady,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := newApprovalTest(t)
+			r := at.request(t)
+
+			if _, err := tt.decide(at, r.ID); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("decide error = %v, want %v", err, tt.wantErr)
+			}
+			got, err := at.svc.GetApprovalRequest(context.Background(), r.ID)
+			if err != nil {
+				t.Fatalf("get request: %v", err)
+			}
+			if got.State != tt.wantState {
+				t.Errorf("state = %s, want %s", got.State, tt.wantState)
+			}
+			if status := at.status(t); status != tt.wantStatus {
+				t.Errorf("model status = %s, want %s", status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestDecideApprovalRequestCountsConcurrentApprovals(t *testing.T) {
+	at := newApprovalTest(t)
+	r := at.request(t)
+
+	// The first approver's decision lands after the second approver loaded
+	// the request, so only a fresh count sees both approvals
+	at.approvals.concurrent = &model.ApprovalDecision{ApproverID: at.approvers[0].ID, Approve: true}
+	got, err := at.svc.DecideApprovalRequest(as(at.approvers[1]), r.ID, true, "")
+	if err != nil {
+		t.Fatalf("decide: %v", err)
+	}
+	if got.State != model.ApprovalApproved || len(got.Decisions) != 2 {
+		t.Errorf("request = %s with %d decisions, want approved with 2", got.State, len(got.Decisions))
+	}
+	if status := at.status(t); status != model.ModelStatusRunning {
+		t.Errorf("model status = %s, want %s", status, model.ModelStatusRunning)
+	}
+}
+
+func TestCreateModelAwaitingPublishApproval(t *testing.T) {
+	at := newApprovalTest(t)
+	if _, err := at.svc.SetApprovalPolicy(context.Background(), &model.ApprovalPolicy{
+		TenantID:          "tenant-a",
+		Action:            model.ApprovalPublish,
+		RequiredApprovals: 1,
+		ApproverRole:      model.RoleDeveloper,
+	}); err != nil {
+		t.Fatalf("set policy: %v", err)
+	}
+
+	m, err := at.svc.CreateModel(as(at.owner), service.CreateModelRequest{
+		Name:      "gpt",
+		Version:   "1.0.0",
+		Framework: model.FrameworkPyTorch,
+		IsPublic:  true,
+	})
+	var required *service.ApprovalRequiredError
+	if !errors.As(err, &required) {
+		t.Fatalf("CreateModel error = %v, want ApprovalRequiredError", err)
+	}
+	if m == nil || m.IsPublic || required.Request.ModelID != m.ID || required.Request.Action != model.ApprovalPublish {
+		t.Fatalf("created %+v with request %+v, want a private model awaiting publication", m, required.Request)
+	}
+
+	if _, err := at.svc.DecideApprovalRequest(as(at.approvers[0]), required.Request.ID, true, ""); err != nil {
+		t.Fatalf("decide: %v", err)
+	}
+	got, err := at.models.GetByID(context.Background(), m.ID)
+	if err != nil {
+		t.Fatalf("get model: %v", err)
+	}
+	if !got.IsPublic {
+		t.Error("model still private after publication was approved")
+	}
+}
+
+func TestPromoteVersionFailsClosed(t *testing.T) {
+	at := newApprovalTest(t)
+	ctx := context.Background()
+	if err := at.models.UpdateStatus(ctx, at.model.ID, model.ModelStatusRunning); err != nil {
+		t.Fatalf("update status: %v", err)
+	}
+	if err := at.models.CreateVersion(ctx, &model.ModelVersion{
+		ModelID:   at.model.ID,
+		Version:   "2.0.0",
+		Status:    model.ModelStatusReady,
+		CreatedBy: at.owner.ID,
+	}); err != nil {
+		t.Fatalf("create version: %v", err)
+	}
+
+	// Replacing the running version needs approval
+	if _, err := at.svc.PromoteVersion(as(at.owner), at.model.ID, "2.0.0"); !errors.Is(err, service.ErrApprovalRequired) {
+		t.Fatalf("PromoteVersion error = %v, want ErrApprovalRequired", err)
+	}
+
+	// and is refused, not waved through, when the model cannot be read
+	unavailable := errors.New("database unavailable")
+	for _, ctx := range []context.Context{as(at.owner), context.Background()} {
+		at.flaky.err = unavailable
+		_, err := at.svc.PromoteVersion(ctx, at.model.ID, "2.0.0")
+		at.flaky.err = nil
+		if !errors.Is(err, unavailable) {
+			t.Errorf("PromoteVersion error = %v, want %v", err, unavailable)
+		}
+	}
+	m, err := at.models.GetByID(ctx, at.model.ID)
+	if err != nil {
+		t.Fatalf("get model: %v", err)
+	}
+	if m.Version != "1.0.0" {
+		t.Errorf("version = %s after failed promotions, want 1.0.0", m.Version)
+	}
+}
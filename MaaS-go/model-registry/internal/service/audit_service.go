@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// AuditService records and queries the audit log
+type AuditService interface {
+	Record(ctx context.Context, event AuditEvent) error
+	List(ctx context.Context, query AuditQuery) (*ListAuditResponse, error)
+	Export(ctx context.Context, query AuditQuery, fn func(*model.AuditEntry) error) error
+}
+
+// AuditEvent describes a change to record in the audit log
+type AuditEvent struct {
+	Action     model.AuditAction
+	TargetType string
+	TargetID   string
+	Before     interface{}
+	After      interface{}
+
+	// Source names the service the change happened in; defaults to the registry
+	Source string
+	// ActorID is used when the context carries no caller, e.g. for logins
+	ActorID string
+	// TenantID is the tenant the target belongs to; it defaults to the caller's
+	TenantID string
+}
+
+// AuditQuery represents filters for querying the audit log
+type AuditQuery struct {
+	TargetID string
+	ActorID  string
+	TenantID string
+	Action   model.AuditAction
+	From     time.Time
+	To       time.Time
+	Page     int
+	Limit    int
+}
+
+// ListAuditResponse represents the response for listing audit entries
+type ListAuditResponse struct {
+	Entries []*model.AuditEntry
+	Total   int64
+	Page    int
+	Limit   int
+}
+
+// auditService implements AuditService
+type auditService struct {
+	repo   repository.AuditRepository
+	logger *logger.Logger
+}
+
+// NewAuditService creates a new audit service
+func NewAuditService(repo repository.AuditRepository, logger *logger.Logger) AuditService {
+	return &auditService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Record appends an event to the audit log under the target's tenant,
+// attributing it to the caller in ctx
+func (s *auditService) Record(ctx context.Context, event AuditEvent) error {
+	entry := &model.AuditEntry{
+		OccurredAt: time.Now().UTC(),
+		ActorID:    event.ActorID,
+		TenantID:   event.TenantID,
+		Source:     event.Source,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+	}
+	if entry.Source == "" {
+		entry.Source = "model-registry"
+	}
+	if caller, ok := auth.FromContext(ctx); ok {
+		entry.ActorID = caller.UserID
+		entry.ActorTenantID = caller.TenantID
+		entry.RequestID = caller.RequestID
+		if entry.TenantID == "" {
+			entry.TenantID = caller.TenantID
+		}
+	}
+
+	var err error
+	if entry.Before, err = marshalSnapshot(event.Before); err != nil {
+		return err
+	}
+	if entry.After, err = marshalSnapshot(event.After); err != nil {
+		return err
+	}
+	if entry.Diff, err = diffSnapshots(entry.Before, entry.After); err != nil {
+		return err
+	}
+
+	return s.repo.Append(ctx, entry)
+}
+
+// List retrieves a paginated list of audit entries
+func (s *auditService) List(ctx context.Context, query AuditQuery) (*ListAuditResponse, error) {
+	entries, total, err := s.repo.List(ctx, s.filter(ctx, query), repository.Pagination{
+		Page:  query.Page,
+		Limit: query.Limit,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list audit entries", "error", err)
+		return nil, err
+	}
+
+	return &ListAuditResponse{
+		Entries: entries,
+		Total:   total,
+		Page:    query.Page,
+		Limit:   query.Limit,
+	}, nil
+}
+
+// Export calls fn for every matching audit entry in chronological order
+func (s *auditService) Export(ctx context.Context, query AuditQuery, fn func(*model.AuditEntry) error) error {
+	return s.repo.Each(ctx, s.filter(ctx, query), fn)
+}
+
+// filter converts a query to repository filters; non-admin callers only see their tenant
+func (s *auditService) filter(ctx context.Context, query AuditQuery) repository.AuditFilter {
+	filter := repository.AuditFilter{
+		TargetID: query.TargetID,
+		ActorID:  query.ActorID,
+		TenantID: query.TenantID,
+		Action:   query.Action,
+		From:     query.From,
+		To:       query.To,
+	}
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		filter.TenantID = caller.TenantID
+	}
+	return filter
+}
+
+// marshalSnapshot encodes a before or after state; nil stays empty
+func marshalSnapshot(v interface{}) (json.RawMessage, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// diffSnapshots returns the changed top-level fields as {"field": {"from": x, "to": y}}
+func diffSnapshots(before, after json.RawMessage) (json.RawMessage, error) {
+	var from, to interface{}
+	if len(before) > 0 {
+		if err := json.Unmarshal(before, &from); err != nil {
+			return nil, err
+		}
+	}
+	if len(after) > 0 {
+		if err := json.Unmarshal(after, &to); err != nil {
+			return nil, err
+		}
+	}
+
+	type change struct {
+		From interface{} `json:"from"`
+		To   interface{} `json:"to"`
+	}
+
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if (from != nil && !fromIsMap) || (to != nil && !toIsMap) {
+		if reflect.DeepEqual(from, to) {
+			return nil, nil
+		}
+		return json.Marshal(change{From: from, To: to})
+	}
+
+	diff := make(map[string]change)
+	for key, value := range toMap {
+		if key == "updated_at" {
+			continue
+		}
+		if old, ok := fromMap[key]; !ok || !reflect.DeepEqual(old, value) {
+			diff[key] = change{From: fromMap[key], To: value}
+		}
+	}
+	for key, value := range fromMap {
+		if _, ok := toMap[key]; !ok && key != "updated_at" {
+			diff[key] = change{From: value}
+		}
+	}
+	if len(diff) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(diff)
+}
@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+func TestAuditEntriesBelongToTheTargetTenant(t *testing.T) {
+	db := newDB(t)
+	log := logger.New("error")
+	audit := service.NewAuditService(repository.NewGormAuditRepository(db), log)
+	models := repository.NewGormModelRepository(db)
+	svc := newModelService(t, db, models, repository.NewGormApprovalRepository(db), audit)
+
+	m := &model.Model{Name: "bert", Version: "1.0.0", Framework: model.FrameworkPyTorch, OwnerID: uuid.New().String(), TenantID: "tenant-a"}
+	if err := models.Create(context.Background(), m); err != nil {
+		t.Fatalf("create model: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		caller     auth.Caller
+		wantActor  string
+		wantTenant string
+	}{
+		{"owner tenant member", auth.Caller{UserID: m.OwnerID, TenantID: "tenant-a", Role: model.RoleDeveloper}, "tenant-a", "tenant-a"},
+		{"admin of another tenant", auth.Caller{UserID: uuid.New().String(), TenantID: "tenant-ops", Role: model.RoleAdmin}, "tenant-ops", "tenant-a"},
+		{"trusted service", auth.Caller{}, "", "tenant-a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.caller.UserID != "" {
+				ctx = auth.NewContext(ctx, tt.caller)
+			}
+			if err := svc.AddModelTags(ctx, m.ID, []string{uuid.New().String()}); err != nil {
+				t.Fatalf("add tags: %v", err)
+			}
+
+			resp, err := audit.List(context.Background(), service.AuditQuery{TargetID: m.ID, Action: model.AuditModelTagsAdd, Limit: 1})
+			if err != nil || len(resp.Entries) == 0 {
+				t.Fatalf("list audit entries = %v, %v", resp, err)
+			}
+			got := resp.Entries[0]
+			if got.TenantID != tt.wantTenant || got.ActorTenantID != tt.wantActor || got.ActorID != tt.caller.UserID {
+				t.Errorf("entry tenant = %q, actor %q of tenant %q; want tenant %q, actor %q of tenant %q",
+					got.TenantID, got.ActorID, got.ActorTenantID, tt.wantTenant, tt.caller.UserID, tt.wantActor)
+			}
+
+			// The target tenant's members see the entry
+			member := auth.NewContext(context.Background(), auth.Caller{UserID: uuid.New().String(), TenantID: "tenant-a", Role: model.RoleDeveloper})
+			visible, err := audit.List(member, service.AuditQuery{TargetID: m.ID})
+			if err != nil {
+				t.Fatalf("list as member: %v", err)
+			}
+			found := false
+			for _, e := range visible.Entries {
+				found = found || e.ID == got.ID
+			}
+			if !found {
+				t.Error("entry is hidden from the target tenant")
+			}
+		})
+	}
+}
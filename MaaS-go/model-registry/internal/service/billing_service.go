@@ -0,0 +1,560 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// Billing errors
+var (
+	ErrPlanNotFound    = repository.ErrPlanNotFound
+	ErrDuplicatePlan   = repository.ErrDuplicatePlan
+	ErrInvoiceNotFound = repository.ErrInvoiceNotFound
+	ErrInvoiceFinal    = repository.ErrInvoiceFinal
+	// ErrNoPricingPlan is returned when invoicing a tenant without a plan
+	ErrNoPricingPlan = errors.New("tenant has no pricing plan")
+)
+
+// bytesPerGB is the size of a billed gigabyte
+const bytesPerGB = 1 << 30
+
+// BillingService manages pricing plans and generates monthly invoices from metered usage
+type BillingService interface {
+	CreatePlan(ctx context.Context, p *model.PricingPlan) (*model.PricingPlan, error)
+	UpdatePlan(ctx context.Context, p *model.PricingPlan) (*model.PricingPlan, error)
+	ListPlans(ctx context.Context) ([]*model.PricingPlan, error)
+	SetTenantPlan(ctx context.Context, tenantID, planID string) error
+
+	// GenerateInvoice computes the invoice of a tenant for the month containing
+	// month and stores it, replacing an earlier one for the same month unless
+	// that one is final
+	GenerateInvoice(ctx context.Context, tenantID string, month time.Time) (*model.Invoice, error)
+	GetInvoice(ctx context.Context, id string) (*model.Invoice, error)
+	ListInvoices(ctx context.Context, query InvoiceQuery) (*ListInvoicesResponse, error)
+	// GenerateDueInvoices refreshes the current month's invoices and finalizes
+	// last month's for every tenant with a plan; it returns how many it generated
+	GenerateDueInvoices(ctx context.Context, now time.Time) (int, error)
+}
+
+// InvoiceQuery represents filters for listing invoices
+type InvoiceQuery struct {
+	TenantID string
+	From     time.Time
+	To       time.Time
+	Page     int
+	Limit    int
+}
+
+// ListInvoicesResponse represents the response for listing invoices
+type ListInvoicesResponse struct {
+	Invoices []*model.Invoice
+	Total    int64
+	Page     int
+	Limit    int
+}
+
+// billingService implements BillingService
+type billingService struct {
+	repo   repository.BillingRepository
+	usage  repository.UsageRepository
+	audit  AuditService
+	logger *logger.Logger
+}
+
+// NewBillingService creates a new billing service
+func NewBillingService(repo repository.BillingRepository, usage repository.UsageRepository, audit AuditService, logger *logger.Logger) BillingService {
+	return &billingService{
+		repo:   repo,
+		usage:  usage,
+		audit:  audit,
+		logger: logger,
+	}
+}
+
+// CreatePlan creates a pricing plan; admins only
+func (s *billingService) CreatePlan(ctx context.Context, p *model.PricingPlan) (*model.PricingPlan, error) {
+	if err := authorizeBillingChange(ctx); err != nil {
+		return nil, err
+	}
+	if err := validatePlan(p); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreatePlan(ctx, p); err != nil {
+		if !errors.Is(err, ErrDuplicatePlan) {
+			s.logger.Error("Failed to create pricing plan", "name", p.Name, "error", err)
+		}
+		return nil, err
+	}
+
+	s.record(ctx, model.AuditPlanCreate, "pricing_plan", p.ID, "", nil, p)
+	s.logger.Info("Pricing plan created", "id", p.ID, "name", p.Name)
+	return p, nil
+}
+
+// UpdatePlan replaces the prices of a plan; admins only. Invoices already
+// generated keep their amounts until they are regenerated.
+func (s *billingService) UpdatePlan(ctx context.Context, p *model.PricingPlan) (*model.PricingPlan, error) {
+	if err := authorizeBillingChange(ctx); err != nil {
+		return nil, err
+	}
+	if err := validatePlan(p); err != nil {
+		return nil, err
+	}
+
+	before, err := s.repo.GetPlan(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	p.CreatedAt = before.CreatedAt
+
+	if err := s.repo.UpdatePlan(ctx, p); err != nil {
+		if !errors.Is(err, ErrDuplicatePlan) {
+			s.logger.Error("Failed to update pricing plan", "id", p.ID, "error", err)
+		}
+		return nil, err
+	}
+
+	s.record(ctx, model.AuditPlanUpdate, "pricing_plan", p.ID, "", before, p)
+	return p, nil
+}
+
+// ListPlans lists all pricing plans
+func (s *billingService) ListPlans(ctx context.Context) ([]*model.PricingPlan, error) {
+	plans, err := s.repo.ListPlans(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list pricing plans", "error", err)
+		return nil, err
+	}
+	return plans, nil
+}
+
+// SetTenantPlan attaches a plan to a tenant; admins only
+func (s *billingService) SetTenantPlan(ctx context.Context, tenantID, planID string) error {
+	if err := authorizeBillingChange(ctx); err != nil {
+		return err
+	}
+	if tenantID == "" {
+		return fmt.Errorf("%w: tenant_id is required", ErrInvalidInput)
+	}
+	if _, err := s.repo.GetPlan(ctx, planID); err != nil {
+		return err
+	}
+
+	var before interface{}
+	if current, err := s.repo.GetTenantPlan(ctx, tenantID); err == nil {
+		before = map[string]string{"plan_id": current.ID}
+	}
+	if err := s.repo.SetTenantPlan(ctx, tenantID, planID); err != nil {
+		s.logger.Error("Failed to set tenant plan", "tenant_id", tenantID, "plan_id", planID, "error", err)
+		return err
+	}
+
+	s.record(ctx, model.AuditTenantPlan, "tenant", tenantID, tenantID, before, map[string]string{"plan_id": planID})
+	return nil
+}
+
+// GenerateInvoice prices a tenant's month with its current plan; admins only.
+// Final invoices are kept as they are.
+func (s *billingService) GenerateInvoice(ctx context.Context, tenantID string, month time.Time) (*model.Invoice, error) {
+	if err := authorizeBillingChange(ctx); err != nil {
+		return nil, err
+	}
+	return s.generate(ctx, tenantID, month, time.Now().UTC())
+}
+
+// GetInvoice retrieves an invoice; non-admin callers only see their tenant's
+func (s *billingService) GetInvoice(ctx context.Context, id string) (*model.Invoice, error) {
+	inv, err := s.repo.GetInvoice(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() && caller.TenantID != inv.TenantID {
+		// Other tenants' invoices are reported as missing so IDs cannot be probed
+		return nil, ErrInvoiceNotFound
+	}
+	return inv, nil
+}
+
+// ListInvoices lists invoices; non-admin callers only see their tenant's
+func (s *billingService) ListInvoices(ctx context.Context, query InvoiceQuery) (*ListInvoicesResponse, error) {
+	filter := repository.InvoiceFilter{
+		TenantID: query.TenantID,
+		From:     query.From,
+		To:       query.To,
+	}
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		if caller.TenantID == "" {
+			return nil, ErrForbidden
+		}
+		filter.TenantID = caller.TenantID
+	}
+
+	invoices, total, err := s.repo.ListInvoices(ctx, filter, repository.Pagination{
+		Page:  query.Page,
+		Limit: query.Limit,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list invoices", "error", err)
+		return nil, err
+	}
+
+	return &ListInvoicesResponse{
+		Invoices: invoices,
+		Total:    total,
+		Page:     query.Page,
+		Limit:    query.Limit,
+	}, nil
+}
+
+// GenerateDueInvoices runs for the invoice scheduler as a trusted caller
+func (s *billingService) GenerateDueInvoices(ctx context.Context, now time.Time) (int, error) {
+	assignments, err := s.repo.TenantPlans(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	current := monthStart(now)
+	previous := current.AddDate(0, -1, 0)
+	generated := 0
+	for _, a := range assignments {
+		// Last month is regenerated until an invoice was produced after it ended
+		if inv, err := s.repo.GetInvoiceByPeriod(ctx, a.TenantID, previous); err != nil || !inv.Final {
+			if _, err := s.generate(ctx, a.TenantID, previous, now); err != nil {
+				s.logger.Error("Failed to generate invoice", "tenant_id", a.TenantID, "period", previous.Format("2006-01"), "error", err)
+			} else {
+				generated++
+			}
+		}
+		if _, err := s.generate(ctx, a.TenantID, current, now); err != nil {
+			s.logger.Error("Failed to generate invoice", "tenant_id", a.TenantID, "period", current.Format("2006-01"), "error", err)
+		} else {
+			generated++
+		}
+	}
+	return generated, nil
+}
+
+// generate computes and stores an invoice as of now
+func (s *billingService) generate(ctx context.Context, tenantID string, month, now time.Time) (*model.Invoice, error) {
+	if tenantID == "" {
+		return nil, fmt.Errorf("%w: tenant_id is required", ErrInvalidInput)
+	}
+	start := monthStart(month)
+	end := start.AddDate(0, 1, 0)
+	if start.After(now) {
+		return nil, fmt.Errorf("%w: cannot invoice a future month", ErrInvalidInput)
+	}
+	// A final invoice was priced with the plan of its time and may have been sent
+	if existing, err := s.repo.GetInvoiceByPeriod(ctx, tenantID, start); err == nil && existing.Final {
+		return nil, ErrInvoiceFinal
+	} else if err != nil && !errors.Is(err, ErrInvoiceNotFound) {
+		return nil, err
+	}
+
+	plan, err := s.repo.GetTenantPlan(ctx, tenantID)
+	if errors.Is(err, ErrPlanNotFound) {
+		return nil, ErrNoPricingPlan
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := s.usage.Summarize(ctx, repository.UsageFilter{TenantID: tenantID, From: start, To: end},
+		[]string{repository.UsageByModel, repository.UsageByStatus})
+	if err != nil {
+		return nil, err
+	}
+	// Storage accrues only up to now while the month is still running
+	accrueTo := end
+	if now.Before(end) {
+		accrueTo = now
+	}
+	models, err := s.repo.TenantModels(ctx, tenantID, start, accrueTo)
+	if err != nil {
+		return nil, err
+	}
+
+	inv := priceInvoice(plan, usage, models, start, end, accrueTo)
+	inv.TenantID = tenantID
+	inv.Final = !now.Before(end)
+	inv.GeneratedAt = now
+
+	if err := s.repo.SaveInvoice(ctx, inv); err != nil {
+		s.logger.Error("Failed to save invoice", "tenant_id", tenantID, "period", start.Format("2006-01"), "error", err)
+		return nil, err
+	}
+
+	s.record(ctx, model.AuditInvoiceGenerate, "invoice", inv.ID, inv.TenantID, nil, map[string]interface{}{
+		"period":       start.Format("2006-01"),
+		"total_micros": inv.TotalMicros,
+		"final":        inv.Final,
+	})
+	return inv, nil
+}
+
+// modelUsage is the billable usage of one model in a month
+type modelUsage struct {
+	requests int64
+	tokens   int64
+	storage  float64
+}
+
+// priceInvoice builds the line items of a month from successful calls and the
+// storage accrued up to accrueTo. Free allowances are used up in model ID order
+// so that regenerating from the same data yields the same invoice.
+func priceInvoice(plan *model.PricingPlan, usage []*repository.UsageSummary, models []*model.Model, start, end, accrueTo time.Time) *model.Invoice {
+	perModel := make(map[string]*modelUsage)
+	names := make(map[string]string)
+	get := func(id string) *modelUsage {
+		u, ok := perModel[id]
+		if !ok {
+			u = &modelUsage{}
+			perModel[id] = u
+		}
+		return u
+	}
+
+	for _, u := range usage {
+		if u.Status != model.UsageSuccess {
+			continue
+		}
+		m := get(u.ModelID)
+		m.requests += u.Requests
+		m.tokens += u.PromptTokens + u.CompletionTokens
+	}
+	monthSeconds := end.Sub(start).Seconds()
+	for _, m := range models {
+		names[m.ID] = m.Name
+		if gbMonths := storageGBMonths(m, start, accrueTo, monthSeconds); gbMonths > 0 {
+			get(m.ID).storage += gbMonths
+		}
+	}
+
+	ids := make([]string, 0, len(perModel))
+	for id := range perModel {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	inv := &model.Invoice{
+		PeriodStart: start,
+		PeriodEnd:   end,
+		PlanID:      plan.ID,
+		PlanName:    plan.Name,
+		Currency:    plan.Currency,
+		LineItems:   make([]model.InvoiceLineItem, 0),
+	}
+	freeRequests, freeTokens, freeStorage := plan.FreeRequests, plan.FreeTokens, plan.FreeStorageGBMonth
+	for _, id := range ids {
+		u := perModel[id]
+		label := id
+		if name := names[id]; name != "" {
+			label = name
+		}
+
+		if u.requests > 0 {
+			billable := u.requests - freeRequests
+			freeRequests -= u.requests - max(billable, 0)
+			billable = max(billable, 0)
+			inv.LineItems = append(inv.LineItems, model.InvoiceLineItem{
+				Kind:            model.LineItemRequests,
+				Description:     "Inference requests: " + label + freeNote(u.requests-billable, "requests"),
+				ModelID:         id,
+				Quantity:        float64(billable),
+				Unit:            "request",
+				UnitPriceMicros: plan.RequestPriceMicros,
+				AmountMicros:    billable * plan.RequestPriceMicros,
+			})
+		}
+		if u.tokens > 0 {
+			billable := u.tokens - freeTokens
+			freeTokens -= u.tokens - max(billable, 0)
+			billable = max(billable, 0)
+			inv.LineItems = append(inv.LineItems, model.InvoiceLineItem{
+				Kind:            model.LineItemTokens,
+				Description:     "Tokens: " + label + freeNote(u.tokens-billable, "tokens"),
+				ModelID:         id,
+				Quantity:        float64(billable) / 1000,
+				Unit:            "1k tokens",
+				UnitPriceMicros: plan.TokenPriceMicros,
+				AmountMicros:    (billable*plan.TokenPriceMicros + 500) / 1000,
+			})
+		}
+		if u.storage > 0 {
+			billable := math.Max(u.storage-freeStorage, 0)
+			freeStorage -= u.storage - billable
+			billable = math.Round(billable*1e6) / 1e6
+			inv.LineItems = append(inv.LineItems, model.InvoiceLineItem{
+				Kind:            model.LineItemStorage,
+				Description:     "Artifact storage: " + label,
+				ModelID:         id,
+				Quantity:        billable,
+				Unit:            "GB-month",
+				UnitPriceMicros: plan.StoragePriceMicros,
+				AmountMicros:    int64(math.Round(billable * float64(plan.StoragePriceMicros))),
+			})
+		}
+	}
+
+	for _, item := range inv.LineItems {
+		inv.SubtotalMicros += item.AmountMicros
+	}
+	for _, d := range discountLines(plan, inv.SubtotalMicros) {
+		inv.LineItems = append(inv.LineItems, d)
+		inv.DiscountMicros -= d.AmountMicros
+	}
+	inv.TotalMicros = inv.SubtotalMicros - inv.DiscountMicros
+	return inv
+}
+
+// discountLines applies graduated discount tiers to a subtotal; each tier
+// discounts the part of the subtotal between its threshold and the next one
+func discountLines(plan *model.PricingPlan, subtotal int64) []model.InvoiceLineItem {
+	tiers := append([]model.DiscountTier(nil), plan.DiscountTiers...)
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].FromMicros < tiers[j].FromMicros })
+
+	var lines []model.InvoiceLineItem
+	for i, tier := range tiers {
+		upper := subtotal
+		if i+1 < len(tiers) && tiers[i+1].FromMicros < upper {
+			upper = tiers[i+1].FromMicros
+		}
+		portion := upper - tier.FromMicros
+		if portion <= 0 || tier.Percent <= 0 {
+			continue
+		}
+		amount := int64(math.Round(float64(portion) * tier.Percent / 100))
+		if amount == 0 {
+			continue
+		}
+		lines = append(lines, model.InvoiceLineItem{
+			Kind:         model.LineItemDiscount,
+			Description:  fmt.Sprintf("Volume discount %g%% above %s", tier.Percent, formatMicros(tier.FromMicros)),
+			Quantity:     1,
+			Unit:         "discount",
+			AmountMicros: -amount,
+		})
+	}
+	return lines
+}
+
+// storageGBMonths returns the GB-months a model's artifacts occupied in
+// [start, accrueTo), counting the model's own artifact unless it is a promoted version's
+func storageGBMonths(m *model.Model, start, accrueTo time.Time, monthSeconds float64) float64 {
+	until := accrueTo
+	if m.DeletedAt.Valid && m.DeletedAt.Time.Before(until) {
+		until = m.DeletedAt.Time
+	}
+	span := func(from time.Time) float64 {
+		if from.Before(start) {
+			from = start
+		}
+		if !from.Before(until) {
+			return 0
+		}
+		return until.Sub(from).Seconds()
+	}
+
+	var byteSeconds float64
+	ownArtifact := true
+	for _, v := range m.Versions {
+		byteSeconds += float64(v.Size) * span(v.CreatedAt)
+		if v.StoragePath != "" && v.StoragePath == m.StoragePath {
+			ownArtifact = false
+		}
+	}
+	if ownArtifact {
+		byteSeconds += float64(m.Size) * span(m.CreatedAt)
+	}
+	return byteSeconds / bytesPerGB / monthSeconds
+}
+
+// validatePlan checks a plan's name, currency, prices and tiers
+func validatePlan(p *model.PricingPlan) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" || len(p.Name) > 100 {
+		return fmt.Errorf("%w: name is required and at most 100 characters", ErrInvalidInput)
+	}
+	if p.Currency == "" {
+		p.Currency = "USD"
+	}
+	p.Currency = strings.ToUpper(p.Currency)
+	if len(p.Currency) != 3 {
+		return fmt.Errorf("%w: currency must be an ISO 4217 code", ErrInvalidInput)
+	}
+	if p.RequestPriceMicros < 0 || p.TokenPriceMicros < 0 || p.StoragePriceMicros < 0 {
+		return fmt.Errorf("%w: prices must not be negative", ErrInvalidInput)
+	}
+	if p.FreeRequests < 0 || p.FreeTokens < 0 || p.FreeStorageGBMonth < 0 {
+		return fmt.Errorf("%w: free allowances must not be negative", ErrInvalidInput)
+	}
+	seen := make(map[int64]bool, len(p.DiscountTiers))
+	for _, tier := range p.DiscountTiers {
+		if tier.FromMicros < 0 || tier.Percent <= 0 || tier.Percent > 100 {
+			return fmt.Errorf("%w: discount tiers need a non-negative threshold and a percent in (0, 100]", ErrInvalidInput)
+		}
+		if seen[tier.FromMicros] {
+			return fmt.Errorf("%w: duplicate discount tier threshold", ErrInvalidInput)
+		}
+		seen[tier.FromMicros] = true
+	}
+	return nil
+}
+
+// authorizeBillingChange allows admins and trusted services
+func authorizeBillingChange(ctx context.Context) error {
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// record writes a billing change to the audit log
+func (s *billingService) record(ctx context.Context, action model.AuditAction, targetType, targetID, tenantID string, before, after interface{}) {
+	err := s.audit.Record(ctx, AuditEvent{
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     before,
+		After:      after,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to record audit entry", "action", action, "target_id", targetID, "error", err)
+	}
+}
+
+// monthStart returns the first instant of the UTC month containing t
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// freeNote describes the free allowance applied to a line item
+func freeNote(free int64, unit string) string {
+	if free <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%d free %s)", free, unit)
+}
+
+// formatMicros formats an amount in micros with two decimals
+func formatMicros(micros int64) string {
+	sign := ""
+	if micros < 0 {
+		sign, micros = "-", -micros
+	}
+	cents := (micros + 5000) / 10000
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
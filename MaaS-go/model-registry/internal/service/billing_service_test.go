@@ -0,0 +1,182 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+)
+
+func TestDiscountLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		tiers    []model.DiscountTier
+		subtotal int64
+		want     []int64
+	}{
+		{"no tiers", nil, 5_000_000, nil},
+		{"flat", []model.DiscountTier{{FromMicros: 0, Percent: 10}}, 1_000_000, []int64{-100_000}},
+		{"below the first threshold", []model.DiscountTier{{FromMicros: 1_000_000, Percent: 10}}, 900_000, nil},
+		{"graduated", []model.DiscountTier{{FromMicros: 1_000_000, Percent: 10}, {FromMicros: 5_000_000, Percent: 20}}, 6_000_000, []int64{-400_000, -200_000}},
+		{"unsorted tiers", []model.DiscountTier{{FromMicros: 5_000_000, Percent: 20}, {FromMicros: 1_000_000, Percent: 10}}, 6_000_000, []int64{-400_000, -200_000}},
+		{"within the first of two tiers", []model.DiscountTier{{FromMicros: 1_000_000, Percent: 10}, {FromMicros: 5_000_000, Percent: 20}}, 3_000_000, []int64{-200_000}},
+		{"zero percent", []model.DiscountTier{{FromMicros: 0, Percent: 0}}, 1_000_000, nil},
+		{"rounds to nothing", []model.DiscountTier{{FromMicros: 0, Percent: 1}}, 40, nil},
+		{"rounds half away from zero", []model.DiscountTier{{FromMicros: 0, Percent: 5}}, 10, []int64{-1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := discountLines(&model.PricingPlan{DiscountTiers: tt.tiers}, tt.subtotal)
+			var got []int64
+			for _, line := range lines {
+				if line.Kind != model.LineItemDiscount {
+					t.Errorf("line kind = %s, want %s", line.Kind, model.LineItemDiscount)
+				}
+				got = append(got, line.AmountMicros)
+			}
+			if !equalInts(got, tt.want) {
+				t.Errorf("discounts = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func equalInts(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// wantLine is the part of an invoice line a pricing test checks
+type wantLine struct {
+	kind     string
+	modelID  string
+	quantity float64
+	amount   int64
+}
+
+func TestPriceInvoice(t *testing.T) {
+	start := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	mid := start.Add(end.Sub(start) / 2)
+
+	plan := &model.PricingPlan{
+		ID:                 "plan-1",
+		Name:               "standard",
+		Currency:           "USD",
+		RequestPriceMicros: 1_000,
+		TokenPriceMicros:   2_000,
+		StoragePriceMicros: 100_000,
+		FreeRequests:       150,
+		FreeTokens:         1_000,
+	}
+	usage := []*repository.UsageSummary{
+		{ModelID: "b", Status: model.UsageSuccess, Requests: 100, PromptTokens: 1_000, CompletionTokens: 500},
+		{ModelID: "a", Status: model.UsageSuccess, Requests: 100},
+		{ModelID: "a", Status: model.UsageServerError, Requests: 50, PromptTokens: 9_000},
+	}
+	gb := int64(bytesPerGB)
+
+	tests := []struct {
+		name      string
+		plan      *model.PricingPlan
+		usage     []*repository.UsageSummary
+		models    []*model.Model
+		accrueTo  time.Time
+		want      []wantLine
+		wantTotal int64
+	}{
+		{
+			name:  "free allowances are used up in model ID order",
+			plan:  plan,
+			usage: usage,
+			want: []wantLine{
+				{model.LineItemRequests, "a", 0, 0},
+				{model.LineItemRequests, "b", 50, 50_000},
+				{model.LineItemTokens, "b", 0.5, 1_000},
+			},
+			wantTotal: 51_000,
+		},
+		{
+			name:      "storage accrues for the whole month",
+			plan:      plan,
+			models:    []*model.Model{{ID: "a", Name: "bert", Size: gb, CreatedAt: start.AddDate(0, -1, 0)}},
+			accrueTo:  end,
+			want:      []wantLine{{model.LineItemStorage, "a", 1, 100_000}},
+			wantTotal: 100_000,
+		},
+		{
+			name:      "storage accrues up to now in an open month",
+			plan:      plan,
+			models:    []*model.Model{{ID: "a", Size: 2 * gb, CreatedAt: start}},
+			accrueTo:  mid,
+			want:      []wantLine{{model.LineItemStorage, "a", 1, 100_000}},
+			wantTotal: 100_000,
+		},
+		{
+			name: "storage stops when the model is deleted",
+			plan: plan,
+			models: []*model.Model{{ID: "a", Size: gb, CreatedAt: start,
+				DeletedAt: gorm.DeletedAt{Time: mid, Valid: true}}},
+			accrueTo:  end,
+			want:      []wantLine{{model.LineItemStorage, "a", 0.5, 50_000}},
+			wantTotal: 50_000,
+		},
+		{
+			name: "a promoted version's artifact is counted once",
+			plan: plan,
+			models: []*model.Model{{ID: "a", Size: gb, CreatedAt: start, StoragePath: "a/2",
+				Versions: []model.ModelVersion{
+					{Version: "1", Size: gb, StoragePath: "a/1", CreatedAt: start},
+					{Version: "2", Size: gb, StoragePath: "a/2", CreatedAt: start},
+				}}},
+			accrueTo:  end,
+			want:      []wantLine{{model.LineItemStorage, "a", 2, 200_000}},
+			wantTotal: 200_000,
+		},
+		{
+			name: "discounts apply to the subtotal",
+			plan: &model.PricingPlan{RequestPriceMicros: 10_000, DiscountTiers: []model.DiscountTier{{FromMicros: 500_000, Percent: 10}}},
+			usage: []*repository.UsageSummary{
+				{ModelID: "a", Status: model.UsageSuccess, Requests: 100},
+			},
+			want: []wantLine{
+				{model.LineItemRequests, "a", 100, 1_000_000},
+				{model.LineItemDiscount, "", 1, -50_000},
+			},
+			wantTotal: 950_000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accrueTo := tt.accrueTo
+			if accrueTo.IsZero() {
+				accrueTo = end
+			}
+			inv := priceInvoice(tt.plan, tt.usage, tt.models, start, end, accrueTo)
+
+			if len(inv.LineItems) != len(tt.want) {
+				t.Fatalf("got %d line items, want %d: %+v", len(inv.LineItems), len(tt.want), inv.LineItems)
+			}
+			for i, want := range tt.want {
+				got := inv.LineItems[i]
+				if got.Kind != want.kind || got.ModelID != want.modelID || got.Quantity != want.quantity || got.AmountMicros != want.amount {
+					t.Errorf("line %d = %s %s x%g = %d, want %s %s x%g = %d", i,
+						got.Kind, got.ModelID, got.Quantity, got.AmountMicros, want.kind, want.modelID, want.quantity, want.amount)
+				}
+			}
+			if inv.TotalMicros != tt.wantTotal || inv.SubtotalMicros-inv.DiscountMicros != inv.TotalMicros {
+				t.Errorf("total = %d (subtotal %d, discount %d), want %d", inv.TotalMicros, inv.SubtotalMicros, inv.DiscountMicros, tt.wantTotal)
+			}
+		})
+	}
+}
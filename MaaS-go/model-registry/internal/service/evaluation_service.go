@@ -0,0 +1,513 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/shared/signature"
+)
+
+// ErrPromotionBlocked is returned when a version fails its model's promotion rules
+var ErrPromotionBlocked = errors.New("promotion blocked by promotion rules")
+
+// maxEvaluationsPerRequest caps how many results one call may record
+const maxEvaluationsPerRequest = 500
+
+// EvaluationResult is one result to record against a model version
+type EvaluationResult struct {
+	Metric  string
+	Value   float64
+	Dataset string
+	Split   string
+	// EvaluatedAt defaults to the time the result is recorded
+	EvaluatedAt time.Time
+}
+
+// VersionComparison diffs two versions of a model
+type VersionComparison struct {
+	ModelID   string              `json:"model_id"`
+	Base      *model.ModelVersion `json:"base"`
+	Target    *model.ModelVersion `json:"target"`
+	Metrics   []MetricDiff        `json:"metrics"`
+	Signature []TensorDiff        `json:"signature"`
+	Fields    []FieldDiff         `json:"fields"`
+	// Rules are the model's promotion rules checked as if target replaced base
+	Rules      []RuleCheck `json:"rules"`
+	Promotable bool        `json:"promotable"`
+}
+
+// MetricDiff compares the latest result of a metric on a dataset split; Base
+// or Target is nil when that version has no such result
+type MetricDiff struct {
+	Metric  string   `json:"metric"`
+	Dataset string   `json:"dataset"`
+	Split   string   `json:"split"`
+	Base    *float64 `json:"base,omitempty"`
+	Target  *float64 `json:"target,omitempty"`
+	Delta   *float64 `json:"delta,omitempty"`
+	// RelativeDelta is Delta as a fraction of Base, unset when Base is missing or zero
+	RelativeDelta *float64 `json:"relative_delta,omitempty"`
+}
+
+// TensorDiff is an input or output that differs between two signatures
+type TensorDiff struct {
+	// Kind is input or output
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Change is added, removed or changed
+	Change string `json:"change"`
+	Base   string `json:"base,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
+// FieldDiff is a version attribute that differs between two versions
+type FieldDiff struct {
+	Field  string `json:"field"`
+	Base   string `json:"base"`
+	Target string `json:"target"`
+}
+
+// RuleCheck is the outcome of one promotion rule
+type RuleCheck struct {
+	Rule    *model.PromotionRule `json:"rule"`
+	Passed  bool                 `json:"passed"`
+	Message string               `json:"message"`
+}
+
+// RecordEvaluations records evaluation results against a version of a model
+func (s *modelService) RecordEvaluations(ctx context.Context, modelID, version string, results []EvaluationResult) ([]*model.Evaluation, error) {
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: at least one result is required", ErrInvalidInput)
+	}
+	if len(results) > maxEvaluationsPerRequest {
+		return nil, fmt.Errorf("%w: at most %d results may be recorded at once", ErrInvalidInput, maxEvaluationsPerRequest)
+	}
+	for i, r := range results {
+		if r.Metric == "" || len(r.Metric) > 100 {
+			return nil, fmt.Errorf("%w: result %d needs a metric name of at most 100 characters", ErrInvalidInput, i)
+		}
+		if math.IsNaN(r.Value) || math.IsInf(r.Value, 0) {
+			return nil, fmt.Errorf("%w: result %d (%s) has a non-finite value", ErrInvalidInput, i, r.Metric)
+		}
+		if len(r.Dataset) > 200 || len(r.Split) > 50 {
+			return nil, fmt.Errorf("%w: result %d (%s) has an over-long dataset or split", ErrInvalidInput, i, r.Metric)
+		}
+	}
+	m, err := s.repo.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, m, true); err != nil {
+		return nil, err
+	}
+	if _, err := s.versionOf(ctx, m, version); err != nil {
+		return nil, err
+	}
+
+	recordedBy := ""
+	if caller, ok := auth.FromContext(ctx); ok {
+		recordedBy = caller.UserID
+	}
+	now := time.Now().UTC()
+	evaluations := make([]*model.Evaluation, len(results))
+	for i, r := range results {
+		evaluatedAt := r.EvaluatedAt
+		if evaluatedAt.IsZero() {
+			evaluatedAt = now
+		}
+		evaluations[i] = &model.Evaluation{
+			ModelID:     modelID,
+			Version:     version,
+			Metric:      r.Metric,
+			Value:       r.Value,
+			Dataset:     r.Dataset,
+			Split:       r.Split,
+			EvaluatedAt: evaluatedAt,
+			RecordedBy:  recordedBy,
+		}
+	}
+
+	if err := s.evaluations.CreateEvaluations(ctx, evaluations); err != nil {
+		s.logger.Error("Failed to record evaluations", "model_id", modelID, "version", version, "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("Evaluations recorded", "model_id", modelID, "version", version, "count", len(evaluations))
+	s.record(ctx, model.AuditVersionEvaluate, m, nil, evaluations)
+	return evaluations, nil
+}
+
+// ListEvaluations lists the evaluation results of a model, newest first; an
+// empty version or metric matches all
+func (s *modelService) ListEvaluations(ctx context.Context, modelID, version, metric string) ([]*model.Evaluation, error) {
+	ctx = repository.AllowReplica(ctx)
+	if err := s.authorizeView(ctx, modelID); err != nil {
+		return nil, err
+	}
+	return s.evaluations.ListEvaluations(ctx, modelID, version, metric)
+}
+
+// GetPromotionRules lists the promotion rules of a model
+func (s *modelService) GetPromotionRules(ctx context.Context, modelID string) ([]*model.PromotionRule, error) {
+	m, err := s.repo.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, m, false); err != nil {
+		return nil, err
+	}
+	return s.evaluations.ListRules(ctx, modelID)
+}
+
+// SetPromotionRules validates and replaces the promotion rules of a model
+func (s *modelService) SetPromotionRules(ctx context.Context, modelID string, rules []*model.PromotionRule) ([]*model.PromotionRule, error) {
+	seen := make(map[string]bool, len(rules))
+	for i, r := range rules {
+		if r.Metric == "" || len(r.Metric) > 100 {
+			return nil, fmt.Errorf("%w: rule %d needs a metric name of at most 100 characters", ErrInvalidInput, i)
+		}
+		if r.Direction != model.HigherIsBetter && r.Direction != model.LowerIsBetter {
+			return nil, fmt.Errorf("%w: rule %d direction must be %q or %q", ErrInvalidInput, i, model.HigherIsBetter, model.LowerIsBetter)
+		}
+		if r.MaxRegression < 0 || math.IsNaN(r.MaxRegression) || math.IsInf(r.MaxRegression, 0) {
+			return nil, fmt.Errorf("%w: rule %d max_regression must be a finite number of at least 0", ErrInvalidInput, i)
+		}
+		key := evaluationKey(r.Metric, r.Dataset, r.Split)
+		if seen[key] {
+			return nil, fmt.Errorf("%w: two rules for %s", ErrInvalidInput, describeMetric(r.Metric, r.Dataset, r.Split))
+		}
+		seen[key] = true
+	}
+	m, err := s.repo.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, m, true); err != nil {
+		return nil, err
+	}
+	before, _ := s.evaluations.ListRules(ctx, modelID)
+
+	createdBy := ""
+	if caller, ok := auth.FromContext(ctx); ok {
+		createdBy = caller.UserID
+	}
+	now := time.Now().UTC()
+	for _, r := range rules {
+		r.ID = ""
+		r.ModelID = modelID
+		r.CreatedBy = createdBy
+		r.CreatedAt = now
+	}
+
+	if err := s.evaluations.ReplaceRules(ctx, modelID, rules); err != nil {
+		s.logger.Error("Failed to set promotion rules", "model_id", modelID, "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("Promotion rules set", "model_id", modelID, "count", len(rules))
+	s.record(ctx, model.AuditPromotionRules, m, before, rules)
+	return rules, nil
+}
+
+// CompareVersions diffs the metrics, signatures, sizes and attributes of two
+// versions; an empty base means the model's current version
+func (s *modelService) CompareVersions(ctx context.Context, modelID, base, target string) (*VersionComparison, error) {
+	ctx = repository.AllowReplica(ctx)
+	m, err := s.repo.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, m, false); err != nil {
+		return nil, err
+	}
+	if base == "" {
+		base = m.Version
+	}
+
+	baseVersion, err := s.versionOf(ctx, m, base)
+	if err != nil {
+		return nil, err
+	}
+	targetVersion, err := s.versionOf(ctx, m, target)
+	if err != nil {
+		return nil, err
+	}
+	baseResults, err := s.latestEvaluations(ctx, modelID, base)
+	if err != nil {
+		return nil, err
+	}
+	targetResults, err := s.latestEvaluations(ctx, modelID, target)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.evaluations.ListRules(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+
+	cmp := &VersionComparison{
+		ModelID:    modelID,
+		Base:       baseVersion,
+		Target:     targetVersion,
+		Metrics:    diffMetrics(baseResults, targetResults),
+		Signature:  diffSignatures(baseVersion.Signature, targetVersion.Signature),
+		Fields:     diffFields(baseVersion, targetVersion),
+		Promotable: true,
+	}
+	for _, rule := range rules {
+		check := checkRule(rule, baseVersion.Version, targetVersion.Version, baseResults, targetResults)
+		cmp.Rules = append(cmp.Rules, check)
+		cmp.Promotable = cmp.Promotable && check.Passed
+	}
+	return cmp, nil
+}
+
+// checkPromotion enforces the model's promotion rules for replacing its current version
+func (s *modelService) checkPromotion(ctx context.Context, current *model.Model, version string) error {
+	if current.Version == version {
+		return nil
+	}
+	rules, err := s.evaluations.ListRules(ctx, current.ID)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+	if _, err := s.repo.GetVersion(ctx, current.ID, version); err != nil {
+		return err
+	}
+	baseResults, err := s.latestEvaluations(ctx, current.ID, current.Version)
+	if err != nil {
+		return err
+	}
+	targetResults, err := s.latestEvaluations(ctx, current.ID, version)
+	if err != nil {
+		return err
+	}
+
+	var failures []string
+	for _, rule := range rules {
+		if check := checkRule(rule, current.Version, version, baseResults, targetResults); !check.Passed {
+			failures = append(failures, check.Message)
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("%w: %s", ErrPromotionBlocked, strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// versionOf loads a version of a model. A model's first version is only
+// recorded on the model itself, so its current version falls back to that.
+func (s *modelService) versionOf(ctx context.Context, m *model.Model, version string) (*model.ModelVersion, error) {
+	v, err := s.repo.GetVersion(ctx, m.ID, version)
+	if !errors.Is(err, ErrVersionNotFound) || version != m.Version {
+		return v, err
+	}
+	return &model.ModelVersion{
+		ModelID:     m.ID,
+		Version:     m.Version,
+		Status:      m.Status,
+		Size:        m.Size,
+		Checksum:    m.Checksum,
+		StoragePath: m.StoragePath,
+		DockerImage: m.DockerImage,
+		Signature:   m.Signature,
+		CreatedBy:   m.OwnerID,
+		CreatedAt:   m.CreatedAt,
+	}, nil
+}
+
+// latestEvaluations returns the newest result of each metric, dataset and split of a version
+func (s *modelService) latestEvaluations(ctx context.Context, modelID, version string) (map[string]*model.Evaluation, error) {
+	evaluations, err := s.evaluations.ListEvaluations(ctx, modelID, version, "")
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[string]*model.Evaluation, len(evaluations))
+	for _, e := range evaluations {
+		key := evaluationKey(e.Metric, e.Dataset, e.Split)
+		if _, ok := latest[key]; !ok {
+			latest[key] = e
+		}
+	}
+	return latest, nil
+}
+
+func evaluationKey(metric, dataset, split string) string {
+	return metric + "\x00" + dataset + "\x00" + split
+}
+
+// describeMetric names a metric with its dataset and split, as accuracy on imagenet/val
+func describeMetric(metric, dataset, split string) string {
+	on := strings.Trim(dataset+"/"+split, "/")
+	if on == "" {
+		return metric
+	}
+	return metric + " on " + on
+}
+
+// checkRule checks that a rule's metric did not regress from base to target
+// by more than the rule allows. The target must have a result; a base
+// without one has nothing to regress from.
+func checkRule(rule *model.PromotionRule, base, target string, baseResults, targetResults map[string]*model.Evaluation) RuleCheck {
+	key := evaluationKey(rule.Metric, rule.Dataset, rule.Split)
+	name := describeMetric(rule.Metric, rule.Dataset, rule.Split)
+	after, ok := targetResults[key]
+	if !ok {
+		return RuleCheck{Rule: rule, Message: fmt.Sprintf("version %s has no %s result", target, name)}
+	}
+	before, ok := baseResults[key]
+	if !ok {
+		return RuleCheck{Rule: rule, Passed: true, Message: fmt.Sprintf("version %s has no %s result to compare against", base, name)}
+	}
+
+	regression := before.Value - after.Value
+	if rule.Direction == model.LowerIsBetter {
+		regression = -regression
+	}
+	allowed := rule.MaxRegression
+	limit := formatNumber(rule.MaxRegression)
+	if rule.Relative {
+		allowed *= math.Abs(before.Value)
+		limit = formatNumber(rule.MaxRegression*100) + "%"
+	}
+
+	change := fmt.Sprintf("%s went from %s in %s to %s in %s", name, formatNumber(before.Value), base, formatNumber(after.Value), target)
+	// Tolerate float rounding at the exact threshold
+	if regression > allowed+1e-9*math.Max(1, math.Abs(before.Value)) {
+		return RuleCheck{Rule: rule, Message: fmt.Sprintf("%s, regressing by more than the allowed %s", change, limit)}
+	}
+	return RuleCheck{Rule: rule, Passed: true, Message: change}
+}
+
+// diffMetrics pairs up the latest results of two versions, sorted by metric
+func diffMetrics(base, target map[string]*model.Evaluation) []MetricDiff {
+	byKey := make(map[string]*MetricDiff)
+	add := func(e *model.Evaluation, isBase bool) {
+		key := evaluationKey(e.Metric, e.Dataset, e.Split)
+		d, ok := byKey[key]
+		if !ok {
+			d = &MetricDiff{Metric: e.Metric, Dataset: e.Dataset, Split: e.Split}
+			byKey[key] = d
+		}
+		value := e.Value
+		if isBase {
+			d.Base = &value
+		} else {
+			d.Target = &value
+		}
+	}
+	for _, e := range base {
+		add(e, true)
+	}
+	for _, e := range target {
+		add(e, false)
+	}
+
+	diffs := make([]MetricDiff, 0, len(byKey))
+	for _, d := range byKey {
+		if d.Base != nil && d.Target != nil {
+			delta := *d.Target - *d.Base
+			d.Delta = &delta
+			if *d.Base != 0 {
+				relative := delta / math.Abs(*d.Base)
+				d.RelativeDelta = &relative
+			}
+		}
+		diffs = append(diffs, *d)
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		a, b := diffs[i], diffs[j]
+		return evaluationKey(a.Metric, a.Dataset, a.Split) < evaluationKey(b.Metric, b.Dataset, b.Split)
+	})
+	return diffs
+}
+
+// diffSignatures lists the inputs and outputs that were added, removed or changed
+func diffSignatures(base, target *signature.Signature) []TensorDiff {
+	var baseInputs, baseOutputs, targetInputs, targetOutputs []signature.TensorSpec
+	if base != nil {
+		baseInputs, baseOutputs = base.Inputs, base.Outputs
+	}
+	if target != nil {
+		targetInputs, targetOutputs = target.Inputs, target.Outputs
+	}
+	return append(diffTensors("input", baseInputs, targetInputs), diffTensors("output", baseOutputs, targetOutputs)...)
+}
+
+func diffTensors(kind string, base, target []signature.TensorSpec) []TensorDiff {
+	targetByName := make(map[string]signature.TensorSpec, len(target))
+	for _, spec := range target {
+		targetByName[spec.Name] = spec
+	}
+
+	var diffs []TensorDiff
+	seen := make(map[string]bool, len(base))
+	for _, b := range base {
+		seen[b.Name] = true
+		t, ok := targetByName[b.Name]
+		switch {
+		case !ok:
+			diffs = append(diffs, TensorDiff{Kind: kind, Name: b.Name, Change: "removed", Base: describeTensor(b)})
+		case describeTensor(b) != describeTensor(t):
+			diffs = append(diffs, TensorDiff{Kind: kind, Name: b.Name, Change: "changed", Base: describeTensor(b), Target: describeTensor(t)})
+		}
+	}
+	for _, t := range target {
+		if !seen[t.Name] {
+			diffs = append(diffs, TensorDiff{Kind: kind, Name: t.Name, Change: "added", Target: describeTensor(t)})
+		}
+	}
+	return diffs
+}
+
+// describeTensor summarizes a tensor spec, as float32[-1,3,224,224]
+func describeTensor(spec signature.TensorSpec) string {
+	desc := string(spec.DType)
+	if len(spec.Schema) > 0 {
+		desc = "schema " + string(spec.Schema)
+	}
+	if len(spec.Shape) > 0 {
+		dims := make([]string, len(spec.Shape))
+		for i, size := range spec.Shape {
+			dims[i] = strconv.FormatInt(size, 10)
+		}
+		desc += "[" + strings.Join(dims, ",") + "]"
+	}
+	if spec.Optional {
+		desc += " optional"
+	}
+	return desc
+}
+
+// diffFields lists the version attributes that differ
+func diffFields(base, target *model.ModelVersion) []FieldDiff {
+	fields := []FieldDiff{
+		{"size", strconv.FormatInt(base.Size, 10), strconv.FormatInt(target.Size, 10)},
+		{"checksum", base.Checksum, target.Checksum},
+		{"storage_path", base.StoragePath, target.StoragePath},
+		{"docker_image", base.DockerImage, target.DockerImage},
+		{"status", string(base.Status), string(target.Status)},
+		{"change_log", base.ChangeLog, target.ChangeLog},
+		{"created_by", base.CreatedBy, target.CreatedBy},
+	}
+	var diffs []FieldDiff
+	for _, f := range fields {
+		if f.Base != f.Target {
+			diffs = append(diffs, f)
+		}
+	}
+	return diffs
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
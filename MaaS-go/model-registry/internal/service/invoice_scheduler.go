@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// InvoiceScheduler periodically refreshes the running month's draft invoices
+// and finalizes last month's once it has ended
+type InvoiceScheduler struct {
+	service  BillingService
+	interval time.Duration
+	logger   *logger.Logger
+}
+
+// NewInvoiceScheduler creates a new invoice scheduler
+func NewInvoiceScheduler(svc BillingService, interval time.Duration, logger *logger.Logger) *InvoiceScheduler {
+	return &InvoiceScheduler{
+		service:  svc,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run generates due invoices until ctx is cancelled
+func (s *InvoiceScheduler) Run(ctx context.Context) {
+	if s.interval <= 0 {
+		s.logger.Info("Invoice scheduler disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.generate(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// generate generates the invoices due now
+func (s *InvoiceScheduler) generate(ctx context.Context) {
+	generated, err := s.service.GenerateDueInvoices(ctx, time.Now().UTC())
+	if err != nil {
+		s.logger.Error("Failed to generate due invoices", "error", err)
+		return
+	}
+	if generated > 0 {
+		s.logger.Debug("Generated invoices", "count", generated)
+	}
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+)
+
+// Lineage errors
+var (
+	ErrEdgeNotFound       = repository.ErrEdgeNotFound
+	ErrDuplicateEdge      = repository.ErrDuplicateEdge
+	ErrLineageCycle       = errors.New("lineage edge would create a cycle")
+	ErrModelHasDependents = errors.New("other models were derived from this model")
+)
+
+// LineageDirection selects which way a lineage query walks
+type LineageDirection string
+
+const (
+	LineageAncestors   LineageDirection = "ancestors"
+	LineageDescendants LineageDirection = "descendants"
+)
+
+const (
+	// defaultLineageDepth is how many edges a lineage query follows by default
+	defaultLineageDepth = 3
+	// maxLineageDepth caps how many edges a lineage query may follow
+	maxLineageDepth = 10
+)
+
+// AddLineageEdgeRequest represents a request to record where a model came from.
+// Dataset relations name a dataset; the others name a parent model.
+type AddLineageEdgeRequest struct {
+	ModelID       string
+	Version       string
+	Relation      model.LineageRelation
+	ParentModelID string
+	ParentVersion string
+	DatasetName   string
+	DatasetURI    string
+}
+
+// LineageNode is a model or dataset reached by a lineage query
+type LineageNode struct {
+	// Kind is model or dataset
+	Kind        string `json:"kind"`
+	ModelID     string `json:"model_id,omitempty"`
+	ModelName   string `json:"model_name,omitempty"`
+	Version     string `json:"version,omitempty"`
+	DatasetName string `json:"dataset_name,omitempty"`
+	DatasetURI  string `json:"dataset_uri,omitempty"`
+	// Depth is how many edges away from the queried model the node is
+	Depth int `json:"depth"`
+}
+
+// LineageGraph is the part of the lineage graph a query reached
+type LineageGraph struct {
+	ModelID   string               `json:"model_id"`
+	Direction LineageDirection     `json:"direction"`
+	Depth     int                  `json:"depth"`
+	Nodes     []LineageNode        `json:"nodes"`
+	Edges     []*model.LineageEdge `json:"edges"`
+	// Truncated is set when the depth limit cut off further edges
+	Truncated bool `json:"truncated"`
+}
+
+// AddLineageEdge records that a model or version came from another model or a dataset
+func (s *modelService) AddLineageEdge(ctx context.Context, req AddLineageEdgeRequest) (*model.LineageEdge, error) {
+	switch req.Relation {
+	case model.RelationTrainedOnDataset:
+		if req.DatasetName == "" || req.ParentModelID != "" || req.ParentVersion != "" {
+			return nil, fmt.Errorf("%w: %s needs a dataset name and no parent model", ErrInvalidInput, req.Relation)
+		}
+		if len(req.DatasetName) > 200 || len(req.DatasetURI) > 1024 {
+			return nil, fmt.Errorf("%w: dataset name or uri is too long", ErrInvalidInput)
+		}
+	case model.RelationDerivedFrom, model.RelationFineTunedFrom, model.RelationQuantizedFrom:
+		if req.ParentModelID == "" || req.DatasetName != "" || req.DatasetURI != "" {
+			return nil, fmt.Errorf("%w: %s needs a parent model and no dataset", ErrInvalidInput, req.Relation)
+		}
+		if req.ParentModelID == req.ModelID {
+			return nil, fmt.Errorf("%w: a model cannot derive from itself", ErrLineageCycle)
+		}
+	default:
+		return nil, fmt.Errorf("%w: relation must be one of %s, %s, %s or %s", ErrInvalidInput,
+			model.RelationDerivedFrom, model.RelationFineTunedFrom, model.RelationQuantizedFrom, model.RelationTrainedOnDataset)
+	}
+
+	m, err := s.repo.GetByID(ctx, req.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, m, true); err != nil {
+		return nil, err
+	}
+	if req.Version != "" {
+		if _, err := s.versionOf(ctx, m, req.Version); err != nil {
+			return nil, err
+		}
+	}
+
+	var parent *model.Model
+	if req.ParentModelID != "" {
+		parent, err = s.repo.GetByID(ctx, req.ParentModelID)
+		if err != nil {
+			return nil, fmt.Errorf("parent %w", err)
+		}
+		if err := authorizeModel(ctx, parent, false); err != nil {
+			return nil, err
+		}
+		if req.ParentVersion != "" {
+			if _, err := s.versionOf(ctx, parent, req.ParentVersion); err != nil {
+				return nil, fmt.Errorf("parent %w", err)
+			}
+		}
+	}
+
+	edge := &model.LineageEdge{
+		ModelID:       req.ModelID,
+		Version:       req.Version,
+		Relation:      req.Relation,
+		ParentModelID: req.ParentModelID,
+		ParentVersion: req.ParentVersion,
+		DatasetName:   req.DatasetName,
+		DatasetURI:    req.DatasetURI,
+	}
+	if caller, ok := auth.FromContext(ctx); ok {
+		edge.CreatedBy = caller.UserID
+	}
+	// The cycle check and the insert share a transaction holding the lineage
+	// lock, so two edges added at once cannot close a cycle between them
+	err = s.tx.InTransaction(ctx, func(ctx context.Context) error {
+		if parent != nil {
+			if err := s.lineage.LockGraph(ctx); err != nil {
+				return err
+			}
+			descends, err := s.descendsFrom(ctx, parent.ID, m.ID)
+			if err != nil {
+				return err
+			}
+			if descends {
+				return fmt.Errorf("%w: %s already derives from %s", ErrLineageCycle, parent.Name, m.Name)
+			}
+		}
+		return s.lineage.CreateEdge(ctx, edge)
+	})
+	if err != nil {
+		if !errors.Is(err, ErrDuplicateEdge) && !errors.Is(err, ErrLineageCycle) {
+			s.logger.Error("Failed to add lineage edge", "model_id", req.ModelID, "error", err)
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Lineage edge added", "model_id", req.ModelID, "relation", req.Relation, "parent_model_id", req.ParentModelID, "dataset", req.DatasetName)
+	s.record(ctx, model.AuditLineageAdd, m, nil, edge)
+	return edge, nil
+}
+
+// RemoveLineageEdge removes a lineage edge of a model
+func (s *modelService) RemoveLineageEdge(ctx context.Context, modelID, edgeID string) error {
+	m, err := s.modelForChange(ctx, modelID)
+	if err != nil {
+		return err
+	}
+	edge, err := s.lineage.DeleteEdge(ctx, modelID, edgeID)
+	if err != nil {
+		if !errors.Is(err, ErrEdgeNotFound) {
+			s.logger.Error("Failed to remove lineage edge", "model_id", modelID, "edge_id", edgeID, "error", err)
+		}
+		return err
+	}
+
+	s.logger.Info("Lineage edge removed", "model_id", modelID, "edge_id", edgeID)
+	s.record(ctx, model.AuditLineageRemove, m, edge, nil)
+	return nil
+}
+
+// GetLineage walks the lineage graph from a model towards its ancestors or
+// descendants, following at most depth edges. Models the caller may not see
+// are left out together with everything reached only through them.
+func (s *modelService) GetLineage(ctx context.Context, modelID string, direction LineageDirection, depth int) (*LineageGraph, error) {
+	if direction != LineageAncestors && direction != LineageDescendants {
+		return nil, fmt.Errorf("%w: direction must be %s or %s", ErrInvalidInput, LineageAncestors, LineageDescendants)
+	}
+	if depth == 0 {
+		depth = defaultLineageDepth
+	}
+	if depth < 0 || depth > maxLineageDepth {
+		return nil, fmt.Errorf("%w: depth must be between 1 and %d", ErrInvalidInput, maxLineageDepth)
+	}
+
+	ctx = repository.AllowReplica(ctx)
+	root, err := s.repo.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, root, false); err != nil {
+		return nil, err
+	}
+
+	graph := &LineageGraph{
+		ModelID:   root.ID,
+		Direction: direction,
+		Depth:     depth,
+		Nodes:     []LineageNode{{Kind: "model", ModelID: root.ID, ModelName: root.Name, Version: root.Version}},
+		Edges:     []*model.LineageEdge{},
+	}
+	visible := map[string]bool{root.ID: true}
+	datasets := make(map[string]bool)
+	frontier := []string{root.ID}
+
+	for level := 1; level <= depth && len(frontier) > 0; level++ {
+		edges, err := s.lineageEdges(ctx, direction, frontier)
+		if err != nil {
+			return nil, err
+		}
+
+		var next []string
+		for _, e := range edges {
+			if e.ParentModelID == "" {
+				key := e.DatasetURI + "\x00" + e.DatasetName
+				if !datasets[key] {
+					datasets[key] = true
+					graph.Nodes = append(graph.Nodes, LineageNode{Kind: "dataset", DatasetName: e.DatasetName, DatasetURI: e.DatasetURI, Depth: level})
+				}
+				graph.Edges = append(graph.Edges, e)
+				continue
+			}
+
+			id := e.ParentModelID
+			if direction == LineageDescendants {
+				id = e.ModelID
+			}
+			seen, ok := visible[id]
+			if !ok {
+				m, err := s.repo.GetByID(ctx, id)
+				seen = err == nil && authorizeModel(ctx, m, false) == nil
+				visible[id] = seen
+				if seen {
+					graph.Nodes = append(graph.Nodes, LineageNode{Kind: "model", ModelID: m.ID, ModelName: m.Name, Version: m.Version, Depth: level})
+					next = append(next, id)
+				}
+			}
+			if seen {
+				graph.Edges = append(graph.Edges, e)
+			}
+		}
+		frontier = next
+	}
+
+	if len(frontier) > 0 {
+		more, err := s.lineageEdges(ctx, direction, frontier)
+		if err != nil {
+			return nil, err
+		}
+		graph.Truncated = len(more) > 0
+	}
+	return graph, nil
+}
+
+// lineageEdges lists the edges leading one step further in a direction
+func (s *modelService) lineageEdges(ctx context.Context, direction LineageDirection, modelIDs []string) ([]*model.LineageEdge, error) {
+	if direction == LineageAncestors {
+		return s.lineage.ListParents(ctx, modelIDs)
+	}
+	return s.lineage.ListChildren(ctx, modelIDs)
+}
+
+// descendsFrom reports whether ancestorID is reachable from modelID by
+// following parent edges, whatever the caller may see
+func (s *modelService) descendsFrom(ctx context.Context, modelID, ancestorID string) (bool, error) {
+	seen := map[string]bool{modelID: true}
+	frontier := []string{modelID}
+	for len(frontier) > 0 {
+		edges, err := s.lineage.ListParents(ctx, frontier)
+		if err != nil {
+			return false, err
+		}
+		var next []string
+		for _, e := range edges {
+			if e.ParentModelID == "" || seen[e.ParentModelID] {
+				continue
+			}
+			if e.ParentModelID == ancestorID {
+				return true, nil
+			}
+			seen[e.ParentModelID] = true
+			next = append(next, e.ParentModelID)
+		}
+		frontier = next
+	}
+	return false, nil
+}
+
+// checkDependents refuses to delete a model that live models were derived
+// from unless force is set, in which case it only warns
+func (s *modelService) checkDependents(ctx context.Context, id string, force bool) error {
+	edges, err := s.lineage.ListChildren(ctx, []string{id})
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	var dependents []string
+	for _, e := range edges {
+		if seen[e.ModelID] {
+			continue
+		}
+		seen[e.ModelID] = true
+		if _, err := s.repo.GetByID(ctx, e.ModelID); err == nil {
+			dependents = append(dependents, e.ModelID)
+		}
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+	if force {
+		s.logger.Warn("Deleting model other models were derived from", "model_id", id, "dependents", strings.Join(dependents, ","))
+		return nil
+	}
+	return fmt.Errorf("%w: %d model(s) depend on it; delete with force to proceed", ErrModelHasDependents, len(dependents))
+}
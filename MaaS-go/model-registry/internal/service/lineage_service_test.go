@@ -0,0 +1,84 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// stallingLineage holds each cycle check after its read until a concurrent
+// one has read too or the stall runs out, so unguarded checks overlap
+type stallingLineage struct {
+	repository.LineageRepository
+	peer  chan struct{}
+	stall time.Duration
+}
+
+func (r *stallingLineage) ListParents(ctx context.Context, modelIDs []string) ([]*model.LineageEdge, error) {
+	edges, err := r.LineageRepository.ListParents(ctx, modelIDs)
+	select {
+	case r.peer <- struct{}{}:
+	case <-r.peer:
+	case <-time.After(r.stall):
+	}
+	return edges, err
+}
+func TestAddLineageEdgeRefusesConcurrentCycle(t *testing.T) {
+	db := newDB(t)
+	log := logger.New("error")
+	models := repository.NewGormModelRepository(db)
+	lineage := &stallingLineage{LineageRepository: repository.NewGormLineageRepository(db), peer: make(chan struct{}), stall: 100 * time.Millisecond}
+	svc := service.NewModelService(models, repository.NewGormEvaluationRepository(db), lineage, repository.NewGormApprovalRepository(db),
+		repository.NewGormTransactor(db), nil, service.NewAuditService(repository.NewGormAuditRepository(db), log), nil, log)
+	ctx := context.Background()
+
+	var pair [2]*model.Model
+	for i := range pair {
+		pair[i] = &model.Model{Name: fmt.Sprintf("model-%d", i), Version: "1.0.0", Framework: model.FrameworkPyTorch,
+			OwnerID: uuid.New().String(), TenantID: "tenant-a"}
+		if err := models.Create(ctx, pair[i]); err != nil {
+			t.Fatalf("create model: %v", err)
+		}
+	}
+
+	// Each model is derived from the other at the same time
+	var wg sync.WaitGroup
+	errs := make([]error, len(pair))
+	for i := range pair {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = svc.AddLineageEdge(ctx, service.AddLineageEdgeRequest{
+				ModelID:       pair[i].ID,
+				Relation:      model.RelationDerivedFrom,
+				ParentModelID: pair[1-i].ID,
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	added, cycles := 0, 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			added++
+		case errors.Is(err, service.ErrLineageCycle):
+			cycles++
+		default:
+			t.Fatalf("AddLineageEdge: %v", err)
+		}
+	}
+	if added != 1 || cycles != 1 {
+		t.Errorf("%d edges added and %d refused as cycles, want one of each", added, cycles)
+	}
+}
@@ -4,31 +4,100 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"strings"
+	"time"
 
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/inspect"
 	"maas-platform/model-registry/internal/model"
 	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/storage"
 	"maas-platform/model-registry/pkg/logger"
+	"maas-platform/shared/modelcard"
+	"maas-platform/shared/policy"
+	"maas-platform/shared/signature"
 )
 
 // Service errors
 var (
-	ErrModelNotFound  = errors.New("model not found")
-	ErrDuplicateModel = errors.New("model already exists")
-	ErrInvalidInput   = errors.New("invalid input")
+	ErrModelNotFound    = repository.ErrModelNotFound
+	ErrDuplicateModel   = repository.ErrDuplicateModel
+	ErrModelInTrash     = repository.ErrModelInTrash
+	ErrVersionNotFound  = repository.ErrVersionNotFound
+	ErrDuplicateVersion = repository.ErrDuplicateVersion
+	ErrNotInTrash       = errors.New("model is not in the trash")
+	ErrCardNotFound     = errors.New("model card not found")
+	ErrInvalidInput     = errors.New("invalid input")
+	ErrForbidden        = errors.New("forbidden")
+)
+
+// RequirePrimary makes reads with ctx observe writes the caller just made,
+// even where they could otherwise be served by a read replica
+func RequirePrimary(ctx context.Context) context.Context {
+	return repository.RequirePrimary(ctx)
+}
+
+// ListScope selects which models a listing covers relative to the caller
+type ListScope string
+
+const (
+	ScopeMine   ListScope = "mine"
+	ScopeTenant ListScope = "tenant"
+	ScopePublic ListScope = "public"
+	ScopeAll    ListScope = "all"
 )
 
 // ModelService defines the interface for model business logic
 type ModelService interface {
+	// CreateModel creates a model; when publishing it needs approval the model
+	// is created private and returned with an ApprovalRequiredError
 	CreateModel(ctx context.Context, req CreateModelRequest) (*model.Model, error)
 	GetModel(ctx context.Context, id string) (*model.Model, error)
 	ListModels(ctx context.Context, filter ListModelsFilter) (*ListModelsResponse, error)
 	UpdateModel(ctx context.Context, id string, req UpdateModelRequest) (*model.Model, error)
-	DeleteModel(ctx context.Context, id string) error
+	DeleteModel(ctx context.Context, id string, force bool) error
 	UpdateModelStatus(ctx context.Context, id string, status model.ModelStatus) error
 	AddModelTags(ctx context.Context, id string, tags []string) error
 	RemoveModelTags(ctx context.Context, id string, tags []string) error
 	SetModelMetadata(ctx context.Context, id string, metadata map[string]string) error
 	GetModelMetadata(ctx context.Context, id string) (map[string]string, error)
+
+	// Trash operations
+	ListDeletedModels(ctx context.Context, filter ListModelsFilter) (*ListModelsResponse, error)
+	RestoreModel(ctx context.Context, id string) (*model.Model, error)
+	PurgeModel(ctx context.Context, id string) error
+	PurgeExpiredModels(ctx context.Context, deletedBefore time.Time) (int, error)
+
+	// Version operations
+	CreateModelVersion(ctx context.Context, req CreateModelVersionRequest) (*model.ModelVersion, error)
+	ListModelVersions(ctx context.Context, modelID string) ([]*model.ModelVersion, error)
+	PromoteVersion(ctx context.Context, modelID, version string) (*model.Model, error)
+
+	// Model cards; an empty version means the current one
+	GetModelCard(ctx context.Context, modelID, version string) (*ModelCard, error)
+	SetModelCard(ctx context.Context, modelID, version string, card *modelcard.Card) (*ModelCard, error)
+
+	// Evaluations and promotion rules; an empty base means the current version
+	RecordEvaluations(ctx context.Context, modelID, version string, results []EvaluationResult) ([]*model.Evaluation, error)
+	ListEvaluations(ctx context.Context, modelID, version, metric string) ([]*model.Evaluation, error)
+	CompareVersions(ctx context.Context, modelID, base, target string) (*VersionComparison, error)
+	GetPromotionRules(ctx context.Context, modelID string) ([]*model.PromotionRule, error)
+	SetPromotionRules(ctx context.Context, modelID string, rules []*model.PromotionRule) ([]*model.PromotionRule, error)
+
+	// Lineage
+	AddLineageEdge(ctx context.Context, req AddLineageEdgeRequest) (*model.LineageEdge, error)
+	RemoveLineageEdge(ctx context.Context, modelID, edgeID string) error
+	GetLineage(ctx context.Context, modelID string, direction LineageDirection, depth int) (*LineageGraph, error)
+
+	// Approval policies and requests; changes a tenant's policy covers fail with
+	// an ApprovalRequiredError until a request for them is approved
+	ListApprovalPolicies(ctx context.Context, tenantID string) ([]*model.ApprovalPolicy, error)
+	SetApprovalPolicy(ctx context.Context, p *model.ApprovalPolicy) (*model.ApprovalPolicy, error)
+	DeleteApprovalPolicy(ctx context.Context, tenantID string, action model.ApprovalAction) error
+	ListApprovalRequests(ctx context.Context, filter ListApprovalRequestsFilter) (*ListApprovalRequestsResponse, error)
+	GetApprovalRequest(ctx context.Context, id string) (*model.ApprovalRequest, error)
+	DecideApprovalRequest(ctx context.Context, id string, approve bool, comment string) (*model.ApprovalRequest, error)
 }
 
 // CreateModelRequest represents a request to create a model
@@ -42,6 +111,28 @@ type CreateModelRequest struct {
 	OwnerID     string
 	TenantID    string
 	IsPublic    bool
+	Signature   *signature.Signature
+}
+
+// CreateModelVersionRequest represents a request to record a model version
+type CreateModelVersionRequest struct {
+	ModelID     string
+	Version     string
+	Size        int64
+	Checksum    string
+	StoragePath string
+	DockerImage string
+	ChangeLog   string
+	CreatedBy   string
+	Signature   *signature.Signature
+}
+
+// ModelCard is the card of one version of a model
+type ModelCard struct {
+	ModelID   string
+	ModelName string
+	Version   string
+	Card      *modelcard.Card
 }
 
 // UpdateModelRequest represents a request to update a model
@@ -62,6 +153,7 @@ type ListModelsFilter struct {
 	TenantID  string
 	Tags      []string
 	IsPublic  *bool
+	Scope     ListScope
 	Page      int
 	Limit     int
 }
@@ -76,24 +168,65 @@ type ListModelsResponse struct {
 
 // modelService implements ModelService
 type modelService struct {
-	repo   repository.ModelRepository
-	logger *logger.Logger
+	repo        repository.ModelRepository
+	evaluations repository.EvaluationRepository
+	lineage     repository.LineageRepository
+	approvals   repository.ApprovalRepository
+	tx          repository.Transactor
+	blobs       storage.BlobStore
+	audit       AuditService
+	webhooks    WebhookService
+	logger      *logger.Logger
 }
 
 // NewModelService creates a new model service
-func NewModelService(repo repository.ModelRepository, logger *logger.Logger) ModelService {
+func NewModelService(repo repository.ModelRepository, evaluations repository.EvaluationRepository, lineage repository.LineageRepository, approvals repository.ApprovalRepository, tx repository.Transactor, blobs storage.BlobStore, audit AuditService, webhooks WebhookService, logger *logger.Logger) ModelService {
 	return &modelService{
-		repo:   repo,
-		logger: logger,
+		repo:        repo,
+		evaluations: evaluations,
+		lineage:     lineage,
+		approvals:   approvals,
+		tx:          tx,
+		blobs:       blobs,
+		audit:       audit,
+		webhooks:    webhooks,
+		logger:      logger,
 	}
 }
 
-// CreateModel creates a new model
+// CreateModel creates a new model. A public model whose tenant requires
+// approval to publish is created private, with the publish request pending.
 func (s *modelService) CreateModel(ctx context.Context, req CreateModelRequest) (*model.Model, error) {
 	// Validate framework
 	if !isValidFramework(req.Framework) {
 		return nil, fmt.Errorf("invalid framework: %s", req.Framework)
 	}
+	if req.Signature != nil {
+		if err := req.Signature.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+
+	// Non-admins create models they own in their own tenant
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		if (req.OwnerID != "" && req.OwnerID != caller.UserID) || (req.TenantID != "" && req.TenantID != caller.TenantID) {
+			return nil, ErrForbidden
+		}
+		req.OwnerID = caller.UserID
+		req.TenantID = caller.TenantID
+	}
+
+	// Publishing on creation needs the same approval as publishing later
+	publishPending := false
+	if _, ok := auth.FromContext(ctx); ok && req.IsPublic && req.TenantID != "" {
+		_, err := s.approvals.GetPolicy(ctx, req.TenantID, model.ApprovalPublish)
+		switch {
+		case err == nil:
+			publishPending = true
+		case !errors.Is(err, ErrPolicyNotFound):
+			return nil, err
+		}
+	}
 
 	// Create model entity
 	m := &model.Model{
@@ -104,50 +237,66 @@ func (s *modelService) CreateModel(ctx context.Context, req CreateModelRequest)
 		Status:      model.ModelStatusPending,
 		OwnerID:     req.OwnerID,
 		TenantID:    req.TenantID,
-		IsPublic:    req.IsPublic,
+		IsPublic:    req.IsPublic && !publishPending,
+		Signature:   req.Signature,
+	}
+	for _, tag := range req.Tags {
+		m.Tags = append(m.Tags, model.Tag{Name: tag})
+	}
+	for key, value := range req.Metadata {
+		m.Metadata = append(m.Metadata, model.Metadata{Key: key, Value: value})
 	}
 
-	// Save to database
+	// Save to database together with tags and metadata
 	if err := s.repo.Create(ctx, m); err != nil {
 		s.logger.Error("Failed to create model", "error", err)
 		return nil, err
 	}
 
-	// Add tags if provided
-	if len(req.Tags) > 0 {
-		if err := s.repo.AddTags(ctx, m.ID, req.Tags); err != nil {
-			s.logger.Error("Failed to add tags", "error", err)
-		}
-	}
-
-	// Add metadata if provided
-	if len(req.Metadata) > 0 {
-		if err := s.repo.SetMetadata(ctx, m.ID, req.Metadata); err != nil {
-			s.logger.Error("Failed to set metadata", "error", err)
-		}
-	}
-
 	s.logger.Info("Model created",
 		"model_id", m.ID,
 		"name", m.Name,
 		"version", m.Version,
 	)
 
+	s.record(ctx, model.AuditModelCreate, m, nil, m)
+	s.notify(ctx, model.EventModelCreated, model.ModelEventPayload{Model: m})
+
+	if publishPending {
+		var required *ApprovalRequiredError
+		switch err := s.requireApproval(ctx, m, model.ApprovalPublish, "", ""); {
+		case errors.As(err, &required):
+			s.logger.Info("Model publication awaits approval", "model_id", m.ID, "request_id", required.Request.ID)
+			return m, err
+		case err != nil:
+			s.logger.Error("Failed to request approval to publish model", "model_id", m.ID, "error", err)
+			return nil, fmt.Errorf("model %s created private: %w", m.ID, err)
+		}
+	}
 	return m, nil
 }
 
 // GetModel retrieves a model by ID
 func (s *modelService) GetModel(ctx context.Context, id string) (*model.Model, error) {
+	ctx = repository.AllowReplica(ctx)
 	m, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		s.logger.Error("Failed to get model", "id", id, "error", err)
 		return nil, err
 	}
+	if err := authorizeModel(ctx, m, false); err != nil {
+		return nil, err
+	}
 	return m, nil
 }
 
 // ListModels retrieves a paginated list of models
 func (s *modelService) ListModels(ctx context.Context, filter ListModelsFilter) (*ListModelsResponse, error) {
+	ctx = repository.AllowReplica(ctx)
+	if err := applyScope(ctx, &filter); err != nil {
+		return nil, err
+	}
+
 	repoFilter := repository.ModelFilter{
 		Name:      filter.Name,
 		Framework: filter.Framework,
@@ -157,6 +306,13 @@ func (s *modelService) ListModels(ctx context.Context, filter ListModelsFilter)
 		Tags:      filter.Tags,
 		IsPublic:  filter.IsPublic,
 	}
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		if caller.TenantID == "" {
+			isPublic := true
+			repoFilter.IsPublic = &isPublic
+		}
+		repoFilter.VisibleToTenant = caller.TenantID
+	}
 
 	pagination := repository.Pagination{
 		Page:  filter.Page,
@@ -184,6 +340,15 @@ func (s *modelService) UpdateModel(ctx context.Context, id string, req UpdateMod
 	if err != nil {
 		return nil, err
 	}
+	if err := authorizeModel(ctx, m, true); err != nil {
+		return nil, err
+	}
+	if req.IsPublic != nil && *req.IsPublic && !m.IsPublic {
+		if err := s.requireApproval(ctx, m, model.ApprovalPublish, "", ""); err != nil {
+			return nil, err
+		}
+	}
+	before := *m
 
 	// Update fields
 	if req.Name != nil {
@@ -196,93 +361,72 @@ func (s *modelService) UpdateModel(ctx context.Context, id string, req UpdateMod
 		m.IsPublic = *req.IsPublic
 	}
 
-	// Save changes
-	if err := s.repo.Update(ctx, m); err != nil {
+	// Save the changes, tags and metadata included, as one update
+	changes := repository.ModelChanges{Tags: req.Tags, Metadata: req.Metadata}
+	if err := s.repo.Update(ctx, m, changes); err != nil {
 		s.logger.Error("Failed to update model", "id", id, "error", err)
 		return nil, err
 	}
 
-	// Update tags if provided
-	if req.Tags != nil {
-		existing := make(map[string]struct{}, len(m.Tags))
-		for _, tag := range m.Tags {
-			existing[tag.Name] = struct{}{}
-		}
-
-		desired := make(map[string]struct{}, len(req.Tags))
-		for _, tag := range req.Tags {
-			desired[tag] = struct{}{}
-		}
-
-		toAdd := make([]string, 0)
-		for tag := range desired {
-			if _, ok := existing[tag]; !ok {
-				toAdd = append(toAdd, tag)
-			}
-		}
-
-		toRemove := make([]string, 0)
-		for tag := range existing {
-			if _, ok := desired[tag]; !ok {
-				toRemove = append(toRemove, tag)
-			}
-		}
-
-		if len(toAdd) > 0 {
-			if err := s.repo.AddTags(ctx, id, toAdd); err != nil {
-				s.logger.Error("Failed to add model tags", "error", err)
-				return nil, err
-			}
-		}
-
-		if len(toRemove) > 0 {
-			if err := s.repo.RemoveTags(ctx, id, toRemove); err != nil {
-				s.logger.Error("Failed to remove model tags", "error", err)
-				return nil, err
-			}
-		}
-	}
-
-	// Update metadata if provided
-	if req.Metadata != nil {
-		if err := s.repo.SetMetadata(ctx, id, req.Metadata); err != nil {
-			s.logger.Error("Failed to update metadata", "error", err)
-		}
-	}
-
 	s.logger.Info("Model updated", "model_id", id)
+	after := s.snapshot(ctx, id)
+	s.record(ctx, model.AuditModelUpdate, m, &before, after)
+	s.notify(ctx, model.EventModelUpdated, model.ModelEventPayload{Model: after})
 	return m, nil
 }
 
 // AddModelTags adds tags to a model
 func (s *modelService) AddModelTags(ctx context.Context, id string, tags []string) error {
+	m, err := s.modelForChange(ctx, id)
+	if err != nil {
+		return err
+	}
 	if err := s.repo.AddTags(ctx, id, tags); err != nil {
 		s.logger.Error("Failed to add model tags", "id", id, "error", err)
 		return err
 	}
+	s.record(ctx, model.AuditModelTagsAdd, m, nil, map[string][]string{"tags": tags})
+	s.notify(ctx, model.EventModelUpdated, model.ModelEventPayload{Model: s.snapshot(ctx, id)})
 	return nil
 }
 
 // RemoveModelTags removes tags from a model
 func (s *modelService) RemoveModelTags(ctx context.Context, id string, tags []string) error {
+	m, err := s.modelForChange(ctx, id)
+	if err != nil {
+		return err
+	}
 	if err := s.repo.RemoveTags(ctx, id, tags); err != nil {
 		s.logger.Error("Failed to remove model tags", "id", id, "error", err)
 		return err
 	}
+	s.record(ctx, model.AuditModelTagsRemove, m, map[string][]string{"tags": tags}, nil)
+	s.notify(ctx, model.EventModelUpdated, model.ModelEventPayload{Model: s.snapshot(ctx, id)})
 	return nil
 }
 
 // SetModelMetadata sets metadata for a model
 func (s *modelService) SetModelMetadata(ctx context.Context, id string, metadata map[string]string) error {
+	m, err := s.modelForChange(ctx, id)
+	if err != nil {
+		return err
+	}
+	before, _ := s.repo.GetMetadata(ctx, id)
 	if err := s.repo.SetMetadata(ctx, id, metadata); err != nil {
 		s.logger.Error("Failed to set model metadata", "id", id, "error", err)
 		return err
 	}
+	s.record(ctx, model.AuditModelMetadata, m, before, metadata)
+	s.notify(ctx, model.EventModelUpdated, model.ModelEventPayload{Model: s.snapshot(ctx, id)})
 	return nil
 }
 
 // GetModelMetadata gets metadata for a model
 func (s *modelService) GetModelMetadata(ctx context.Context, id string) (map[string]string, error) {
+	ctx = repository.AllowReplica(ctx)
+	if err := s.authorizeView(ctx, id); err != nil {
+		return nil, err
+	}
 	metadata, err := s.repo.GetMetadata(ctx, id)
 	if err != nil {
 		s.logger.Error("Failed to get model metadata", "id", id, "error", err)
@@ -291,19 +435,205 @@ func (s *modelService) GetModelMetadata(ctx context.Context, id string) (map[str
 	return metadata, nil
 }
 
-// DeleteModel deletes a model
-func (s *modelService) DeleteModel(ctx context.Context, id string) error {
+// DeleteModel deletes a model; one that other models were derived from is
+// only deleted with force
+func (s *modelService) DeleteModel(ctx context.Context, id string, force bool) error {
+	before, err := s.modelForChange(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.checkDependents(ctx, id, force); err != nil {
+		return err
+	}
 	if err := s.repo.Delete(ctx, id); err != nil {
 		s.logger.Error("Failed to delete model", "id", id, "error", err)
 		return err
 	}
 
 	s.logger.Info("Model deleted", "model_id", id)
+	s.record(ctx, model.AuditModelDelete, before, before, nil)
+	s.notify(ctx, model.EventModelDeleted, model.ModelEventPayload{Model: before})
 	return nil
 }
 
+// ListDeletedModels retrieves a paginated list of models in the trash
+func (s *modelService) ListDeletedModels(ctx context.Context, filter ListModelsFilter) (*ListModelsResponse, error) {
+	ctx = repository.AllowReplica(ctx)
+	if err := applyScope(ctx, &filter); err != nil {
+		return nil, err
+	}
+	// The trash is never public; non-admins only see their tenant's
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		if caller.TenantID == "" || (filter.TenantID != "" && filter.TenantID != caller.TenantID) {
+			return nil, ErrForbidden
+		}
+		filter.TenantID = caller.TenantID
+	}
+
+	repoFilter := repository.ModelFilter{
+		Name:      filter.Name,
+		Framework: filter.Framework,
+		Status:    filter.Status,
+		OwnerID:   filter.OwnerID,
+		TenantID:  filter.TenantID,
+		Tags:      filter.Tags,
+		IsPublic:  filter.IsPublic,
+	}
+
+	pagination := repository.Pagination{
+		Page:  filter.Page,
+		Limit: filter.Limit,
+	}
+
+	models, total, err := s.repo.ListDeleted(ctx, repoFilter, pagination)
+	if err != nil {
+		s.logger.Error("Failed to list deleted models", "error", err)
+		return nil, err
+	}
+
+	return &ListModelsResponse{
+		Models: models,
+		Total:  total,
+		Page:   filter.Page,
+		Limit:  filter.Limit,
+	}, nil
+}
+
+// RestoreModel moves a model out of the trash
+func (s *modelService) RestoreModel(ctx context.Context, id string) (*model.Model, error) {
+	if _, ok := auth.FromContext(ctx); ok {
+		m, err := s.repo.GetDeletedByID(ctx, id)
+		if err != nil && !errors.Is(err, repository.ErrModelNotFound) {
+			return nil, err
+		}
+		if m != nil {
+			if err := authorizeModel(ctx, m, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := s.repo.Restore(ctx, id); err != nil {
+		s.logger.Error("Failed to restore model", "id", id, "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("Model restored", "model_id", id)
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.record(ctx, model.AuditModelRestore, m, nil, m)
+	s.notify(ctx, model.EventModelRestored, model.ModelEventPayload{Model: m})
+	return m, nil
+}
+
+// PurgeModel permanently deletes a model in the trash together with its artifacts
+func (s *modelService) PurgeModel(ctx context.Context, id string) error {
+	m, err := s.repo.GetDeletedByID(ctx, id)
+	if errors.Is(err, repository.ErrModelNotFound) {
+		if _, liveErr := s.repo.GetByID(ctx, id); liveErr == nil {
+			return ErrNotInTrash
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if err := authorizeModel(ctx, m, true); err != nil {
+		return err
+	}
+
+	// Remove artifacts first so a failure leaves the row for the next attempt
+	paths := []string{m.StoragePath}
+	for _, v := range m.Versions {
+		paths = append(paths, v.StoragePath)
+	}
+	for _, path := range paths {
+		if err := s.blobs.Delete(ctx, path); err != nil {
+			s.logger.Error("Failed to delete model artifact", "id", id, "path", path, "error", err)
+			return err
+		}
+	}
+
+	// The model and what hangs off it go together or not at all
+	err = s.tx.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.evaluations.DeleteModel(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete model evaluations: %w", err)
+		}
+		if err := s.lineage.DeleteModel(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete model lineage: %w", err)
+		}
+		if err := s.approvals.DeleteModel(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete model approval requests: %w", err)
+		}
+		return s.repo.Purge(ctx, id)
+	})
+	if err != nil {
+		s.logger.Error("Failed to purge model", "id", id, "error", err)
+		return err
+	}
+
+	s.logger.Info("Model purged", "model_id", id, "name", m.Name, "version", m.Version)
+	s.record(ctx, model.AuditModelPurge, m, m, nil)
+	return nil
+}
+
+// PurgeExpiredModels purges the models deleted before the given time, trying
+// each once; failures are logged and skipped
+func (s *modelService) PurgeExpiredModels(ctx context.Context, deletedBefore time.Time) (int, error) {
+	const limit = 100
+	filter := repository.ModelFilter{DeletedBefore: deletedBefore}
+
+	// Models that fail stay in the trash ahead of the rest, so each is tried
+	// once and the listing moves past pages holding nothing left to try
+	tried := make(map[string]bool)
+	purged, page := 0, 1
+	for ctx.Err() == nil {
+		models, _, err := s.repo.ListDeleted(ctx, filter, repository.Pagination{Page: page, Limit: limit})
+		if err != nil {
+			return purged, err
+		}
+
+		fresh := 0
+		for _, m := range models {
+			if tried[m.ID] {
+				continue
+			}
+			tried[m.ID] = true
+			fresh++
+			if err := s.PurgeModel(ctx, m.ID); err != nil {
+				s.logger.Error("Failed to purge expired model", "model_id", m.ID, "error", err)
+				continue
+			}
+			purged++
+		}
+
+		if len(models) < limit {
+			break
+		}
+		if fresh == 0 {
+			page++
+		}
+	}
+	return purged, nil
+}
+
 // UpdateModelStatus updates the status of a model
 func (s *modelService) UpdateModelStatus(ctx context.Context, id string, status model.ModelStatus) error {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeModel(ctx, m, true); err != nil {
+		return err
+	}
+	if status == model.ModelStatusRunning && m.Status != model.ModelStatusRunning {
+		if err := s.requireApproval(ctx, m, model.ApprovalPromote, status, ""); err != nil {
+			return err
+		}
+	}
+	before := map[string]model.ModelStatus{"status": m.Status}
+	previous := m.Status
+
 	if err := s.repo.UpdateStatus(ctx, id, status); err != nil {
 		s.logger.Error("Failed to update model status",
 			"id", id,
@@ -317,6 +647,340 @@ func (s *modelService) UpdateModelStatus(ctx context.Context, id string, status
 		"model_id", id,
 		"status", status,
 	)
+	s.record(ctx, model.AuditModelStatus, m, before, map[string]model.ModelStatus{"status": status})
+	s.notify(ctx, model.EventModelStatusChanged, model.ModelEventPayload{
+		Model:          s.snapshot(ctx, id),
+		PreviousStatus: previous,
+	})
+	return nil
+}
+
+// CreateModelVersion records a new version of a model
+func (s *modelService) CreateModelVersion(ctx context.Context, req CreateModelVersionRequest) (*model.ModelVersion, error) {
+	if req.Version == "" {
+		return nil, fmt.Errorf("%w: version is required", ErrInvalidInput)
+	}
+	if req.Signature != nil {
+		if err := req.Signature.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+	m, err := s.modelForChange(ctx, req.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	if req.CreatedBy == "" {
+		if caller, ok := auth.FromContext(ctx); ok {
+			req.CreatedBy = caller.UserID
+		}
+	}
+
+	v := &model.ModelVersion{
+		ModelID:     req.ModelID,
+		Version:     req.Version,
+		Status:      model.ModelStatusReady,
+		Size:        req.Size,
+		Checksum:    req.Checksum,
+		StoragePath: req.StoragePath,
+		DockerImage: req.DockerImage,
+		ChangeLog:   req.ChangeLog,
+		CreatedBy:   req.CreatedBy,
+		Signature:   req.Signature,
+	}
+
+	if err := s.repo.CreateVersion(ctx, v); err != nil {
+		s.logger.Error("Failed to create model version", "model_id", req.ModelID, "version", req.Version, "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("Model version created", "model_id", v.ModelID, "version", v.Version)
+	s.record(ctx, model.AuditVersionCreate, m, nil, v)
+	s.inspectArtifact(ctx, v)
+	return v, nil
+}
+
+// inspectArtifact records what the version's uploaded artifact turned out to be
+// as model metadata under inspect.MetadataPrefix, replacing what an earlier
+// version recorded. The metadata goes through SetModelMetadata, so it is
+// audited and announced like any other change; failures are logged, not returned
+func (s *modelService) inspectArtifact(ctx context.Context, v *model.ModelVersion) {
+	if v.StoragePath == "" {
+		return
+	}
+	name := strings.TrimPrefix(path.Clean("/"+v.StoragePath), "/")
+	report, err := inspect.Inspect(s.blobs.FS(), name)
+	if err != nil {
+		s.logger.Warn("Failed to inspect model artifact", "model_id", v.ModelID, "version", v.Version, "path", v.StoragePath, "error", err)
+		return
+	}
+
+	m, err := s.repo.GetByID(ctx, v.ModelID)
+	if err != nil {
+		s.logger.Error("Failed to load model for artifact metadata", "model_id", v.ModelID, "error", err)
+		return
+	}
+	metadata := make(map[string]string, len(m.Metadata))
+	for _, md := range m.Metadata {
+		if !strings.HasPrefix(md.Key, inspect.MetadataPrefix) {
+			metadata[md.Key] = md.Value
+		}
+	}
+	for key, value := range report.Metadata() {
+		metadata[key] = value
+	}
+	metadata[inspect.MetadataPrefix+"version"] = v.Version
+	if report.Mismatch(m.Framework) {
+		metadata[inspect.MetadataPrefix+"framework_mismatch"] = fmt.Sprintf("declared %s, detected %s", m.Framework, report.Framework)
+		s.logger.Warn("Model artifact does not match its declared framework",
+			"model_id", v.ModelID,
+			"version", v.Version,
+			"declared", m.Framework,
+			"detected", report.Framework,
+			"format", report.Format,
+		)
+	}
+
+	if err := s.SetModelMetadata(ctx, v.ModelID, metadata); err != nil {
+		s.logger.Error("Failed to record artifact metadata", "model_id", v.ModelID, "error", err)
+		return
+	}
+	s.logger.Info("Model artifact inspected", "model_id", v.ModelID, "version", v.Version, "format", report.Format, "size", report.Size)
+}
+
+// ListModelVersions lists all versions of a model
+func (s *modelService) ListModelVersions(ctx context.Context, modelID string) ([]*model.ModelVersion, error) {
+	ctx = repository.AllowReplica(ctx)
+	m, err := s.repo.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, m, false); err != nil {
+		return nil, err
+	}
+
+	versions, err := s.repo.ListVersions(ctx, modelID)
+	if err != nil {
+		s.logger.Error("Failed to list model versions", "model_id", modelID, "error", err)
+		return nil, err
+	}
+	return versions, nil
+}
+
+// PromoteVersion makes the given version the current one of its model once
+// it passes the model's promotion rules
+func (s *modelService) PromoteVersion(ctx context.Context, modelID, version string) (*model.Model, error) {
+	// The rules and the approval gate both judge the current model, so a
+	// failed read must not let the promotion through unchecked
+	before, err := s.repo.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, before, true); err != nil {
+		return nil, err
+	}
+	if err := s.checkPromotion(ctx, before, version); err != nil {
+		s.logger.Warn("Model version promotion blocked", "model_id", modelID, "version", version, "error", err)
+		return nil, err
+	}
+	if before.Status == model.ModelStatusRunning && before.Version != version {
+		if err := s.requireApproval(ctx, before, model.ApprovalPromote, "", version); err != nil {
+			return nil, err
+		}
+	}
+
+	m, err := s.repo.PromoteVersion(ctx, modelID, version)
+	if err != nil {
+		s.logger.Error("Failed to promote model version", "model_id", modelID, "version", version, "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("Model version promoted", "model_id", modelID, "version", version)
+	s.record(ctx, model.AuditVersionPromote, before, before, m)
+	if v, err := s.repo.GetVersion(ctx, modelID, version); err == nil {
+		s.notify(ctx, model.EventVersionPromoted, model.ModelEventPayload{Model: m, Version: v})
+	}
+	return m, nil
+}
+
+// GetModelCard retrieves the card of a version of a model
+func (s *modelService) GetModelCard(ctx context.Context, modelID, version string) (*ModelCard, error) {
+	ctx = repository.AllowReplica(ctx)
+	m, err := s.repo.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, m, false); err != nil {
+		return nil, err
+	}
+	if version == "" {
+		version = m.Version
+	}
+
+	v, err := s.repo.GetVersion(ctx, modelID, version)
+	if err != nil {
+		return nil, err
+	}
+	if v.Card == nil {
+		return nil, ErrCardNotFound
+	}
+	return &ModelCard{ModelID: m.ID, ModelName: m.Name, Version: v.Version, Card: v.Card}, nil
+}
+
+// SetModelCard validates and replaces the card of a version of a model
+func (s *modelService) SetModelCard(ctx context.Context, modelID, version string, card *modelcard.Card) (*ModelCard, error) {
+	if card == nil {
+		return nil, fmt.Errorf("%w: card is required", ErrInvalidInput)
+	}
+	if err := card.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	m, err := s.repo.GetByID(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, m, true); err != nil {
+		return nil, err
+	}
+	if version == "" {
+		version = m.Version
+	}
+	v, err := s.repo.GetVersion(ctx, modelID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt := time.Now().UTC()
+	card.UpdatedAt = &updatedAt
+	card.UpdatedBy = ""
+	if caller, ok := auth.FromContext(ctx); ok {
+		card.UpdatedBy = caller.UserID
+	}
+	if err := s.repo.SetVersionCard(ctx, modelID, version, card); err != nil {
+		s.logger.Error("Failed to set model card", "model_id", modelID, "version", version, "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("Model card updated", "model_id", modelID, "version", version)
+	s.record(ctx, model.AuditVersionCard, m, v.Card, card)
+	return &ModelCard{ModelID: m.ID, ModelName: m.Name, Version: version, Card: card}, nil
+}
+
+// snapshot loads the current state of a model for the audit log
+func (s *modelService) snapshot(ctx context.Context, id string) *model.Model {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return m
+}
+
+// record writes a model change to the audit log; failures are logged, not returned
+func (s *modelService) record(ctx context.Context, action model.AuditAction, m *model.Model, before, after interface{}) {
+	err := s.audit.Record(ctx, AuditEvent{
+		Action:     action,
+		TargetType: "model",
+		TargetID:   m.ID,
+		Before:     before,
+		After:      after,
+		TenantID:   m.TenantID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to record audit entry", "action", action, "model_id", m.ID, "error", err)
+	}
+}
+
+// notify queues webhook deliveries for a model change; failures are logged, not returned
+func (s *modelService) notify(ctx context.Context, eventType model.EventType, payload model.ModelEventPayload) {
+	if s.webhooks == nil || payload.Model == nil {
+		return
+	}
+	// The change is already committed, so queue the deliveries even if the caller has gone away
+	if err := s.webhooks.Notify(context.WithoutCancel(ctx), eventType, payload); err != nil {
+		s.logger.Error("Failed to queue webhook deliveries", "type", eventType, "model_id", payload.Model.ID, "error", err)
+	}
+}
+
+// modelForChange loads a live model the caller may change
+func (s *modelService) modelForChange(ctx context.Context, id string) (*model.Model, error) {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeModel(ctx, m, true); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// authorizeView checks the caller may see a live model; trusted calls skip the lookup
+func (s *modelService) authorizeView(ctx context.Context, id string) error {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil
+	}
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return authorizeModel(ctx, m, false)
+}
+
+// authorizeModel checks the caller may see the model, or change it when modify is set
+func authorizeModel(ctx context.Context, m *model.Model, modify bool) error {
+	caller, ok := auth.FromContext(ctx)
+	if !ok || approvedFor(ctx, m.ID) {
+		return nil
+	}
+
+	r := policy.Resource{OwnerID: m.OwnerID, TenantID: m.TenantID, IsPublic: m.IsPublic}
+	if modify && !policy.CanModify(caller.Subject(), r) {
+		return ErrForbidden
+	}
+	if !modify && !policy.CanRead(caller.Subject(), r) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// applyScope narrows the filter to the models the scope covers for the caller
+func applyScope(ctx context.Context, filter *ListModelsFilter) error {
+	if filter.Scope == "" {
+		return nil
+	}
+
+	caller, ok := auth.FromContext(ctx)
+
+	switch filter.Scope {
+	case ScopeMine:
+		if !ok {
+			return fmt.Errorf("%w: scope %q requires a caller", ErrInvalidInput, filter.Scope)
+		}
+		if filter.OwnerID != "" && filter.OwnerID != caller.UserID {
+			return fmt.Errorf("%w: owner_id conflicts with scope %q", ErrInvalidInput, filter.Scope)
+		}
+		filter.OwnerID = caller.UserID
+	case ScopeTenant:
+		if !ok || caller.TenantID == "" {
+			return fmt.Errorf("%w: scope %q requires a caller tenant", ErrInvalidInput, filter.Scope)
+		}
+		if filter.TenantID != "" && filter.TenantID != caller.TenantID {
+			return fmt.Errorf("%w: tenant_id conflicts with scope %q", ErrInvalidInput, filter.Scope)
+		}
+		filter.TenantID = caller.TenantID
+	case ScopePublic:
+		if filter.IsPublic != nil && !*filter.IsPublic {
+			return fmt.Errorf("%w: is_public=false conflicts with scope %q", ErrInvalidInput, filter.Scope)
+		}
+		isPublic := true
+		filter.IsPublic = &isPublic
+	case ScopeAll:
+		if !ok || !caller.IsAdmin() {
+			return ErrForbidden
+		}
+	default:
+		return fmt.Errorf("%w: unknown scope %q", ErrInvalidInput, filter.Scope)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/internal/storage"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// newModelService returns a model service over db using the given model and
+// approval repositories
+func newModelService(t *testing.T, db *gorm.DB, models repository.ModelRepository, approvals repository.ApprovalRepository, audit service.AuditService) service.ModelService {
+	t.Helper()
+	return service.NewModelService(models, repository.NewGormEvaluationRepository(db), repository.NewGormLineageRepository(db),
+		approvals, repository.NewGormTransactor(db), storage.NewLocalBlobStore(t.TempDir()), audit, nil, logger.New("error"))
+}
+
+// failingApprovals fails to delete the approval requests of the listed models
+type failingApprovals struct {
+	repository.ApprovalRepository
+	failing map[string]bool
+}
+
+func (r *failingApprovals) DeleteModel(ctx context.Context, modelID string) error {
+	if r.failing[modelID] {
+		return errors.New("approval requests are locked")
+	}
+	return r.ApprovalRepository.DeleteModel(ctx, modelID)
+}
+
+// purgeTest holds a model service whose purges fail for chosen models
+type purgeTest struct {
+	svc         service.ModelService
+	models      repository.ModelRepository
+	evaluations repository.EvaluationRepository
+	approvals   *failingApprovals
+}
+
+func newPurgeTest(t *testing.T) *purgeTest {
+	t.Helper()
+	db := newDB(t)
+	pt := &purgeTest{
+		models:      repository.NewGormModelRepository(db),
+		evaluations: repository.NewGormEvaluationRepository(db),
+		approvals:   &failingApprovals{ApprovalRepository: repository.NewGormApprovalRepository(db), failing: map[string]bool{}},
+	}
+	audit := service.NewAuditService(repository.NewGormAuditRepository(db), logger.New("error"))
+	pt.svc = newModelService(t, db, pt.models, pt.approvals, audit)
+	return pt
+}
+
+// trash creates a model with an evaluation and moves it to the trash
+func (pt *purgeTest) trash(t *testing.T, version string) *model.Model {
+	t.Helper()
+	ctx := context.Background()
+	m := &model.Model{Name: "bert", Version: version, Framework: model.FrameworkPyTorch, OwnerID: uuid.New().String(), TenantID: "tenant-a"}
+	if err := pt.models.Create(ctx, m); err != nil {
+		t.Fatalf("create model: %v", err)
+	}
+	if err := pt.evaluations.CreateEvaluations(ctx, []*model.Evaluation{
+		{ModelID: m.ID, Version: version, Metric: "accuracy", Value: 0.9, EvaluatedAt: time.Now()},
+	}); err != nil {
+		t.Fatalf("create evaluation: %v", err)
+	}
+	if err := pt.models.Delete(ctx, m.ID); err != nil {
+		t.Fatalf("delete model: %v", err)
+	}
+	return m
+}
+
+// trashed reports whether the model is still in the trash
+func (pt *purgeTest) trashed(t *testing.T, id string) bool {
+	t.Helper()
+	_, err := pt.models.GetDeletedByID(context.Background(), id)
+	if err != nil && !errors.Is(err, repository.ErrModelNotFound) {
+		t.Fatalf("get deleted model: %v", err)
+	}
+	return err == nil
+}
+
+func TestPurgeModelIsAllOrNothing(t *testing.T) {
+	pt := newPurgeTest(t)
+	ctx := context.Background()
+	m := pt.trash(t, "1.0.0")
+
+	pt.approvals.failing[m.ID] = true
+	if err := pt.svc.PurgeModel(ctx, m.ID); err == nil {
+		t.Fatal("PurgeModel succeeded while deleting approval requests failed")
+	}
+	if !pt.trashed(t, m.ID) {
+		t.Error("model left the trash although the purge failed")
+	}
+	if evaluations, err := pt.evaluations.ListEvaluations(ctx, m.ID, "", ""); err != nil || len(evaluations) != 1 {
+		t.Errorf("evaluations after a failed purge = %d, %v; want them kept", len(evaluations), err)
+	}
+
+	delete(pt.approvals.failing, m.ID)
+	if err := pt.svc.PurgeModel(ctx, m.ID); err != nil {
+		t.Fatalf("PurgeModel: %v", err)
+	}
+	if pt.trashed(t, m.ID) {
+		t.Error("model still in the trash after purging")
+	}
+	if evaluations, err := pt.evaluations.ListEvaluations(ctx, m.ID, "", ""); err != nil || len(evaluations) != 0 {
+		t.Errorf("evaluations after purging = %d, %v; want none", len(evaluations), err)
+	}
+}
+
+func TestPurgeExpiredModelsSkipsFailures(t *testing.T) {
+	pt := newPurgeTest(t)
+
+	// More failing models than fit a page sit ahead of the ones that can go
+	var failing, expired []*model.Model
+	for i := 0; i < 101; i++ {
+		m := pt.trash(t, fmt.Sprintf("1.0.%d", i))
+		pt.approvals.failing[m.ID] = true
+		failing = append(failing, m)
+	}
+	for i := 0; i < 2; i++ {
+		expired = append(expired, pt.trash(t, fmt.Sprintf("2.0.%d", i)))
+	}
+
+	purged, err := pt.svc.PurgeExpiredModels(context.Background(), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("PurgeExpiredModels: %v", err)
+	}
+	if purged != len(expired) {
+		t.Errorf("purged %d models, want %d", purged, len(expired))
+	}
+	for _, m := range expired {
+		if pt.trashed(t, m.ID) {
+			t.Errorf("model %s %s still in the trash", m.Name, m.Version)
+		}
+	}
+	for _, m := range failing {
+		if !pt.trashed(t, m.ID) {
+			t.Fatalf("failing model %s %s left the trash", m.Name, m.Version)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// Reaper periodically purges models whose retention period in the trash has expired
+type Reaper struct {
+	service   ModelService
+	retention time.Duration
+	interval  time.Duration
+	logger    *logger.Logger
+}
+
+// NewReaper creates a new trash reaper
+func NewReaper(svc ModelService, retention, interval time.Duration, logger *logger.Logger) *Reaper {
+	return &Reaper{
+		service:   svc,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+// Run purges expired models until ctx is cancelled
+func (r *Reaper) Run(ctx context.Context) {
+	if r.retention <= 0 || r.interval <= 0 {
+		r.logger.Info("Trash reaper disabled")
+		return
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.reap(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reap purges models deleted longer ago than the retention period
+func (r *Reaper) reap(ctx context.Context) {
+	cutoff := time.Now().Add(-r.retention)
+
+	purged, err := r.service.PurgeExpiredModels(ctx, cutoff)
+	if err != nil {
+		r.logger.Error("Failed to purge expired models", "error", err)
+		return
+	}
+	if purged > 0 {
+		r.logger.Info("Purged expired models", "count", purged, "deleted_before", cutoff)
+	}
+}
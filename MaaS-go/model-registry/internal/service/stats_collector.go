@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+	"maas-platform/model-registry/pkg/metrics"
+)
+
+// StatsCollector periodically refreshes the business gauges from the database
+// so that scrapes never wait on a query
+type StatsCollector struct {
+	repo     repository.StatsRepository
+	interval time.Duration
+	logger   *logger.Logger
+}
+
+// NewStatsCollector creates a new stats collector
+func NewStatsCollector(repo repository.StatsRepository, interval time.Duration, logger *logger.Logger) *StatsCollector {
+	return &StatsCollector{
+		repo:     repo,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run refreshes the gauges until ctx is cancelled
+func (s *StatsCollector) Run(ctx context.Context) {
+	if s.interval <= 0 {
+		s.logger.Info("Business metrics disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.collect(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// collect refreshes every gauge; one failing query leaves the others current
+func (s *StatsCollector) collect(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	if counts, err := s.repo.ModelCounts(ctx); err != nil {
+		s.fail("models", err)
+	} else {
+		result := make([]metrics.ModelCount, len(counts))
+		for i, c := range counts {
+			result[i] = metrics.ModelCount{Status: string(c.Status), Framework: string(c.Framework), Count: c.Count}
+		}
+		metrics.SetModelCounts(result)
+	}
+
+	if n, err := s.repo.UnpublishedEvents(ctx); err != nil {
+		s.fail("outbox", err)
+	} else {
+		metrics.SetQueueDepth("outbox", n)
+	}
+
+	if counts, err := s.repo.DeliveryCounts(ctx); err != nil {
+		s.fail("webhook deliveries", err)
+	} else {
+		metrics.SetQueueDepth("webhook_deliveries", counts[model.DeliveryPending]+counts[model.DeliveryRetrying])
+		metrics.SetWebhookDeadLetters(counts[model.DeliveryDeadLetter])
+	}
+}
+
+// fail logs and counts a failed refresh
+func (s *StatsCollector) fail(what string, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	metrics.RecordStatsRefreshError()
+	s.logger.Warn("Failed to refresh metrics", "metrics", what, "error", err)
+}
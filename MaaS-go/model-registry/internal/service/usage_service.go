@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+	"maas-platform/model-registry/pkg/metrics"
+)
+
+// maxUsageRange bounds a usage query so a summary never scans unbounded history
+const maxUsageRange = 366 * 24 * time.Hour
+
+// usageEventRetention is how long recorded events are remembered; the gateway
+// gives up on redelivering a batch long before
+const usageEventRetention = 7 * 24 * time.Hour
+
+// usageEventPruneInterval bounds how often old events are deleted
+const usageEventPruneInterval = time.Hour
+
+// UsageService aggregates metering records into hourly rollups and summarizes them
+type UsageService interface {
+	Record(ctx context.Context, records []UsageRecord) (int, error)
+	Summarize(ctx context.Context, query UsageQuery) ([]*repository.UsageSummary, error)
+}
+
+// UsageRecord is the metering record of a single call
+type UsageRecord struct {
+	// EventID identifies the call so a redelivered record is counted once;
+	// records without one are always counted
+	EventID          string
+	TenantID         string
+	ModelID          string
+	Version          string
+	Status           model.UsageStatus
+	LatencyMs        int64
+	RequestBytes     int64
+	ResponseBytes    int64
+	PromptTokens     int64
+	CompletionTokens int64
+	OccurredAt       time.Time
+}
+
+// UsageQuery represents filters and grouping for summarizing usage
+type UsageQuery struct {
+	TenantID string
+	ModelID  string
+	From     time.Time
+	To       time.Time
+	GroupBy  []string
+}
+
+// usageService implements UsageService
+type usageService struct {
+	repo   repository.UsageRepository
+	logger *logger.Logger
+	// lastPrune is the Unix time events were last pruned
+	lastPrune atomic.Int64
+}
+
+// NewUsageService creates a new usage service
+func NewUsageService(repo repository.UsageRepository, logger *logger.Logger) UsageService {
+	return &usageService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// rollupKey identifies the rollup a record belongs to
+type rollupKey struct {
+	hour     int64
+	tenantID string
+	modelID  string
+	version  string
+	status   model.UsageStatus
+}
+
+// Record folds records into hourly rollups and stores them; only trusted services may call it.
+// Records without a tenant or model are skipped and not counted as accepted. Records whose
+// event was recorded before are accepted without being counted again.
+func (s *usageService) Record(ctx context.Context, records []UsageRecord) (int, error) {
+	if _, ok := auth.FromContext(ctx); ok {
+		return 0, ErrForbidden
+	}
+
+	eventIDs := make([]string, 0, len(records))
+	for _, r := range records {
+		if r.EventID != "" {
+			eventIDs = append(eventIDs, r.EventID)
+		}
+	}
+
+	// A concurrent redelivery of the same events makes the store fail as a
+	// whole; the second attempt sees its events and skips them
+	for attempt := 1; ; attempt++ {
+		recorded, err := s.repo.RecordedEvents(ctx, eventIDs)
+		if err != nil {
+			s.logger.Error("Failed to look up usage events", "events", len(eventIDs), "error", err)
+			return 0, err
+		}
+
+		now := time.Now().UTC()
+		events, batch, accepted := s.fold(records, recorded, now)
+		err = s.repo.Add(ctx, events, batch)
+		if errors.Is(err, repository.ErrDuplicateUsageEvent) && attempt < 2 {
+			continue
+		}
+		if err != nil {
+			s.logger.Error("Failed to store usage rollups", "rollups", len(batch), "error", err)
+			return 0, err
+		}
+
+		for _, rollup := range batch {
+			metrics.RecordUsageReceived(rollup.ModelID, string(rollup.Status), int(rollup.Requests))
+		}
+		if skipped := len(eventIDs) - len(events); skipped > 0 {
+			s.logger.Info("Ignored redelivered usage records", "records", skipped)
+		}
+		s.pruneEvents(ctx, now)
+		return accepted, nil
+	}
+}
+
+// fold sums the records not recorded before into rollups sorted by key and
+// returns the events they came from
+func (s *usageService) fold(records []UsageRecord, recorded map[string]bool, now time.Time) ([]*model.UsageEvent, []*model.UsageRollup, int) {
+	rollups := make(map[rollupKey]*model.UsageRollup)
+	var events []*model.UsageEvent
+	accepted := 0
+	for _, r := range records {
+		if r.TenantID == "" || r.ModelID == "" {
+			continue
+		}
+		accepted++
+		if r.EventID != "" {
+			if recorded[r.EventID] {
+				continue
+			}
+			recorded[r.EventID] = true
+			events = append(events, &model.UsageEvent{EventID: r.EventID, RecordedAt: now})
+		}
+
+		switch r.Status {
+		case model.UsageSuccess, model.UsageClientError, model.UsageServerError:
+		default:
+			r.Status = model.UsageServerError
+		}
+		if r.OccurredAt.IsZero() {
+			r.OccurredAt = now
+		}
+
+		hour := r.OccurredAt.UTC().Truncate(time.Hour)
+		key := rollupKey{hour: hour.Unix(), tenantID: r.TenantID, modelID: r.ModelID, version: r.Version, status: r.Status}
+		rollup, ok := rollups[key]
+		if !ok {
+			rollup = &model.UsageRollup{
+				HourStart: hour,
+				TenantID:  r.TenantID,
+				ModelID:   r.ModelID,
+				Version:   r.Version,
+				Status:    r.Status,
+				UpdatedAt: now,
+			}
+			rollups[key] = rollup
+		}
+		rollup.Requests++
+		rollup.TotalLatencyMs += r.LatencyMs
+		if r.LatencyMs > rollup.MaxLatencyMs {
+			rollup.MaxLatencyMs = r.LatencyMs
+		}
+		rollup.RequestBytes += r.RequestBytes
+		rollup.ResponseBytes += r.ResponseBytes
+		rollup.PromptTokens += r.PromptTokens
+		rollup.CompletionTokens += r.CompletionTokens
+	}
+
+	batch := make([]*model.UsageRollup, 0, len(rollups))
+	for _, rollup := range rollups {
+		batch = append(batch, rollup)
+	}
+	// A stable order keeps concurrent upserts from deadlocking on each other's rows
+	sort.Slice(batch, func(i, j int) bool {
+		a, b := batch[i], batch[j]
+		if !a.HourStart.Equal(b.HourStart) {
+			return a.HourStart.Before(b.HourStart)
+		}
+		if a.TenantID != b.TenantID {
+			return a.TenantID < b.TenantID
+		}
+		if a.ModelID != b.ModelID {
+			return a.ModelID < b.ModelID
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		return a.Status < b.Status
+	})
+	return events, batch, accepted
+}
+
+// pruneEvents forgets events past the retention, at most once per interval
+func (s *usageService) pruneEvents(ctx context.Context, now time.Time) {
+	last := s.lastPrune.Load()
+	if now.Unix()-last < int64(usageEventPruneInterval/time.Second) || !s.lastPrune.CompareAndSwap(last, now.Unix()) {
+		return
+	}
+	pruned, err := s.repo.PruneEvents(ctx, now.Add(-usageEventRetention))
+	if err != nil {
+		s.logger.Warn("Failed to prune usage events", "error", err)
+		return
+	}
+	if pruned > 0 {
+		s.logger.Info("Pruned usage events", "events", pruned)
+	}
+}
+
+// Summarize sums usage per group; non-admin callers only see their tenant
+func (s *usageService) Summarize(ctx context.Context, query UsageQuery) ([]*repository.UsageSummary, error) {
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		if caller.TenantID == "" {
+			return nil, ErrForbidden
+		}
+		query.TenantID = caller.TenantID
+	}
+
+	if query.To.IsZero() {
+		query.To = time.Now().UTC()
+	}
+	if query.From.IsZero() {
+		query.From = query.To.AddDate(0, 0, -30)
+	}
+	if !query.From.Before(query.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	if query.To.Sub(query.From) > maxUsageRange {
+		return nil, fmt.Errorf("%w: time range must not exceed 366 days", ErrInvalidInput)
+	}
+
+	periods := 0
+	seen := make(map[string]bool, len(query.GroupBy))
+	for _, dim := range query.GroupBy {
+		switch dim {
+		case repository.UsageByHour, repository.UsageByDay, repository.UsageByMonth:
+			periods++
+		case repository.UsageByTenant, repository.UsageByModel, repository.UsageByVersion, repository.UsageByStatus:
+		default:
+			return nil, fmt.Errorf("%w: unknown group_by %q", ErrInvalidInput, dim)
+		}
+		if seen[dim] {
+			return nil, fmt.Errorf("%w: duplicate group_by %q", ErrInvalidInput, dim)
+		}
+		seen[dim] = true
+	}
+	if periods > 1 {
+		return nil, fmt.Errorf("%w: group by at most one of hour, day and month", ErrInvalidInput)
+	}
+
+	summaries, err := s.repo.Summarize(ctx, repository.UsageFilter{
+		TenantID: query.TenantID,
+		ModelID:  query.ModelID,
+		From:     query.From,
+		To:       query.To,
+	}, query.GroupBy)
+	if err != nil {
+		s.logger.Error("Failed to summarize usage", "error", err)
+		return nil, err
+	}
+	return summaries, nil
+}
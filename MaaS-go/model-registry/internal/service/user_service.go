@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// User errors
+var (
+	ErrUserNotFound  = repository.ErrUserNotFound
+	ErrDuplicateUser = repository.ErrDuplicateUser
+	// ErrUserDisabled is returned when an inactive or banned user signs in
+	ErrUserDisabled = errors.New("user is disabled")
+	// ErrIdentityConflict is returned when the email belongs to a user linked to another identity
+	ErrIdentityConflict = errors.New("email is linked to another identity")
+	// ErrInvalidCredentials is returned for an unknown username or a wrong password
+	ErrInvalidCredentials = errors.New("invalid username or password")
+)
+
+// maxUsernameLength matches the users.username column
+const maxUsernameLength = 50
+
+// usernameDisallowed matches characters not kept in generated usernames
+var usernameDisallowed = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// UserService manages platform users
+type UserService interface {
+	// ProvisionExternalUser finds or creates the user of an external identity
+	// and syncs its email, role and tenant; created reports a new user
+	ProvisionExternalUser(ctx context.Context, id ExternalIdentity) (u *model.User, created bool, err error)
+	// AuthenticateUser checks the password of a local user and records the login
+	AuthenticateUser(ctx context.Context, username, password string) (*model.User, error)
+}
+
+// ExternalIdentity is a user asserted by an identity provider
+type ExternalIdentity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Username      string
+	Role          model.UserRole
+	TenantID      string
+}
+
+// userService implements UserService
+type userService struct {
+	repo   repository.UserRepository
+	audit  AuditService
+	logger *logger.Logger
+}
+
+// NewUserService creates a new user service
+func NewUserService(repo repository.UserRepository, audit AuditService, logger *logger.Logger) UserService {
+	return &userService{
+		repo:   repo,
+		audit:  audit,
+		logger: logger,
+	}
+}
+
+// ProvisionExternalUser provisions a user just in time; only trusted services may call it
+func (s *userService) ProvisionExternalUser(ctx context.Context, id ExternalIdentity) (*model.User, bool, error) {
+	if _, ok := auth.FromContext(ctx); ok {
+		return nil, false, ErrForbidden
+	}
+	if id.Issuer == "" || id.Subject == "" || id.Email == "" || id.TenantID == "" {
+		return nil, false, fmt.Errorf("%w: issuer, subject, email and tenant_id are required", ErrInvalidInput)
+	}
+	switch id.Role {
+	case model.RoleAdmin, model.RoleDeveloper, model.RoleViewer:
+	default:
+		return nil, false, fmt.Errorf("%w: invalid role %q", ErrInvalidInput, id.Role)
+	}
+
+	u, err := s.repo.GetByExternalID(ctx, id.Issuer, id.Subject)
+	linking := false
+	if errors.Is(err, ErrUserNotFound) {
+		u, err = s.findLinkable(ctx, id)
+		linking = err == nil
+	}
+	if errors.Is(err, ErrUserNotFound) {
+		return s.create(ctx, id)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if u.Status != model.UserStatusActive {
+		return nil, false, ErrUserDisabled
+	}
+
+	before := *u
+	if linking {
+		u.ExternalIssuer = &id.Issuer
+		u.ExternalSubject = &id.Subject
+	}
+	now := time.Now().UTC()
+	u.Email = id.Email
+	u.Role = id.Role
+	u.TenantID = id.TenantID
+	u.LastLoginAt = &now
+	if err := s.repo.Update(ctx, u); err != nil {
+		s.logger.Error("Failed to sync user", "user_id", u.ID, "error", err)
+		return nil, false, err
+	}
+
+	if linking || before.Role != u.Role || before.TenantID != u.TenantID || before.Email != u.Email {
+		s.logger.Info("User synced from identity provider", "user_id", u.ID, "role", u.Role, "tenant_id", u.TenantID)
+		s.record(ctx, model.AuditUserSync, u, &before, u)
+	}
+	return u, false, nil
+}
+
+// AuthenticateUser signs in a password user; only trusted services may call it.
+// Users provisioned from an identity provider have no password and cannot sign in here.
+func (s *userService) AuthenticateUser(ctx context.Context, username, password string) (*model.User, error) {
+	if _, ok := auth.FromContext(ctx); ok {
+		return nil, ErrForbidden
+	}
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	u, err := s.repo.GetByUsername(ctx, username)
+	if errors.Is(err, ErrUserNotFound) {
+		// Spend as long as a real check, so timing does not reveal which usernames exist
+		_ = bcrypt.CompareHashAndPassword(unknownUserHash, []byte(password))
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.Password == "" || bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)) != nil {
+		s.logger.Warn("Failed login", "user_id", u.ID)
+		return nil, ErrInvalidCredentials
+	}
+	if u.Status != model.UserStatusActive {
+		return nil, ErrUserDisabled
+	}
+
+	now := time.Now().UTC()
+	u.LastLoginAt = &now
+	if err := s.repo.Update(ctx, u); err != nil {
+		s.logger.Error("Failed to record login", "user_id", u.ID, "error", err)
+		return nil, err
+	}
+	return u, nil
+}
+
+// unknownUserHash is compared against when the username does not exist
+var unknownUserHash, _ = bcrypt.GenerateFromPassword([]byte("maas-unknown-user"), bcrypt.DefaultCost)
+
+// findLinkable finds a local user with the same verified email to link to the external identity
+func (s *userService) findLinkable(ctx context.Context, id ExternalIdentity) (*model.User, error) {
+	if !id.EmailVerified {
+		return nil, ErrUserNotFound
+	}
+	u, err := s.repo.GetByEmail(ctx, id.Email)
+	if err != nil {
+		return nil, err
+	}
+	if u.ExternalSubject != nil {
+		return nil, ErrIdentityConflict
+	}
+	return u, nil
+}
+
+// create creates the user of an external identity
+func (s *userService) create(ctx context.Context, id ExternalIdentity) (*model.User, bool, error) {
+	username, err := s.uniqueUsername(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	now := time.Now().UTC()
+	u := &model.User{
+		Username:        username,
+		Email:           id.Email,
+		Role:            id.Role,
+		Status:          model.UserStatusActive,
+		TenantID:        id.TenantID,
+		ExternalIssuer:  &id.Issuer,
+		ExternalSubject: &id.Subject,
+		LastLoginAt:     &now,
+	}
+	if err := s.repo.Create(ctx, u); err != nil {
+		if errors.Is(err, ErrDuplicateUser) {
+			// The email is taken by a user we could not link
+			return nil, false, ErrIdentityConflict
+		}
+		s.logger.Error("Failed to provision user", "error", err)
+		return nil, false, err
+	}
+
+	s.logger.Info("User provisioned", "user_id", u.ID, "username", u.Username, "role", u.Role, "tenant_id", u.TenantID)
+	s.record(ctx, model.AuditUserProvision, u, nil, u)
+	return u, true, nil
+}
+
+// uniqueUsername derives a free username from the preferred one or the email
+func (s *userService) uniqueUsername(ctx context.Context, id ExternalIdentity) (string, error) {
+	base := id.Username
+	if base == "" {
+		base, _, _ = strings.Cut(id.Email, "@")
+	}
+	base = strings.Trim(usernameDisallowed.ReplaceAllString(base, "-"), "-")
+	if base == "" {
+		base = "user"
+	}
+	if len(base) > maxUsernameLength-7 {
+		base = base[:maxUsernameLength-7]
+	}
+
+	candidate := base
+	for attempt := 0; attempt < 5; attempt++ {
+		exists, err := s.repo.UsernameExists(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+		suffix := make([]byte, 3)
+		if _, err := rand.Read(suffix); err != nil {
+			return "", err
+		}
+		candidate = base + "-" + hex.EncodeToString(suffix)
+	}
+	return "", fmt.Errorf("%w: no free username for %q", ErrDuplicateUser, base)
+}
+
+// record writes a user change to the audit log
+func (s *userService) record(ctx context.Context, action model.AuditAction, u *model.User, before, after interface{}) {
+	err := s.audit.Record(ctx, AuditEvent{
+		Action:     action,
+		TargetType: "user",
+		TargetID:   u.ID,
+		Before:     before,
+		After:      after,
+		ActorID:    u.ID,
+		TenantID:   u.TenantID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to record audit entry", "action", action, "user_id", u.ID, "error", err)
+	}
+}
@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/migrate"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/internal/service"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// newDB returns a fresh, migrated SQLite database
+func newDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := repository.NewDatabase(repository.DatabaseConfig{
+		Backend: repository.BackendSQLite,
+		Path:    filepath.Join(t.TempDir(), "registry.db"),
+	})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	db.Logger = gormlogger.Discard
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("sql db: %v", err)
+	}
+	m, err := migrate.New(sqlDB, db.Dialector.Name(), logger.New("error"))
+	if err != nil {
+		t.Fatalf("migrator: %v", err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	return db
+}
+
+// newUserService returns a user service over a fresh SQLite database
+func newUserService(t *testing.T) (service.UserService, repository.UserRepository) {
+	t.Helper()
+	db := newDB(t)
+	log := logger.New("error")
+	repo := repository.NewGormUserRepository(db)
+	audit := service.NewAuditService(repository.NewGormAuditRepository(db), log)
+	return service.NewUserService(repo, audit, log), repo
+}
+
+// localPassword is the password of users made by createLocalUser
+const localPassword = "correct horse battery"
+
+// createLocalUser creates a password user without an external identity
+func createLocalUser(t *testing.T, repo repository.UserRepository, email string) *model.User {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(localPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+	u := &model.User{
+		Username: "local",
+		Email:    email,
+		Password: string(hash),
+		Role:     model.RoleViewer,
+		Status:   model.UserStatusActive,
+		TenantID: "tenant-a",
+	}
+	if err := repo.Create(context.Background(), u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return u
+}
+
+func externalIdentity(subject, email string, verified bool) service.ExternalIdentity {
+	return service.ExternalIdentity{
+		Issuer:        "https://idp.example.com",
+		Subject:       subject,
+		Email:         email,
+		EmailVerified: verified,
+		Username:      "alice",
+		Role:          model.RoleDeveloper,
+		TenantID:      "tenant-b",
+	}
+}
+
+func TestProvisionCreatesAndSyncsUser(t *testing.T) {
+	svc, _ := newUserService(t)
+	ctx := context.Background()
+
+	u, created, err := svc.ProvisionExternalUser(ctx, externalIdentity("sub-1", "alice@example.com", false))
+	if err != nil || !created {
+		t.Fatalf("first login: created = %v, err = %v", created, err)
+	}
+
+	id := externalIdentity("sub-1", "alice@corp.example.com", false)
+	id.Role = model.RoleAdmin
+	again, created, err := svc.ProvisionExternalUser(ctx, id)
+	if err != nil || created {
+		t.Fatalf("second login: created = %v, err = %v", created, err)
+	}
+	if again.ID != u.ID || again.Role != model.RoleAdmin || again.Email != "alice@corp.example.com" {
+		t.Errorf("second login returned %+v, want user %s synced to admin", again, u.ID)
+	}
+}
+
+func TestProvisionLinksVerifiedEmail(t *testing.T) {
+	svc, repo := newUserService(t)
+	local := createLocalUser(t, repo, "alice@example.com")
+
+	u, created, err := svc.ProvisionExternalUser(context.Background(), externalIdentity("sub-1", "alice@example.com", true))
+	if err != nil || created {
+		t.Fatalf("created = %v, err = %v", created, err)
+	}
+	if u.ID != local.ID {
+		t.Fatalf("linked user %s, want %s", u.ID, local.ID)
+	}
+	if u.ExternalSubject == nil || *u.ExternalSubject != "sub-1" {
+		t.Errorf("external subject = %v, want sub-1", u.ExternalSubject)
+	}
+	if u.Role != model.RoleDeveloper || u.TenantID != "tenant-b" {
+		t.Errorf("role and tenant = %s/%s, want developer/tenant-b", u.Role, u.TenantID)
+	}
+}
+
+func TestProvisionRefusesUnsafeLinks(t *testing.T) {
+	t.Run("unverified email", func(t *testing.T) {
+		svc, repo := newUserService(t)
+		createLocalUser(t, repo, "alice@example.com")
+
+		_, _, err := svc.ProvisionExternalUser(context.Background(), externalIdentity("sub-1", "alice@example.com", false))
+		if !errors.Is(err, service.ErrIdentityConflict) {
+			t.Fatalf("err = %v, want ErrIdentityConflict", err)
+		}
+	})
+
+	t.Run("email linked to another subject", func(t *testing.T) {
+		svc, _ := newUserService(t)
+		ctx := context.Background()
+		if _, _, err := svc.ProvisionExternalUser(ctx, externalIdentity("sub-1", "alice@example.com", true)); err != nil {
+			t.Fatalf("first identity: %v", err)
+		}
+
+		_, _, err := svc.ProvisionExternalUser(ctx, externalIdentity("sub-2", "alice@example.com", true))
+		if !errors.Is(err, service.ErrIdentityConflict) {
+			t.Fatalf("err = %v, want ErrIdentityConflict", err)
+		}
+	})
+}
+
+func TestAuthenticateUser(t *testing.T) {
+	svc, repo := newUserService(t)
+	ctx := context.Background()
+	local := createLocalUser(t, repo, "local@example.com")
+	if _, _, err := svc.ProvisionExternalUser(ctx, externalIdentity("sub-1", "alice@example.com", true)); err != nil {
+		t.Fatalf("provision external user: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		username string
+		password string
+		wantErr  error
+	}{
+		{"valid password", ctx, "local", localPassword, nil},
+		{"wrong password", ctx, "local", "wrong", service.ErrInvalidCredentials},
+		{"empty password", ctx, "local", "", service.ErrInvalidCredentials},
+		{"unknown user", ctx, "nobody", localPassword, service.ErrInvalidCredentials},
+		{"user without password", ctx, "alice", "", service.ErrInvalidCredentials},
+		{"user without password, any guess", ctx, "alice", localPassword, service.ErrInvalidCredentials},
+		{"called for a user", auth.NewContext(ctx, auth.Caller{UserID: local.ID, TenantID: local.TenantID, Role: model.RoleAdmin}), "local", localPassword, service.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := svc.AuthenticateUser(tt.ctx, tt.username, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if u.ID != local.ID || u.LastLoginAt == nil {
+				t.Errorf("signed in %s with last login %v, want %s with a login time", u.ID, u.LastLoginAt, local.ID)
+			}
+		})
+	}
+
+	t.Run("disabled user", func(t *testing.T) {
+		local.Status = model.UserStatusBanned
+		if err := repo.Update(ctx, local); err != nil {
+			t.Fatalf("ban user: %v", err)
+		}
+		if _, err := svc.AuthenticateUser(ctx, "local", localPassword); !errors.Is(err, service.ErrUserDisabled) {
+			t.Fatalf("err = %v, want ErrUserDisabled", err)
+		}
+		// A wrong password does not reveal that the account is disabled
+		if _, err := svc.AuthenticateUser(ctx, "local", "wrong"); !errors.Is(err, service.ErrInvalidCredentials) {
+			t.Fatalf("wrong password: err = %v, want ErrInvalidCredentials", err)
+		}
+	})
+}
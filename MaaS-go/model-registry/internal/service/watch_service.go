@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+)
+
+// ErrResumeTokenExpired is returned when the events after a resume token were pruned
+var ErrResumeTokenExpired = errors.New("resume token expired; relist models and watch without a token")
+
+// WatchEventType is the kind of change a watch event reports
+type WatchEventType string
+
+const (
+	WatchAdded    WatchEventType = "ADDED"
+	WatchModified WatchEventType = "MODIFIED"
+	WatchDeleted  WatchEventType = "DELETED"
+)
+
+// WatchFilter selects the models a watch covers; list fields match any of their values
+type WatchFilter struct {
+	TenantID string
+	ModelIDs []string
+	Names    []string
+	// Tags must all be present on the model
+	Tags []string
+	// ResumeToken continues a previous watch after the last token it received
+	ResumeToken string
+}
+
+// WatchEvent is a change to a watched model
+type WatchEvent struct {
+	Type       WatchEventType
+	EventType  model.EventType
+	Model      *model.Model
+	OccurredAt time.Time
+	// ResumeToken resumes the watch right after this event
+	ResumeToken string
+}
+
+// WatchService streams model changes from the event outbox.
+// Delivery is at-least-once: a resumed watch may repeat events sent just
+// before the token, never skip any.
+type WatchService interface {
+	Watch(ctx context.Context, filter WatchFilter, fn func(WatchEvent) error) error
+	// Run polls the outbox and wakes watchers until ctx is cancelled
+	Run(ctx context.Context)
+}
+
+// watchService implements WatchService
+type watchService struct {
+	repo         repository.OutboxRepository
+	pollInterval time.Duration
+	// gapTimeout is how long a missing sequence is waited for before it is
+	// assumed to belong to a rolled back transaction
+	gapTimeout time.Duration
+	logger     *logger.Logger
+
+	mu      sync.Mutex
+	latest  int64
+	changed chan struct{}
+}
+
+// NewWatchService creates a new watch service
+func NewWatchService(repo repository.OutboxRepository, pollInterval, gapTimeout time.Duration, logger *logger.Logger) WatchService {
+	if pollInterval <= 0 {
+		pollInterval = 500 * time.Millisecond
+	}
+	if gapTimeout <= 0 {
+		gapTimeout = 10 * time.Second
+	}
+	return &watchService{
+		repo:         repo,
+		pollInterval: pollInterval,
+		gapTimeout:   gapTimeout,
+		logger:       logger,
+		changed:      make(chan struct{}),
+	}
+}
+
+// Run polls the latest outbox sequence so watchers only query when something changed
+func (s *watchService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, latest, err := s.repo.SequenceRange(ctx); err != nil {
+			if ctx.Err() == nil {
+				s.logger.Error("Failed to poll event outbox", "error", err)
+			}
+		} else {
+			s.broadcast(latest)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// broadcast wakes all watchers when the latest sequence moved
+func (s *watchService) broadcast(latest int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if latest != s.latest {
+		s.latest = latest
+		close(s.changed)
+		s.changed = make(chan struct{})
+	}
+}
+
+// wait returns a channel closed on the next change
+func (s *watchService) wait() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.changed
+}
+
+// Watch calls fn for every change matching the filter until ctx is done or fn fails
+func (s *watchService) Watch(ctx context.Context, filter WatchFilter, fn func(WatchEvent) error) error {
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		if filter.TenantID != "" && filter.TenantID != caller.TenantID {
+			return ErrForbidden
+		}
+		filter.TenantID = caller.TenantID
+	}
+
+	c, err := s.newCursor(ctx, filter.ResumeToken)
+	if err != nil {
+		return err
+	}
+
+	// Re-check periodically so missing sequences are eventually given up on
+	ticker := time.NewTicker(s.gapTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		changed := s.wait()
+
+		for {
+			events, err := s.repo.Since(ctx, c.high, c.missing(), 500)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			for _, e := range events {
+				c.advance(e.Sequence)
+				event, ok, err := toWatchEvent(e, filter)
+				if err != nil {
+					s.logger.Warn("Skipping undecodable outbox event", "sequence", e.Sequence, "error", err)
+					continue
+				}
+				if !ok {
+					continue
+				}
+				event.ResumeToken = c.token()
+				if err := fn(event); err != nil {
+					return err
+				}
+			}
+			c.expire(time.Now(), s.gapTimeout)
+			if len(events) < 500 {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// newCursor positions a cursor at the resume token, or at the latest event when there is none
+func (s *watchService) newCursor(ctx context.Context, token string) (*cursor, error) {
+	oldest, latest, err := s.repo.SequenceRange(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if token == "" {
+		return &cursor{high: latest, gaps: make(map[int64]time.Time)}, nil
+	}
+
+	after, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || after < 0 {
+		return nil, fmt.Errorf("%w: malformed resume token", ErrInvalidInput)
+	}
+	if oldest > 0 && after < oldest-1 {
+		return nil, ErrResumeTokenExpired
+	}
+	return &cursor{high: after, gaps: make(map[int64]time.Time)}, nil
+}
+
+// maxGapSpan bounds how many missing sequences a single jump can open
+const maxGapSpan = 1000
+
+// cursor tracks how far a watcher has read. Sequences are allocated before
+// commit, so a lower sequence can become visible after a higher one; those
+// gaps are re-queried until they show up or time out, and the resume token
+// never moves past the oldest open gap.
+type cursor struct {
+	high int64
+	gaps map[int64]time.Time
+}
+
+// advance records that a sequence was read
+func (c *cursor) advance(seq int64) {
+	if seq <= c.high {
+		delete(c.gaps, seq)
+		return
+	}
+	now := time.Now()
+	from := c.high + 1
+	if seq-from > maxGapSpan {
+		// A jump this large is not in-flight transactions; don't track it
+		from = seq - maxGapSpan
+	}
+	for missing := from; missing < seq; missing++ {
+		c.gaps[missing] = now
+	}
+	c.high = seq
+}
+
+// missing returns the open gaps
+func (c *cursor) missing() []int64 {
+	seqs := make([]int64, 0, len(c.gaps))
+	for seq := range c.gaps {
+		seqs = append(seqs, seq)
+	}
+	return seqs
+}
+
+// expire gives up on gaps older than timeout
+func (c *cursor) expire(now time.Time, timeout time.Duration) {
+	for seq, seen := range c.gaps {
+		if now.Sub(seen) > timeout {
+			delete(c.gaps, seq)
+		}
+	}
+}
+
+// token returns the position everything up to which has been read
+func (c *cursor) token() string {
+	position := c.high
+	for seq := range c.gaps {
+		if seq-1 < position {
+			position = seq - 1
+		}
+	}
+	return strconv.FormatInt(position, 10)
+}
+
+// toWatchEvent decodes an outbox event and reports whether it matches the filter
+func toWatchEvent(e *model.OutboxEvent, filter WatchFilter) (WatchEvent, bool, error) {
+	var payload model.ModelEventPayload
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return WatchEvent{}, false, err
+	}
+	m := payload.Model
+	if m == nil || !matchesWatch(m, filter) {
+		return WatchEvent{}, false, nil
+	}
+
+	event := WatchEvent{
+		Type:       WatchModified,
+		EventType:  e.Type,
+		Model:      m,
+		OccurredAt: e.OccurredAt,
+	}
+	switch e.Type {
+	case model.EventModelCreated, model.EventModelRestored:
+		event.Type = WatchAdded
+	case model.EventModelDeleted:
+		event.Type = WatchDeleted
+	}
+	return event, true, nil
+}
+
+// matchesWatch checks a model against the watch filter
+func matchesWatch(m *model.Model, filter WatchFilter) bool {
+	if filter.TenantID != "" && m.TenantID != filter.TenantID {
+		return false
+	}
+	if len(filter.ModelIDs) > 0 && !containsString(filter.ModelIDs, m.ID) {
+		return false
+	}
+	if len(filter.Names) > 0 && !containsString(filter.Names, m.Name) {
+		return false
+	}
+	for _, tag := range filter.Tags {
+		found := false
+		for _, t := range m.Tags {
+			if t.Name == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// containsString reports whether s contains v
+func containsString(s []string, v string) bool {
+	for _, item := range s {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
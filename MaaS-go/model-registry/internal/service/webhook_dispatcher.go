@@ -0,0 +1,203 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+	"maas-platform/model-registry/pkg/webhook"
+)
+
+// WebhookDispatcherConfig holds webhook delivery settings
+type WebhookDispatcherConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// MaxAttempts is the number of attempts before a delivery is dead-lettered
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Timeout        time.Duration
+}
+
+// WebhookDispatcher sends queued webhook deliveries, retrying failures with
+// exponential backoff and dead-lettering them after MaxAttempts
+type WebhookDispatcher struct {
+	repo   repository.WebhookRepository
+	client *http.Client
+	cfg    WebhookDispatcherConfig
+	logger *logger.Logger
+}
+
+// NewWebhookDispatcher creates a new webhook dispatcher; deliveries only
+// connect to addresses the guard allows
+func NewWebhookDispatcher(repo repository.WebhookRepository, guard *webhook.Guard, cfg WebhookDispatcherConfig, logger *logger.Logger) *WebhookDispatcher {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 50
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 8
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = 10 * time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = time.Hour
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	// No proxy: the guard must see the address the request really goes to
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = guard.DialContext
+
+	return &WebhookDispatcher{
+		repo:   repo,
+		client: &http.Client{Timeout: cfg.Timeout, Transport: transport},
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// Run dispatches due deliveries until ctx is cancelled
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch sends one batch of due deliveries
+func (d *WebhookDispatcher) dispatch(ctx context.Context) {
+	// Lease claimed deliveries long enough to attempt each of them once
+	lease := time.Duration(d.cfg.BatchSize+1) * d.cfg.Timeout
+	deliveries, err := d.repo.ClaimDue(ctx, time.Now().UTC(), lease, d.cfg.BatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			d.logger.Error("Failed to claim webhook deliveries", "error", err)
+		}
+		return
+	}
+
+	subscriptions := make(map[string]*model.WebhookSubscription)
+	for _, delivery := range deliveries {
+		w, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			w, err = d.repo.GetSubscription(ctx, delivery.SubscriptionID)
+			if err != nil && !errors.Is(err, repository.ErrWebhookNotFound) {
+				d.logger.Error("Failed to load webhook", "webhook_id", delivery.SubscriptionID, "error", err)
+				continue
+			}
+			subscriptions[delivery.SubscriptionID] = w
+		}
+
+		d.attempt(ctx, w, delivery)
+	}
+}
+
+// attempt sends a delivery once and records the outcome
+func (d *WebhookDispatcher) attempt(ctx context.Context, w *model.WebhookSubscription, delivery *model.WebhookDelivery) {
+	now := time.Now().UTC()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+
+	var err error
+	switch {
+	case w == nil:
+		err = errors.New("webhook subscription was deleted")
+		delivery.Attempts = d.cfg.MaxAttempts
+	case !w.Active:
+		err = errors.New("webhook subscription is inactive")
+		delivery.Attempts = d.cfg.MaxAttempts
+	default:
+		delivery.ResponseCode, err = d.send(ctx, w, delivery)
+		if errors.Is(err, webhook.ErrBlockedAddress) {
+			delivery.Attempts = d.cfg.MaxAttempts
+		}
+	}
+
+	switch {
+	case err == nil:
+		delivery.Status = model.DeliverySucceeded
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+	case delivery.Attempts >= d.cfg.MaxAttempts:
+		delivery.Status = model.DeliveryDeadLetter
+		delivery.LastError = err.Error()
+		d.logger.Warn("Webhook delivery dead-lettered",
+			"delivery_id", delivery.ID,
+			"webhook_id", delivery.SubscriptionID,
+			"attempts", delivery.Attempts,
+			"error", err,
+		)
+	default:
+		delivery.Status = model.DeliveryRetrying
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = now.Add(d.backoff(delivery.Attempts))
+	}
+
+	if err := d.repo.UpdateDelivery(ctx, delivery); err != nil {
+		d.logger.Error("Failed to record webhook delivery", "delivery_id", delivery.ID, "error", err)
+	}
+}
+
+// send POSTs the signed payload and returns the response status
+func (d *WebhookDispatcher) send(ctx context.Context, w *model.WebhookSubscription, delivery *model.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "MaaS-Webhooks/1.0")
+	req.Header.Set(webhook.HeaderEvent, string(delivery.EventType))
+	req.Header.Set(webhook.HeaderDelivery, delivery.ID)
+	req.Header.Set(webhook.HeaderTimestamp, fmt.Sprintf("%d", timestamp.Unix()))
+	req.Header.Set(webhook.HeaderSignature, webhook.SignatureHeader(w.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("endpoint returned %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay before the next attempt with up to 20% jitter
+func (d *WebhookDispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.InitialBackoff
+	for i := 1; i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.cfg.MaxBackoff {
+		delay = d.cfg.MaxBackoff
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/5 + 1))
+	return delay + jitter
+}
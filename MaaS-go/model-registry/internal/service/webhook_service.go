@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"maas-platform/model-registry/internal/auth"
+	"maas-platform/model-registry/internal/model"
+	"maas-platform/model-registry/internal/repository"
+	"maas-platform/model-registry/pkg/logger"
+	"maas-platform/model-registry/pkg/webhook"
+)
+
+// Webhook errors
+var (
+	ErrWebhookNotFound  = repository.ErrWebhookNotFound
+	ErrDeliveryNotFound = repository.ErrDeliveryNotFound
+)
+
+// WebhookService manages webhook subscriptions and queues deliveries of model events
+type WebhookService interface {
+	// CreateWebhook registers a subscription and returns it with its signing secret
+	CreateWebhook(ctx context.Context, req CreateWebhookRequest) (*model.WebhookSubscription, string, error)
+	GetWebhook(ctx context.Context, id string) (*model.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context, tenantID string, page, limit int) (*ListWebhooksResponse, error)
+	// UpdateWebhook changes a subscription; the returned secret is set only when it was rotated
+	UpdateWebhook(ctx context.Context, id string, req UpdateWebhookRequest) (*model.WebhookSubscription, string, error)
+	DeleteWebhook(ctx context.Context, id string) error
+
+	ListDeliveries(ctx context.Context, query DeliveryQuery) (*ListDeliveriesResponse, error)
+	// Redeliver queues a fresh copy of a past delivery
+	Redeliver(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error)
+
+	// Notify queues an event for every matching subscription of the model's tenant
+	Notify(ctx context.Context, eventType model.EventType, payload model.ModelEventPayload) error
+}
+
+// CreateWebhookRequest represents a request to register a webhook
+type CreateWebhookRequest struct {
+	TenantID    string
+	URL         string
+	EventTypes  []model.EventType
+	ModelID     string
+	Description string
+}
+
+// UpdateWebhookRequest represents a request to change a webhook
+type UpdateWebhookRequest struct {
+	URL          *string
+	EventTypes   []model.EventType
+	ModelID      *string
+	Description  *string
+	Active       *bool
+	RotateSecret bool
+}
+
+// ListWebhooksResponse represents a page of webhook subscriptions
+type ListWebhooksResponse struct {
+	Webhooks []*model.WebhookSubscription `json:"webhooks"`
+	Total    int64                        `json:"total"`
+	Page     int                          `json:"page"`
+	Limit    int                          `json:"limit"`
+}
+
+// DeliveryQuery selects webhook deliveries
+type DeliveryQuery struct {
+	SubscriptionID string
+	Status         model.DeliveryStatus
+	EventID        string
+	Page           int
+	Limit          int
+}
+
+// ListDeliveriesResponse represents a page of webhook deliveries
+type ListDeliveriesResponse struct {
+	Deliveries []*model.WebhookDelivery `json:"deliveries"`
+	Total      int64                    `json:"total"`
+	Page       int                      `json:"page"`
+	Limit      int                      `json:"limit"`
+}
+
+// WebhookEvent is the JSON body POSTed to subscribers
+type WebhookEvent struct {
+	ID         string                  `json:"id"`
+	Type       model.EventType         `json:"type"`
+	OccurredAt time.Time               `json:"occurred_at"`
+	ModelID    string                  `json:"model_id"`
+	TenantID   string                  `json:"tenant_id"`
+	Data       model.ModelEventPayload `json:"data"`
+}
+
+// webhookService implements WebhookService
+type webhookService struct {
+	repo   repository.WebhookRepository
+	guard  *webhook.Guard
+	logger *logger.Logger
+}
+
+// NewWebhookService creates a new webhook service; guard vets webhook URLs
+func NewWebhookService(repo repository.WebhookRepository, guard *webhook.Guard, logger *logger.Logger) WebhookService {
+	return &webhookService{
+		repo:   repo,
+		guard:  guard,
+		logger: logger,
+	}
+}
+
+// CreateWebhook registers a webhook subscription
+func (s *webhookService) CreateWebhook(ctx context.Context, req CreateWebhookRequest) (*model.WebhookSubscription, string, error) {
+	w := &model.WebhookSubscription{
+		TenantID:    req.TenantID,
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		ModelID:     req.ModelID,
+		Description: req.Description,
+		Active:      true,
+	}
+	if caller, ok := auth.FromContext(ctx); ok {
+		w.CreatedBy = caller.UserID
+		if w.TenantID == "" {
+			w.TenantID = caller.TenantID
+		}
+	}
+	if w.TenantID == "" {
+		return nil, "", fmt.Errorf("%w: tenant_id is required", ErrInvalidInput)
+	}
+	if err := authorizeTenant(ctx, w.TenantID); err != nil {
+		return nil, "", err
+	}
+	if err := s.validateWebhook(ctx, w); err != nil {
+		return nil, "", err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	w.Secret = secret
+
+	if err := s.repo.CreateSubscription(ctx, w); err != nil {
+		s.logger.Error("Failed to create webhook", "error", err)
+		return nil, "", err
+	}
+
+	s.logger.Info("Webhook created", "webhook_id", w.ID, "tenant_id", w.TenantID)
+	return w, secret, nil
+}
+
+// GetWebhook retrieves a webhook subscription
+func (s *webhookService) GetWebhook(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	w, err := s.repo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeTenant(ctx, w.TenantID); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// ListWebhooks lists webhook subscriptions; non-admin callers only see their tenant
+func (s *webhookService) ListWebhooks(ctx context.Context, tenantID string, page, limit int) (*ListWebhooksResponse, error) {
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		tenantID = caller.TenantID
+	}
+
+	pagination := repository.Pagination{Page: page, Limit: limit}
+	webhooks, total, err := s.repo.ListSubscriptions(ctx, tenantID, pagination)
+	if err != nil {
+		s.logger.Error("Failed to list webhooks", "error", err)
+		return nil, err
+	}
+
+	return &ListWebhooksResponse{
+		Webhooks: webhooks,
+		Total:    total,
+		Page:     page,
+		Limit:    limit,
+	}, nil
+}
+
+// UpdateWebhook changes a webhook subscription
+func (s *webhookService) UpdateWebhook(ctx context.Context, id string, req UpdateWebhookRequest) (*model.WebhookSubscription, string, error) {
+	w, err := s.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if req.URL != nil {
+		w.URL = *req.URL
+	}
+	if req.EventTypes != nil {
+		w.EventTypes = req.EventTypes
+	}
+	if req.ModelID != nil {
+		w.ModelID = *req.ModelID
+	}
+	if req.Description != nil {
+		w.Description = *req.Description
+	}
+	if req.Active != nil {
+		w.Active = *req.Active
+	}
+	if err := s.validateWebhook(ctx, w); err != nil {
+		return nil, "", err
+	}
+
+	secret := ""
+	if req.RotateSecret {
+		if secret, err = generateWebhookSecret(); err != nil {
+			return nil, "", err
+		}
+		w.Secret = secret
+	}
+
+	if err := s.repo.UpdateSubscription(ctx, w); err != nil {
+		s.logger.Error("Failed to update webhook", "webhook_id", id, "error", err)
+		return nil, "", err
+	}
+
+	s.logger.Info("Webhook updated", "webhook_id", id, "secret_rotated", req.RotateSecret)
+	return w, secret, nil
+}
+
+// DeleteWebhook removes a webhook subscription
+func (s *webhookService) DeleteWebhook(ctx context.Context, id string) error {
+	if _, err := s.GetWebhook(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteSubscription(ctx, id); err != nil {
+		s.logger.Error("Failed to delete webhook", "webhook_id", id, "error", err)
+		return err
+	}
+	s.logger.Info("Webhook deleted", "webhook_id", id)
+	return nil
+}
+
+// ListDeliveries returns the delivery history; non-admin callers only see their tenant
+func (s *webhookService) ListDeliveries(ctx context.Context, query DeliveryQuery) (*ListDeliveriesResponse, error) {
+	filter := repository.DeliveryFilter{
+		SubscriptionID: query.SubscriptionID,
+		Status:         query.Status,
+		EventID:        query.EventID,
+	}
+	if caller, ok := auth.FromContext(ctx); ok && !caller.IsAdmin() {
+		filter.TenantID = caller.TenantID
+	}
+
+	pagination := repository.Pagination{Page: query.Page, Limit: query.Limit}
+	deliveries, total, err := s.repo.ListDeliveries(ctx, filter, pagination)
+	if err != nil {
+		s.logger.Error("Failed to list webhook deliveries", "error", err)
+		return nil, err
+	}
+
+	return &ListDeliveriesResponse{
+		Deliveries: deliveries,
+		Total:      total,
+		Page:       query.Page,
+		Limit:      query.Limit,
+	}, nil
+}
+
+// Redeliver queues a copy of a delivery that is sent on the next dispatch
+func (s *webhookService) Redeliver(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	original, err := s.repo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeTenant(ctx, original.TenantID); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetSubscription(ctx, original.SubscriptionID); err != nil {
+		return nil, err
+	}
+
+	d := &model.WebhookDelivery{
+		SubscriptionID: original.SubscriptionID,
+		TenantID:       original.TenantID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		ModelID:        original.ModelID,
+		Payload:        original.Payload,
+		Status:         model.DeliveryPending,
+		NextAttemptAt:  time.Now().UTC(),
+		RedeliveryOf:   original.ID,
+	}
+	if err := s.repo.CreateDeliveries(ctx, []*model.WebhookDelivery{d}); err != nil {
+		s.logger.Error("Failed to queue redelivery", "delivery_id", deliveryID, "error", err)
+		return nil, err
+	}
+
+	s.logger.Info("Webhook redelivery queued", "delivery_id", d.ID, "redelivery_of", original.ID)
+	return d, nil
+}
+
+// Notify queues deliveries of an event to the matching subscriptions
+func (s *webhookService) Notify(ctx context.Context, eventType model.EventType, payload model.ModelEventPayload) error {
+	m := payload.Model
+	if m == nil {
+		return errors.New("webhook event without model")
+	}
+
+	subscriptions, err := s.repo.ActiveSubscriptions(ctx, m.TenantID)
+	if err != nil {
+		return err
+	}
+
+	event := WebhookEvent{
+		ID:         uuid.New().String(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		ModelID:    m.ID,
+		TenantID:   m.TenantID,
+		Data:       payload,
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []*model.WebhookDelivery
+	for _, w := range subscriptions {
+		if !w.Matches(eventType, m.ID) {
+			continue
+		}
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			SubscriptionID: w.ID,
+			TenantID:       w.TenantID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			ModelID:        m.ID,
+			Payload:        body,
+			Status:         model.DeliveryPending,
+			NextAttemptAt:  event.OccurredAt,
+		})
+	}
+
+	return s.repo.CreateDeliveries(ctx, deliveries)
+}
+
+// authorizeTenant allows admins and callers of the same tenant; calls
+// without a caller come from trusted services
+func authorizeTenant(ctx context.Context, tenantID string) error {
+	caller, ok := auth.FromContext(ctx)
+	if !ok || caller.IsAdmin() || caller.TenantID == tenantID {
+		return nil
+	}
+	return ErrForbidden
+}
+
+// validateWebhook checks the target URL and event types
+func (s *webhookService) validateWebhook(ctx context.Context, w *model.WebhookSubscription) error {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidInput)
+	}
+	if err := s.guard.CheckURL(ctx, w.URL); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	for _, t := range w.EventTypes {
+		if !isValidEventType(t) {
+			return fmt.Errorf("%w: unknown event type %q", ErrInvalidInput, t)
+		}
+	}
+	return nil
+}
+
+// isValidEventType checks if an event type can be subscribed to
+func isValidEventType(t model.EventType) bool {
+	switch t {
+	case model.EventModelCreated,
+		model.EventModelUpdated,
+		model.EventModelStatusChanged,
+		model.EventModelDeleted,
+		model.EventModelRestored,
+		model.EventVersionPromoted:
+		return true
+	}
+	return false
+}
+
+// generateWebhookSecret returns a random signing secret
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
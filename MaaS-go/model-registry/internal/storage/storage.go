@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// BlobStore stores model artifacts
+type BlobStore interface {
+	// Delete removes the artifact at path; missing artifacts are not an error
+	Delete(ctx context.Context, path string) error
+	// FS exposes the artifacts for reading, named by storage path without the leading slash
+	FS() fs.FS
+}
+
+// LocalBlobStore implements BlobStore on the local filesystem
+type LocalBlobStore struct {
+	root string
+}
+
+// NewLocalBlobStore creates a blob store rooted at the given directory
+func NewLocalBlobStore(root string) *LocalBlobStore {
+	return &LocalBlobStore{root: root}
+}
+
+// Delete removes the file or directory at path
+func (s *LocalBlobStore) Delete(ctx context.Context, path string) error {
+	if path == "" {
+		return nil
+	}
+
+	full, err := s.resolve(path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(full); err != nil {
+		return fmt.Errorf("failed to delete artifact %s: %w", path, err)
+	}
+	return nil
+}
+
+// FS returns the storage root as a read-only file system
+func (s *LocalBlobStore) FS() fs.FS {
+	return os.DirFS(s.root)
+}
+
+// resolve maps a storage path to a filesystem path inside the root
+func (s *LocalBlobStore) resolve(path string) (string, error) {
+	root, err := filepath.Abs(s.root)
+	if err != nil {
+		return "", err
+	}
+
+	full := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(path, "/")))
+	if full == root || !strings.HasPrefix(full, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("artifact path %q escapes storage root", path)
+	}
+	return full, nil
+}
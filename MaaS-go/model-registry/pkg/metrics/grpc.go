@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// GRPCServerStarted tracks gRPC calls started on the server
+	GRPCServerStarted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_started_total",
+			Help: "Total number of gRPC calls started on the server",
+		},
+		[]string{"service", "method"},
+	)
+
+	// GRPCServerHandled tracks completed gRPC calls by status code
+	GRPCServerHandled = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Total number of gRPC calls completed on the server",
+		},
+		[]string{"service", "method", "code"},
+	)
+
+	// GRPCServerHandlingSeconds tracks gRPC call duration; streams are measured until they end
+	GRPCServerHandlingSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "gRPC call duration in seconds on the server",
+			Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		},
+		[]string{"service", "method"},
+	)
+)
+
+// UnaryServerInterceptor records the rate, errors and duration of unary calls
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, method := splitMethod(info.FullMethod)
+		GRPCServerStarted.WithLabelValues(service, method).Inc()
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+		recordServerCall(service, method, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the rate, errors and duration of streams
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, method := splitMethod(info.FullMethod)
+		GRPCServerStarted.WithLabelValues(service, method).Inc()
+		start := time.Now()
+
+		err := handler(srv, ss)
+		recordServerCall(service, method, start, err)
+		return err
+	}
+}
+
+// recordServerCall counts a finished call by its status code
+func recordServerCall(service, method string, start time.Time, err error) {
+	GRPCServerHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	GRPCServerHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// splitMethod splits /package.Service/Method into service and method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
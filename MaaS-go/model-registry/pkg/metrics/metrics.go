@@ -0,0 +1,175 @@
+// Package metrics exposes the model registry's Prometheus metrics: HTTP and
+// gRPC request rates, errors and durations, database pool statistics and
+// business gauges refreshed from the database
+package metrics
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	// HTTPRequestDuration tracks HTTP request duration
+	HTTPRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request duration in seconds",
+			Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		},
+		[]string{"method", "path", "status"},
+	)
+
+	// HTTPRequestTotal tracks total HTTP requests
+	HTTPRequestTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of HTTP requests",
+		},
+		[]string{"method", "path", "status"},
+	)
+
+	// ModelsTotal tracks live models by status and framework
+	ModelsTotal = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "registry_models",
+			Help: "Number of models by status and framework, excluding the trash",
+		},
+		[]string{"status", "framework"},
+	)
+
+	// QueueDepth tracks work waiting in the outbox and the webhook delivery queue
+	QueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "queue_depth",
+			Help: "Number of items waiting in a queue",
+		},
+		[]string{"queue"},
+	)
+
+	// WebhookDeadLetters tracks webhook deliveries that gave up
+	WebhookDeadLetters = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "webhook_dead_letters",
+			Help: "Number of webhook deliveries in the dead-letter queue",
+		},
+	)
+
+	// UsageRecordsReceived tracks metering records reported by the gateway by model and status
+	UsageRecordsReceived = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "usage_records_received_total",
+			Help: "Total number of metering records received by model and status",
+		},
+		[]string{"model", "status"},
+	)
+
+	// StatsRefreshErrors tracks failed refreshes of the business gauges
+	StatsRefreshErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "registry_stats_refresh_errors_total",
+			Help: "Total number of failed refreshes of the business gauges",
+		},
+	)
+
+	// ServiceInfo provides service information
+	ServiceInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "service_info",
+			Help: "Service information",
+		},
+		[]string{"version", "environment"},
+	)
+)
+
+func init() {
+	// Register all metrics
+	prometheus.MustRegister(HTTPRequestDuration)
+	prometheus.MustRegister(HTTPRequestTotal)
+	prometheus.MustRegister(GRPCServerStarted)
+	prometheus.MustRegister(GRPCServerHandled)
+	prometheus.MustRegister(GRPCServerHandlingSeconds)
+	prometheus.MustRegister(ModelsTotal)
+	prometheus.MustRegister(QueueDepth)
+	prometheus.MustRegister(WebhookDeadLetters)
+	prometheus.MustRegister(UsageRecordsReceived)
+	prometheus.MustRegister(StatsRefreshErrors)
+	prometheus.MustRegister(ServiceInfo)
+}
+
+// PrometheusMiddleware returns a Gin middleware that collects Prometheus metrics
+func PrometheusMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.URL.Path == "/metrics" {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		path := c.FullPath()
+		if path == "" {
+			path = "unknown"
+		}
+
+		c.Next()
+
+		status := strconv.Itoa(c.Writer.Status())
+		HTTPRequestDuration.WithLabelValues(c.Request.Method, path, status).Observe(time.Since(start).Seconds())
+		HTTPRequestTotal.WithLabelValues(c.Request.Method, path, status).Inc()
+	}
+}
+
+// Handler returns HTTP handler for Prometheus metrics
+func Handler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.Handler())
+}
+
+// RegisterDBStats exposes the connection pool statistics of a database as go_sql_* metrics
+func RegisterDBStats(db *sql.DB, name string) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// SetServiceInfo sets service_info metric
+func SetServiceInfo(version, environment string) {
+	ServiceInfo.WithLabelValues(version, environment).Set(1)
+}
+
+// ModelCount is the number of models with one status and framework
+type ModelCount struct {
+	Status    string
+	Framework string
+	Count     int64
+}
+
+// SetModelCounts replaces the models gauge, dropping combinations that no longer exist
+func SetModelCounts(counts []ModelCount) {
+	ModelsTotal.Reset()
+	for _, c := range counts {
+		ModelsTotal.WithLabelValues(c.Status, c.Framework).Set(float64(c.Count))
+	}
+}
+
+// SetQueueDepth sets the depth of a queue
+func SetQueueDepth(queue string, depth int64) {
+	QueueDepth.WithLabelValues(queue).Set(float64(depth))
+}
+
+// SetWebhookDeadLetters sets the size of the webhook dead-letter queue
+func SetWebhookDeadLetters(n int64) {
+	WebhookDeadLetters.Set(float64(n))
+}
+
+// RecordUsageReceived counts metering records received for a model
+func RecordUsageReceived(model, status string, n int) {
+	UsageRecordsReceived.WithLabelValues(model, status).Add(float64(n))
+}
+
+// RecordStatsRefreshError counts a failed refresh of the business gauges
+func RecordStatsRefreshError() {
+	StatsRefreshErrors.Inc()
+}
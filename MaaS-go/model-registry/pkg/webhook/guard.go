@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"time"
+)
+
+// ErrBlockedAddress is returned for webhook targets in internal address ranges
+var ErrBlockedAddress = errors.New("webhook target resolves to a blocked address")
+
+// blockedPrefixes are ranges not covered by the netip predicates that still
+// reach infrastructure rather than the internet
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+}
+
+// Guard keeps tenants from pointing webhooks at the registry's own network:
+// loopback, private, link-local (e.g. cloud metadata at 169.254.169.254) and
+// other non-public addresses are refused unless an operator allowlists them.
+type Guard struct {
+	allowed  []netip.Prefix
+	resolver *net.Resolver
+	dialer   *net.Dialer
+}
+
+// NewGuard creates a guard that additionally permits the given CIDR ranges
+func NewGuard(allowedCIDRs []string) (*Guard, error) {
+	g := &Guard{
+		resolver: net.DefaultResolver,
+		dialer:   &net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second},
+	}
+	for _, cidr := range allowedCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed webhook CIDR %q: %w", cidr, err)
+		}
+		g.allowed = append(g.allowed, prefix.Masked())
+	}
+	return g, nil
+}
+
+// CheckURL resolves the host of a webhook URL and fails if any of its
+// addresses is blocked
+func (g *Guard) CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	_, err = g.resolve(ctx, u.Hostname())
+	return err
+}
+
+// DialContext dials only addresses that pass the guard. The check runs on the
+// addresses actually dialed, so redirects and DNS changes after registration
+// cannot reach a blocked address.
+func (g *Guard) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := g.resolve(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, addr := range addrs {
+		conn, err := g.dialer.DialContext(ctx, network, net.JoinHostPort(addr.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// resolve looks up a host and returns its addresses if none of them is blocked
+func (g *Guard) resolve(ctx context.Context, host string) ([]netip.Addr, error) {
+	var addrs []netip.Addr
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{addr}
+	} else {
+		ips, err := g.resolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return nil, fmt.Errorf("resolve webhook host %s: %w", host, err)
+		}
+		addrs = ips
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("resolve webhook host %s: no addresses", host)
+	}
+
+	for i, addr := range addrs {
+		addr = addr.Unmap()
+		if g.blocked(addr) {
+			return nil, fmt.Errorf("%w: %s is %s", ErrBlockedAddress, host, addr)
+		}
+		addrs[i] = addr
+	}
+	return addrs, nil
+}
+
+// blocked reports whether an address is internal and not allowlisted
+func (g *Guard) blocked(addr netip.Addr) bool {
+	for _, prefix := range g.allowed {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return true
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
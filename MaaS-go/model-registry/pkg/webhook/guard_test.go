@@ -0,0 +1,107 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"maas-platform/model-registry/pkg/webhook"
+)
+
+func TestGuardCheckURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		url     string
+		blocked bool
+	}{
+		{"public v4", nil, "https://93.184.216.34/hook", false},
+		{"public v6", nil, "https://[2606:2800:220:1::1]/hook", false},
+		{"loopback", nil, "http://127.0.0.1:8080/hook", true},
+		{"loopback name", nil, "http://localhost/hook", true},
+		{"loopback v6", nil, "http://[::1]/hook", true},
+		{"private", nil, "http://10.1.2.3/hook", true},
+		{"private 192.168", nil, "http://192.168.0.10/hook", true},
+		{"cloud metadata", nil, "http://169.254.169.254/latest/meta-data", true},
+		{"unspecified", nil, "http://0.0.0.0/hook", true},
+		{"carrier-grade nat", nil, "http://100.64.0.1/hook", true},
+		{"benchmarking", nil, "http://198.18.0.1/hook", true},
+		{"v4-mapped loopback", nil, "http://[::ffff:127.0.0.1]/hook", true},
+		{"unique local v6", nil, "http://[fd00::1]/hook", true},
+		{"multicast", nil, "http://224.0.0.1/hook", true},
+		{"allowlisted range", []string{"10.0.0.0/8"}, "http://10.1.2.3/hook", false},
+		{"outside the allowlist", []string{"10.0.0.0/8"}, "http://192.168.0.10/hook", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard, err := webhook.NewGuard(tt.allowed)
+			if err != nil {
+				t.Fatalf("NewGuard: %v", err)
+			}
+			err = guard.CheckURL(context.Background(), tt.url)
+			if got := errors.Is(err, webhook.ErrBlockedAddress); got != tt.blocked {
+				t.Errorf("CheckURL(%s) = %v, blocked %v; want blocked %v", tt.url, err, got, tt.blocked)
+			}
+			if !tt.blocked && err != nil {
+				t.Errorf("CheckURL(%s): %v", tt.url, err)
+			}
+		})
+	}
+}
+
+func TestNewGuardRejectsInvalidCIDR(t *testing.T) {
+	if _, err := webhook.NewGuard([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("NewGuard accepted an invalid CIDR")
+	}
+}
+
+func TestGuardDialContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name    string
+		allowed []string
+		blocked bool
+	}{
+		{"loopback is refused", nil, true},
+		{"allowlisted loopback is dialed", []string{"127.0.0.0/8"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard, err := webhook.NewGuard(tt.allowed)
+			if err != nil {
+				t.Fatalf("NewGuard: %v", err)
+			}
+			client := &http.Client{Transport: &http.Transport{DialContext: guard.DialContext}}
+			resp, err := client.Post(srv.URL, "application/json", nil)
+			if tt.blocked {
+				if !errors.Is(err, webhook.ErrBlockedAddress) {
+					t.Errorf("POST = %v, want %v", err, webhook.ErrBlockedAddress)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("POST: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusNoContent {
+				t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+			}
+		})
+	}
+
+	// Redirects and DNS changes end in a dial, which checks the address again
+	guard, err := webhook.NewGuard(nil)
+	if err != nil {
+		t.Fatalf("NewGuard: %v", err)
+	}
+	if _, err := guard.DialContext(context.Background(), "tcp", net.JoinHostPort("169.254.169.254", "80")); !errors.Is(err, webhook.ErrBlockedAddress) {
+		t.Errorf("dial metadata address = %v, want %v", err, webhook.ErrBlockedAddress)
+	}
+}
@@ -0,0 +1,48 @@
+package webhook_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"maas-platform/model-registry/pkg/webhook"
+)
+
+func TestVerify(t *testing.T) {
+	const secret = "whsec_test"
+	body := []byte(`{"event":"model.created"}`)
+	now := time.Now()
+	valid := webhook.SignatureHeader(secret, now, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		header    string
+		body      []byte
+		tolerance time.Duration
+		want      error
+	}{
+		{"valid", secret, valid, body, 0, nil},
+		{"spaces and unknown keys", secret, fmt.Sprintf("t=%d, v0=old, v1=%s", now.Unix(), webhook.Sign(secret, now, body)), body, 0, nil},
+		{"one of several signatures", secret, fmt.Sprintf("t=%d,v1=deadbeef,v1=%s", now.Unix(), webhook.Sign(secret, now, body)), body, 0, nil},
+		{"wrong secret", "other", valid, body, 0, webhook.ErrSignatureMismatch},
+		{"tampered body", secret, valid, []byte(`{"event":"model.deleted"}`), 0, webhook.ErrSignatureMismatch},
+		{"timestamp moved", secret, fmt.Sprintf("t=%d,v1=%s", now.Unix()+1, webhook.Sign(secret, now, body)), body, 0, webhook.ErrSignatureMismatch},
+		{"too old", secret, webhook.SignatureHeader(secret, now.Add(-10*time.Minute), body), body, 0, webhook.ErrTimestampExpired},
+		{"too far ahead", secret, webhook.SignatureHeader(secret, now.Add(10*time.Minute), body), body, 0, webhook.ErrTimestampExpired},
+		{"within a wider tolerance", secret, webhook.SignatureHeader(secret, now.Add(-10*time.Minute), body), body, time.Hour, nil},
+		{"no timestamp", secret, "v1=" + webhook.Sign(secret, now, body), body, 0, webhook.ErrInvalidHeader},
+		{"no signature", secret, fmt.Sprintf("t=%d", now.Unix()), body, 0, webhook.ErrInvalidHeader},
+		{"bad timestamp", secret, "t=yesterday,v1=abc", body, 0, webhook.ErrInvalidHeader},
+		{"not key value", secret, "garbage", body, 0, webhook.ErrInvalidHeader},
+		{"empty", secret, "", body, 0, webhook.ErrInvalidHeader},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := webhook.Verify(tt.secret, tt.header, tt.body, tt.tolerance); !errors.Is(err, tt.want) {
+				t.Errorf("Verify = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
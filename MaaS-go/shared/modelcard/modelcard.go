@@ -0,0 +1,227 @@
+// Package modelcard holds the structured documentation of a model version:
+// what it is for, where it falls short, what it was trained and evaluated on
+// and who answers for it. The registry stores cards and the gateway renders
+// them for governance reviews.
+package modelcard
+
+import (
+	"bytes"
+	"database/sql/driver"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	modelpb "maas-platform/shared/proto"
+)
+
+// Schema is the JSON Schema every card is validated against
+//
+//go:embed schema.json
+var Schema []byte
+
+// ErrInvalid is returned for cards that do not match Schema
+var ErrInvalid = errors.New("invalid model card")
+
+// maxReported caps how many schema violations an error lists
+const maxReported = 5
+
+var schema = func() *jsonschema.Schema {
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat = true
+	if err := compiler.AddResource("model-card.json", bytes.NewReader(Schema)); err != nil {
+		panic(err)
+	}
+	return compiler.MustCompile("model-card.json")
+}()
+
+// Card documents a model version
+type Card struct {
+	Summary               string          `json:"summary,omitempty"`
+	IntendedUse           string          `json:"intended_use"`
+	OutOfScopeUses        []string        `json:"out_of_scope_uses,omitempty"`
+	Limitations           []string        `json:"limitations,omitempty"`
+	TrainingData          []DataReference `json:"training_data,omitempty"`
+	Metrics               []Metric        `json:"metrics,omitempty"`
+	EthicalConsiderations string          `json:"ethical_considerations,omitempty"`
+	License               string          `json:"license"`
+	Contact               string          `json:"contact"`
+
+	// UpdatedBy and UpdatedAt are set by the registry on every change
+	UpdatedBy string     `json:"updated_by,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// DataReference points at a dataset
+type DataReference struct {
+	Name        string `json:"name"`
+	URI         string `json:"uri,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Metric is an evaluation result
+type Metric struct {
+	Name        string  `json:"name"`
+	Value       float64 `json:"value"`
+	Dataset     string  `json:"dataset,omitempty"`
+	Description string  `json:"description,omitempty"`
+}
+
+// Parse validates a card document against Schema and decodes it, so that
+// misspelled fields are reported rather than silently dropped
+func Parse(data []byte) (*Card, error) {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if err := validate(doc); err != nil {
+		return nil, err
+	}
+	var c Card
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return &c, nil
+}
+
+// Validate checks the card against Schema
+func (c *Card) Validate() error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return validate(doc)
+}
+
+// validate reports the innermost schema violations of a decoded document
+func validate(doc interface{}) error {
+	err := schema.Validate(doc)
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		return err
+	}
+
+	var problems []string
+	var walk func(e *jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) > 0 {
+			for _, cause := range e.Causes {
+				walk(cause)
+			}
+			return
+		}
+		field := fieldPath(e.InstanceLocation)
+		if field == "" {
+			problems = append(problems, e.Message)
+		} else {
+			problems = append(problems, field+": "+e.Message)
+		}
+	}
+	walk(verr)
+
+	if len(problems) > maxReported {
+		problems = append(problems[:maxReported], fmt.Sprintf("and %d more", len(problems)-maxReported))
+	}
+	return fmt.Errorf("%w: %s", ErrInvalid, strings.Join(problems, "; "))
+}
+
+// fieldPath turns /metrics/0/name into metrics[0].name
+func fieldPath(pointer string) string {
+	var b strings.Builder
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		if _, err := strconv.Atoi(token); err == nil {
+			b.WriteString("[" + token + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(token)
+	}
+	return b.String()
+}
+
+// Value stores a card as JSON
+func (c Card) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan reads a card stored as JSON
+func (c *Card) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("cannot scan %T into a model card", src)
+	}
+}
+
+// FromProto converts a protobuf card; nil stays nil
+func FromProto(pb *modelpb.ModelCard) *Card {
+	if pb == nil {
+		return nil
+	}
+	c := &Card{
+		Summary:               pb.Summary,
+		IntendedUse:           pb.IntendedUse,
+		OutOfScopeUses:        pb.OutOfScopeUses,
+		Limitations:           pb.Limitations,
+		EthicalConsiderations: pb.EthicalConsiderations,
+		License:               pb.License,
+		Contact:               pb.Contact,
+		UpdatedBy:             pb.UpdatedBy,
+	}
+	for _, d := range pb.TrainingData {
+		c.TrainingData = append(c.TrainingData, DataReference{Name: d.Name, URI: d.Uri, Description: d.Description})
+	}
+	for _, m := range pb.Metrics {
+		c.Metrics = append(c.Metrics, Metric{Name: m.Name, Value: m.Value, Dataset: m.Dataset, Description: m.Description})
+	}
+	if pb.UpdatedAt != nil {
+		updatedAt := pb.UpdatedAt.AsTime()
+		c.UpdatedAt = &updatedAt
+	}
+	return c
+}
+
+// ToProto converts a card to protobuf; nil stays nil
+func ToProto(c *Card) *modelpb.ModelCard {
+	if c == nil {
+		return nil
+	}
+	pb := &modelpb.ModelCard{
+		Summary:               c.Summary,
+		IntendedUse:           c.IntendedUse,
+		OutOfScopeUses:        c.OutOfScopeUses,
+		Limitations:           c.Limitations,
+		EthicalConsiderations: c.EthicalConsiderations,
+		License:               c.License,
+		Contact:               c.Contact,
+		UpdatedBy:             c.UpdatedBy,
+	}
+	for _, d := range c.TrainingData {
+		pb.TrainingData = append(pb.TrainingData, &modelpb.DataReference{Name: d.Name, Uri: d.URI, Description: d.Description})
+	}
+	for _, m := range c.Metrics {
+		pb.Metrics = append(pb.Metrics, &modelpb.CardMetric{Name: m.Name, Value: m.Value, Dataset: m.Dataset, Description: m.Description})
+	}
+	if c.UpdatedAt != nil {
+		pb.UpdatedAt = timestamppb.New(*c.UpdatedAt)
+	}
+	return pb
+}
@@ -0,0 +1,188 @@
+package modelcard
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Markdown renders the card as a Markdown document titled with the model and
+// version. Card text may use Markdown but not HTML, which is escaped.
+func (c *Card) Markdown(model, version string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Model card: %s %s\n", inline(model), inline(version))
+	if c.Summary != "" {
+		fmt.Fprintf(&b, "\n%s\n", inline(c.Summary))
+	}
+
+	section(&b, "Intended use", c.IntendedUse)
+	list(&b, "Out-of-scope uses", c.OutOfScopeUses)
+	list(&b, "Limitations", c.Limitations)
+
+	if len(c.TrainingData) > 0 {
+		b.WriteString("\n## Training data\n\n")
+		for _, d := range c.TrainingData {
+			item := inline(d.Name)
+			switch {
+			case webURL(d.URI):
+				item = fmt.Sprintf("[%s](%s)", linkText(d.Name), linkTarget(d.URI))
+			case d.URI != "":
+				item += " (" + inline(d.URI) + ")"
+			}
+			if d.Description != "" {
+				item += ": " + inline(d.Description)
+			}
+			b.WriteString("- " + listItem(item) + "\n")
+		}
+	}
+
+	if len(c.Metrics) > 0 {
+		b.WriteString("\n## Evaluation metrics\n\n| Metric | Value | Dataset | Notes |\n| --- | ---: | --- | --- |\n")
+		for _, m := range c.Metrics {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", cell(m.Name), formatValue(m.Value), cell(m.Dataset), cell(m.Description))
+		}
+	}
+
+	section(&b, "Ethical considerations", c.EthicalConsiderations)
+	section(&b, "License", c.License)
+	section(&b, "Contact", c.Contact)
+
+	if c.UpdatedAt != nil {
+		fmt.Fprintf(&b, "\n---\n\n_Last updated %s", c.UpdatedAt.UTC().Format("2006-01-02 15:04 MST"))
+		if c.UpdatedBy != "" {
+			fmt.Fprintf(&b, " by %s", inline(c.UpdatedBy))
+		}
+		b.WriteString("_\n")
+	}
+	return b.String()
+}
+
+func section(b *strings.Builder, heading, text string) {
+	if text != "" {
+		fmt.Fprintf(b, "\n## %s\n\n%s\n", heading, inline(text))
+	}
+}
+
+func list(b *strings.Builder, heading string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n## %s\n\n", heading)
+	for _, item := range items {
+		b.WriteString("- " + listItem(inline(item)) + "\n")
+	}
+}
+
+// listItem indents continuation lines so they stay in their list item
+func listItem(s string) string {
+	return strings.ReplaceAll(s, "\n", "\n  ")
+}
+
+// cell keeps text on one line of a table row
+func cell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ").Replace(inline(s))
+}
+
+// inline escapes the angle brackets that would start raw HTML or an autolink
+func inline(s string) string {
+	return strings.ReplaceAll(s, "<", "&lt;")
+}
+
+// linkText escapes text so it stays inside the brackets of a link
+func linkText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`).Replace(inline(s))
+}
+
+// linkTarget encodes the characters that would end a link destination
+func linkTarget(uri string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E").Replace(uri)
+}
+
+// webURL reports whether a data reference is safe to link to; other URIs,
+// such as s3:// locations, are shown as text
+func webURL(uri string) bool {
+	u, err := url.Parse(uri)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var page = template.Must(template.New("card").Funcs(template.FuncMap{
+	"value":  formatValue,
+	"webURL": webURL,
+	"date":   func(c *Card) string { return c.UpdatedAt.UTC().Format("2006-01-02 15:04 MST") },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Model card: {{.Model}} {{.Version}}</title>
+<style>
+body { font-family: system-ui, sans-serif; max-width: 50rem; margin: 2rem auto; padding: 0 1rem; line-height: 1.5; color: #1f2328; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #d0d7de; padding: 0.3rem 0.7rem; text-align: left; }
+td.value { text-align: right; font-variant-numeric: tabular-nums; }
+p { white-space: pre-line; }
+footer { margin-top: 2rem; color: #656d76; font-size: 0.9rem; }
+</style>
+</head>
+<body>
+<h1>Model card: {{.Model}} {{.Version}}</h1>
+{{with .Card}}
+{{- if .Summary}}<p>{{.Summary}}</p>{{end}}
+<h2>Intended use</h2>
+<p>{{.IntendedUse}}</p>
+{{- if .OutOfScopeUses}}
+<h2>Out-of-scope uses</h2>
+<ul>{{range .OutOfScopeUses}}<li>{{.}}</li>{{end}}</ul>
+{{- end}}
+{{- if .Limitations}}
+<h2>Limitations</h2>
+<ul>{{range .Limitations}}<li>{{.}}</li>{{end}}</ul>
+{{- end}}
+{{- if .TrainingData}}
+<h2>Training data</h2>
+<ul>{{range .TrainingData}}<li>{{if webURL .URI}}<a href="{{.URI}}">{{.Name}}</a>{{else}}{{.Name}}{{if .URI}} ({{.URI}}){{end}}{{end}}{{if .Description}}: {{.Description}}{{end}}</li>{{end}}</ul>
+{{- end}}
+{{- if .Metrics}}
+<h2>Evaluation metrics</h2>
+<table>
+<tr><th>Metric</th><th>Value</th><th>Dataset</th><th>Notes</th></tr>
+{{- range .Metrics}}
+<tr><td>{{.Name}}</td><td class="value">{{value .Value}}</td><td>{{.Dataset}}</td><td>{{.Description}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .EthicalConsiderations}}
+<h2>Ethical considerations</h2>
+<p>{{.EthicalConsiderations}}</p>
+{{- end}}
+<h2>License</h2>
+<p>{{.License}}</p>
+<h2>Contact</h2>
+<p>{{.Contact}}</p>
+{{- if .UpdatedAt}}
+<footer>Last updated {{date .}}{{if .UpdatedBy}} by {{.UpdatedBy}}{{end}}</footer>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+// HTML renders the card as a standalone HTML page titled with the model and version
+func (c *Card) HTML(model, version string) (string, error) {
+	var buf bytes.Buffer
+	err := page.Execute(&buf, struct {
+		Model   string
+		Version string
+		Card    *Card
+	}{model, version, c})
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,130 @@
+package modelcard_test
+
+import (
+	"strings"
+	"testing"
+
+	"maas-platform/shared/modelcard"
+)
+
+const script = `<script>alert(1)</script>`
+
+func TestMarkdownEscaping(t *testing.T) {
+	tests := []struct {
+		name    string
+		card    modelcard.Card
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "html in text",
+			card:    modelcard.Card{Summary: script, IntendedUse: "x <img src=x onerror=alert(1)>", License: "MIT", Contact: "a@example.com"},
+			want:    []string{"&lt;script>alert(1)&lt;/script>", "x &lt;img src=x onerror=alert(1)>"},
+			notWant: []string{"<script>", "<img"},
+		},
+		{
+			name:    "html in list items",
+			card:    modelcard.Card{Limitations: []string{script}, OutOfScopeUses: []string{"<b>medical\nuse</b>"}},
+			want:    []string{"- &lt;script>", "- &lt;b>medical\n  use&lt;/b>"},
+			notWant: []string{"<script>", "<b>"},
+		},
+		{
+			name: "table cells stay in their column",
+			card: modelcard.Card{Metrics: []modelcard.Metric{{Name: "f1 | recall", Value: 0.5, Dataset: "<i>squad</i>", Description: "line\nbreak"}}},
+			want: []string{`| f1 \| recall | 0.5 | &lt;i>squad&lt;/i> | line break |`},
+		},
+		{
+			name: "web link",
+			card: modelcard.Card{TrainingData: []modelcard.DataReference{{Name: "web [crawl]", URI: "https://example.com/a b(1)"}}},
+			want: []string{`- [web \[crawl\]](https://example.com/a%20b%281%29)`},
+		},
+		{
+			name:    "other schemes are not linked",
+			card:    modelcard.Card{TrainingData: []modelcard.DataReference{{Name: "click", URI: "javascript://%0aalert(1)"}, {Name: "lake", URI: "s3://bucket/data"}}},
+			want:    []string{"- click (javascript://%0aalert(1))", "- lake (s3://bucket/data)"},
+			notWant: []string{"](javascript:", "](s3:"},
+		},
+		{
+			name:    "autolinks",
+			card:    modelcard.Card{Contact: "<javascript:alert(1)>"},
+			want:    []string{"&lt;javascript:alert(1)>"},
+			notWant: []string{"<javascript:"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.card.Markdown("bert", "1.0.0")
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("Markdown lacks %q:\n%s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("Markdown contains %q:\n%s", notWant, got)
+				}
+			}
+		})
+	}
+}
+
+func TestHTMLEscaping(t *testing.T) {
+	tests := []struct {
+		name    string
+		model   string
+		card    modelcard.Card
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "html in text",
+			model:   "bert",
+			card:    modelcard.Card{Summary: script, IntendedUse: `" onmouseover="alert(1)`, Limitations: []string{"<b>x</b>"}},
+			want:    []string{"&lt;script&gt;alert(1)&lt;/script&gt;", "&#34; onmouseover=&#34;alert(1)", "&lt;b&gt;x&lt;/b&gt;"},
+			notWant: []string{"<script>alert", "<b>x"},
+		},
+		{
+			name:    "html in the title",
+			model:   "</title>" + script,
+			want:    []string{"<title>Model card: &lt;/title&gt;&lt;script&gt;"},
+			notWant: []string{"<script>alert"},
+		},
+		{
+			name:  "web link",
+			model: "bert",
+			card:  modelcard.Card{TrainingData: []modelcard.DataReference{{Name: "crawl", URI: `https://example.com/?q="x"`}}},
+			want:  []string{`<a href="https://example.com/?q=%22x%22">crawl</a>`},
+		},
+		{
+			name:    "other schemes are not linked",
+			model:   "bert",
+			card:    modelcard.Card{TrainingData: []modelcard.DataReference{{Name: "click", URI: "javascript://%0aalert(1)"}, {Name: "lake", URI: "s3://bucket/data"}}},
+			want:    []string{"<li>click (javascript://%0aalert(1))</li>", "<li>lake (s3://bucket/data)</li>"},
+			notWant: []string{"href"},
+		},
+		{
+			name:  "metrics",
+			model: "bert",
+			card:  modelcard.Card{Metrics: []modelcard.Metric{{Name: "<i>f1</i>", Value: 0.25}}},
+			want:  []string{"<td>&lt;i&gt;f1&lt;/i&gt;</td><td class=\"value\">0.25</td>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.card.HTML(tt.model, "1.0.0")
+			if err != nil {
+				t.Fatalf("HTML: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("HTML lacks %q:\n%s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("HTML contains %q:\n%s", notWant, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Package policy maps roles and API key scopes to permissions, and decides
+// who may read or change a tenant-owned resource. The gateway and the model
+// registry both enforce it, so bypassing the gateway grants nothing.
+package policy
+
+// Permission is an action on a kind of resource
+type Permission string
+
+const (
+	ModelsRead      Permission = "models:read"
+	ModelsWrite     Permission = "models:write"
+	InferenceInvoke Permission = "inference:invoke"
+	AuditRead       Permission = "audit:read"
+	AuditWrite      Permission = "audit:write"
+	WebhooksManage  Permission = "webhooks:manage"
+	APIKeysManage   Permission = "apikeys:manage"
+	UsageRead       Permission = "usage:read"
+	BillingRead     Permission = "billing:read"
+	// BillingManage and ApprovalsManage are granted to no role besides admin
+	BillingManage   Permission = "billing:manage"
+	ApprovalsManage Permission = "approvals:manage"
+	// APIKeysVerify, UsersProvision, UsersAuthenticate and UsageRecord are
+	// granted to no role; only trusted services calling without a caller use them
+	APIKeysVerify     Permission = "apikeys:verify"
+	UsersProvision    Permission = "users:provision"
+	UsersAuthenticate Permission = "users:authenticate"
+	UsageRecord       Permission = "usage:record"
+)
+
+// Roles
+const (
+	RoleAdmin     = "admin"
+	RoleDeveloper = "developer"
+	RoleViewer    = "viewer"
+)
+
+// rolePermissions lists what each role may do; admins may do anything
+var rolePermissions = map[string][]Permission{
+	RoleDeveloper: {ModelsRead, ModelsWrite, InferenceInvoke, AuditRead, WebhooksManage, APIKeysManage, UsageRead, BillingRead},
+	RoleViewer:    {ModelsRead, InferenceInvoke, APIKeysManage},
+}
+
+// scopePermissions lists what an API key scope allows on top of the key's role
+var scopePermissions = map[string][]Permission{
+	"models:read":      {ModelsRead},
+	"models:write":     {ModelsWrite},
+	"inference:invoke": {InferenceInvoke},
+}
+
+// methodPermissions maps registry gRPC methods to the permission they require
+var methodPermissions = map[string]Permission{
+	"/model.ModelService/CreateModel":           ModelsWrite,
+	"/model.ModelService/GetModel":              ModelsRead,
+	"/model.ModelService/ListModels":            ModelsRead,
+	"/model.ModelService/UpdateModel":           ModelsWrite,
+	"/model.ModelService/DeleteModel":           ModelsWrite,
+	"/model.ModelService/UpdateModelStatus":     ModelsWrite,
+	"/model.ModelService/AddModelTags":          ModelsWrite,
+	"/model.ModelService/RemoveModelTags":       ModelsWrite,
+	"/model.ModelService/SetModelMetadata":      ModelsWrite,
+	"/model.ModelService/GetModelMetadata":      ModelsRead,
+	"/model.ModelService/ListDeletedModels":     ModelsRead,
+	"/model.ModelService/RestoreModel":          ModelsWrite,
+	"/model.ModelService/PurgeModel":            ModelsWrite,
+	"/model.ModelService/CreateModelVersion":    ModelsWrite,
+	"/model.ModelService/ListModelVersions":     ModelsRead,
+	"/model.ModelService/PromoteVersion":        ModelsWrite,
+	"/model.ModelService/GetModelCard":          ModelsRead,
+	"/model.ModelService/SetModelCard":          ModelsWrite,
+	"/model.ModelService/RecordEvaluations":     ModelsWrite,
+	"/model.ModelService/ListEvaluations":       ModelsRead,
+	"/model.ModelService/CompareVersions":       ModelsRead,
+	"/model.ModelService/GetPromotionRules":     ModelsRead,
+	"/model.ModelService/SetPromotionRules":     ModelsWrite,
+	"/model.ModelService/AddLineageEdge":        ModelsWrite,
+	"/model.ModelService/RemoveLineageEdge":     ModelsWrite,
+	"/model.ModelService/GetLineage":            ModelsRead,
+	"/model.ModelService/ListApprovalPolicies":  ModelsRead,
+	"/model.ModelService/SetApprovalPolicy":     ApprovalsManage,
+	"/model.ModelService/DeleteApprovalPolicy":  ApprovalsManage,
+	"/model.ModelService/ListApprovalRequests":  ModelsRead,
+	"/model.ModelService/GetApprovalRequest":    ModelsRead,
+	"/model.ModelService/DecideApprovalRequest": ModelsWrite,
+	"/model.ModelService/WatchModels":           ModelsRead,
+
+	"/model.AuditService/RecordAuditEvent":  AuditWrite,
+	"/model.AuditService/ListAuditEvents":   AuditRead,
+	"/model.AuditService/ExportAuditEvents": AuditRead,
+
+	"/model.WebhookService/CreateWebhook":         WebhooksManage,
+	"/model.WebhookService/GetWebhook":            WebhooksManage,
+	"/model.WebhookService/ListWebhooks":          WebhooksManage,
+	"/model.WebhookService/UpdateWebhook":         WebhooksManage,
+	"/model.WebhookService/DeleteWebhook":         WebhooksManage,
+	"/model.WebhookService/ListWebhookDeliveries": WebhooksManage,
+	"/model.WebhookService/RedeliverWebhook":      WebhooksManage,
+
+	"/model.APIKeyService/CreateAPIKey": APIKeysManage,
+	"/model.APIKeyService/GetAPIKey":    APIKeysManage,
+	"/model.APIKeyService/ListAPIKeys":  APIKeysManage,
+	"/model.APIKeyService/RotateAPIKey": APIKeysManage,
+	"/model.APIKeyService/RevokeAPIKey": APIKeysManage,
+	"/model.APIKeyService/VerifyAPIKey": APIKeysVerify,
+
+	"/model.UserService/ProvisionUser":    UsersProvision,
+	"/model.UserService/AuthenticateUser": UsersAuthenticate,
+
+	"/model.UsageService/RecordUsage": UsageRecord,
+	"/model.UsageService/ListUsage":   UsageRead,
+
+	"/model.BillingService/CreatePricingPlan": BillingManage,
+	"/model.BillingService/UpdatePricingPlan": BillingManage,
+	"/model.BillingService/ListPricingPlans":  BillingRead,
+	"/model.BillingService/SetTenantPlan":     BillingManage,
+	"/model.BillingService/GenerateInvoice":   BillingManage,
+	"/model.BillingService/GetInvoice":        BillingRead,
+	"/model.BillingService/ListInvoices":      BillingRead,
+}
+
+// Subject is the authenticated caller a decision is made for
+type Subject struct {
+	UserID   string
+	TenantID string
+	Role     string
+	// Scopes restrict an API key; nil means the caller is not limited by scopes
+	Scopes []string
+}
+
+// IsAdmin returns true if the subject has the admin role
+func (s Subject) IsAdmin() bool {
+	return s.Role == RoleAdmin
+}
+
+// Resource is the ownership of a tenant-owned object, e.g. a model
+type Resource struct {
+	OwnerID  string
+	TenantID string
+	IsPublic bool
+}
+
+// Allowed reports whether the subject's role, and its scopes if any, grant the permission
+func Allowed(s Subject, p Permission) bool {
+	if !s.IsAdmin() && !contains(rolePermissions[s.Role], p) {
+		return false
+	}
+	if s.Scopes == nil {
+		return true
+	}
+	for _, scope := range s.Scopes {
+		if contains(scopePermissions[scope], p) {
+			return true
+		}
+	}
+	return false
+}
+
+// MethodPermission returns the permission a gRPC method requires
+func MethodPermission(fullMethod string) (Permission, bool) {
+	p, ok := methodPermissions[fullMethod]
+	return p, ok
+}
+
+// CanRead reports whether the subject may see the resource: admins see
+// everything, others their tenant's resources and public ones
+func CanRead(s Subject, r Resource) bool {
+	return s.IsAdmin() || r.IsPublic || (s.TenantID != "" && s.TenantID == r.TenantID)
+}
+
+// CanModify reports whether the subject may change the resource: admins
+// change anything, developers their own resources and their tenant's
+// non-public ones, viewers nothing
+func CanModify(s Subject, r Resource) bool {
+	switch s.Role {
+	case RoleAdmin:
+		return true
+	case RoleDeveloper:
+		if s.UserID != "" && s.UserID == r.OwnerID {
+			return true
+		}
+		return !r.IsPublic && s.TenantID != "" && s.TenantID == r.TenantID
+	}
+	return false
+}
+
+// contains reports whether p is in perms
+func contains(perms []Permission, p Permission) bool {
+	for _, perm := range perms {
+		if perm == p {
+			return true
+		}
+	}
+	return false
+}
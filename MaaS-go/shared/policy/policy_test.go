@@ -0,0 +1,99 @@
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"maas-platform/shared/policy"
+	modelpb "maas-platform/shared/proto"
+)
+
+func TestAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject policy.Subject
+		perm    policy.Permission
+		want    bool
+	}{
+		{"admin may do anything", policy.Subject{Role: policy.RoleAdmin}, policy.BillingManage, true},
+		{"developer writes models", policy.Subject{Role: policy.RoleDeveloper}, policy.ModelsWrite, true},
+		{"developer cannot manage billing", policy.Subject{Role: policy.RoleDeveloper}, policy.BillingManage, false},
+		{"developer cannot manage approvals", policy.Subject{Role: policy.RoleDeveloper}, policy.ApprovalsManage, false},
+		{"viewer reads models", policy.Subject{Role: policy.RoleViewer}, policy.ModelsRead, true},
+		{"viewer cannot write models", policy.Subject{Role: policy.RoleViewer}, policy.ModelsWrite, false},
+		{"viewer cannot read audit", policy.Subject{Role: policy.RoleViewer}, policy.AuditRead, false},
+		{"unknown role", policy.Subject{Role: "owner"}, policy.ModelsRead, false},
+		{"no role", policy.Subject{}, policy.ModelsRead, false},
+		{"only trusted services verify keys", policy.Subject{Role: policy.RoleDeveloper}, policy.APIKeysVerify, false},
+		{"scope grants within the role", policy.Subject{Role: policy.RoleDeveloper, Scopes: []string{"models:write"}}, policy.ModelsWrite, true},
+		{"scope limits the role", policy.Subject{Role: policy.RoleDeveloper, Scopes: []string{"models:read"}}, policy.ModelsWrite, false},
+		{"scope limits an admin", policy.Subject{Role: policy.RoleAdmin, Scopes: []string{"inference:invoke"}}, policy.BillingManage, false},
+		{"scope cannot exceed the role", policy.Subject{Role: policy.RoleViewer, Scopes: []string{"models:write"}}, policy.ModelsWrite, false},
+		{"empty scopes allow nothing", policy.Subject{Role: policy.RoleAdmin, Scopes: []string{}}, policy.ModelsRead, false},
+		{"unknown scope", policy.Subject{Role: policy.RoleDeveloper, Scopes: []string{"models:*"}}, policy.ModelsRead, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Allowed(tt.subject, tt.perm); got != tt.want {
+				t.Errorf("Allowed(%+v, %s) = %v, want %v", tt.subject, tt.perm, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanReadAndModify(t *testing.T) {
+	const owner = "user-1"
+	own := policy.Resource{OwnerID: owner, TenantID: "tenant-a"}
+	shared := policy.Resource{OwnerID: "user-2", TenantID: "tenant-a"}
+	foreign := policy.Resource{OwnerID: "user-3", TenantID: "tenant-b"}
+	public := policy.Resource{OwnerID: "user-3", TenantID: "tenant-b", IsPublic: true}
+	publicInTenant := policy.Resource{OwnerID: "user-2", TenantID: "tenant-a", IsPublic: true}
+
+	admin := policy.Subject{UserID: "admin", TenantID: "tenant-ops", Role: policy.RoleAdmin}
+	developer := policy.Subject{UserID: owner, TenantID: "tenant-a", Role: policy.RoleDeveloper}
+	viewer := policy.Subject{UserID: owner, TenantID: "tenant-a", Role: policy.RoleViewer}
+	tenantless := policy.Subject{UserID: "user-9", Role: policy.RoleDeveloper}
+
+	tests := []struct {
+		name       string
+		subject    policy.Subject
+		resource   policy.Resource
+		wantRead   bool
+		wantModify bool
+	}{
+		{"admin, other tenant", admin, foreign, true, true},
+		{"developer, own resource", developer, own, true, true},
+		{"developer, tenant resource", developer, shared, true, true},
+		{"developer, public tenant resource of another owner", developer, publicInTenant, true, false},
+		{"developer, other tenant", developer, foreign, false, false},
+		{"developer, public resource of another tenant", developer, public, true, false},
+		{"viewer, own resource", viewer, own, true, false},
+		{"viewer, other tenant", viewer, foreign, false, false},
+		{"no tenant does not match an empty tenant", tenantless, policy.Resource{OwnerID: "user-2"}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.CanRead(tt.subject, tt.resource); got != tt.wantRead {
+				t.Errorf("CanRead = %v, want %v", got, tt.wantRead)
+			}
+			if got := policy.CanModify(tt.subject, tt.resource); got != tt.wantModify {
+				t.Errorf("CanModify = %v, want %v", got, tt.wantModify)
+			}
+		})
+	}
+}
+
+// TestEveryMethodHasAPermission keeps new registry RPCs from being callable
+// without a permission check
+func TestEveryMethodHasAPermission(t *testing.T) {
+	services := modelpb.File_model_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		svc := services.Get(i)
+		for j := 0; j < svc.Methods().Len(); j++ {
+			method := fmt.Sprintf("/%s/%s", svc.FullName(), svc.Methods().Get(j).Name())
+			if _, ok := policy.MethodPermission(method); !ok {
+				t.Errorf("%s has no permission", method)
+			}
+		}
+	}
+}
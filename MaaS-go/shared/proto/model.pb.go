@@ -25,23 +25,27 @@ const (
 
 // Model represents a machine learning model
 type Model struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Framework     string                 `protobuf:"bytes,5,opt,name=framework,proto3" json:"framework,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	Size          int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
-	Checksum      string                 `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	StoragePath   string                 `protobuf:"bytes,9,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
-	DockerImage   string                 `protobuf:"bytes,10,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
-	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,12,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	IsPublic      bool                   `protobuf:"varint,14,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Version     string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Framework   string                 `protobuf:"bytes,5,opt,name=framework,proto3" json:"framework,omitempty"`
+	Status      string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Size        int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum    string                 `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	StoragePath string                 `protobuf:"bytes,9,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
+	DockerImage string                 `protobuf:"bytes,10,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	Tags        []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	OwnerId     string                 `protobuf:"bytes,12,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	TenantId    string                 `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	IsPublic    bool                   `protobuf:"varint,14,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Set only for models in the trash
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Signature of the current version
+	Signature     *ModelSignature `protobuf:"bytes,18,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -188,6 +192,153 @@ func (x *Model) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Model) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Model) GetSignature() *ModelSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// TensorSpec describes one named input or output of a model
+type TensorSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// float16, float32, float64, int8, int16, int32, int64, uint8, bool or string
+	Dtype string `protobuf:"bytes,2,opt,name=dtype,proto3" json:"dtype,omitempty"`
+	// Size of each dimension, outermost first; -1 is dynamic and none is a scalar
+	Shape []int64 `protobuf:"varint,3,rep,packed,name=shape,proto3" json:"shape,omitempty"`
+	// JSON Schema for structured values such as tabular rows; replaces dtype
+	JsonSchema    string `protobuf:"bytes,4,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	Optional      bool   `protobuf:"varint,5,opt,name=optional,proto3" json:"optional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TensorSpec) Reset() {
+	*x = TensorSpec{}
+	mi := &file_model_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TensorSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TensorSpec) ProtoMessage() {}
+
+func (x *TensorSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TensorSpec.ProtoReflect.Descriptor instead.
+func (*TensorSpec) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TensorSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TensorSpec) GetDtype() string {
+	if x != nil {
+		return x.Dtype
+	}
+	return ""
+}
+
+func (x *TensorSpec) GetShape() []int64 {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+func (x *TensorSpec) GetJsonSchema() string {
+	if x != nil {
+		return x.JsonSchema
+	}
+	return ""
+}
+
+func (x *TensorSpec) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+// ModelSignature lists the typed inputs and outputs of a model version
+type ModelSignature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inputs        []*TensorSpec          `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs       []*TensorSpec          `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelSignature) Reset() {
+	*x = ModelSignature{}
+	mi := &file_model_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelSignature) ProtoMessage() {}
+
+func (x *ModelSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelSignature.ProtoReflect.Descriptor instead.
+func (*ModelSignature) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ModelSignature) GetInputs() []*TensorSpec {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *ModelSignature) GetOutputs() []*TensorSpec {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
 // CreateModelRequest is the request for CreateModel
 type CreateModelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -200,13 +351,14 @@ type CreateModelRequest struct {
 	OwnerId       string                 `protobuf:"bytes,7,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	TenantId      string                 `protobuf:"bytes,8,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	IsPublic      bool                   `protobuf:"varint,9,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Signature     *ModelSignature        `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateModelRequest) Reset() {
 	*x = CreateModelRequest{}
-	mi := &file_model_proto_msgTypes[1]
+	mi := &file_model_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -218,7 +370,7 @@ func (x *CreateModelRequest) String() string {
 func (*CreateModelRequest) ProtoMessage() {}
 
 func (x *CreateModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[1]
+	mi := &file_model_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +383,7 @@ func (x *CreateModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateModelRequest.ProtoReflect.Descriptor instead.
 func (*CreateModelRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{1}
+	return file_model_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateModelRequest) GetName() string {
@@ -297,6 +449,13 @@ func (x *CreateModelRequest) GetIsPublic() bool {
 	return false
 }
 
+func (x *CreateModelRequest) GetSignature() *ModelSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 // CreateModelResponse is the response for CreateModel
 type CreateModelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -307,7 +466,7 @@ type CreateModelResponse struct {
 
 func (x *CreateModelResponse) Reset() {
 	*x = CreateModelResponse{}
-	mi := &file_model_proto_msgTypes[2]
+	mi := &file_model_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -319,7 +478,7 @@ func (x *CreateModelResponse) String() string {
 func (*CreateModelResponse) ProtoMessage() {}
 
 func (x *CreateModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[2]
+	mi := &file_model_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +491,7 @@ func (x *CreateModelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateModelResponse.ProtoReflect.Descriptor instead.
 func (*CreateModelResponse) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{2}
+	return file_model_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateModelResponse) GetModel() *Model {
@@ -352,7 +511,7 @@ type GetModelRequest struct {
 
 func (x *GetModelRequest) Reset() {
 	*x = GetModelRequest{}
-	mi := &file_model_proto_msgTypes[3]
+	mi := &file_model_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +523,7 @@ func (x *GetModelRequest) String() string {
 func (*GetModelRequest) ProtoMessage() {}
 
 func (x *GetModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[3]
+	mi := &file_model_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +536,7 @@ func (x *GetModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelRequest.ProtoReflect.Descriptor instead.
 func (*GetModelRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{3}
+	return file_model_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetModelRequest) GetId() string {
@@ -397,7 +556,7 @@ type GetModelResponse struct {
 
 func (x *GetModelResponse) Reset() {
 	*x = GetModelResponse{}
-	mi := &file_model_proto_msgTypes[4]
+	mi := &file_model_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -409,7 +568,7 @@ func (x *GetModelResponse) String() string {
 func (*GetModelResponse) ProtoMessage() {}
 
 func (x *GetModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[4]
+	mi := &file_model_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +581,7 @@ func (x *GetModelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelResponse.ProtoReflect.Descriptor instead.
 func (*GetModelResponse) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{4}
+	return file_model_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetModelResponse) GetModel() *Model {
@@ -434,23 +593,27 @@ func (x *GetModelResponse) GetModel() *Model {
 
 // ListModelsRequest is the request for ListModels
 type ListModelsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Framework     string                 `protobuf:"bytes,2,opt,name=framework,proto3" json:"framework,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	IsPublic      bool                   `protobuf:"varint,7,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	Page          int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Framework string                 `protobuf:"bytes,2,opt,name=framework,proto3" json:"framework,omitempty"`
+	Status    string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	OwnerId   string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	TenantId  string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Models must carry all of the given tags
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Unset matches both public and private models
+	IsPublic *bool `protobuf:"varint,7,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"`
+	Page     int32 `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	Limit    int32 `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Scope relative to the caller: mine, tenant, public or all (admin only)
+	Scope         string `protobuf:"bytes,10,opt,name=scope,proto3" json:"scope,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListModelsRequest) Reset() {
 	*x = ListModelsRequest{}
-	mi := &file_model_proto_msgTypes[5]
+	mi := &file_model_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,7 +625,7 @@ func (x *ListModelsRequest) String() string {
 func (*ListModelsRequest) ProtoMessage() {}
 
 func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[5]
+	mi := &file_model_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +638,7 @@ func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListModelsRequest.ProtoReflect.Descriptor instead.
 func (*ListModelsRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{5}
+	return file_model_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListModelsRequest) GetName() string {
@@ -521,8 +684,8 @@ func (x *ListModelsRequest) GetTags() []string {
 }
 
 func (x *ListModelsRequest) GetIsPublic() bool {
-	if x != nil {
-		return x.IsPublic
+	if x != nil && x.IsPublic != nil {
+		return *x.IsPublic
 	}
 	return false
 }
@@ -541,6 +704,13 @@ func (x *ListModelsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListModelsRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
 // ListModelsResponse is the response for ListModels
 type ListModelsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -554,7 +724,7 @@ type ListModelsResponse struct {
 
 func (x *ListModelsResponse) Reset() {
 	*x = ListModelsResponse{}
-	mi := &file_model_proto_msgTypes[6]
+	mi := &file_model_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -566,7 +736,7 @@ func (x *ListModelsResponse) String() string {
 func (*ListModelsResponse) ProtoMessage() {}
 
 func (x *ListModelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[6]
+	mi := &file_model_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +749,7 @@ func (x *ListModelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListModelsResponse.ProtoReflect.Descriptor instead.
 func (*ListModelsResponse) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{6}
+	return file_model_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListModelsResponse) GetModels() []*Model {
@@ -625,7 +795,7 @@ type UpdateModelRequest struct {
 
 func (x *UpdateModelRequest) Reset() {
 	*x = UpdateModelRequest{}
-	mi := &file_model_proto_msgTypes[7]
+	mi := &file_model_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +807,7 @@ func (x *UpdateModelRequest) String() string {
 func (*UpdateModelRequest) ProtoMessage() {}
 
 func (x *UpdateModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[7]
+	mi := &file_model_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +820,7 @@ func (x *UpdateModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateModelRequest.ProtoReflect.Descriptor instead.
 func (*UpdateModelRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{7}
+	return file_model_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateModelRequest) GetId() string {
@@ -705,7 +875,7 @@ type UpdateModelResponse struct {
 
 func (x *UpdateModelResponse) Reset() {
 	*x = UpdateModelResponse{}
-	mi := &file_model_proto_msgTypes[8]
+	mi := &file_model_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +887,7 @@ func (x *UpdateModelResponse) String() string {
 func (*UpdateModelResponse) ProtoMessage() {}
 
 func (x *UpdateModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[8]
+	mi := &file_model_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +900,7 @@ func (x *UpdateModelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateModelResponse.ProtoReflect.Descriptor instead.
 func (*UpdateModelResponse) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{8}
+	return file_model_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateModelResponse) GetModel() *Model {
@@ -742,15 +912,17 @@ func (x *UpdateModelResponse) GetModel() *Model {
 
 // DeleteModelRequest is the request for DeleteModel
 type DeleteModelRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// force deletes a model even though other models were derived from it
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteModelRequest) Reset() {
 	*x = DeleteModelRequest{}
-	mi := &file_model_proto_msgTypes[9]
+	mi := &file_model_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +934,7 @@ func (x *DeleteModelRequest) String() string {
 func (*DeleteModelRequest) ProtoMessage() {}
 
 func (x *DeleteModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[9]
+	mi := &file_model_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +947,7 @@ func (x *DeleteModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteModelRequest.ProtoReflect.Descriptor instead.
 func (*DeleteModelRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{9}
+	return file_model_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteModelRequest) GetId() string {
@@ -785,6 +957,13 @@ func (x *DeleteModelRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteModelRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 // UpdateModelStatusRequest is the request for UpdateModelStatus
 type UpdateModelStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -796,7 +975,7 @@ type UpdateModelStatusRequest struct {
 
 func (x *UpdateModelStatusRequest) Reset() {
 	*x = UpdateModelStatusRequest{}
-	mi := &file_model_proto_msgTypes[10]
+	mi := &file_model_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +987,7 @@ func (x *UpdateModelStatusRequest) String() string {
 func (*UpdateModelStatusRequest) ProtoMessage() {}
 
 func (x *UpdateModelStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[10]
+	mi := &file_model_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +1000,7 @@ func (x *UpdateModelStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateModelStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateModelStatusRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{10}
+	return file_model_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateModelStatusRequest) GetId() string {
@@ -848,7 +1027,7 @@ type UpdateModelStatusResponse struct {
 
 func (x *UpdateModelStatusResponse) Reset() {
 	*x = UpdateModelStatusResponse{}
-	mi := &file_model_proto_msgTypes[11]
+	mi := &file_model_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -860,7 +1039,7 @@ func (x *UpdateModelStatusResponse) String() string {
 func (*UpdateModelStatusResponse) ProtoMessage() {}
 
 func (x *UpdateModelStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[11]
+	mi := &file_model_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1052,7 @@ func (x *UpdateModelStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateModelStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateModelStatusResponse) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{11}
+	return file_model_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateModelStatusResponse) GetModel() *Model {
@@ -894,7 +1073,7 @@ type AddModelTagsRequest struct {
 
 func (x *AddModelTagsRequest) Reset() {
 	*x = AddModelTagsRequest{}
-	mi := &file_model_proto_msgTypes[12]
+	mi := &file_model_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +1085,7 @@ func (x *AddModelTagsRequest) String() string {
 func (*AddModelTagsRequest) ProtoMessage() {}
 
 func (x *AddModelTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[12]
+	mi := &file_model_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1098,7 @@ func (x *AddModelTagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddModelTagsRequest.ProtoReflect.Descriptor instead.
 func (*AddModelTagsRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{12}
+	return file_model_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AddModelTagsRequest) GetModelId() string {
@@ -947,7 +1126,7 @@ type RemoveModelTagsRequest struct {
 
 func (x *RemoveModelTagsRequest) Reset() {
 	*x = RemoveModelTagsRequest{}
-	mi := &file_model_proto_msgTypes[13]
+	mi := &file_model_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -959,7 +1138,7 @@ func (x *RemoveModelTagsRequest) String() string {
 func (*RemoveModelTagsRequest) ProtoMessage() {}
 
 func (x *RemoveModelTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[13]
+	mi := &file_model_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1151,7 @@ func (x *RemoveModelTagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveModelTagsRequest.ProtoReflect.Descriptor instead.
 func (*RemoveModelTagsRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{13}
+	return file_model_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RemoveModelTagsRequest) GetModelId() string {
@@ -1000,7 +1179,7 @@ type SetModelMetadataRequest struct {
 
 func (x *SetModelMetadataRequest) Reset() {
 	*x = SetModelMetadataRequest{}
-	mi := &file_model_proto_msgTypes[14]
+	mi := &file_model_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1012,7 +1191,7 @@ func (x *SetModelMetadataRequest) String() string {
 func (*SetModelMetadataRequest) ProtoMessage() {}
 
 func (x *SetModelMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[14]
+	mi := &file_model_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,7 +1204,7 @@ func (x *SetModelMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetModelMetadataRequest.ProtoReflect.Descriptor instead.
 func (*SetModelMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{14}
+	return file_model_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SetModelMetadataRequest) GetModelId() string {
@@ -1052,7 +1231,7 @@ type GetModelMetadataRequest struct {
 
 func (x *GetModelMetadataRequest) Reset() {
 	*x = GetModelMetadataRequest{}
-	mi := &file_model_proto_msgTypes[15]
+	mi := &file_model_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1064,7 +1243,7 @@ func (x *GetModelMetadataRequest) String() string {
 func (*GetModelMetadataRequest) ProtoMessage() {}
 
 func (x *GetModelMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[15]
+	mi := &file_model_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +1256,7 @@ func (x *GetModelMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelMetadataRequest.ProtoReflect.Descriptor instead.
 func (*GetModelMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{15}
+	return file_model_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetModelMetadataRequest) GetModelId() string {
@@ -1089,15 +1268,17 @@ func (x *GetModelMetadataRequest) GetModelId() string {
 
 // GetModelMetadataResponse is the response for GetModelMetadata
 type GetModelMetadataResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      map[string]string      `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata map[string]string      `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Signature of the current version
+	Signature     *ModelSignature `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetModelMetadataResponse) Reset() {
 	*x = GetModelMetadataResponse{}
-	mi := &file_model_proto_msgTypes[16]
+	mi := &file_model_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1109,7 +1290,7 @@ func (x *GetModelMetadataResponse) String() string {
 func (*GetModelMetadataResponse) ProtoMessage() {}
 
 func (x *GetModelMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[16]
+	mi := &file_model_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,7 +1303,7 @@ func (x *GetModelMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelMetadataResponse.ProtoReflect.Descriptor instead.
 func (*GetModelMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{16}
+	return file_model_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetModelMetadataResponse) GetMetadata() map[string]string {
@@ -1132,60 +1313,7897 @@ func (x *GetModelMetadataResponse) GetMetadata() map[string]string {
 	return nil
 }
 
-var File_model_proto protoreflect.FileDescriptor
+func (x *GetModelMetadataResponse) GetSignature() *ModelSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
 
-const file_model_proto_rawDesc = "" +
-	"\n" +
-	"\vmodel.proto\x12\x05model\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xf2\x03\n" +
-	"\x05Model\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
-	"\aversion\x18\x04 \x01(\tR\aversion\x12\x1c\n" +
-	"\tframework\x18\x05 \x01(\tR\tframework\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\x12\x12\n" +
-	"\x04size\x18\a \x01(\x03R\x04size\x12\x1a\n" +
-	"\bchecksum\x18\b \x01(\tR\bchecksum\x12!\n" +
-	"\fstorage_path\x18\t \x01(\tR\vstoragePath\x12!\n" +
-	"\fdocker_image\x18\n" +
-	" \x01(\tR\vdockerImage\x12\x12\n" +
-	"\x04tags\x18\v \x03(\tR\x04tags\x12\x19\n" +
-	"\bowner_id\x18\f \x01(\tR\aownerId\x12\x1b\n" +
-	"\ttenant_id\x18\r \x01(\tR\btenantId\x12\x1b\n" +
-	"\tis_public\x18\x0e \x01(\bR\bisPublic\x129\n" +
-	"\n" +
-	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xed\x02\n" +
-	"\x12CreateModelRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1c\n" +
-	"\tframework\x18\x04 \x01(\tR\tframework\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\x12C\n" +
-	"\bmetadata\x18\x06 \x03(\v2'.model.CreateModelRequest.MetadataEntryR\bmetadata\x12\x19\n" +
-	"\bowner_id\x18\a \x01(\tR\aownerId\x12\x1b\n" +
-	"\ttenant_id\x18\b \x01(\tR\btenantId\x12\x1b\n" +
-	"\tis_public\x18\t \x01(\bR\bisPublic\x1a;\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+// ListDeletedModelsRequest is the request for ListDeletedModels
+type ListDeletedModelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Scope         string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedModelsRequest) Reset() {
+	*x = ListDeletedModelsRequest{}
+	mi := &file_model_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedModelsRequest) ProtoMessage() {}
+
+func (x *ListDeletedModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedModelsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedModelsRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDeletedModelsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListDeletedModelsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListDeletedModelsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListDeletedModelsRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ListDeletedModelsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedModelsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// RestoreModelRequest is the request for RestoreModel
+type RestoreModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreModelRequest) Reset() {
+	*x = RestoreModelRequest{}
+	mi := &file_model_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreModelRequest) ProtoMessage() {}
+
+func (x *RestoreModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreModelRequest.ProtoReflect.Descriptor instead.
+func (*RestoreModelRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RestoreModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RestoreModelResponse is the response for RestoreModel
+type RestoreModelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *Model                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreModelResponse) Reset() {
+	*x = RestoreModelResponse{}
+	mi := &file_model_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreModelResponse) ProtoMessage() {}
+
+func (x *RestoreModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreModelResponse.ProtoReflect.Descriptor instead.
+func (*RestoreModelResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreModelResponse) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+// PurgeModelRequest is the request for PurgeModel
+type PurgeModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeModelRequest) Reset() {
+	*x = PurgeModelRequest{}
+	mi := &file_model_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeModelRequest) ProtoMessage() {}
+
+func (x *PurgeModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeModelRequest.ProtoReflect.Descriptor instead.
+func (*PurgeModelRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PurgeModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// WatchModelsRequest is the request for WatchModels; list filters match any of their values
+type WatchModelsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ModelIds []string               `protobuf:"bytes,2,rep,name=model_ids,json=modelIds,proto3" json:"model_ids,omitempty"`
+	Names    []string               `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	// Models must carry all of these tags
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Resume after the event that carried this token; empty starts with new changes
+	ResumeToken   string `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchModelsRequest) Reset() {
+	*x = WatchModelsRequest{}
+	mi := &file_model_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchModelsRequest) ProtoMessage() {}
+
+func (x *WatchModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchModelsRequest.ProtoReflect.Descriptor instead.
+func (*WatchModelsRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WatchModelsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *WatchModelsRequest) GetModelIds() []string {
+	if x != nil {
+		return x.ModelIds
+	}
+	return nil
+}
+
+func (x *WatchModelsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *WatchModelsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *WatchModelsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// ModelWatchEvent is a change to a watched model
+type ModelWatchEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ADDED, MODIFIED or DELETED
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Model *Model `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	// Pass as resume_token to continue after this event
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Domain event behind the change, e.g. model.status_changed
+	EventType     string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelWatchEvent) Reset() {
+	*x = ModelWatchEvent{}
+	mi := &file_model_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelWatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelWatchEvent) ProtoMessage() {}
+
+func (x *ModelWatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelWatchEvent.ProtoReflect.Descriptor instead.
+func (*ModelWatchEvent) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ModelWatchEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ModelWatchEvent) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *ModelWatchEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ModelWatchEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ModelWatchEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// ModelVersion is a specific version of a model
+type ModelVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModelId       string                 `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum      string                 `protobuf:"bytes,6,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	StoragePath   string                 `protobuf:"bytes,7,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
+	DockerImage   string                 `protobuf:"bytes,8,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	ChangeLog     string                 `protobuf:"bytes,9,opt,name=change_log,json=changeLog,proto3" json:"change_log,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Signature     *ModelSignature        `protobuf:"bytes,12,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelVersion) Reset() {
+	*x = ModelVersion{}
+	mi := &file_model_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelVersion) ProtoMessage() {}
+
+func (x *ModelVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelVersion.ProtoReflect.Descriptor instead.
+func (*ModelVersion) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ModelVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModelVersion) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *ModelVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ModelVersion) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModelVersion) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ModelVersion) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *ModelVersion) GetStoragePath() string {
+	if x != nil {
+		return x.StoragePath
+	}
+	return ""
+}
+
+func (x *ModelVersion) GetDockerImage() string {
+	if x != nil {
+		return x.DockerImage
+	}
+	return ""
+}
+
+func (x *ModelVersion) GetChangeLog() string {
+	if x != nil {
+		return x.ChangeLog
+	}
+	return ""
+}
+
+func (x *ModelVersion) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ModelVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModelVersion) GetSignature() *ModelSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// CreateModelVersionRequest is the request for CreateModelVersion
+type CreateModelVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum      string                 `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	StoragePath   string                 `protobuf:"bytes,5,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
+	DockerImage   string                 `protobuf:"bytes,6,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	ChangeLog     string                 `protobuf:"bytes,7,opt,name=change_log,json=changeLog,proto3" json:"change_log,omitempty"`
+	Signature     *ModelSignature        `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateModelVersionRequest) Reset() {
+	*x = CreateModelVersionRequest{}
+	mi := &file_model_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateModelVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateModelVersionRequest) ProtoMessage() {}
+
+func (x *CreateModelVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateModelVersionRequest.ProtoReflect.Descriptor instead.
+func (*CreateModelVersionRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateModelVersionRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *CreateModelVersionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CreateModelVersionRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CreateModelVersionRequest) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *CreateModelVersionRequest) GetStoragePath() string {
+	if x != nil {
+		return x.StoragePath
+	}
+	return ""
+}
+
+func (x *CreateModelVersionRequest) GetDockerImage() string {
+	if x != nil {
+		return x.DockerImage
+	}
+	return ""
+}
+
+func (x *CreateModelVersionRequest) GetChangeLog() string {
+	if x != nil {
+		return x.ChangeLog
+	}
+	return ""
+}
+
+func (x *CreateModelVersionRequest) GetSignature() *ModelSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// CreateModelVersionResponse is the response for CreateModelVersion
+type CreateModelVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *ModelVersion          `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateModelVersionResponse) Reset() {
+	*x = CreateModelVersionResponse{}
+	mi := &file_model_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateModelVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateModelVersionResponse) ProtoMessage() {}
+
+func (x *CreateModelVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateModelVersionResponse.ProtoReflect.Descriptor instead.
+func (*CreateModelVersionResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateModelVersionResponse) GetVersion() *ModelVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// ListModelVersionsRequest is the request for ListModelVersions
+type ListModelVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModelVersionsRequest) Reset() {
+	*x = ListModelVersionsRequest{}
+	mi := &file_model_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModelVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelVersionsRequest) ProtoMessage() {}
+
+func (x *ListModelVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListModelVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListModelVersionsRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+// ListModelVersionsResponse is the response for ListModelVersions
+type ListModelVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*ModelVersion        `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModelVersionsResponse) Reset() {
+	*x = ListModelVersionsResponse{}
+	mi := &file_model_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModelVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelVersionsResponse) ProtoMessage() {}
+
+func (x *ListModelVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListModelVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListModelVersionsResponse) GetVersions() []*ModelVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// PromoteVersionRequest is the request for PromoteVersion
+type PromoteVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteVersionRequest) Reset() {
+	*x = PromoteVersionRequest{}
+	mi := &file_model_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteVersionRequest) ProtoMessage() {}
+
+func (x *PromoteVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteVersionRequest.ProtoReflect.Descriptor instead.
+func (*PromoteVersionRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PromoteVersionRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *PromoteVersionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// PromoteVersionResponse is the response for PromoteVersion
+type PromoteVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *Model                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteVersionResponse) Reset() {
+	*x = PromoteVersionResponse{}
+	mi := &file_model_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteVersionResponse) ProtoMessage() {}
+
+func (x *PromoteVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteVersionResponse.ProtoReflect.Descriptor instead.
+func (*PromoteVersionResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PromoteVersionResponse) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+// DataReference points at a dataset a model was trained or evaluated on
+type DataReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataReference) Reset() {
+	*x = DataReference{}
+	mi := &file_model_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataReference) ProtoMessage() {}
+
+func (x *DataReference) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataReference.ProtoReflect.Descriptor instead.
+func (*DataReference) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DataReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DataReference) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DataReference) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CardMetric is an evaluation result documented in a model card
+type CardMetric struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Dataset       string                 `protobuf:"bytes,3,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardMetric) Reset() {
+	*x = CardMetric{}
+	mi := &file_model_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardMetric) ProtoMessage() {}
+
+func (x *CardMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardMetric.ProtoReflect.Descriptor instead.
+func (*CardMetric) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CardMetric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CardMetric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CardMetric) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *CardMetric) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ModelCard documents the intended use and limitations of a model version
+type ModelCard struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Summary               string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	IntendedUse           string                 `protobuf:"bytes,2,opt,name=intended_use,json=intendedUse,proto3" json:"intended_use,omitempty"`
+	OutOfScopeUses        []string               `protobuf:"bytes,3,rep,name=out_of_scope_uses,json=outOfScopeUses,proto3" json:"out_of_scope_uses,omitempty"`
+	Limitations           []string               `protobuf:"bytes,4,rep,name=limitations,proto3" json:"limitations,omitempty"`
+	TrainingData          []*DataReference       `protobuf:"bytes,5,rep,name=training_data,json=trainingData,proto3" json:"training_data,omitempty"`
+	Metrics               []*CardMetric          `protobuf:"bytes,6,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	EthicalConsiderations string                 `protobuf:"bytes,7,opt,name=ethical_considerations,json=ethicalConsiderations,proto3" json:"ethical_considerations,omitempty"`
+	License               string                 `protobuf:"bytes,8,opt,name=license,proto3" json:"license,omitempty"`
+	Contact               string                 `protobuf:"bytes,9,opt,name=contact,proto3" json:"contact,omitempty"`
+	UpdatedBy             string                 `protobuf:"bytes,10,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ModelCard) Reset() {
+	*x = ModelCard{}
+	mi := &file_model_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelCard) ProtoMessage() {}
+
+func (x *ModelCard) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelCard.ProtoReflect.Descriptor instead.
+func (*ModelCard) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ModelCard) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ModelCard) GetIntendedUse() string {
+	if x != nil {
+		return x.IntendedUse
+	}
+	return ""
+}
+
+func (x *ModelCard) GetOutOfScopeUses() []string {
+	if x != nil {
+		return x.OutOfScopeUses
+	}
+	return nil
+}
+
+func (x *ModelCard) GetLimitations() []string {
+	if x != nil {
+		return x.Limitations
+	}
+	return nil
+}
+
+func (x *ModelCard) GetTrainingData() []*DataReference {
+	if x != nil {
+		return x.TrainingData
+	}
+	return nil
+}
+
+func (x *ModelCard) GetMetrics() []*CardMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *ModelCard) GetEthicalConsiderations() string {
+	if x != nil {
+		return x.EthicalConsiderations
+	}
+	return ""
+}
+
+func (x *ModelCard) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *ModelCard) GetContact() string {
+	if x != nil {
+		return x.Contact
+	}
+	return ""
+}
+
+func (x *ModelCard) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *ModelCard) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetModelCardRequest is the request for GetModelCard; no version means the current one
+type GetModelCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModelCardRequest) Reset() {
+	*x = GetModelCardRequest{}
+	mi := &file_model_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModelCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelCardRequest) ProtoMessage() {}
+
+func (x *GetModelCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelCardRequest.ProtoReflect.Descriptor instead.
+func (*GetModelCardRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetModelCardRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *GetModelCardRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// SetModelCardRequest is the request for SetModelCard; no version means the current one
+type SetModelCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Card          *ModelCard             `protobuf:"bytes,3,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetModelCardRequest) Reset() {
+	*x = SetModelCardRequest{}
+	mi := &file_model_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetModelCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetModelCardRequest) ProtoMessage() {}
+
+func (x *SetModelCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetModelCardRequest.ProtoReflect.Descriptor instead.
+func (*SetModelCardRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetModelCardRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *SetModelCardRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SetModelCardRequest) GetCard() *ModelCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+// ModelCardResponse is the response for GetModelCard and SetModelCard
+type ModelCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	ModelName     string                 `protobuf:"bytes,2,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Card          *ModelCard             `protobuf:"bytes,4,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelCardResponse) Reset() {
+	*x = ModelCardResponse{}
+	mi := &file_model_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelCardResponse) ProtoMessage() {}
+
+func (x *ModelCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelCardResponse.ProtoReflect.Descriptor instead.
+func (*ModelCardResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ModelCardResponse) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *ModelCardResponse) GetModelName() string {
+	if x != nil {
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *ModelCardResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ModelCardResponse) GetCard() *ModelCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+// Evaluation is an evaluation result recorded against a model version
+type Evaluation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModelId       string                 `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Metric        string                 `protobuf:"bytes,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	Dataset       string                 `protobuf:"bytes,6,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Split         string                 `protobuf:"bytes,7,opt,name=split,proto3" json:"split,omitempty"`
+	EvaluatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	RecordedBy    string                 `protobuf:"bytes,9,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Evaluation) Reset() {
+	*x = Evaluation{}
+	mi := &file_model_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Evaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Evaluation) ProtoMessage() {}
+
+func (x *Evaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Evaluation.ProtoReflect.Descriptor instead.
+func (*Evaluation) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Evaluation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Evaluation) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *Evaluation) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Evaluation) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *Evaluation) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Evaluation) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *Evaluation) GetSplit() string {
+	if x != nil {
+		return x.Split
+	}
+	return ""
+}
+
+func (x *Evaluation) GetEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return nil
+}
+
+func (x *Evaluation) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+func (x *Evaluation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// EvaluationResult is a result to record; evaluated_at defaults to now
+type EvaluationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        string                 `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Dataset       string                 `protobuf:"bytes,3,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Split         string                 `protobuf:"bytes,4,opt,name=split,proto3" json:"split,omitempty"`
+	EvaluatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluationResult) Reset() {
+	*x = EvaluationResult{}
+	mi := &file_model_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationResult) ProtoMessage() {}
+
+func (x *EvaluationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationResult.ProtoReflect.Descriptor instead.
+func (*EvaluationResult) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EvaluationResult) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *EvaluationResult) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetSplit() string {
+	if x != nil {
+		return x.Split
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return nil
+}
+
+// RecordEvaluationsRequest is the request for RecordEvaluations
+type RecordEvaluationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Results       []*EvaluationResult    `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEvaluationsRequest) Reset() {
+	*x = RecordEvaluationsRequest{}
+	mi := &file_model_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEvaluationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEvaluationsRequest) ProtoMessage() {}
+
+func (x *RecordEvaluationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEvaluationsRequest.ProtoReflect.Descriptor instead.
+func (*RecordEvaluationsRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RecordEvaluationsRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *RecordEvaluationsRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RecordEvaluationsRequest) GetResults() []*EvaluationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// RecordEvaluationsResponse is the response for RecordEvaluations
+type RecordEvaluationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Evaluations   []*Evaluation          `protobuf:"bytes,1,rep,name=evaluations,proto3" json:"evaluations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEvaluationsResponse) Reset() {
+	*x = RecordEvaluationsResponse{}
+	mi := &file_model_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEvaluationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEvaluationsResponse) ProtoMessage() {}
+
+func (x *RecordEvaluationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEvaluationsResponse.ProtoReflect.Descriptor instead.
+func (*RecordEvaluationsResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RecordEvaluationsResponse) GetEvaluations() []*Evaluation {
+	if x != nil {
+		return x.Evaluations
+	}
+	return nil
+}
+
+// ListEvaluationsRequest is the request for ListEvaluations; empty filters match all
+type ListEvaluationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Metric        string                 `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEvaluationsRequest) Reset() {
+	*x = ListEvaluationsRequest{}
+	mi := &file_model_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationsRequest) ProtoMessage() {}
+
+func (x *ListEvaluationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationsRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationsRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListEvaluationsRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *ListEvaluationsRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ListEvaluationsRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+// ListEvaluationsResponse is the response for ListEvaluations
+type ListEvaluationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Evaluations   []*Evaluation          `protobuf:"bytes,1,rep,name=evaluations,proto3" json:"evaluations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEvaluationsResponse) Reset() {
+	*x = ListEvaluationsResponse{}
+	mi := &file_model_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationsResponse) ProtoMessage() {}
+
+func (x *ListEvaluationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationsResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListEvaluationsResponse) GetEvaluations() []*Evaluation {
+	if x != nil {
+		return x.Evaluations
+	}
+	return nil
+}
+
+// PromotionRule blocks promoting a version whose metric regresses too far;
+// direction is higher or lower and max_regression a fraction when relative
+type PromotionRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Metric        string                 `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	Dataset       string                 `protobuf:"bytes,3,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Split         string                 `protobuf:"bytes,4,opt,name=split,proto3" json:"split,omitempty"`
+	Direction     string                 `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	MaxRegression float64                `protobuf:"fixed64,6,opt,name=max_regression,json=maxRegression,proto3" json:"max_regression,omitempty"`
+	Relative      bool                   `protobuf:"varint,7,opt,name=relative,proto3" json:"relative,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionRule) Reset() {
+	*x = PromotionRule{}
+	mi := &file_model_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionRule) ProtoMessage() {}
+
+func (x *PromotionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionRule.ProtoReflect.Descriptor instead.
+func (*PromotionRule) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PromotionRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromotionRule) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *PromotionRule) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *PromotionRule) GetSplit() string {
+	if x != nil {
+		return x.Split
+	}
+	return ""
+}
+
+func (x *PromotionRule) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *PromotionRule) GetMaxRegression() float64 {
+	if x != nil {
+		return x.MaxRegression
+	}
+	return 0
+}
+
+func (x *PromotionRule) GetRelative() bool {
+	if x != nil {
+		return x.Relative
+	}
+	return false
+}
+
+func (x *PromotionRule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PromotionRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GetPromotionRulesRequest is the request for GetPromotionRules
+type GetPromotionRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromotionRulesRequest) Reset() {
+	*x = GetPromotionRulesRequest{}
+	mi := &file_model_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromotionRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromotionRulesRequest) ProtoMessage() {}
+
+func (x *GetPromotionRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromotionRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetPromotionRulesRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetPromotionRulesRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+// SetPromotionRulesRequest is the request for SetPromotionRules
+type SetPromotionRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Rules         []*PromotionRule       `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPromotionRulesRequest) Reset() {
+	*x = SetPromotionRulesRequest{}
+	mi := &file_model_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPromotionRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPromotionRulesRequest) ProtoMessage() {}
+
+func (x *SetPromotionRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPromotionRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetPromotionRulesRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetPromotionRulesRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *SetPromotionRulesRequest) GetRules() []*PromotionRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// PromotionRulesResponse is the response for GetPromotionRules and SetPromotionRules
+type PromotionRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*PromotionRule       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionRulesResponse) Reset() {
+	*x = PromotionRulesResponse{}
+	mi := &file_model_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionRulesResponse) ProtoMessage() {}
+
+func (x *PromotionRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionRulesResponse.ProtoReflect.Descriptor instead.
+func (*PromotionRulesResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PromotionRulesResponse) GetRules() []*PromotionRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// LineageEdge links a model or version to the model or dataset it came from;
+// relation is derived_from, fine_tuned_from, quantized_from or trained_on_dataset
+type LineageEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModelId       string                 `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Relation      string                 `protobuf:"bytes,4,opt,name=relation,proto3" json:"relation,omitempty"`
+	ParentModelId string                 `protobuf:"bytes,5,opt,name=parent_model_id,json=parentModelId,proto3" json:"parent_model_id,omitempty"`
+	ParentVersion string                 `protobuf:"bytes,6,opt,name=parent_version,json=parentVersion,proto3" json:"parent_version,omitempty"`
+	DatasetName   string                 `protobuf:"bytes,7,opt,name=dataset_name,json=datasetName,proto3" json:"dataset_name,omitempty"`
+	DatasetUri    string                 `protobuf:"bytes,8,opt,name=dataset_uri,json=datasetUri,proto3" json:"dataset_uri,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineageEdge) Reset() {
+	*x = LineageEdge{}
+	mi := &file_model_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineageEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineageEdge) ProtoMessage() {}
+
+func (x *LineageEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineageEdge.ProtoReflect.Descriptor instead.
+func (*LineageEdge) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LineageEdge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LineageEdge) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *LineageEdge) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *LineageEdge) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *LineageEdge) GetParentModelId() string {
+	if x != nil {
+		return x.ParentModelId
+	}
+	return ""
+}
+
+func (x *LineageEdge) GetParentVersion() string {
+	if x != nil {
+		return x.ParentVersion
+	}
+	return ""
+}
+
+func (x *LineageEdge) GetDatasetName() string {
+	if x != nil {
+		return x.DatasetName
+	}
+	return ""
+}
+
+func (x *LineageEdge) GetDatasetUri() string {
+	if x != nil {
+		return x.DatasetUri
+	}
+	return ""
+}
+
+func (x *LineageEdge) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *LineageEdge) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AddLineageEdgeRequest is the request for AddLineageEdge; dataset relations
+// name a dataset and the others a parent model
+type AddLineageEdgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Relation      string                 `protobuf:"bytes,3,opt,name=relation,proto3" json:"relation,omitempty"`
+	ParentModelId string                 `protobuf:"bytes,4,opt,name=parent_model_id,json=parentModelId,proto3" json:"parent_model_id,omitempty"`
+	ParentVersion string                 `protobuf:"bytes,5,opt,name=parent_version,json=parentVersion,proto3" json:"parent_version,omitempty"`
+	DatasetName   string                 `protobuf:"bytes,6,opt,name=dataset_name,json=datasetName,proto3" json:"dataset_name,omitempty"`
+	DatasetUri    string                 `protobuf:"bytes,7,opt,name=dataset_uri,json=datasetUri,proto3" json:"dataset_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLineageEdgeRequest) Reset() {
+	*x = AddLineageEdgeRequest{}
+	mi := &file_model_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLineageEdgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLineageEdgeRequest) ProtoMessage() {}
+
+func (x *AddLineageEdgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLineageEdgeRequest.ProtoReflect.Descriptor instead.
+func (*AddLineageEdgeRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AddLineageEdgeRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *AddLineageEdgeRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AddLineageEdgeRequest) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *AddLineageEdgeRequest) GetParentModelId() string {
+	if x != nil {
+		return x.ParentModelId
+	}
+	return ""
+}
+
+func (x *AddLineageEdgeRequest) GetParentVersion() string {
+	if x != nil {
+		return x.ParentVersion
+	}
+	return ""
+}
+
+func (x *AddLineageEdgeRequest) GetDatasetName() string {
+	if x != nil {
+		return x.DatasetName
+	}
+	return ""
+}
+
+func (x *AddLineageEdgeRequest) GetDatasetUri() string {
+	if x != nil {
+		return x.DatasetUri
+	}
+	return ""
+}
+
+// RemoveLineageEdgeRequest is the request for RemoveLineageEdge
+type RemoveLineageEdgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	EdgeId        string                 `protobuf:"bytes,2,opt,name=edge_id,json=edgeId,proto3" json:"edge_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLineageEdgeRequest) Reset() {
+	*x = RemoveLineageEdgeRequest{}
+	mi := &file_model_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLineageEdgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLineageEdgeRequest) ProtoMessage() {}
+
+func (x *RemoveLineageEdgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLineageEdgeRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLineageEdgeRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RemoveLineageEdgeRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *RemoveLineageEdgeRequest) GetEdgeId() string {
+	if x != nil {
+		return x.EdgeId
+	}
+	return ""
+}
+
+// GetLineageRequest is the request for GetLineage; direction is ancestors or
+// descendants and no depth means the default
+type GetLineageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Depth         int32                  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLineageRequest) Reset() {
+	*x = GetLineageRequest{}
+	mi := &file_model_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLineageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLineageRequest) ProtoMessage() {}
+
+func (x *GetLineageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLineageRequest.ProtoReflect.Descriptor instead.
+func (*GetLineageRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetLineageRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *GetLineageRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *GetLineageRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// LineageNode is a model or dataset reached by a lineage query
+type LineageNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ModelId       string                 `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	ModelName     string                 `protobuf:"bytes,3,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	DatasetName   string                 `protobuf:"bytes,5,opt,name=dataset_name,json=datasetName,proto3" json:"dataset_name,omitempty"`
+	DatasetUri    string                 `protobuf:"bytes,6,opt,name=dataset_uri,json=datasetUri,proto3" json:"dataset_uri,omitempty"`
+	Depth         int32                  `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineageNode) Reset() {
+	*x = LineageNode{}
+	mi := &file_model_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineageNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineageNode) ProtoMessage() {}
+
+func (x *LineageNode) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineageNode.ProtoReflect.Descriptor instead.
+func (*LineageNode) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LineageNode) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LineageNode) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *LineageNode) GetModelName() string {
+	if x != nil {
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *LineageNode) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *LineageNode) GetDatasetName() string {
+	if x != nil {
+		return x.DatasetName
+	}
+	return ""
+}
+
+func (x *LineageNode) GetDatasetUri() string {
+	if x != nil {
+		return x.DatasetUri
+	}
+	return ""
+}
+
+func (x *LineageNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// LineageResponse is the response for GetLineage
+type LineageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Depth         int32                  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Nodes         []*LineageNode         `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*LineageEdge         `protobuf:"bytes,5,rep,name=edges,proto3" json:"edges,omitempty"`
+	Truncated     bool                   `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineageResponse) Reset() {
+	*x = LineageResponse{}
+	mi := &file_model_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineageResponse) ProtoMessage() {}
+
+func (x *LineageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineageResponse.ProtoReflect.Descriptor instead.
+func (*LineageResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LineageResponse) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *LineageResponse) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *LineageResponse) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *LineageResponse) GetNodes() []*LineageNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *LineageResponse) GetEdges() []*LineageEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *LineageResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// ApprovalPolicy makes an action of a tenant, promote or publish, wait until
+// required_approvals users with approver_role approve it
+type ApprovalPolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId          string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Action            string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	RequiredApprovals int32                  `protobuf:"varint,4,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	ApproverRole      string                 `protobuf:"bytes,5,opt,name=approver_role,json=approverRole,proto3" json:"approver_role,omitempty"`
+	TimeoutSeconds    int64                  `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	CreatedBy         string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ApprovalPolicy) Reset() {
+	*x = ApprovalPolicy{}
+	mi := &file_model_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalPolicy) ProtoMessage() {}
+
+func (x *ApprovalPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalPolicy.ProtoReflect.Descriptor instead.
+func (*ApprovalPolicy) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ApprovalPolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetApproverRole() string {
+	if x != nil {
+		return x.ApproverRole
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ApprovalPolicy) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ApprovalPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApprovalPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ListApprovalPoliciesRequest is the request for ListApprovalPolicies; no
+// tenant means the caller's
+type ListApprovalPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalPoliciesRequest) Reset() {
+	*x = ListApprovalPoliciesRequest{}
+	mi := &file_model_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalPoliciesRequest) ProtoMessage() {}
+
+func (x *ListApprovalPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListApprovalPoliciesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// ListApprovalPoliciesResponse is the response for ListApprovalPolicies
+type ListApprovalPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*ApprovalPolicy      `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalPoliciesResponse) Reset() {
+	*x = ListApprovalPoliciesResponse{}
+	mi := &file_model_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalPoliciesResponse) ProtoMessage() {}
+
+func (x *ListApprovalPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListApprovalPoliciesResponse) GetPolicies() []*ApprovalPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// DeleteApprovalPolicyRequest is the request for DeleteApprovalPolicy
+type DeleteApprovalPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApprovalPolicyRequest) Reset() {
+	*x = DeleteApprovalPolicyRequest{}
+	mi := &file_model_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApprovalPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApprovalPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteApprovalPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApprovalPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApprovalPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteApprovalPolicyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DeleteApprovalPolicyRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// ApprovalDecision is one approver's verdict on a request
+type ApprovalDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApproverId    string                 `protobuf:"bytes,1,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalDecision) Reset() {
+	*x = ApprovalDecision{}
+	mi := &file_model_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalDecision) ProtoMessage() {}
+
+func (x *ApprovalDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalDecision.ProtoReflect.Descriptor instead.
+func (*ApprovalDecision) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ApprovalDecision) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *ApprovalDecision) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ApprovalDecision) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ApprovalDecision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ApprovalRequest is a change held back until enough approvers agree to it;
+// target_status or target_version say what a promote request changes. It is
+// attached as a detail to the FailedPrecondition error of the held back call.
+type ApprovalRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId          string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ModelId           string                 `protobuf:"bytes,3,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Action            string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	TargetStatus      string                 `protobuf:"bytes,5,opt,name=target_status,json=targetStatus,proto3" json:"target_status,omitempty"`
+	TargetVersion     string                 `protobuf:"bytes,6,opt,name=target_version,json=targetVersion,proto3" json:"target_version,omitempty"`
+	State             string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	RequiredApprovals int32                  `protobuf:"varint,8,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	ApproverRole      string                 `protobuf:"bytes,9,opt,name=approver_role,json=approverRole,proto3" json:"approver_role,omitempty"`
+	RequestedBy       string                 `protobuf:"bytes,10,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Error             string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	Decisions         []*ApprovalDecision    `protobuf:"bytes,12,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ResolvedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=resolved_at,json=resolvedAt,proto3,oneof" json:"resolved_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ApprovalRequest) Reset() {
+	*x = ApprovalRequest{}
+	mi := &file_model_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalRequest) ProtoMessage() {}
+
+func (x *ApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalRequest.ProtoReflect.Descriptor instead.
+func (*ApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ApprovalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetTargetStatus() string {
+	if x != nil {
+		return x.TargetStatus
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetTargetVersion() string {
+	if x != nil {
+		return x.TargetVersion
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetApproverRole() string {
+	if x != nil {
+		return x.ApproverRole
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetDecisions() []*ApprovalDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListApprovalRequestsRequest is the request for ListApprovalRequests
+type ListApprovalRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ModelId       string                 `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalRequestsRequest) Reset() {
+	*x = ListApprovalRequestsRequest{}
+	mi := &file_model_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalRequestsRequest) ProtoMessage() {}
+
+func (x *ListApprovalRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListApprovalRequestsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListApprovalRequestsRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *ListApprovalRequestsRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListApprovalRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListApprovalRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListApprovalRequestsResponse is the response for ListApprovalRequests
+type ListApprovalRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*ApprovalRequest     `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalRequestsResponse) Reset() {
+	*x = ListApprovalRequestsResponse{}
+	mi := &file_model_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalRequestsResponse) ProtoMessage() {}
+
+func (x *ListApprovalRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListApprovalRequestsResponse) GetRequests() []*ApprovalRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListApprovalRequestsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListApprovalRequestsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListApprovalRequestsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetApprovalRequestRequest is the request for GetApprovalRequest
+type GetApprovalRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovalRequestRequest) Reset() {
+	*x = GetApprovalRequestRequest{}
+	mi := &file_model_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovalRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovalRequestRequest) ProtoMessage() {}
+
+func (x *GetApprovalRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovalRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetApprovalRequestRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetApprovalRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DecideApprovalRequestRequest is the request for DecideApprovalRequest
+type DecideApprovalRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideApprovalRequestRequest) Reset() {
+	*x = DecideApprovalRequestRequest{}
+	mi := &file_model_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideApprovalRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideApprovalRequestRequest) ProtoMessage() {}
+
+func (x *DecideApprovalRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideApprovalRequestRequest.ProtoReflect.Descriptor instead.
+func (*DecideApprovalRequestRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DecideApprovalRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DecideApprovalRequestRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *DecideApprovalRequestRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// CompareVersionsRequest is the request for CompareVersions; no base means the current version
+type CompareVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Base          string                 `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareVersionsRequest) Reset() {
+	*x = CompareVersionsRequest{}
+	mi := &file_model_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareVersionsRequest) ProtoMessage() {}
+
+func (x *CompareVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareVersionsRequest.ProtoReflect.Descriptor instead.
+func (*CompareVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CompareVersionsRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *CompareVersionsRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *CompareVersionsRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// MetricDiff compares the latest result of a metric in two versions
+type MetricDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        string                 `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Dataset       string                 `protobuf:"bytes,2,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Split         string                 `protobuf:"bytes,3,opt,name=split,proto3" json:"split,omitempty"`
+	Base          *float64               `protobuf:"fixed64,4,opt,name=base,proto3,oneof" json:"base,omitempty"`
+	Target        *float64               `protobuf:"fixed64,5,opt,name=target,proto3,oneof" json:"target,omitempty"`
+	Delta         *float64               `protobuf:"fixed64,6,opt,name=delta,proto3,oneof" json:"delta,omitempty"`
+	RelativeDelta *float64               `protobuf:"fixed64,7,opt,name=relative_delta,json=relativeDelta,proto3,oneof" json:"relative_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricDiff) Reset() {
+	*x = MetricDiff{}
+	mi := &file_model_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricDiff) ProtoMessage() {}
+
+func (x *MetricDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricDiff.ProtoReflect.Descriptor instead.
+func (*MetricDiff) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MetricDiff) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *MetricDiff) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *MetricDiff) GetSplit() string {
+	if x != nil {
+		return x.Split
+	}
+	return ""
+}
+
+func (x *MetricDiff) GetBase() float64 {
+	if x != nil && x.Base != nil {
+		return *x.Base
+	}
+	return 0
+}
+
+func (x *MetricDiff) GetTarget() float64 {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return 0
+}
+
+func (x *MetricDiff) GetDelta() float64 {
+	if x != nil && x.Delta != nil {
+		return *x.Delta
+	}
+	return 0
+}
+
+func (x *MetricDiff) GetRelativeDelta() float64 {
+	if x != nil && x.RelativeDelta != nil {
+		return *x.RelativeDelta
+	}
+	return 0
+}
+
+// TensorDiff is a signature input or output that was added, removed or changed
+type TensorDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Change        string                 `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"`
+	Base          string                 `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	Target        string                 `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TensorDiff) Reset() {
+	*x = TensorDiff{}
+	mi := &file_model_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TensorDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TensorDiff) ProtoMessage() {}
+
+func (x *TensorDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TensorDiff.ProtoReflect.Descriptor instead.
+func (*TensorDiff) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TensorDiff) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TensorDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TensorDiff) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *TensorDiff) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *TensorDiff) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// FieldDiff is a version attribute that differs
+type FieldDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Base          string                 `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
+	mi := &file_model_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiff) ProtoMessage() {}
+
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *FieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// RuleCheck is the outcome of a promotion rule
+type RuleCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *PromotionRule         `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleCheck) Reset() {
+	*x = RuleCheck{}
+	mi := &file_model_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleCheck) ProtoMessage() {}
+
+func (x *RuleCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleCheck.ProtoReflect.Descriptor instead.
+func (*RuleCheck) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RuleCheck) GetRule() *PromotionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *RuleCheck) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *RuleCheck) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// CompareVersionsResponse is the response for CompareVersions
+type CompareVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelId       string                 `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Base          *ModelVersion          `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Target        *ModelVersion          `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Metrics       []*MetricDiff          `protobuf:"bytes,4,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	Signature     []*TensorDiff          `protobuf:"bytes,5,rep,name=signature,proto3" json:"signature,omitempty"`
+	Fields        []*FieldDiff           `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	Rules         []*RuleCheck           `protobuf:"bytes,7,rep,name=rules,proto3" json:"rules,omitempty"`
+	Promotable    bool                   `protobuf:"varint,8,opt,name=promotable,proto3" json:"promotable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareVersionsResponse) Reset() {
+	*x = CompareVersionsResponse{}
+	mi := &file_model_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareVersionsResponse) ProtoMessage() {}
+
+func (x *CompareVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareVersionsResponse.ProtoReflect.Descriptor instead.
+func (*CompareVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CompareVersionsResponse) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *CompareVersionsResponse) GetBase() *ModelVersion {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CompareVersionsResponse) GetTarget() *ModelVersion {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CompareVersionsResponse) GetMetrics() []*MetricDiff {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *CompareVersionsResponse) GetSignature() []*TensorDiff {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *CompareVersionsResponse) GetFields() []*FieldDiff {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *CompareVersionsResponse) GetRules() []*RuleCheck {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *CompareVersionsResponse) GetPromotable() bool {
+	if x != nil {
+		return x.Promotable
+	}
+	return false
+}
+
+// AuditEvent is an entry of the audit log; before, after and diff hold JSON
+type AuditEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ActorId    string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TenantId   string                 `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RequestId  string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Source     string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	Action     string                 `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType string                 `protobuf:"bytes,8,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId   string                 `protobuf:"bytes,9,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Before     string                 `protobuf:"bytes,10,opt,name=before,proto3" json:"before,omitempty"`
+	After      string                 `protobuf:"bytes,11,opt,name=after,proto3" json:"after,omitempty"`
+	Diff       string                 `protobuf:"bytes,12,opt,name=diff,proto3" json:"diff,omitempty"`
+	// tenant_id is the tenant of the target; actor_tenant_id is the actor's
+	ActorTenantId string `protobuf:"bytes,13,opt,name=actor_tenant_id,json=actorTenantId,proto3" json:"actor_tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_model_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorTenantId() string {
+	if x != nil {
+		return x.ActorTenantId
+	}
+	return ""
+}
+
+// RecordAuditEventRequest is the request for RecordAuditEvent
+type RecordAuditEventRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Action     string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType string                 `protobuf:"bytes,2,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId   string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Source     string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// Used when the call carries no caller identity
+	ActorId  string `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TenantId string `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// JSON encoded states
+	Before        string `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After         string `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAuditEventRequest) Reset() {
+	*x = RecordAuditEventRequest{}
+	mi := &file_model_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAuditEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAuditEventRequest) ProtoMessage() {}
+
+func (x *RecordAuditEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAuditEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordAuditEventRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RecordAuditEventRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// ListAuditEventsRequest is the request for ListAuditEvents and ExportAuditEvents
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Page          int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_model_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListAuditEventsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListAuditEventsResponse is the response for ListAuditEvents
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_model_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Webhook is a subscription to model events
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	ModelId       string                 `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_model_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *Webhook) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// WebhookDelivery is one attempt series of sending an event to a webhook
+type WebhookDelivery struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId    string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId      string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType    string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	ModelId      string                 `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Status       string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts     int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseCode int32                  `protobuf:"varint,8,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`
+	LastError    string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	RedeliveryOf string                 `protobuf:"bytes,10,opt,name=redelivery_of,json=redeliveryOf,proto3" json:"redelivery_of,omitempty"`
+	// JSON body sent to the endpoint
+	Payload       string                 `protobuf:"bytes,11,opt,name=payload,proto3" json:"payload,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastAttemptAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_model_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetRedeliveryOf() string {
+	if x != nil {
+		return x.RedeliveryOf
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateWebhookRequest is the request for CreateWebhook
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	ModelId       string                 `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_model_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateWebhookRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CreateWebhookResponse is the response for CreateWebhook
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_model_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// GetWebhookRequest is the request for GetWebhook
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_model_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetWebhookResponse is the response for GetWebhook
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	mi := &file_model_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// ListWebhooksRequest is the request for ListWebhooks
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_model_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListWebhooksRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhooksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListWebhooksResponse is the response for ListWebhooks
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_model_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListWebhooksResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhooksResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// UpdateWebhookRequest is the request for UpdateWebhook; unset fields are left unchanged
+type UpdateWebhookRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url        *string                `protobuf:"bytes,2,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	EventTypes []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Replace event_types even when the list is empty
+	SetEventTypes bool    `protobuf:"varint,4,opt,name=set_event_types,json=setEventTypes,proto3" json:"set_event_types,omitempty"`
+	ModelId       *string `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3,oneof" json:"model_id,omitempty"`
+	Description   *string `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Active        *bool   `protobuf:"varint,7,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	RotateSecret  bool    `protobuf:"varint,8,opt,name=rotate_secret,json=rotateSecret,proto3" json:"rotate_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_model_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetSetEventTypes() bool {
+	if x != nil {
+		return x.SetEventTypes
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetModelId() string {
+	if x != nil && x.ModelId != nil {
+		return *x.ModelId
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetRotateSecret() bool {
+	if x != nil {
+		return x.RotateSecret
+	}
+	return false
+}
+
+// UpdateWebhookResponse is the response for UpdateWebhook
+type UpdateWebhookResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Webhook *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// Set only when the secret was rotated
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_model_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *UpdateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// DeleteWebhookRequest is the request for DeleteWebhook
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_model_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListWebhookDeliveriesRequest is the request for ListWebhookDeliveries
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_model_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListWebhookDeliveriesResponse is the response for ListWebhookDeliveries
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_model_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// RedeliverWebhookRequest is the request for RedeliverWebhook
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_model_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+// RedeliverWebhookResponse is the response for RedeliverWebhook
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_model_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// APIKey is a tenant-scoped credential; the secret itself is never returned after creation
+type APIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,6,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_model_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *APIKey) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *APIKey) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateAPIKeyRequest is the request for CreateAPIKey
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_model_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CreateAPIKeyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreateAPIKeyResponse is the response for CreateAPIKey
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_model_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// GetAPIKeyRequest is the request for GetAPIKey
+type GetAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIKeyRequest) Reset() {
+	*x = GetAPIKeyRequest{}
+	mi := &file_model_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeyRequest) ProtoMessage() {}
+
+func (x *GetAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetAPIKeyResponse is the response for GetAPIKey
+type GetAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIKeyResponse) Reset() {
+	*x = GetAPIKeyResponse{}
+	mi := &file_model_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeyResponse) ProtoMessage() {}
+
+func (x *GetAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+// ListAPIKeysRequest is the request for ListAPIKeys
+type ListAPIKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	IncludeRevoked bool                   `protobuf:"varint,2,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_model_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListAPIKeysRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListAPIKeysRequest) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+func (x *ListAPIKeysRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAPIKeysRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListAPIKeysResponse is the response for ListAPIKeys
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*APIKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_model_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+func (x *ListAPIKeysResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAPIKeysResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAPIKeysResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// RotateAPIKeyRequest is the request for RotateAPIKey
+type RotateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyRequest) Reset() {
+	*x = RotateAPIKeyRequest{}
+	mi := &file_model_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyRequest) ProtoMessage() {}
+
+func (x *RotateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RotateAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RotateAPIKeyResponse is the response for RotateAPIKey
+type RotateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyResponse) Reset() {
+	*x = RotateAPIKeyResponse{}
+	mi := &file_model_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyResponse) ProtoMessage() {}
+
+func (x *RotateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RotateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *RotateAPIKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// RevokeAPIKeyRequest is the request for RevokeAPIKey
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_model_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RevokeAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RevokeAPIKeyResponse is the response for RevokeAPIKey
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_model_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RevokeAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+// VerifyAPIKeyRequest is the request for VerifyAPIKey
+type VerifyAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyRequest) Reset() {
+	*x = VerifyAPIKeyRequest{}
+	mi := &file_model_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyRequest) ProtoMessage() {}
+
+func (x *VerifyAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *VerifyAPIKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// VerifyAPIKeyResponse is the response for VerifyAPIKey
+type VerifyAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAPIKeyResponse) Reset() {
+	*x = VerifyAPIKeyResponse{}
+	mi := &file_model_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAPIKeyResponse) ProtoMessage() {}
+
+func (x *VerifyAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *VerifyAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+// User represents a platform user
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	TenantId      string                 `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastLoginAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_model_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *User) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *User) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetLastLoginAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return nil
+}
+
+// ProvisionUserRequest is the request for ProvisionUser
+type ProvisionUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Issuer and subject identify the user at the identity provider
+	Issuer        string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Subject       string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified bool   `protobuf:"varint,4,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	// Preferred username; made unique when taken
+	Username      string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	TenantId      string `protobuf:"bytes,7,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionUserRequest) Reset() {
+	*x = ProvisionUserRequest{}
+	mi := &file_model_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionUserRequest) ProtoMessage() {}
+
+func (x *ProvisionUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionUserRequest.ProtoReflect.Descriptor instead.
+func (*ProvisionUserRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ProvisionUserRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *ProvisionUserRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ProvisionUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ProvisionUserRequest) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *ProvisionUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ProvisionUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ProvisionUserRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// ProvisionUserResponse is the response for ProvisionUser
+type ProvisionUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Created is set when the user did not exist before
+	Created       bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionUserResponse) Reset() {
+	*x = ProvisionUserResponse{}
+	mi := &file_model_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionUserResponse) ProtoMessage() {}
+
+func (x *ProvisionUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionUserResponse.ProtoReflect.Descriptor instead.
+func (*ProvisionUserResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ProvisionUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ProvisionUserResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// AuthenticateUserRequest is the request for AuthenticateUser
+type AuthenticateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticateUserRequest) Reset() {
+	*x = AuthenticateUserRequest{}
+	mi := &file_model_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateUserRequest) ProtoMessage() {}
+
+func (x *AuthenticateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateUserRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateUserRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AuthenticateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AuthenticateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// AuthenticateUserResponse is the response for AuthenticateUser
+type AuthenticateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticateUserResponse) Reset() {
+	*x = AuthenticateUserResponse{}
+	mi := &file_model_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateUserResponse) ProtoMessage() {}
+
+func (x *AuthenticateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateUserResponse.ProtoReflect.Descriptor instead.
+func (*AuthenticateUserResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AuthenticateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// UsageRecord is the metering record of a single inference call
+type UsageRecord struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId   string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ApiKeyId string                 `protobuf:"bytes,3,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	ModelId  string                 `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version  string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// Status is success, client_error or server_error
+	Status        string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	LatencyMs     int64  `protobuf:"varint,7,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	RequestBytes  int64  `protobuf:"varint,8,opt,name=request_bytes,json=requestBytes,proto3" json:"request_bytes,omitempty"`
+	ResponseBytes int64  `protobuf:"varint,9,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+	// Token counts are zero when the model does not report them
+	PromptTokens     int64                  `protobuf:"varint,10,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                  `protobuf:"varint,11,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	RequestId        string                 `protobuf:"bytes,12,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	OccurredAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// EventID is unique per call; the registry ignores records whose event it has seen
+	EventId       string `protobuf:"bytes,14,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageRecord) Reset() {
+	*x = UsageRecord{}
+	mi := &file_model_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRecord) ProtoMessage() {}
+
+func (x *UsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRecord.ProtoReflect.Descriptor instead.
+func (*UsageRecord) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *UsageRecord) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetRequestBytes() int64 {
+	if x != nil {
+		return x.RequestBytes
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetResponseBytes() int64 {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *UsageRecord) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// RecordUsageRequest is the request for RecordUsage
+type RecordUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*UsageRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageRequest) Reset() {
+	*x = RecordUsageRequest{}
+	mi := &file_model_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageRequest) ProtoMessage() {}
+
+func (x *RecordUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageRequest.ProtoReflect.Descriptor instead.
+func (*RecordUsageRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *RecordUsageRequest) GetRecords() []*UsageRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// RecordUsageResponse is the response for RecordUsage
+type RecordUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageResponse) Reset() {
+	*x = RecordUsageResponse{}
+	mi := &file_model_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageResponse) ProtoMessage() {}
+
+func (x *RecordUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageResponse.ProtoReflect.Descriptor instead.
+func (*RecordUsageResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RecordUsageResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+// ListUsageRequest is the request for ListUsage
+type ListUsageRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ModelId  string                 `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	From     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Group by any of hour, day, month, tenant, model, version and status
+	GroupBy       []string `protobuf:"bytes,5,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageRequest) Reset() {
+	*x = ListUsageRequest{}
+	mi := &file_model_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageRequest) ProtoMessage() {}
+
+func (x *ListUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageRequest.ProtoReflect.Descriptor instead.
+func (*ListUsageRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListUsageRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListUsageRequest) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *ListUsageRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListUsageRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListUsageRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+// UsageSummary aggregates usage over one group; fields not grouped by are empty
+type UsageSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	TenantId         string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ModelId          string                 `protobuf:"bytes,3,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Version          string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Requests         int64                  `protobuf:"varint,6,opt,name=requests,proto3" json:"requests,omitempty"`
+	TotalLatencyMs   int64                  `protobuf:"varint,7,opt,name=total_latency_ms,json=totalLatencyMs,proto3" json:"total_latency_ms,omitempty"`
+	MaxLatencyMs     int64                  `protobuf:"varint,8,opt,name=max_latency_ms,json=maxLatencyMs,proto3" json:"max_latency_ms,omitempty"`
+	RequestBytes     int64                  `protobuf:"varint,9,opt,name=request_bytes,json=requestBytes,proto3" json:"request_bytes,omitempty"`
+	ResponseBytes    int64                  `protobuf:"varint,10,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+	PromptTokens     int64                  `protobuf:"varint,11,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                  `protobuf:"varint,12,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UsageSummary) Reset() {
+	*x = UsageSummary{}
+	mi := &file_model_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSummary) ProtoMessage() {}
+
+func (x *UsageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSummary.ProtoReflect.Descriptor instead.
+func (*UsageSummary) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UsageSummary) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *UsageSummary) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetTotalLatencyMs() int64 {
+	if x != nil {
+		return x.TotalLatencyMs
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetMaxLatencyMs() int64 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetRequestBytes() int64 {
+	if x != nil {
+		return x.RequestBytes
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetResponseBytes() int64 {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+// ListUsageResponse is the response for ListUsage
+type ListUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UsageSummary        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageResponse) Reset() {
+	*x = ListUsageResponse{}
+	mi := &file_model_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageResponse) ProtoMessage() {}
+
+func (x *ListUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageResponse.ProtoReflect.Descriptor instead.
+func (*ListUsageResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListUsageResponse) GetItems() []*UsageSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// DiscountTier discounts the part of an invoice subtotal from a threshold up to the next tier
+type DiscountTier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromMicros    int64                  `protobuf:"varint,1,opt,name=from_micros,json=fromMicros,proto3" json:"from_micros,omitempty"`
+	Percent       float64                `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountTier) Reset() {
+	*x = DiscountTier{}
+	mi := &file_model_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountTier) ProtoMessage() {}
+
+func (x *DiscountTier) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountTier.ProtoReflect.Descriptor instead.
+func (*DiscountTier) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DiscountTier) GetFromMicros() int64 {
+	if x != nil {
+		return x.FromMicros
+	}
+	return 0
+}
+
+func (x *DiscountTier) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+// PricingPlan prices metered usage; amounts are millionths of the currency unit
+type PricingPlan struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Currency    string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Per request, per 1,000 tokens and per GB-month
+	RequestPriceMicros int64                  `protobuf:"varint,5,opt,name=request_price_micros,json=requestPriceMicros,proto3" json:"request_price_micros,omitempty"`
+	TokenPriceMicros   int64                  `protobuf:"varint,6,opt,name=token_price_micros,json=tokenPriceMicros,proto3" json:"token_price_micros,omitempty"`
+	StoragePriceMicros int64                  `protobuf:"varint,7,opt,name=storage_price_micros,json=storagePriceMicros,proto3" json:"storage_price_micros,omitempty"`
+	FreeRequests       int64                  `protobuf:"varint,8,opt,name=free_requests,json=freeRequests,proto3" json:"free_requests,omitempty"`
+	FreeTokens         int64                  `protobuf:"varint,9,opt,name=free_tokens,json=freeTokens,proto3" json:"free_tokens,omitempty"`
+	FreeStorageGbMonth float64                `protobuf:"fixed64,10,opt,name=free_storage_gb_month,json=freeStorageGbMonth,proto3" json:"free_storage_gb_month,omitempty"`
+	DiscountTiers      []*DiscountTier        `protobuf:"bytes,11,rep,name=discount_tiers,json=discountTiers,proto3" json:"discount_tiers,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PricingPlan) Reset() {
+	*x = PricingPlan{}
+	mi := &file_model_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingPlan) ProtoMessage() {}
+
+func (x *PricingPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingPlan.ProtoReflect.Descriptor instead.
+func (*PricingPlan) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *PricingPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PricingPlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PricingPlan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PricingPlan) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PricingPlan) GetRequestPriceMicros() int64 {
+	if x != nil {
+		return x.RequestPriceMicros
+	}
+	return 0
+}
+
+func (x *PricingPlan) GetTokenPriceMicros() int64 {
+	if x != nil {
+		return x.TokenPriceMicros
+	}
+	return 0
+}
+
+func (x *PricingPlan) GetStoragePriceMicros() int64 {
+	if x != nil {
+		return x.StoragePriceMicros
+	}
+	return 0
+}
+
+func (x *PricingPlan) GetFreeRequests() int64 {
+	if x != nil {
+		return x.FreeRequests
+	}
+	return 0
+}
+
+func (x *PricingPlan) GetFreeTokens() int64 {
+	if x != nil {
+		return x.FreeTokens
+	}
+	return 0
+}
+
+func (x *PricingPlan) GetFreeStorageGbMonth() float64 {
+	if x != nil {
+		return x.FreeStorageGbMonth
+	}
+	return 0
+}
+
+func (x *PricingPlan) GetDiscountTiers() []*DiscountTier {
+	if x != nil {
+		return x.DiscountTiers
+	}
+	return nil
+}
+
+func (x *PricingPlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PricingPlan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreatePricingPlanRequest is the request for CreatePricingPlan; the id is ignored
+type CreatePricingPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *PricingPlan           `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePricingPlanRequest) Reset() {
+	*x = CreatePricingPlanRequest{}
+	mi := &file_model_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePricingPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePricingPlanRequest) ProtoMessage() {}
+
+func (x *CreatePricingPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePricingPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreatePricingPlanRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreatePricingPlanRequest) GetPlan() *PricingPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// CreatePricingPlanResponse is the response for CreatePricingPlan
+type CreatePricingPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *PricingPlan           `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePricingPlanResponse) Reset() {
+	*x = CreatePricingPlanResponse{}
+	mi := &file_model_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePricingPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePricingPlanResponse) ProtoMessage() {}
+
+func (x *CreatePricingPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePricingPlanResponse.ProtoReflect.Descriptor instead.
+func (*CreatePricingPlanResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreatePricingPlanResponse) GetPlan() *PricingPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// UpdatePricingPlanRequest is the request for UpdatePricingPlan; the whole plan is replaced
+type UpdatePricingPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *PricingPlan           `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePricingPlanRequest) Reset() {
+	*x = UpdatePricingPlanRequest{}
+	mi := &file_model_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePricingPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePricingPlanRequest) ProtoMessage() {}
+
+func (x *UpdatePricingPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePricingPlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePricingPlanRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *UpdatePricingPlanRequest) GetPlan() *PricingPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// UpdatePricingPlanResponse is the response for UpdatePricingPlan
+type UpdatePricingPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *PricingPlan           `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePricingPlanResponse) Reset() {
+	*x = UpdatePricingPlanResponse{}
+	mi := &file_model_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePricingPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePricingPlanResponse) ProtoMessage() {}
+
+func (x *UpdatePricingPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePricingPlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePricingPlanResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *UpdatePricingPlanResponse) GetPlan() *PricingPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// ListPricingPlansRequest is the request for ListPricingPlans
+type ListPricingPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPricingPlansRequest) Reset() {
+	*x = ListPricingPlansRequest{}
+	mi := &file_model_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPricingPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPricingPlansRequest) ProtoMessage() {}
+
+func (x *ListPricingPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPricingPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPricingPlansRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{119}
+}
+
+// ListPricingPlansResponse is the response for ListPricingPlans
+type ListPricingPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*PricingPlan         `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPricingPlansResponse) Reset() {
+	*x = ListPricingPlansResponse{}
+	mi := &file_model_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPricingPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPricingPlansResponse) ProtoMessage() {}
+
+func (x *ListPricingPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPricingPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListPricingPlansResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListPricingPlansResponse) GetPlans() []*PricingPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// SetTenantPlanRequest is the request for SetTenantPlan
+type SetTenantPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PlanId        string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTenantPlanRequest) Reset() {
+	*x = SetTenantPlanRequest{}
+	mi := &file_model_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTenantPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTenantPlanRequest) ProtoMessage() {}
+
+func (x *SetTenantPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTenantPlanRequest.ProtoReflect.Descriptor instead.
+func (*SetTenantPlanRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *SetTenantPlanRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SetTenantPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// InvoiceLineItem is a single charge or discount on an invoice
+type InvoiceLineItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind is requests, tokens, storage or discount
+	Kind            string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Description     string  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ModelId         string  `protobuf:"bytes,3,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	Quantity        float64 `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit            string  `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	UnitPriceMicros int64   `protobuf:"varint,6,opt,name=unit_price_micros,json=unitPriceMicros,proto3" json:"unit_price_micros,omitempty"`
+	// Negative for discounts
+	AmountMicros  int64 `protobuf:"varint,7,opt,name=amount_micros,json=amountMicros,proto3" json:"amount_micros,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceLineItem) Reset() {
+	*x = InvoiceLineItem{}
+	mi := &file_model_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLineItem) ProtoMessage() {}
+
+func (x *InvoiceLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLineItem.ProtoReflect.Descriptor instead.
+func (*InvoiceLineItem) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *InvoiceLineItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetUnitPriceMicros() int64 {
+	if x != nil {
+		return x.UnitPriceMicros
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetAmountMicros() int64 {
+	if x != nil {
+		return x.AmountMicros
+	}
+	return 0
+}
+
+// Invoice charges a tenant for one calendar month
+type Invoice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId       string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PeriodStart    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	PlanId         string                 `protobuf:"bytes,5,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PlanName       string                 `protobuf:"bytes,6,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	Currency       string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	LineItems      []*InvoiceLineItem     `protobuf:"bytes,8,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	SubtotalMicros int64                  `protobuf:"varint,9,opt,name=subtotal_micros,json=subtotalMicros,proto3" json:"subtotal_micros,omitempty"`
+	DiscountMicros int64                  `protobuf:"varint,10,opt,name=discount_micros,json=discountMicros,proto3" json:"discount_micros,omitempty"`
+	TotalMicros    int64                  `protobuf:"varint,11,opt,name=total_micros,json=totalMicros,proto3" json:"total_micros,omitempty"`
+	// Final is set once the invoice was generated after its period ended
+	Final         bool                   `protobuf:"varint,12,opt,name=final,proto3" json:"final,omitempty"`
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_model_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Invoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invoice) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *Invoice) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *Invoice) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *Invoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Invoice) GetLineItems() []*InvoiceLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Invoice) GetSubtotalMicros() int64 {
+	if x != nil {
+		return x.SubtotalMicros
+	}
+	return 0
+}
+
+func (x *Invoice) GetDiscountMicros() int64 {
+	if x != nil {
+		return x.DiscountMicros
+	}
+	return 0
+}
+
+func (x *Invoice) GetTotalMicros() int64 {
+	if x != nil {
+		return x.TotalMicros
+	}
+	return 0
+}
+
+func (x *Invoice) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+func (x *Invoice) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+// GenerateInvoiceRequest is the request for GenerateInvoice
+type GenerateInvoiceRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Any instant in the month to invoice
+	Month         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateInvoiceRequest) Reset() {
+	*x = GenerateInvoiceRequest{}
+	mi := &file_model_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateInvoiceRequest) ProtoMessage() {}
+
+func (x *GenerateInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GenerateInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GenerateInvoiceRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GenerateInvoiceRequest) GetMonth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Month
+	}
+	return nil
+}
+
+// GenerateInvoiceResponse is the response for GenerateInvoice
+type GenerateInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateInvoiceResponse) Reset() {
+	*x = GenerateInvoiceResponse{}
+	mi := &file_model_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateInvoiceResponse) ProtoMessage() {}
+
+func (x *GenerateInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*GenerateInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GenerateInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+// GetInvoiceRequest is the request for GetInvoice
+type GetInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_model_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetInvoiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetInvoiceResponse is the response for GetInvoice
+type GetInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceResponse) Reset() {
+	*x = GetInvoiceResponse{}
+	mi := &file_model_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceResponse) ProtoMessage() {}
+
+func (x *GetInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+// ListInvoicesRequest is the request for ListInvoices; from and to bound the period start
+type ListInvoicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_model_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListInvoicesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListInvoicesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListInvoicesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListInvoicesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInvoicesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListInvoicesResponse is the response for ListInvoices
+type ListInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*Invoice             `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	mi := &file_model_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *ListInvoicesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListInvoicesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInvoicesResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+var File_model_proto protoreflect.FileDescriptor
+
+const file_model_proto_rawDesc = "" +
+	"\n" +
+	"\vmodel.proto\x12\x05model\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xe2\x04\n" +
+	"\x05Model\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x1c\n" +
+	"\tframework\x18\x05 \x01(\tR\tframework\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\b \x01(\tR\bchecksum\x12!\n" +
+	"\fstorage_path\x18\t \x01(\tR\vstoragePath\x12!\n" +
+	"\fdocker_image\x18\n" +
+	" \x01(\tR\vdockerImage\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\x19\n" +
+	"\bowner_id\x18\f \x01(\tR\aownerId\x12\x1b\n" +
+	"\ttenant_id\x18\r \x01(\tR\btenantId\x12\x1b\n" +
+	"\tis_public\x18\x0e \x01(\bR\bisPublic\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x123\n" +
+	"\tsignature\x18\x12 \x01(\v2\x15.model.ModelSignatureR\tsignature\"\x89\x01\n" +
+	"\n" +
+	"TensorSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05dtype\x18\x02 \x01(\tR\x05dtype\x12\x14\n" +
+	"\x05shape\x18\x03 \x03(\x03R\x05shape\x12\x1f\n" +
+	"\vjson_schema\x18\x04 \x01(\tR\n" +
+	"jsonSchema\x12\x1a\n" +
+	"\boptional\x18\x05 \x01(\bR\boptional\"h\n" +
+	"\x0eModelSignature\x12)\n" +
+	"\x06inputs\x18\x01 \x03(\v2\x11.model.TensorSpecR\x06inputs\x12+\n" +
+	"\aoutputs\x18\x02 \x03(\v2\x11.model.TensorSpecR\aoutputs\"\xa2\x03\n" +
+	"\x12CreateModelRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1c\n" +
+	"\tframework\x18\x04 \x01(\tR\tframework\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12C\n" +
+	"\bmetadata\x18\x06 \x03(\v2'.model.CreateModelRequest.MetadataEntryR\bmetadata\x12\x19\n" +
+	"\bowner_id\x18\a \x01(\tR\aownerId\x12\x1b\n" +
+	"\ttenant_id\x18\b \x01(\tR\btenantId\x12\x1b\n" +
+	"\tis_public\x18\t \x01(\bR\bisPublic\x123\n" +
+	"\tsignature\x18\n" +
+	" \x01(\v2\x15.model.ModelSignatureR\tsignature\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"9\n" +
 	"\x13CreateModelResponse\x12\"\n" +
 	"\x05model\x18\x01 \x01(\v2\f.model.ModelR\x05model\"!\n" +
 	"\x0fGetModelRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
 	"\x10GetModelResponse\x12\"\n" +
-	"\x05model\x18\x01 \x01(\v2\f.model.ModelR\x05model\"\xf0\x01\n" +
+	"\x05model\x18\x01 \x01(\v2\f.model.ModelR\x05model\"\x99\x02\n" +
 	"\x11ListModelsRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
 	"\tframework\x18\x02 \x01(\tR\tframework\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x19\n" +
 	"\bowner_id\x18\x04 \x01(\tR\aownerId\x12\x1b\n" +
 	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x12\x12\n" +
-	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1b\n" +
-	"\tis_public\x18\a \x01(\bR\bisPublic\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12 \n" +
+	"\tis_public\x18\a \x01(\bH\x00R\bisPublic\x88\x01\x01\x12\x12\n" +
 	"\x04page\x18\b \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\t \x01(\x05R\x05limit\"z\n" +
+	"\x05limit\x18\t \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05scope\x18\n" +
+	" \x01(\tR\x05scopeB\f\n" +
+	"\n" +
+	"_is_public\"z\n" +
 	"\x12ListModelsResponse\x12$\n" +
 	"\x06models\x18\x01 \x03(\v2\f.model.ModelR\x06models\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
@@ -1202,9 +9220,10 @@ const file_model_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"9\n" +
 	"\x13UpdateModelResponse\x12\"\n" +
-	"\x05model\x18\x01 \x01(\v2\f.model.ModelR\x05model\"$\n" +
+	"\x05model\x18\x01 \x01(\v2\f.model.ModelR\x05model\":\n" +
 	"\x12DeleteModelRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"B\n" +
 	"\x18UpdateModelStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\"?\n" +
@@ -1223,12 +9242,673 @@ const file_model_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"4\n" +
 	"\x17GetModelMetadataRequest\x12\x19\n" +
-	"\bmodel_id\x18\x01 \x01(\tR\amodelId\"\xa2\x01\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\"\xd7\x01\n" +
 	"\x18GetModelMetadataResponse\x12I\n" +
-	"\bmetadata\x18\x01 \x03(\v2-.model.GetModelMetadataResponse.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x01 \x03(\v2-.model.GetModelMetadataResponse.MetadataEntryR\bmetadata\x123\n" +
+	"\tsignature\x18\x02 \x01(\v2\x15.model.ModelSignatureR\tsignature\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xe3\x05\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa6\x01\n" +
+	"\x18ListDeletedModelsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\tR\x05scope\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"%\n" +
+	"\x13RestoreModelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x14RestoreModelResponse\x12\"\n" +
+	"\x05model\x18\x01 \x01(\v2\f.model.ModelR\x05model\"#\n" +
+	"\x11PurgeModelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9b\x01\n" +
+	"\x12WatchModelsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tmodel_ids\x18\x02 \x03(\tR\bmodelIds\x12\x14\n" +
+	"\x05names\x18\x03 \x03(\tR\x05names\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\"\xc8\x01\n" +
+	"\x0fModelWatchEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\"\n" +
+	"\x05model\x18\x02 \x01(\v2\f.model.ModelR\x05model\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x8f\x03\n" +
+	"\fModelVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmodel_id\x18\x02 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\x06 \x01(\tR\bchecksum\x12!\n" +
+	"\fstorage_path\x18\a \x01(\tR\vstoragePath\x12!\n" +
+	"\fdocker_image\x18\b \x01(\tR\vdockerImage\x12\x1d\n" +
+	"\n" +
+	"change_log\x18\t \x01(\tR\tchangeLog\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\tsignature\x18\f \x01(\v2\x15.model.ModelSignatureR\tsignature\"\x9a\x02\n" +
+	"\x19CreateModelVersionRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\x04 \x01(\tR\bchecksum\x12!\n" +
+	"\fstorage_path\x18\x05 \x01(\tR\vstoragePath\x12!\n" +
+	"\fdocker_image\x18\x06 \x01(\tR\vdockerImage\x12\x1d\n" +
+	"\n" +
+	"change_log\x18\a \x01(\tR\tchangeLog\x123\n" +
+	"\tsignature\x18\b \x01(\v2\x15.model.ModelSignatureR\tsignature\"K\n" +
+	"\x1aCreateModelVersionResponse\x12-\n" +
+	"\aversion\x18\x01 \x01(\v2\x13.model.ModelVersionR\aversion\"5\n" +
+	"\x18ListModelVersionsRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\"L\n" +
+	"\x19ListModelVersionsResponse\x12/\n" +
+	"\bversions\x18\x01 \x03(\v2\x13.model.ModelVersionR\bversions\"L\n" +
+	"\x15PromoteVersionRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"<\n" +
+	"\x16PromoteVersionResponse\x12\"\n" +
+	"\x05model\x18\x01 \x01(\v2\f.model.ModelR\x05model\"W\n" +
+	"\rDataReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"r\n" +
+	"\n" +
+	"CardMetric\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x18\n" +
+	"\adataset\x18\x03 \x01(\tR\adataset\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\xc2\x03\n" +
+	"\tModelCard\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12!\n" +
+	"\fintended_use\x18\x02 \x01(\tR\vintendedUse\x12)\n" +
+	"\x11out_of_scope_uses\x18\x03 \x03(\tR\x0eoutOfScopeUses\x12 \n" +
+	"\vlimitations\x18\x04 \x03(\tR\vlimitations\x129\n" +
+	"\rtraining_data\x18\x05 \x03(\v2\x14.model.DataReferenceR\ftrainingData\x12+\n" +
+	"\ametrics\x18\x06 \x03(\v2\x11.model.CardMetricR\ametrics\x125\n" +
+	"\x16ethical_considerations\x18\a \x01(\tR\x15ethicalConsiderations\x12\x18\n" +
+	"\alicense\x18\b \x01(\tR\alicense\x12\x18\n" +
+	"\acontact\x18\t \x01(\tR\acontact\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\n" +
+	" \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\x13GetModelCardRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"p\n" +
+	"\x13SetModelCardRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12$\n" +
+	"\x04card\x18\x03 \x01(\v2\x10.model.ModelCardR\x04card\"\x8d\x01\n" +
+	"\x11ModelCardResponse\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x1d\n" +
+	"\n" +
+	"model_name\x18\x02 \x01(\tR\tmodelName\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12$\n" +
+	"\x04card\x18\x04 \x01(\v2\x10.model.ModelCardR\x04card\"\xca\x02\n" +
+	"\n" +
+	"Evaluation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmodel_id\x18\x02 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x16\n" +
+	"\x06metric\x18\x04 \x01(\tR\x06metric\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\x12\x18\n" +
+	"\adataset\x18\x06 \x01(\tR\adataset\x12\x14\n" +
+	"\x05split\x18\a \x01(\tR\x05split\x12=\n" +
+	"\fevaluated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vevaluatedAt\x12\x1f\n" +
+	"\vrecorded_by\x18\t \x01(\tR\n" +
+	"recordedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xaf\x01\n" +
+	"\x10EvaluationResult\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x18\n" +
+	"\adataset\x18\x03 \x01(\tR\adataset\x12\x14\n" +
+	"\x05split\x18\x04 \x01(\tR\x05split\x12=\n" +
+	"\fevaluated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vevaluatedAt\"\x82\x01\n" +
+	"\x18RecordEvaluationsRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x121\n" +
+	"\aresults\x18\x03 \x03(\v2\x17.model.EvaluationResultR\aresults\"P\n" +
+	"\x19RecordEvaluationsResponse\x123\n" +
+	"\vevaluations\x18\x01 \x03(\v2\x11.model.EvaluationR\vevaluations\"e\n" +
+	"\x16ListEvaluationsRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x16\n" +
+	"\x06metric\x18\x03 \x01(\tR\x06metric\"N\n" +
+	"\x17ListEvaluationsResponse\x123\n" +
+	"\vevaluations\x18\x01 \x03(\v2\x11.model.EvaluationR\vevaluations\"\xa2\x02\n" +
+	"\rPromotionRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06metric\x18\x02 \x01(\tR\x06metric\x12\x18\n" +
+	"\adataset\x18\x03 \x01(\tR\adataset\x12\x14\n" +
+	"\x05split\x18\x04 \x01(\tR\x05split\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\x12%\n" +
+	"\x0emax_regression\x18\x06 \x01(\x01R\rmaxRegression\x12\x1a\n" +
+	"\brelative\x18\a \x01(\bR\brelative\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"5\n" +
+	"\x18GetPromotionRulesRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\"a\n" +
+	"\x18SetPromotionRulesRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12*\n" +
+	"\x05rules\x18\x02 \x03(\v2\x14.model.PromotionRuleR\x05rules\"D\n" +
+	"\x16PromotionRulesResponse\x12*\n" +
+	"\x05rules\x18\x01 \x03(\v2\x14.model.PromotionRuleR\x05rules\"\xdb\x02\n" +
+	"\vLineageEdge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmodel_id\x18\x02 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1a\n" +
+	"\brelation\x18\x04 \x01(\tR\brelation\x12&\n" +
+	"\x0fparent_model_id\x18\x05 \x01(\tR\rparentModelId\x12%\n" +
+	"\x0eparent_version\x18\x06 \x01(\tR\rparentVersion\x12!\n" +
+	"\fdataset_name\x18\a \x01(\tR\vdatasetName\x12\x1f\n" +
+	"\vdataset_uri\x18\b \x01(\tR\n" +
+	"datasetUri\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xfb\x01\n" +
+	"\x15AddLineageEdgeRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1a\n" +
+	"\brelation\x18\x03 \x01(\tR\brelation\x12&\n" +
+	"\x0fparent_model_id\x18\x04 \x01(\tR\rparentModelId\x12%\n" +
+	"\x0eparent_version\x18\x05 \x01(\tR\rparentVersion\x12!\n" +
+	"\fdataset_name\x18\x06 \x01(\tR\vdatasetName\x12\x1f\n" +
+	"\vdataset_uri\x18\a \x01(\tR\n" +
+	"datasetUri\"N\n" +
+	"\x18RemoveLineageEdgeRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x17\n" +
+	"\aedge_id\x18\x02 \x01(\tR\x06edgeId\"b\n" +
+	"\x11GetLineageRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x05R\x05depth\"\xcf\x01\n" +
+	"\vLineageNode\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x19\n" +
+	"\bmodel_id\x18\x02 \x01(\tR\amodelId\x12\x1d\n" +
+	"\n" +
+	"model_name\x18\x03 \x01(\tR\tmodelName\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12!\n" +
+	"\fdataset_name\x18\x05 \x01(\tR\vdatasetName\x12\x1f\n" +
+	"\vdataset_uri\x18\x06 \x01(\tR\n" +
+	"datasetUri\x12\x14\n" +
+	"\x05depth\x18\a \x01(\x05R\x05depth\"\xd2\x01\n" +
+	"\x0fLineageResponse\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x05R\x05depth\x12(\n" +
+	"\x05nodes\x18\x04 \x03(\v2\x12.model.LineageNodeR\x05nodes\x12(\n" +
+	"\x05edges\x18\x05 \x03(\v2\x12.model.LineageEdgeR\x05edges\x12\x1c\n" +
+	"\ttruncated\x18\x06 \x01(\bR\ttruncated\"\xe7\x02\n" +
+	"\x0eApprovalPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12-\n" +
+	"\x12required_approvals\x18\x04 \x01(\x05R\x11requiredApprovals\x12#\n" +
+	"\rapprover_role\x18\x05 \x01(\tR\fapproverRole\x12'\n" +
+	"\x0ftimeout_seconds\x18\x06 \x01(\x03R\x0etimeoutSeconds\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\":\n" +
+	"\x1bListApprovalPoliciesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"Q\n" +
+	"\x1cListApprovalPoliciesResponse\x121\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x15.model.ApprovalPolicyR\bpolicies\"R\n" +
+	"\x1bDeleteApprovalPolicyRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\"\xa2\x01\n" +
+	"\x10ApprovalDecision\x12\x1f\n" +
+	"\vapprover_id\x18\x01 \x01(\tR\n" +
+	"approverId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xdf\x04\n" +
+	"\x0fApprovalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x19\n" +
+	"\bmodel_id\x18\x03 \x01(\tR\amodelId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12#\n" +
+	"\rtarget_status\x18\x05 \x01(\tR\ftargetStatus\x12%\n" +
+	"\x0etarget_version\x18\x06 \x01(\tR\rtargetVersion\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x12-\n" +
+	"\x12required_approvals\x18\b \x01(\x05R\x11requiredApprovals\x12#\n" +
+	"\rapprover_role\x18\t \x01(\tR\fapproverRole\x12!\n" +
+	"\frequested_by\x18\n" +
+	" \x01(\tR\vrequestedBy\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\x125\n" +
+	"\tdecisions\x18\f \x03(\v2\x17.model.ApprovalDecisionR\tdecisions\x129\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12@\n" +
+	"\vresolved_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"resolvedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_resolved_at\"\x95\x01\n" +
+	"\x1bListApprovalRequestsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x19\n" +
+	"\bmodel_id\x18\x02 \x01(\tR\amodelId\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x92\x01\n" +
+	"\x1cListApprovalRequestsResponse\x122\n" +
+	"\brequests\x18\x01 \x03(\v2\x16.model.ApprovalRequestR\brequests\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"+\n" +
+	"\x19GetApprovalRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"b\n" +
+	"\x1cDecideApprovalRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"_\n" +
+	"\x16CompareVersionsRequest\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12\x12\n" +
+	"\x04base\x18\x02 \x01(\tR\x04base\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\"\x82\x02\n" +
+	"\n" +
+	"MetricDiff\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x12\x18\n" +
+	"\adataset\x18\x02 \x01(\tR\adataset\x12\x14\n" +
+	"\x05split\x18\x03 \x01(\tR\x05split\x12\x17\n" +
+	"\x04base\x18\x04 \x01(\x01H\x00R\x04base\x88\x01\x01\x12\x1b\n" +
+	"\x06target\x18\x05 \x01(\x01H\x01R\x06target\x88\x01\x01\x12\x19\n" +
+	"\x05delta\x18\x06 \x01(\x01H\x02R\x05delta\x88\x01\x01\x12*\n" +
+	"\x0erelative_delta\x18\a \x01(\x01H\x03R\rrelativeDelta\x88\x01\x01B\a\n" +
+	"\x05_baseB\t\n" +
+	"\a_targetB\b\n" +
+	"\x06_deltaB\x11\n" +
+	"\x0f_relative_delta\"x\n" +
+	"\n" +
+	"TensorDiff\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06change\x18\x03 \x01(\tR\x06change\x12\x12\n" +
+	"\x04base\x18\x04 \x01(\tR\x04base\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\tR\x06target\"M\n" +
+	"\tFieldDiff\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04base\x18\x02 \x01(\tR\x04base\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\"g\n" +
+	"\tRuleCheck\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.model.PromotionRuleR\x04rule\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xda\x02\n" +
+	"\x17CompareVersionsResponse\x12\x19\n" +
+	"\bmodel_id\x18\x01 \x01(\tR\amodelId\x12'\n" +
+	"\x04base\x18\x02 \x01(\v2\x13.model.ModelVersionR\x04base\x12+\n" +
+	"\x06target\x18\x03 \x01(\v2\x13.model.ModelVersionR\x06target\x12+\n" +
+	"\ametrics\x18\x04 \x03(\v2\x11.model.MetricDiffR\ametrics\x12/\n" +
+	"\tsignature\x18\x05 \x03(\v2\x11.model.TensorDiffR\tsignature\x12(\n" +
+	"\x06fields\x18\x06 \x03(\v2\x10.model.FieldDiffR\x06fields\x12&\n" +
+	"\x05rules\x18\a \x03(\v2\x10.model.RuleCheckR\x05rules\x12\x1e\n" +
+	"\n" +
+	"promotable\x18\b \x01(\bR\n" +
+	"promotable\"\x88\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x16\n" +
+	"\x06action\x18\a \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\b \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\t \x01(\tR\btargetId\x12\x16\n" +
+	"\x06before\x18\n" +
+	" \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\v \x01(\tR\x05after\x12\x12\n" +
+	"\x04diff\x18\f \x01(\tR\x04diff\x12&\n" +
+	"\x0factor_tenant_id\x18\r \x01(\tR\ractorTenantId\"\xed\x01\n" +
+	"\x17RecordAuditEventRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\x02 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06before\x18\a \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\b \x01(\tR\x05after\"\x8b\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12.\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x12\n" +
+	"\x04page\x18\a \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\"\x84\x01\n" +
+	"\x17ListAuditEventsResponse\x12)\n" +
+	"\x06events\x18\x01 \x03(\v2\x11.model.AuditEventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xd3\x02\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x19\n" +
+	"\bmodel_id\x18\x05 \x01(\tR\amodelId\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xce\x04\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x19\n" +
+	"\bmodel_id\x18\x05 \x01(\tR\amodelId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12#\n" +
+	"\rresponse_code\x18\b \x01(\x05R\fresponseCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12#\n" +
+	"\rredelivery_of\x18\n" +
+	" \x01(\tR\fredeliveryOf\x12\x18\n" +
+	"\apayload\x18\v \x01(\tR\apayload\x12B\n" +
+	"\x0fnext_attempt_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12B\n" +
+	"\x0flast_attempt_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\rlastAttemptAt\x12=\n" +
+	"\fdelivered_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa3\x01\n" +
+	"\x14CreateWebhookRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x19\n" +
+	"\bmodel_id\x18\x04 \x01(\tR\amodelId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"Y\n" +
+	"\x15CreateWebhookResponse\x12(\n" +
+	"\awebhook\x18\x01 \x01(\v2\x0e.model.WebhookR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"#\n" +
+	"\x11GetWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x12GetWebhookResponse\x12(\n" +
+	"\awebhook\x18\x01 \x01(\v2\x0e.model.WebhookR\awebhook\"\\\n" +
+	"\x13ListWebhooksRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x82\x01\n" +
+	"\x14ListWebhooksResponse\x12*\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x0e.model.WebhookR\bwebhooks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xbf\x02\n" +
+	"\x14UpdateWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tH\x00R\x03url\x88\x01\x01\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12&\n" +
+	"\x0fset_event_types\x18\x04 \x01(\bR\rsetEventTypes\x12\x1e\n" +
+	"\bmodel_id\x18\x05 \x01(\tH\x01R\amodelId\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x02R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06active\x18\a \x01(\bH\x03R\x06active\x88\x01\x01\x12#\n" +
+	"\rrotate_secret\x18\b \x01(\bR\frotateSecretB\x06\n" +
+	"\x04_urlB\v\n" +
+	"\t_model_idB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_active\"Y\n" +
+	"\x15UpdateWebhookResponse\x12(\n" +
+	"\awebhook\x18\x01 \x01(\v2\x0e.model.WebhookR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9a\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x97\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x126\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x16.model.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\":\n" +
+	"\x17RedeliverWebhookRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\"N\n" +
+	"\x18RedeliverWebhookResponse\x122\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x16.model.WebhookDeliveryR\bdelivery\"\x95\x03\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x06 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\a \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc6\x01\n" +
+	"\x13CreateAPIKeyRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"V\n" +
+	"\x14CreateAPIKeyResponse\x12&\n" +
+	"\aapi_key\x18\x01 \x01(\v2\r.model.APIKeyR\x06apiKey\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\"\n" +
+	"\x10GetAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x11GetAPIKeyResponse\x12&\n" +
+	"\aapi_key\x18\x01 \x01(\v2\r.model.APIKeyR\x06apiKey\"\x84\x01\n" +
+	"\x12ListAPIKeysRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12'\n" +
+	"\x0finclude_revoked\x18\x02 \x01(\bR\x0eincludeRevoked\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x7f\n" +
+	"\x13ListAPIKeysResponse\x12(\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\r.model.APIKeyR\aapiKeys\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"%\n" +
+	"\x13RotateAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x14RotateAPIKeyResponse\x12&\n" +
+	"\aapi_key\x18\x01 \x01(\v2\r.model.APIKeyR\x06apiKey\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"%\n" +
+	"\x13RevokeAPIKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x14RevokeAPIKeyResponse\x12&\n" +
+	"\aapi_key\x18\x01 \x01(\v2\r.model.APIKeyR\x06apiKey\"'\n" +
+	"\x13VerifyAPIKeyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\">\n" +
+	"\x14VerifyAPIKeyResponse\x12&\n" +
+	"\aapi_key\x18\x01 \x01(\v2\r.model.APIKeyR\x06apiKey\"\x8c\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\tR\btenantId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\rlast_login_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\"\xd2\x01\n" +
+	"\x14ProvisionUserRequest\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12%\n" +
+	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x12\x1b\n" +
+	"\ttenant_id\x18\a \x01(\tR\btenantId\"R\n" +
+	"\x15ProvisionUserResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.model.UserR\x04user\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"Q\n" +
+	"\x17AuthenticateUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\";\n" +
+	"\x18AuthenticateUserResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.model.UserR\x04user\"\xe2\x03\n" +
+	"\vUsageRecord\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x03 \x01(\tR\bapiKeyId\x12\x19\n" +
+	"\bmodel_id\x18\x04 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\a \x01(\x03R\tlatencyMs\x12#\n" +
+	"\rrequest_bytes\x18\b \x01(\x03R\frequestBytes\x12%\n" +
+	"\x0eresponse_bytes\x18\t \x01(\x03R\rresponseBytes\x12#\n" +
+	"\rprompt_tokens\x18\n" +
+	" \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\v \x01(\x03R\x10completionTokens\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\f \x01(\tR\trequestId\x12;\n" +
+	"\voccurred_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\bevent_id\x18\x0e \x01(\tR\aeventId\"B\n" +
+	"\x12RecordUsageRequest\x12,\n" +
+	"\arecords\x18\x01 \x03(\v2\x12.model.UsageRecordR\arecords\"1\n" +
+	"\x13RecordUsageResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\"\xc1\x01\n" +
+	"\x10ListUsageRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x19\n" +
+	"\bmodel_id\x18\x02 \x01(\tR\amodelId\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x19\n" +
+	"\bgroup_by\x18\x05 \x03(\tR\agroupBy\"\xc1\x03\n" +
+	"\fUsageSummary\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x19\n" +
+	"\bmodel_id\x18\x03 \x01(\tR\amodelId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\brequests\x18\x06 \x01(\x03R\brequests\x12(\n" +
+	"\x10total_latency_ms\x18\a \x01(\x03R\x0etotalLatencyMs\x12$\n" +
+	"\x0emax_latency_ms\x18\b \x01(\x03R\fmaxLatencyMs\x12#\n" +
+	"\rrequest_bytes\x18\t \x01(\x03R\frequestBytes\x12%\n" +
+	"\x0eresponse_bytes\x18\n" +
+	" \x01(\x03R\rresponseBytes\x12#\n" +
+	"\rprompt_tokens\x18\v \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\f \x01(\x03R\x10completionTokens\">\n" +
+	"\x11ListUsageResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.model.UsageSummaryR\x05items\"I\n" +
+	"\fDiscountTier\x12\x1f\n" +
+	"\vfrom_micros\x18\x01 \x01(\x03R\n" +
+	"fromMicros\x12\x18\n" +
+	"\apercent\x18\x02 \x01(\x01R\apercent\"\xac\x04\n" +
+	"\vPricingPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x120\n" +
+	"\x14request_price_micros\x18\x05 \x01(\x03R\x12requestPriceMicros\x12,\n" +
+	"\x12token_price_micros\x18\x06 \x01(\x03R\x10tokenPriceMicros\x120\n" +
+	"\x14storage_price_micros\x18\a \x01(\x03R\x12storagePriceMicros\x12#\n" +
+	"\rfree_requests\x18\b \x01(\x03R\ffreeRequests\x12\x1f\n" +
+	"\vfree_tokens\x18\t \x01(\x03R\n" +
+	"freeTokens\x121\n" +
+	"\x15free_storage_gb_month\x18\n" +
+	" \x01(\x01R\x12freeStorageGbMonth\x12:\n" +
+	"\x0ediscount_tiers\x18\v \x03(\v2\x13.model.DiscountTierR\rdiscountTiers\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"B\n" +
+	"\x18CreatePricingPlanRequest\x12&\n" +
+	"\x04plan\x18\x01 \x01(\v2\x12.model.PricingPlanR\x04plan\"C\n" +
+	"\x19CreatePricingPlanResponse\x12&\n" +
+	"\x04plan\x18\x01 \x01(\v2\x12.model.PricingPlanR\x04plan\"B\n" +
+	"\x18UpdatePricingPlanRequest\x12&\n" +
+	"\x04plan\x18\x01 \x01(\v2\x12.model.PricingPlanR\x04plan\"C\n" +
+	"\x19UpdatePricingPlanResponse\x12&\n" +
+	"\x04plan\x18\x01 \x01(\v2\x12.model.PricingPlanR\x04plan\"\x19\n" +
+	"\x17ListPricingPlansRequest\"D\n" +
+	"\x18ListPricingPlansResponse\x12(\n" +
+	"\x05plans\x18\x01 \x03(\v2\x12.model.PricingPlanR\x05plans\"L\n" +
+	"\x14SetTenantPlanRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\tR\x06planId\"\xe3\x01\n" +
+	"\x0fInvoiceLineItem\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
+	"\bmodel_id\x18\x03 \x01(\tR\amodelId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12*\n" +
+	"\x11unit_price_micros\x18\x06 \x01(\x03R\x0funitPriceMicros\x12#\n" +
+	"\ramount_micros\x18\a \x01(\x03R\famountMicros\"\x83\x04\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12=\n" +
+	"\fperiod_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x17\n" +
+	"\aplan_id\x18\x05 \x01(\tR\x06planId\x12\x1b\n" +
+	"\tplan_name\x18\x06 \x01(\tR\bplanName\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x125\n" +
+	"\n" +
+	"line_items\x18\b \x03(\v2\x16.model.InvoiceLineItemR\tlineItems\x12'\n" +
+	"\x0fsubtotal_micros\x18\t \x01(\x03R\x0esubtotalMicros\x12'\n" +
+	"\x0fdiscount_micros\x18\n" +
+	" \x01(\x03R\x0ediscountMicros\x12!\n" +
+	"\ftotal_micros\x18\v \x01(\x03R\vtotalMicros\x12\x14\n" +
+	"\x05final\x18\f \x01(\bR\x05final\x12=\n" +
+	"\fgenerated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"g\n" +
+	"\x16GenerateInvoiceRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x120\n" +
+	"\x05month\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05month\"C\n" +
+	"\x17GenerateInvoiceResponse\x12(\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x0e.model.InvoiceR\ainvoice\"#\n" +
+	"\x11GetInvoiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x12GetInvoiceResponse\x12(\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x0e.model.InvoiceR\ainvoice\"\xb8\x01\n" +
+	"\x13ListInvoicesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x82\x01\n" +
+	"\x14ListInvoicesResponse\x12*\n" +
+	"\binvoices\x18\x01 \x03(\v2\x0e.model.InvoiceR\binvoices\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit2\x86\x14\n" +
 	"\fModelService\x12D\n" +
 	"\vCreateModel\x12\x19.model.CreateModelRequest\x1a\x1a.model.CreateModelResponse\x12;\n" +
 	"\bGetModel\x12\x16.model.GetModelRequest\x1a\x17.model.GetModelResponse\x12A\n" +
@@ -1240,7 +9920,67 @@ const file_model_proto_rawDesc = "" +
 	"\fAddModelTags\x12\x1a.model.AddModelTagsRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
 	"\x0fRemoveModelTags\x12\x1d.model.RemoveModelTagsRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
 	"\x10SetModelMetadata\x12\x1e.model.SetModelMetadataRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
-	"\x10GetModelMetadata\x12\x1e.model.GetModelMetadataRequest\x1a\x1f.model.GetModelMetadataResponseB8Z6github.com/17882237881/MaaS/shared/proto/model;modelpbb\x06proto3"
+	"\x10GetModelMetadata\x12\x1e.model.GetModelMetadataRequest\x1a\x1f.model.GetModelMetadataResponse\x12O\n" +
+	"\x11ListDeletedModels\x12\x1f.model.ListDeletedModelsRequest\x1a\x19.model.ListModelsResponse\x12G\n" +
+	"\fRestoreModel\x12\x1a.model.RestoreModelRequest\x1a\x1b.model.RestoreModelResponse\x12>\n" +
+	"\n" +
+	"PurgeModel\x12\x18.model.PurgeModelRequest\x1a\x16.google.protobuf.Empty\x12Y\n" +
+	"\x12CreateModelVersion\x12 .model.CreateModelVersionRequest\x1a!.model.CreateModelVersionResponse\x12V\n" +
+	"\x11ListModelVersions\x12\x1f.model.ListModelVersionsRequest\x1a .model.ListModelVersionsResponse\x12M\n" +
+	"\x0ePromoteVersion\x12\x1c.model.PromoteVersionRequest\x1a\x1d.model.PromoteVersionResponse\x12D\n" +
+	"\fGetModelCard\x12\x1a.model.GetModelCardRequest\x1a\x18.model.ModelCardResponse\x12D\n" +
+	"\fSetModelCard\x12\x1a.model.SetModelCardRequest\x1a\x18.model.ModelCardResponse\x12V\n" +
+	"\x11RecordEvaluations\x12\x1f.model.RecordEvaluationsRequest\x1a .model.RecordEvaluationsResponse\x12P\n" +
+	"\x0fListEvaluations\x12\x1d.model.ListEvaluationsRequest\x1a\x1e.model.ListEvaluationsResponse\x12P\n" +
+	"\x0fCompareVersions\x12\x1d.model.CompareVersionsRequest\x1a\x1e.model.CompareVersionsResponse\x12S\n" +
+	"\x11GetPromotionRules\x12\x1f.model.GetPromotionRulesRequest\x1a\x1d.model.PromotionRulesResponse\x12S\n" +
+	"\x11SetPromotionRules\x12\x1f.model.SetPromotionRulesRequest\x1a\x1d.model.PromotionRulesResponse\x12B\n" +
+	"\x0eAddLineageEdge\x12\x1c.model.AddLineageEdgeRequest\x1a\x12.model.LineageEdge\x12L\n" +
+	"\x11RemoveLineageEdge\x12\x1f.model.RemoveLineageEdgeRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\n" +
+	"GetLineage\x12\x18.model.GetLineageRequest\x1a\x16.model.LineageResponse\x12_\n" +
+	"\x14ListApprovalPolicies\x12\".model.ListApprovalPoliciesRequest\x1a#.model.ListApprovalPoliciesResponse\x12A\n" +
+	"\x11SetApprovalPolicy\x12\x15.model.ApprovalPolicy\x1a\x15.model.ApprovalPolicy\x12R\n" +
+	"\x14DeleteApprovalPolicy\x12\".model.DeleteApprovalPolicyRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x14ListApprovalRequests\x12\".model.ListApprovalRequestsRequest\x1a#.model.ListApprovalRequestsResponse\x12N\n" +
+	"\x12GetApprovalRequest\x12 .model.GetApprovalRequestRequest\x1a\x16.model.ApprovalRequest\x12T\n" +
+	"\x15DecideApprovalRequest\x12#.model.DecideApprovalRequestRequest\x1a\x16.model.ApprovalRequest\x12B\n" +
+	"\vWatchModels\x12\x19.model.WatchModelsRequest\x1a\x16.model.ModelWatchEvent0\x012\xf5\x01\n" +
+	"\fAuditService\x12J\n" +
+	"\x10RecordAuditEvent\x12\x1e.model.RecordAuditEventRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x0fListAuditEvents\x12\x1d.model.ListAuditEventsRequest\x1a\x1e.model.ListAuditEventsResponse\x12G\n" +
+	"\x11ExportAuditEvents\x12\x1d.model.ListAuditEventsRequest\x1a\x11.model.AuditEvent0\x012\xb3\x04\n" +
+	"\x0eWebhookService\x12J\n" +
+	"\rCreateWebhook\x12\x1b.model.CreateWebhookRequest\x1a\x1c.model.CreateWebhookResponse\x12A\n" +
+	"\n" +
+	"GetWebhook\x12\x18.model.GetWebhookRequest\x1a\x19.model.GetWebhookResponse\x12G\n" +
+	"\fListWebhooks\x12\x1a.model.ListWebhooksRequest\x1a\x1b.model.ListWebhooksResponse\x12J\n" +
+	"\rUpdateWebhook\x12\x1b.model.UpdateWebhookRequest\x1a\x1c.model.UpdateWebhookResponse\x12D\n" +
+	"\rDeleteWebhook\x12\x1b.model.DeleteWebhookRequest\x1a\x16.google.protobuf.Empty\x12b\n" +
+	"\x15ListWebhookDeliveries\x12#.model.ListWebhookDeliveriesRequest\x1a$.model.ListWebhookDeliveriesResponse\x12S\n" +
+	"\x10RedeliverWebhook\x12\x1e.model.RedeliverWebhookRequest\x1a\x1f.model.RedeliverWebhookResponse2\xb9\x03\n" +
+	"\rAPIKeyService\x12G\n" +
+	"\fCreateAPIKey\x12\x1a.model.CreateAPIKeyRequest\x1a\x1b.model.CreateAPIKeyResponse\x12>\n" +
+	"\tGetAPIKey\x12\x17.model.GetAPIKeyRequest\x1a\x18.model.GetAPIKeyResponse\x12D\n" +
+	"\vListAPIKeys\x12\x19.model.ListAPIKeysRequest\x1a\x1a.model.ListAPIKeysResponse\x12G\n" +
+	"\fRotateAPIKey\x12\x1a.model.RotateAPIKeyRequest\x1a\x1b.model.RotateAPIKeyResponse\x12G\n" +
+	"\fRevokeAPIKey\x12\x1a.model.RevokeAPIKeyRequest\x1a\x1b.model.RevokeAPIKeyResponse\x12G\n" +
+	"\fVerifyAPIKey\x12\x1a.model.VerifyAPIKeyRequest\x1a\x1b.model.VerifyAPIKeyResponse2\xae\x01\n" +
+	"\vUserService\x12J\n" +
+	"\rProvisionUser\x12\x1b.model.ProvisionUserRequest\x1a\x1c.model.ProvisionUserResponse\x12S\n" +
+	"\x10AuthenticateUser\x12\x1e.model.AuthenticateUserRequest\x1a\x1f.model.AuthenticateUserResponse2\x94\x01\n" +
+	"\fUsageService\x12D\n" +
+	"\vRecordUsage\x12\x19.model.RecordUsageRequest\x1a\x1a.model.RecordUsageResponse\x12>\n" +
+	"\tListUsage\x12\x17.model.ListUsageRequest\x1a\x18.model.ListUsageResponse2\xb9\x04\n" +
+	"\x0eBillingService\x12V\n" +
+	"\x11CreatePricingPlan\x12\x1f.model.CreatePricingPlanRequest\x1a .model.CreatePricingPlanResponse\x12V\n" +
+	"\x11UpdatePricingPlan\x12\x1f.model.UpdatePricingPlanRequest\x1a .model.UpdatePricingPlanResponse\x12S\n" +
+	"\x10ListPricingPlans\x12\x1e.model.ListPricingPlansRequest\x1a\x1f.model.ListPricingPlansResponse\x12D\n" +
+	"\rSetTenantPlan\x12\x1b.model.SetTenantPlanRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x0fGenerateInvoice\x12\x1d.model.GenerateInvoiceRequest\x1a\x1e.model.GenerateInvoiceResponse\x12A\n" +
+	"\n" +
+	"GetInvoice\x12\x18.model.GetInvoiceRequest\x1a\x19.model.GetInvoiceResponse\x12G\n" +
+	"\fListInvoices\x12\x1a.model.ListInvoicesRequest\x1a\x1b.model.ListInvoicesResponseB8Z6github.com/17882237881/MaaS/shared/proto/model;modelpbb\x06proto3"
 
 var (
 	file_model_proto_rawDescOnce sync.Once
@@ -1254,69 +9994,385 @@ func file_model_proto_rawDescGZIP() []byte {
 	return file_model_proto_rawDescData
 }
 
-var file_model_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_model_proto_msgTypes = make([]protoimpl.MessageInfo, 134)
 var file_model_proto_goTypes = []any{
-	(*Model)(nil),                     // 0: model.Model
-	(*CreateModelRequest)(nil),        // 1: model.CreateModelRequest
-	(*CreateModelResponse)(nil),       // 2: model.CreateModelResponse
-	(*GetModelRequest)(nil),           // 3: model.GetModelRequest
-	(*GetModelResponse)(nil),          // 4: model.GetModelResponse
-	(*ListModelsRequest)(nil),         // 5: model.ListModelsRequest
-	(*ListModelsResponse)(nil),        // 6: model.ListModelsResponse
-	(*UpdateModelRequest)(nil),        // 7: model.UpdateModelRequest
-	(*UpdateModelResponse)(nil),       // 8: model.UpdateModelResponse
-	(*DeleteModelRequest)(nil),        // 9: model.DeleteModelRequest
-	(*UpdateModelStatusRequest)(nil),  // 10: model.UpdateModelStatusRequest
-	(*UpdateModelStatusResponse)(nil), // 11: model.UpdateModelStatusResponse
-	(*AddModelTagsRequest)(nil),       // 12: model.AddModelTagsRequest
-	(*RemoveModelTagsRequest)(nil),    // 13: model.RemoveModelTagsRequest
-	(*SetModelMetadataRequest)(nil),   // 14: model.SetModelMetadataRequest
-	(*GetModelMetadataRequest)(nil),   // 15: model.GetModelMetadataRequest
-	(*GetModelMetadataResponse)(nil),  // 16: model.GetModelMetadataResponse
-	nil,                               // 17: model.CreateModelRequest.MetadataEntry
-	nil,                               // 18: model.UpdateModelRequest.MetadataEntry
-	nil,                               // 19: model.SetModelMetadataRequest.MetadataEntry
-	nil,                               // 20: model.GetModelMetadataResponse.MetadataEntry
-	(*timestamppb.Timestamp)(nil),     // 21: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),             // 22: google.protobuf.Empty
+	(*Model)(nil),                         // 0: model.Model
+	(*TensorSpec)(nil),                    // 1: model.TensorSpec
+	(*ModelSignature)(nil),                // 2: model.ModelSignature
+	(*CreateModelRequest)(nil),            // 3: model.CreateModelRequest
+	(*CreateModelResponse)(nil),           // 4: model.CreateModelResponse
+	(*GetModelRequest)(nil),               // 5: model.GetModelRequest
+	(*GetModelResponse)(nil),              // 6: model.GetModelResponse
+	(*ListModelsRequest)(nil),             // 7: model.ListModelsRequest
+	(*ListModelsResponse)(nil),            // 8: model.ListModelsResponse
+	(*UpdateModelRequest)(nil),            // 9: model.UpdateModelRequest
+	(*UpdateModelResponse)(nil),           // 10: model.UpdateModelResponse
+	(*DeleteModelRequest)(nil),            // 11: model.DeleteModelRequest
+	(*UpdateModelStatusRequest)(nil),      // 12: model.UpdateModelStatusRequest
+	(*UpdateModelStatusResponse)(nil),     // 13: model.UpdateModelStatusResponse
+	(*AddModelTagsRequest)(nil),           // 14: model.AddModelTagsRequest
+	(*RemoveModelTagsRequest)(nil),        // 15: model.RemoveModelTagsRequest
+	(*SetModelMetadataRequest)(nil),       // 16: model.SetModelMetadataRequest
+	(*GetModelMetadataRequest)(nil),       // 17: model.GetModelMetadataRequest
+	(*GetModelMetadataResponse)(nil),      // 18: model.GetModelMetadataResponse
+	(*ListDeletedModelsRequest)(nil),      // 19: model.ListDeletedModelsRequest
+	(*RestoreModelRequest)(nil),           // 20: model.RestoreModelRequest
+	(*RestoreModelResponse)(nil),          // 21: model.RestoreModelResponse
+	(*PurgeModelRequest)(nil),             // 22: model.PurgeModelRequest
+	(*WatchModelsRequest)(nil),            // 23: model.WatchModelsRequest
+	(*ModelWatchEvent)(nil),               // 24: model.ModelWatchEvent
+	(*ModelVersion)(nil),                  // 25: model.ModelVersion
+	(*CreateModelVersionRequest)(nil),     // 26: model.CreateModelVersionRequest
+	(*CreateModelVersionResponse)(nil),    // 27: model.CreateModelVersionResponse
+	(*ListModelVersionsRequest)(nil),      // 28: model.ListModelVersionsRequest
+	(*ListModelVersionsResponse)(nil),     // 29: model.ListModelVersionsResponse
+	(*PromoteVersionRequest)(nil),         // 30: model.PromoteVersionRequest
+	(*PromoteVersionResponse)(nil),        // 31: model.PromoteVersionResponse
+	(*DataReference)(nil),                 // 32: model.DataReference
+	(*CardMetric)(nil),                    // 33: model.CardMetric
+	(*ModelCard)(nil),                     // 34: model.ModelCard
+	(*GetModelCardRequest)(nil),           // 35: model.GetModelCardRequest
+	(*SetModelCardRequest)(nil),           // 36: model.SetModelCardRequest
+	(*ModelCardResponse)(nil),             // 37: model.ModelCardResponse
+	(*Evaluation)(nil),                    // 38: model.Evaluation
+	(*EvaluationResult)(nil),              // 39: model.EvaluationResult
+	(*RecordEvaluationsRequest)(nil),      // 40: model.RecordEvaluationsRequest
+	(*RecordEvaluationsResponse)(nil),     // 41: model.RecordEvaluationsResponse
+	(*ListEvaluationsRequest)(nil),        // 42: model.ListEvaluationsRequest
+	(*ListEvaluationsResponse)(nil),       // 43: model.ListEvaluationsResponse
+	(*PromotionRule)(nil),                 // 44: model.PromotionRule
+	(*GetPromotionRulesRequest)(nil),      // 45: model.GetPromotionRulesRequest
+	(*SetPromotionRulesRequest)(nil),      // 46: model.SetPromotionRulesRequest
+	(*PromotionRulesResponse)(nil),        // 47: model.PromotionRulesResponse
+	(*LineageEdge)(nil),                   // 48: model.LineageEdge
+	(*AddLineageEdgeRequest)(nil),         // 49: model.AddLineageEdgeRequest
+	(*RemoveLineageEdgeRequest)(nil),      // 50: model.RemoveLineageEdgeRequest
+	(*GetLineageRequest)(nil),             // 51: model.GetLineageRequest
+	(*LineageNode)(nil),                   // 52: model.LineageNode
+	(*LineageResponse)(nil),               // 53: model.LineageResponse
+	(*ApprovalPolicy)(nil),                // 54: model.ApprovalPolicy
+	(*ListApprovalPoliciesRequest)(nil),   // 55: model.ListApprovalPoliciesRequest
+	(*ListApprovalPoliciesResponse)(nil),  // 56: model.ListApprovalPoliciesResponse
+	(*DeleteApprovalPolicyRequest)(nil),   // 57: model.DeleteApprovalPolicyRequest
+	(*ApprovalDecision)(nil),              // 58: model.ApprovalDecision
+	(*ApprovalRequest)(nil),               // 59: model.ApprovalRequest
+	(*ListApprovalRequestsRequest)(nil),   // 60: model.ListApprovalRequestsRequest
+	(*ListApprovalRequestsResponse)(nil),  // 61: model.ListApprovalRequestsResponse
+	(*GetApprovalRequestRequest)(nil),     // 62: model.GetApprovalRequestRequest
+	(*DecideApprovalRequestRequest)(nil),  // 63: model.DecideApprovalRequestRequest
+	(*CompareVersionsRequest)(nil),        // 64: model.CompareVersionsRequest
+	(*MetricDiff)(nil),                    // 65: model.MetricDiff
+	(*TensorDiff)(nil),                    // 66: model.TensorDiff
+	(*FieldDiff)(nil),                     // 67: model.FieldDiff
+	(*RuleCheck)(nil),                     // 68: model.RuleCheck
+	(*CompareVersionsResponse)(nil),       // 69: model.CompareVersionsResponse
+	(*AuditEvent)(nil),                    // 70: model.AuditEvent
+	(*RecordAuditEventRequest)(nil),       // 71: model.RecordAuditEventRequest
+	(*ListAuditEventsRequest)(nil),        // 72: model.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),       // 73: model.ListAuditEventsResponse
+	(*Webhook)(nil),                       // 74: model.Webhook
+	(*WebhookDelivery)(nil),               // 75: model.WebhookDelivery
+	(*CreateWebhookRequest)(nil),          // 76: model.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),         // 77: model.CreateWebhookResponse
+	(*GetWebhookRequest)(nil),             // 78: model.GetWebhookRequest
+	(*GetWebhookResponse)(nil),            // 79: model.GetWebhookResponse
+	(*ListWebhooksRequest)(nil),           // 80: model.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),          // 81: model.ListWebhooksResponse
+	(*UpdateWebhookRequest)(nil),          // 82: model.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),         // 83: model.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),          // 84: model.DeleteWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),  // 85: model.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil), // 86: model.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),       // 87: model.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),      // 88: model.RedeliverWebhookResponse
+	(*APIKey)(nil),                        // 89: model.APIKey
+	(*CreateAPIKeyRequest)(nil),           // 90: model.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),          // 91: model.CreateAPIKeyResponse
+	(*GetAPIKeyRequest)(nil),              // 92: model.GetAPIKeyRequest
+	(*GetAPIKeyResponse)(nil),             // 93: model.GetAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),            // 94: model.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),           // 95: model.ListAPIKeysResponse
+	(*RotateAPIKeyRequest)(nil),           // 96: model.RotateAPIKeyRequest
+	(*RotateAPIKeyResponse)(nil),          // 97: model.RotateAPIKeyResponse
+	(*RevokeAPIKeyRequest)(nil),           // 98: model.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),          // 99: model.RevokeAPIKeyResponse
+	(*VerifyAPIKeyRequest)(nil),           // 100: model.VerifyAPIKeyRequest
+	(*VerifyAPIKeyResponse)(nil),          // 101: model.VerifyAPIKeyResponse
+	(*User)(nil),                          // 102: model.User
+	(*ProvisionUserRequest)(nil),          // 103: model.ProvisionUserRequest
+	(*ProvisionUserResponse)(nil),         // 104: model.ProvisionUserResponse
+	(*AuthenticateUserRequest)(nil),       // 105: model.AuthenticateUserRequest
+	(*AuthenticateUserResponse)(nil),      // 106: model.AuthenticateUserResponse
+	(*UsageRecord)(nil),                   // 107: model.UsageRecord
+	(*RecordUsageRequest)(nil),            // 108: model.RecordUsageRequest
+	(*RecordUsageResponse)(nil),           // 109: model.RecordUsageResponse
+	(*ListUsageRequest)(nil),              // 110: model.ListUsageRequest
+	(*UsageSummary)(nil),                  // 111: model.UsageSummary
+	(*ListUsageResponse)(nil),             // 112: model.ListUsageResponse
+	(*DiscountTier)(nil),                  // 113: model.DiscountTier
+	(*PricingPlan)(nil),                   // 114: model.PricingPlan
+	(*CreatePricingPlanRequest)(nil),      // 115: model.CreatePricingPlanRequest
+	(*CreatePricingPlanResponse)(nil),     // 116: model.CreatePricingPlanResponse
+	(*UpdatePricingPlanRequest)(nil),      // 117: model.UpdatePricingPlanRequest
+	(*UpdatePricingPlanResponse)(nil),     // 118: model.UpdatePricingPlanResponse
+	(*ListPricingPlansRequest)(nil),       // 119: model.ListPricingPlansRequest
+	(*ListPricingPlansResponse)(nil),      // 120: model.ListPricingPlansResponse
+	(*SetTenantPlanRequest)(nil),          // 121: model.SetTenantPlanRequest
+	(*InvoiceLineItem)(nil),               // 122: model.InvoiceLineItem
+	(*Invoice)(nil),                       // 123: model.Invoice
+	(*GenerateInvoiceRequest)(nil),        // 124: model.GenerateInvoiceRequest
+	(*GenerateInvoiceResponse)(nil),       // 125: model.GenerateInvoiceResponse
+	(*GetInvoiceRequest)(nil),             // 126: model.GetInvoiceRequest
+	(*GetInvoiceResponse)(nil),            // 127: model.GetInvoiceResponse
+	(*ListInvoicesRequest)(nil),           // 128: model.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),          // 129: model.ListInvoicesResponse
+	nil,                                   // 130: model.CreateModelRequest.MetadataEntry
+	nil,                                   // 131: model.UpdateModelRequest.MetadataEntry
+	nil,                                   // 132: model.SetModelMetadataRequest.MetadataEntry
+	nil,                                   // 133: model.GetModelMetadataResponse.MetadataEntry
+	(*timestamppb.Timestamp)(nil),         // 134: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 135: google.protobuf.Empty
 }
 var file_model_proto_depIdxs = []int32{
-	21, // 0: model.Model.created_at:type_name -> google.protobuf.Timestamp
-	21, // 1: model.Model.updated_at:type_name -> google.protobuf.Timestamp
-	17, // 2: model.CreateModelRequest.metadata:type_name -> model.CreateModelRequest.MetadataEntry
-	0,  // 3: model.CreateModelResponse.model:type_name -> model.Model
-	0,  // 4: model.GetModelResponse.model:type_name -> model.Model
-	0,  // 5: model.ListModelsResponse.models:type_name -> model.Model
-	18, // 6: model.UpdateModelRequest.metadata:type_name -> model.UpdateModelRequest.MetadataEntry
-	0,  // 7: model.UpdateModelResponse.model:type_name -> model.Model
-	0,  // 8: model.UpdateModelStatusResponse.model:type_name -> model.Model
-	19, // 9: model.SetModelMetadataRequest.metadata:type_name -> model.SetModelMetadataRequest.MetadataEntry
-	20, // 10: model.GetModelMetadataResponse.metadata:type_name -> model.GetModelMetadataResponse.MetadataEntry
-	1,  // 11: model.ModelService.CreateModel:input_type -> model.CreateModelRequest
-	3,  // 12: model.ModelService.GetModel:input_type -> model.GetModelRequest
-	5,  // 13: model.ModelService.ListModels:input_type -> model.ListModelsRequest
-	7,  // 14: model.ModelService.UpdateModel:input_type -> model.UpdateModelRequest
-	9,  // 15: model.ModelService.DeleteModel:input_type -> model.DeleteModelRequest
-	10, // 16: model.ModelService.UpdateModelStatus:input_type -> model.UpdateModelStatusRequest
-	12, // 17: model.ModelService.AddModelTags:input_type -> model.AddModelTagsRequest
-	13, // 18: model.ModelService.RemoveModelTags:input_type -> model.RemoveModelTagsRequest
-	14, // 19: model.ModelService.SetModelMetadata:input_type -> model.SetModelMetadataRequest
-	15, // 20: model.ModelService.GetModelMetadata:input_type -> model.GetModelMetadataRequest
-	2,  // 21: model.ModelService.CreateModel:output_type -> model.CreateModelResponse
-	4,  // 22: model.ModelService.GetModel:output_type -> model.GetModelResponse
-	6,  // 23: model.ModelService.ListModels:output_type -> model.ListModelsResponse
-	8,  // 24: model.ModelService.UpdateModel:output_type -> model.UpdateModelResponse
-	22, // 25: model.ModelService.DeleteModel:output_type -> google.protobuf.Empty
-	11, // 26: model.ModelService.UpdateModelStatus:output_type -> model.UpdateModelStatusResponse
-	22, // 27: model.ModelService.AddModelTags:output_type -> google.protobuf.Empty
-	22, // 28: model.ModelService.RemoveModelTags:output_type -> google.protobuf.Empty
-	22, // 29: model.ModelService.SetModelMetadata:output_type -> google.protobuf.Empty
-	16, // 30: model.ModelService.GetModelMetadata:output_type -> model.GetModelMetadataResponse
-	21, // [21:31] is the sub-list for method output_type
-	11, // [11:21] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	134, // 0: model.Model.created_at:type_name -> google.protobuf.Timestamp
+	134, // 1: model.Model.updated_at:type_name -> google.protobuf.Timestamp
+	134, // 2: model.Model.deleted_at:type_name -> google.protobuf.Timestamp
+	2,   // 3: model.Model.signature:type_name -> model.ModelSignature
+	1,   // 4: model.ModelSignature.inputs:type_name -> model.TensorSpec
+	1,   // 5: model.ModelSignature.outputs:type_name -> model.TensorSpec
+	130, // 6: model.CreateModelRequest.metadata:type_name -> model.CreateModelRequest.MetadataEntry
+	2,   // 7: model.CreateModelRequest.signature:type_name -> model.ModelSignature
+	0,   // 8: model.CreateModelResponse.model:type_name -> model.Model
+	0,   // 9: model.GetModelResponse.model:type_name -> model.Model
+	0,   // 10: model.ListModelsResponse.models:type_name -> model.Model
+	131, // 11: model.UpdateModelRequest.metadata:type_name -> model.UpdateModelRequest.MetadataEntry
+	0,   // 12: model.UpdateModelResponse.model:type_name -> model.Model
+	0,   // 13: model.UpdateModelStatusResponse.model:type_name -> model.Model
+	132, // 14: model.SetModelMetadataRequest.metadata:type_name -> model.SetModelMetadataRequest.MetadataEntry
+	133, // 15: model.GetModelMetadataResponse.metadata:type_name -> model.GetModelMetadataResponse.MetadataEntry
+	2,   // 16: model.GetModelMetadataResponse.signature:type_name -> model.ModelSignature
+	0,   // 17: model.RestoreModelResponse.model:type_name -> model.Model
+	0,   // 18: model.ModelWatchEvent.model:type_name -> model.Model
+	134, // 19: model.ModelWatchEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	134, // 20: model.ModelVersion.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 21: model.ModelVersion.signature:type_name -> model.ModelSignature
+	2,   // 22: model.CreateModelVersionRequest.signature:type_name -> model.ModelSignature
+	25,  // 23: model.CreateModelVersionResponse.version:type_name -> model.ModelVersion
+	25,  // 24: model.ListModelVersionsResponse.versions:type_name -> model.ModelVersion
+	0,   // 25: model.PromoteVersionResponse.model:type_name -> model.Model
+	32,  // 26: model.ModelCard.training_data:type_name -> model.DataReference
+	33,  // 27: model.ModelCard.metrics:type_name -> model.CardMetric
+	134, // 28: model.ModelCard.updated_at:type_name -> google.protobuf.Timestamp
+	34,  // 29: model.SetModelCardRequest.card:type_name -> model.ModelCard
+	34,  // 30: model.ModelCardResponse.card:type_name -> model.ModelCard
+	134, // 31: model.Evaluation.evaluated_at:type_name -> google.protobuf.Timestamp
+	134, // 32: model.Evaluation.created_at:type_name -> google.protobuf.Timestamp
+	134, // 33: model.EvaluationResult.evaluated_at:type_name -> google.protobuf.Timestamp
+	39,  // 34: model.RecordEvaluationsRequest.results:type_name -> model.EvaluationResult
+	38,  // 35: model.RecordEvaluationsResponse.evaluations:type_name -> model.Evaluation
+	38,  // 36: model.ListEvaluationsResponse.evaluations:type_name -> model.Evaluation
+	134, // 37: model.PromotionRule.created_at:type_name -> google.protobuf.Timestamp
+	44,  // 38: model.SetPromotionRulesRequest.rules:type_name -> model.PromotionRule
+	44,  // 39: model.PromotionRulesResponse.rules:type_name -> model.PromotionRule
+	134, // 40: model.LineageEdge.created_at:type_name -> google.protobuf.Timestamp
+	52,  // 41: model.LineageResponse.nodes:type_name -> model.LineageNode
+	48,  // 42: model.LineageResponse.edges:type_name -> model.LineageEdge
+	134, // 43: model.ApprovalPolicy.created_at:type_name -> google.protobuf.Timestamp
+	134, // 44: model.ApprovalPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	54,  // 45: model.ListApprovalPoliciesResponse.policies:type_name -> model.ApprovalPolicy
+	134, // 46: model.ApprovalDecision.created_at:type_name -> google.protobuf.Timestamp
+	58,  // 47: model.ApprovalRequest.decisions:type_name -> model.ApprovalDecision
+	134, // 48: model.ApprovalRequest.expires_at:type_name -> google.protobuf.Timestamp
+	134, // 49: model.ApprovalRequest.resolved_at:type_name -> google.protobuf.Timestamp
+	134, // 50: model.ApprovalRequest.created_at:type_name -> google.protobuf.Timestamp
+	59,  // 51: model.ListApprovalRequestsResponse.requests:type_name -> model.ApprovalRequest
+	44,  // 52: model.RuleCheck.rule:type_name -> model.PromotionRule
+	25,  // 53: model.CompareVersionsResponse.base:type_name -> model.ModelVersion
+	25,  // 54: model.CompareVersionsResponse.target:type_name -> model.ModelVersion
+	65,  // 55: model.CompareVersionsResponse.metrics:type_name -> model.MetricDiff
+	66,  // 56: model.CompareVersionsResponse.signature:type_name -> model.TensorDiff
+	67,  // 57: model.CompareVersionsResponse.fields:type_name -> model.FieldDiff
+	68,  // 58: model.CompareVersionsResponse.rules:type_name -> model.RuleCheck
+	134, // 59: model.AuditEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	134, // 60: model.ListAuditEventsRequest.from:type_name -> google.protobuf.Timestamp
+	134, // 61: model.ListAuditEventsRequest.to:type_name -> google.protobuf.Timestamp
+	70,  // 62: model.ListAuditEventsResponse.events:type_name -> model.AuditEvent
+	134, // 63: model.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	134, // 64: model.Webhook.updated_at:type_name -> google.protobuf.Timestamp
+	134, // 65: model.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	134, // 66: model.WebhookDelivery.last_attempt_at:type_name -> google.protobuf.Timestamp
+	134, // 67: model.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	134, // 68: model.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 69: model.CreateWebhookResponse.webhook:type_name -> model.Webhook
+	74,  // 70: model.GetWebhookResponse.webhook:type_name -> model.Webhook
+	74,  // 71: model.ListWebhooksResponse.webhooks:type_name -> model.Webhook
+	74,  // 72: model.UpdateWebhookResponse.webhook:type_name -> model.Webhook
+	75,  // 73: model.ListWebhookDeliveriesResponse.deliveries:type_name -> model.WebhookDelivery
+	75,  // 74: model.RedeliverWebhookResponse.delivery:type_name -> model.WebhookDelivery
+	134, // 75: model.APIKey.expires_at:type_name -> google.protobuf.Timestamp
+	134, // 76: model.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	134, // 77: model.APIKey.revoked_at:type_name -> google.protobuf.Timestamp
+	134, // 78: model.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	134, // 79: model.CreateAPIKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	89,  // 80: model.CreateAPIKeyResponse.api_key:type_name -> model.APIKey
+	89,  // 81: model.GetAPIKeyResponse.api_key:type_name -> model.APIKey
+	89,  // 82: model.ListAPIKeysResponse.api_keys:type_name -> model.APIKey
+	89,  // 83: model.RotateAPIKeyResponse.api_key:type_name -> model.APIKey
+	89,  // 84: model.RevokeAPIKeyResponse.api_key:type_name -> model.APIKey
+	89,  // 85: model.VerifyAPIKeyResponse.api_key:type_name -> model.APIKey
+	134, // 86: model.User.created_at:type_name -> google.protobuf.Timestamp
+	134, // 87: model.User.last_login_at:type_name -> google.protobuf.Timestamp
+	102, // 88: model.ProvisionUserResponse.user:type_name -> model.User
+	102, // 89: model.AuthenticateUserResponse.user:type_name -> model.User
+	134, // 90: model.UsageRecord.occurred_at:type_name -> google.protobuf.Timestamp
+	107, // 91: model.RecordUsageRequest.records:type_name -> model.UsageRecord
+	134, // 92: model.ListUsageRequest.from:type_name -> google.protobuf.Timestamp
+	134, // 93: model.ListUsageRequest.to:type_name -> google.protobuf.Timestamp
+	134, // 94: model.UsageSummary.period_start:type_name -> google.protobuf.Timestamp
+	111, // 95: model.ListUsageResponse.items:type_name -> model.UsageSummary
+	113, // 96: model.PricingPlan.discount_tiers:type_name -> model.DiscountTier
+	134, // 97: model.PricingPlan.created_at:type_name -> google.protobuf.Timestamp
+	134, // 98: model.PricingPlan.updated_at:type_name -> google.protobuf.Timestamp
+	114, // 99: model.CreatePricingPlanRequest.plan:type_name -> model.PricingPlan
+	114, // 100: model.CreatePricingPlanResponse.plan:type_name -> model.PricingPlan
+	114, // 101: model.UpdatePricingPlanRequest.plan:type_name -> model.PricingPlan
+	114, // 102: model.UpdatePricingPlanResponse.plan:type_name -> model.PricingPlan
+	114, // 103: model.ListPricingPlansResponse.plans:type_name -> model.PricingPlan
+	134, // 104: model.Invoice.period_start:type_name -> google.protobuf.Timestamp
+	134, // 105: model.Invoice.period_end:type_name -> google.protobuf.Timestamp
+	122, // 106: model.Invoice.line_items:type_name -> model.InvoiceLineItem
+	134, // 107: model.Invoice.generated_at:type_name -> google.protobuf.Timestamp
+	134, // 108: model.GenerateInvoiceRequest.month:type_name -> google.protobuf.Timestamp
+	123, // 109: model.GenerateInvoiceResponse.invoice:type_name -> model.Invoice
+	123, // 110: model.GetInvoiceResponse.invoice:type_name -> model.Invoice
+	134, // 111: model.ListInvoicesRequest.from:type_name -> google.protobuf.Timestamp
+	134, // 112: model.ListInvoicesRequest.to:type_name -> google.protobuf.Timestamp
+	123, // 113: model.ListInvoicesResponse.invoices:type_name -> model.Invoice
+	3,   // 114: model.ModelService.CreateModel:input_type -> model.CreateModelRequest
+	5,   // 115: model.ModelService.GetModel:input_type -> model.GetModelRequest
+	7,   // 116: model.ModelService.ListModels:input_type -> model.ListModelsRequest
+	9,   // 117: model.ModelService.UpdateModel:input_type -> model.UpdateModelRequest
+	11,  // 118: model.ModelService.DeleteModel:input_type -> model.DeleteModelRequest
+	12,  // 119: model.ModelService.UpdateModelStatus:input_type -> model.UpdateModelStatusRequest
+	14,  // 120: model.ModelService.AddModelTags:input_type -> model.AddModelTagsRequest
+	15,  // 121: model.ModelService.RemoveModelTags:input_type -> model.RemoveModelTagsRequest
+	16,  // 122: model.ModelService.SetModelMetadata:input_type -> model.SetModelMetadataRequest
+	17,  // 123: model.ModelService.GetModelMetadata:input_type -> model.GetModelMetadataRequest
+	19,  // 124: model.ModelService.ListDeletedModels:input_type -> model.ListDeletedModelsRequest
+	20,  // 125: model.ModelService.RestoreModel:input_type -> model.RestoreModelRequest
+	22,  // 126: model.ModelService.PurgeModel:input_type -> model.PurgeModelRequest
+	26,  // 127: model.ModelService.CreateModelVersion:input_type -> model.CreateModelVersionRequest
+	28,  // 128: model.ModelService.ListModelVersions:input_type -> model.ListModelVersionsRequest
+	30,  // 129: model.ModelService.PromoteVersion:input_type -> model.PromoteVersionRequest
+	35,  // 130: model.ModelService.GetModelCard:input_type -> model.GetModelCardRequest
+	36,  // 131: model.ModelService.SetModelCard:input_type -> model.SetModelCardRequest
+	40,  // 132: model.ModelService.RecordEvaluations:input_type -> model.RecordEvaluationsRequest
+	42,  // 133: model.ModelService.ListEvaluations:input_type -> model.ListEvaluationsRequest
+	64,  // 134: model.ModelService.CompareVersions:input_type -> model.CompareVersionsRequest
+	45,  // 135: model.ModelService.GetPromotionRules:input_type -> model.GetPromotionRulesRequest
+	46,  // 136: model.ModelService.SetPromotionRules:input_type -> model.SetPromotionRulesRequest
+	49,  // 137: model.ModelService.AddLineageEdge:input_type -> model.AddLineageEdgeRequest
+	50,  // 138: model.ModelService.RemoveLineageEdge:input_type -> model.RemoveLineageEdgeRequest
+	51,  // 139: model.ModelService.GetLineage:input_type -> model.GetLineageRequest
+	55,  // 140: model.ModelService.ListApprovalPolicies:input_type -> model.ListApprovalPoliciesRequest
+	54,  // 141: model.ModelService.SetApprovalPolicy:input_type -> model.ApprovalPolicy
+	57,  // 142: model.ModelService.DeleteApprovalPolicy:input_type -> model.DeleteApprovalPolicyRequest
+	60,  // 143: model.ModelService.ListApprovalRequests:input_type -> model.ListApprovalRequestsRequest
+	62,  // 144: model.ModelService.GetApprovalRequest:input_type -> model.GetApprovalRequestRequest
+	63,  // 145: model.ModelService.DecideApprovalRequest:input_type -> model.DecideApprovalRequestRequest
+	23,  // 146: model.ModelService.WatchModels:input_type -> model.WatchModelsRequest
+	71,  // 147: model.AuditService.RecordAuditEvent:input_type -> model.RecordAuditEventRequest
+	72,  // 148: model.AuditService.ListAuditEvents:input_type -> model.ListAuditEventsRequest
+	72,  // 149: model.AuditService.ExportAuditEvents:input_type -> model.ListAuditEventsRequest
+	76,  // 150: model.WebhookService.CreateWebhook:input_type -> model.CreateWebhookRequest
+	78,  // 151: model.WebhookService.GetWebhook:input_type -> model.GetWebhookRequest
+	80,  // 152: model.WebhookService.ListWebhooks:input_type -> model.ListWebhooksRequest
+	82,  // 153: model.WebhookService.UpdateWebhook:input_type -> model.UpdateWebhookRequest
+	84,  // 154: model.WebhookService.DeleteWebhook:input_type -> model.DeleteWebhookRequest
+	85,  // 155: model.WebhookService.ListWebhookDeliveries:input_type -> model.ListWebhookDeliveriesRequest
+	87,  // 156: model.WebhookService.RedeliverWebhook:input_type -> model.RedeliverWebhookRequest
+	90,  // 157: model.APIKeyService.CreateAPIKey:input_type -> model.CreateAPIKeyRequest
+	92,  // 158: model.APIKeyService.GetAPIKey:input_type -> model.GetAPIKeyRequest
+	94,  // 159: model.APIKeyService.ListAPIKeys:input_type -> model.ListAPIKeysRequest
+	96,  // 160: model.APIKeyService.RotateAPIKey:input_type -> model.RotateAPIKeyRequest
+	98,  // 161: model.APIKeyService.RevokeAPIKey:input_type -> model.RevokeAPIKeyRequest
+	100, // 162: model.APIKeyService.VerifyAPIKey:input_type -> model.VerifyAPIKeyRequest
+	103, // 163: model.UserService.ProvisionUser:input_type -> model.ProvisionUserRequest
+	105, // 164: model.UserService.AuthenticateUser:input_type -> model.AuthenticateUserRequest
+	108, // 165: model.UsageService.RecordUsage:input_type -> model.RecordUsageRequest
+	110, // 166: model.UsageService.ListUsage:input_type -> model.ListUsageRequest
+	115, // 167: model.BillingService.CreatePricingPlan:input_type -> model.CreatePricingPlanRequest
+	117, // 168: model.BillingService.UpdatePricingPlan:input_type -> model.UpdatePricingPlanRequest
+	119, // 169: model.BillingService.ListPricingPlans:input_type -> model.ListPricingPlansRequest
+	121, // 170: model.BillingService.SetTenantPlan:input_type -> model.SetTenantPlanRequest
+	124, // 171: model.BillingService.GenerateInvoice:input_type -> model.GenerateInvoiceRequest
+	126, // 172: model.BillingService.GetInvoice:input_type -> model.GetInvoiceRequest
+	128, // 173: model.BillingService.ListInvoices:input_type -> model.ListInvoicesRequest
+	4,   // 174: model.ModelService.CreateModel:output_type -> model.CreateModelResponse
+	6,   // 175: model.ModelService.GetModel:output_type -> model.GetModelResponse
+	8,   // 176: model.ModelService.ListModels:output_type -> model.ListModelsResponse
+	10,  // 177: model.ModelService.UpdateModel:output_type -> model.UpdateModelResponse
+	135, // 178: model.ModelService.DeleteModel:output_type -> google.protobuf.Empty
+	13,  // 179: model.ModelService.UpdateModelStatus:output_type -> model.UpdateModelStatusResponse
+	135, // 180: model.ModelService.AddModelTags:output_type -> google.protobuf.Empty
+	135, // 181: model.ModelService.RemoveModelTags:output_type -> google.protobuf.Empty
+	135, // 182: model.ModelService.SetModelMetadata:output_type -> google.protobuf.Empty
+	18,  // 183: model.ModelService.GetModelMetadata:output_type -> model.GetModelMetadataResponse
+	8,   // 184: model.ModelService.ListDeletedModels:output_type -> model.ListModelsResponse
+	21,  // 185: model.ModelService.RestoreModel:output_type -> model.RestoreModelResponse
+	135, // 186: model.ModelService.PurgeModel:output_type -> google.protobuf.Empty
+	27,  // 187: model.ModelService.CreateModelVersion:output_type -> model.CreateModelVersionResponse
+	29,  // 188: model.ModelService.ListModelVersions:output_type -> model.ListModelVersionsResponse
+	31,  // 189: model.ModelService.PromoteVersion:output_type -> model.PromoteVersionResponse
+	37,  // 190: model.ModelService.GetModelCard:output_type -> model.ModelCardResponse
+	37,  // 191: model.ModelService.SetModelCard:output_type -> model.ModelCardResponse
+	41,  // 192: model.ModelService.RecordEvaluations:output_type -> model.RecordEvaluationsResponse
+	43,  // 193: model.ModelService.ListEvaluations:output_type -> model.ListEvaluationsResponse
+	69,  // 194: model.ModelService.CompareVersions:output_type -> model.CompareVersionsResponse
+	47,  // 195: model.ModelService.GetPromotionRules:output_type -> model.PromotionRulesResponse
+	47,  // 196: model.ModelService.SetPromotionRules:output_type -> model.PromotionRulesResponse
+	48,  // 197: model.ModelService.AddLineageEdge:output_type -> model.LineageEdge
+	135, // 198: model.ModelService.RemoveLineageEdge:output_type -> google.protobuf.Empty
+	53,  // 199: model.ModelService.GetLineage:output_type -> model.LineageResponse
+	56,  // 200: model.ModelService.ListApprovalPolicies:output_type -> model.ListApprovalPoliciesResponse
+	54,  // 201: model.ModelService.SetApprovalPolicy:output_type -> model.ApprovalPolicy
+	135, // 202: model.ModelService.DeleteApprovalPolicy:output_type -> google.protobuf.Empty
+	61,  // 203: model.ModelService.ListApprovalRequests:output_type -> model.ListApprovalRequestsResponse
+	59,  // 204: model.ModelService.GetApprovalRequest:output_type -> model.ApprovalRequest
+	59,  // 205: model.ModelService.DecideApprovalRequest:output_type -> model.ApprovalRequest
+	24,  // 206: model.ModelService.WatchModels:output_type -> model.ModelWatchEvent
+	135, // 207: model.AuditService.RecordAuditEvent:output_type -> google.protobuf.Empty
+	73,  // 208: model.AuditService.ListAuditEvents:output_type -> model.ListAuditEventsResponse
+	70,  // 209: model.AuditService.ExportAuditEvents:output_type -> model.AuditEvent
+	77,  // 210: model.WebhookService.CreateWebhook:output_type -> model.CreateWebhookResponse
+	79,  // 211: model.WebhookService.GetWebhook:output_type -> model.GetWebhookResponse
+	81,  // 212: model.WebhookService.ListWebhooks:output_type -> model.ListWebhooksResponse
+	83,  // 213: model.WebhookService.UpdateWebhook:output_type -> model.UpdateWebhookResponse
+	135, // 214: model.WebhookService.DeleteWebhook:output_type -> google.protobuf.Empty
+	86,  // 215: model.WebhookService.ListWebhookDeliveries:output_type -> model.ListWebhookDeliveriesResponse
+	88,  // 216: model.WebhookService.RedeliverWebhook:output_type -> model.RedeliverWebhookResponse
+	91,  // 217: model.APIKeyService.CreateAPIKey:output_type -> model.CreateAPIKeyResponse
+	93,  // 218: model.APIKeyService.GetAPIKey:output_type -> model.GetAPIKeyResponse
+	95,  // 219: model.APIKeyService.ListAPIKeys:output_type -> model.ListAPIKeysResponse
+	97,  // 220: model.APIKeyService.RotateAPIKey:output_type -> model.RotateAPIKeyResponse
+	99,  // 221: model.APIKeyService.RevokeAPIKey:output_type -> model.RevokeAPIKeyResponse
+	101, // 222: model.APIKeyService.VerifyAPIKey:output_type -> model.VerifyAPIKeyResponse
+	104, // 223: model.UserService.ProvisionUser:output_type -> model.ProvisionUserResponse
+	106, // 224: model.UserService.AuthenticateUser:output_type -> model.AuthenticateUserResponse
+	109, // 225: model.UsageService.RecordUsage:output_type -> model.RecordUsageResponse
+	112, // 226: model.UsageService.ListUsage:output_type -> model.ListUsageResponse
+	116, // 227: model.BillingService.CreatePricingPlan:output_type -> model.CreatePricingPlanResponse
+	118, // 228: model.BillingService.UpdatePricingPlan:output_type -> model.UpdatePricingPlanResponse
+	120, // 229: model.BillingService.ListPricingPlans:output_type -> model.ListPricingPlansResponse
+	135, // 230: model.BillingService.SetTenantPlan:output_type -> google.protobuf.Empty
+	125, // 231: model.BillingService.GenerateInvoice:output_type -> model.GenerateInvoiceResponse
+	127, // 232: model.BillingService.GetInvoice:output_type -> model.GetInvoiceResponse
+	129, // 233: model.BillingService.ListInvoices:output_type -> model.ListInvoicesResponse
+	174, // [174:234] is the sub-list for method output_type
+	114, // [114:174] is the sub-list for method input_type
+	114, // [114:114] is the sub-list for extension type_name
+	114, // [114:114] is the sub-list for extension extendee
+	0,   // [0:114] is the sub-list for field type_name
 }
 
 func init() { file_model_proto_init() }
@@ -1324,15 +10380,19 @@ func file_model_proto_init() {
 	if File_model_proto != nil {
 		return
 	}
+	file_model_proto_msgTypes[7].OneofWrappers = []any{}
+	file_model_proto_msgTypes[59].OneofWrappers = []any{}
+	file_model_proto_msgTypes[65].OneofWrappers = []any{}
+	file_model_proto_msgTypes[82].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_model_proto_rawDesc), len(file_model_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   134,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   7,
 		},
 		GoTypes:           file_model_proto_goTypes,
 		DependencyIndexes: file_model_proto_depIdxs,
@@ -0,0 +1,33 @@
+// Package query parses HTTP query parameters the same way in the gateway and
+// the model registry, so both REST APIs accept the same filters.
+package query
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseOptionalBool parses a tri-state boolean query value; empty means unset
+func ParseOptionalBool(value string) (*bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// ParseList accepts both repeated and comma-separated parameters, e.g. tags
+func ParseList(values []string) []string {
+	var items []string
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
@@ -0,0 +1,344 @@
+package signature
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// maxFieldErrors caps how many problems one validation reports; the walk
+// stops once it is reached
+const maxFieldErrors = 20
+
+// FieldError is one problem with one input value
+type FieldError struct {
+	// Field is the input path, such as image[0][2]
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError lists the problems found in an inference input
+type ValidationError struct {
+	Errors []FieldError
+	// Truncated is set when there were more problems than maxFieldErrors
+	Truncated bool
+}
+
+// Error summarizes the first problem
+func (e *ValidationError) Error() string {
+	if len(e.Errors) == 0 {
+		return "invalid input"
+	}
+	first := e.Errors[0]
+	msg := fmt.Sprintf("invalid input: %s: %s", first.Field, first.Message)
+	switch more := len(e.Errors) - 1; {
+	case e.Truncated:
+		msg += fmt.Sprintf(" (and over %d more)", more)
+	case more > 0:
+		msg += fmt.Sprintf(" (and %d more)", more)
+	}
+	return msg
+}
+
+// checker accumulates problems while walking an input
+type checker struct {
+	errs      []FieldError
+	truncated bool
+	// schema is the compiled schema of the spec being walked
+	schema *jsonschema.Schema
+}
+
+func (c *checker) fail(field, format string, args ...interface{}) {
+	if c.full() {
+		c.truncated = true
+		return
+	}
+	c.errs = append(c.errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// full reports whether no more problems will be recorded
+func (c *checker) full() bool {
+	return len(c.errs) >= maxFieldErrors
+}
+
+// Coerce checks input against the signature's inputs and returns it with
+// every element converted to its dtype: numeric strings become numbers,
+// integral floats become integers and "true"/"false" become booleans.
+// Problems are reported together as a *ValidationError.
+func (s *Signature) Coerce(input map[string]interface{}) (map[string]interface{}, error) {
+	c := &checker{}
+	out := make(map[string]interface{}, len(input))
+
+	known := make(map[string]bool, len(s.Inputs))
+	for _, spec := range s.Inputs {
+		known[spec.Name] = true
+		value, ok := input[spec.Name]
+		if !ok {
+			if !spec.Optional {
+				c.fail(spec.Name, "required input is missing")
+			}
+			continue
+		}
+		c.schema = nil
+		if len(spec.Schema) > 0 {
+			schema, err := compile(spec.Schema)
+			if err != nil {
+				c.fail(spec.Name, "model schema does not compile: %v", err)
+				continue
+			}
+			c.schema = schema
+		}
+		out[spec.Name] = c.tensor(spec, spec.Name, value, 0)
+	}
+
+	var unknown []string
+	for name := range input {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		c.fail(name, "unexpected input; the model accepts %s", strings.Join(s.inputNames(), ", "))
+	}
+
+	if len(c.errs) > 0 {
+		return nil, &ValidationError{Errors: c.errs, Truncated: c.truncated}
+	}
+	return out, nil
+}
+
+func (s *Signature) inputNames() []string {
+	names := make([]string, len(s.Inputs))
+	for i, spec := range s.Inputs {
+		names[i] = spec.Name
+	}
+	return names
+}
+
+// tensor checks the value at dimension dim of spec, returning it coerced
+func (c *checker) tensor(spec TensorSpec, field string, value interface{}, dim int) interface{} {
+	if dim == len(spec.Shape) {
+		return c.element(spec, field, value)
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		c.fail(field, "expected an array for dimension %d of shape %s, got %s", dim, formatShape(spec.Shape), describe(value))
+		return value
+	}
+	if size := spec.Shape[dim]; size != Dynamic && int64(len(items)) != size {
+		c.fail(field, "expected %d elements along dimension %d of shape %s, got %d", size, dim, formatShape(spec.Shape), len(items))
+		return value
+	}
+
+	out := make([]interface{}, len(items))
+	for i, item := range items {
+		if c.full() {
+			c.truncated = true
+			return value
+		}
+		out[i] = c.tensor(spec, fmt.Sprintf("%s[%d]", field, i), item, dim+1)
+	}
+	// Dynamic inner dimensions must still be the same length across the batch
+	if dim+1 < len(spec.Shape) && spec.Shape[dim+1] == Dynamic && len(items) > 1 {
+		want := -1
+		for i, item := range items {
+			inner, ok := item.([]interface{})
+			if !ok {
+				break
+			}
+			if want == -1 {
+				want = len(inner)
+			} else if len(inner) != want {
+				c.fail(fmt.Sprintf("%s[%d]", field, i), "ragged array: expected %d elements along dimension %d like %s[0], got %d", want, dim+1, field, len(inner))
+				break
+			}
+		}
+	}
+	return out
+}
+
+// element checks and coerces one innermost value
+func (c *checker) element(spec TensorSpec, field string, value interface{}) interface{} {
+	if c.schema != nil {
+		return c.structured(field, value)
+	}
+
+	switch spec.DType {
+	case Float16, Float32, Float64:
+		f, ok := toFloat(value)
+		if !ok {
+			c.fail(field, "expected %s, got %s", spec.DType, describe(value))
+			return value
+		}
+		if limit := floatLimit(spec.DType); math.Abs(f) > limit {
+			c.fail(field, "%g is out of range for %s", f, spec.DType)
+		}
+		return f
+	case Int8, Int16, Int32, Int64, Uint8:
+		n, ok := toInt(value)
+		if !ok {
+			c.fail(field, "expected %s, got %s", spec.DType, describe(value))
+			return value
+		}
+		if lo, hi := intRange(spec.DType); n < lo || n > hi {
+			c.fail(field, "%d is out of range for %s", n, spec.DType)
+		}
+		return n
+	case Bool:
+		switch v := value.(type) {
+		case bool:
+			return v
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b
+			}
+		}
+		c.fail(field, "expected bool, got %s", describe(value))
+		return value
+	case String:
+		switch v := value.(type) {
+		case string:
+			return v
+		case float64, json.Number, bool:
+			return fmt.Sprint(v)
+		}
+		c.fail(field, "expected string, got %s", describe(value))
+		return value
+	}
+	c.fail(field, "unsupported dtype %q", spec.DType)
+	return value
+}
+
+// structured validates a value against the spec's JSON Schema
+func (c *checker) structured(field string, value interface{}) interface{} {
+	err := c.schema.Validate(value)
+	var verr *jsonschema.ValidationError
+	if errors.As(err, &verr) {
+		for _, leaf := range leaves(verr) {
+			c.fail(field+jsonPointerPath(leaf.InstanceLocation), "%s", leaf.Message)
+		}
+	} else if err != nil {
+		c.fail(field, "%v", err)
+	}
+	return value
+}
+
+// leaves returns the innermost causes of a schema validation error
+func leaves(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	var out []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		out = append(out, leaves(cause)...)
+	}
+	return out
+}
+
+// jsonPointerPath turns /rows/0/age into .rows[0].age
+func jsonPointerPath(pointer string) string {
+	if pointer == "" || pointer == "/" {
+		return ""
+	}
+	var b strings.Builder
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		if _, err := strconv.Atoi(token); err == nil {
+			b.WriteString("[" + token + "]")
+		} else {
+			b.WriteString("." + token)
+		}
+	}
+	return b.String()
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil && !math.IsNaN(f) && !math.IsInf(f, 0)
+	}
+	return 0, false
+}
+
+func toInt(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n, true
+		}
+	case string:
+		if n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+			return n, true
+		}
+	}
+	f, ok := toFloat(value)
+	if !ok || f != math.Trunc(f) || math.Abs(f) > 1<<53 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+func floatLimit(d DType) float64 {
+	switch d {
+	case Float16:
+		return 65504
+	case Float32:
+		return math.MaxFloat32
+	}
+	return math.MaxFloat64
+}
+
+func intRange(d DType) (int64, int64) {
+	switch d {
+	case Int8:
+		return math.MinInt8, math.MaxInt8
+	case Int16:
+		return math.MinInt16, math.MaxInt16
+	case Int32:
+		return math.MinInt32, math.MaxInt32
+	case Uint8:
+		return 0, math.MaxUint8
+	}
+	return math.MinInt64, math.MaxInt64
+}
+
+// describe names the JSON type of a value for error messages
+func describe(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case float64, json.Number:
+		return "number"
+	case string:
+		return strconv.Quote(v)
+	case []interface{}:
+		return fmt.Sprintf("an array of %d", len(v))
+	case map[string]interface{}:
+		return "an object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func formatShape(shape []int64) string {
+	dims := make([]string, len(shape))
+	for i, size := range shape {
+		dims[i] = strconv.FormatInt(size, 10)
+	}
+	return "[" + strings.Join(dims, ",") + "]"
+}
@@ -0,0 +1,157 @@
+package signature_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"maas-platform/shared/signature"
+)
+
+// decode parses a JSON request body the way the gateway does
+func decode(t *testing.T, body string) map[string]interface{} {
+	t.Helper()
+	var input map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &input); err != nil {
+		t.Fatalf("decode %s: %v", body, err)
+	}
+	return input
+}
+
+func TestCoerce(t *testing.T) {
+	sig := &signature.Signature{Inputs: []signature.TensorSpec{
+		{Name: "image", DType: signature.Uint8, Shape: []int64{signature.Dynamic, 2}},
+		{Name: "scale", DType: signature.Float32},
+		{Name: "flag", DType: signature.Bool, Optional: true},
+		{Name: "rows", Shape: []int64{signature.Dynamic}, Schema: json.RawMessage(
+			`{"type":"object","properties":{"age":{"type":"integer","minimum":0}},"required":["age"]}`)},
+	}}
+
+	tests := []struct {
+		name       string
+		body       string
+		want       string
+		wantFields []string
+	}{
+		{
+			name: "already typed",
+			body: `{"image":[[1,2],[3,4]],"scale":0.5,"rows":[{"age":3}]}`,
+			want: `{"image":[[1,2],[3,4]],"scale":0.5,"rows":[{"age":3}]}`,
+		},
+		{
+			name: "strings and integral floats are coerced",
+			body: `{"image":[["1",2.0]],"scale":"0.5","flag":"true","rows":[]}`,
+			want: `{"image":[[1,2]],"scale":0.5,"flag":true,"rows":[]}`,
+		},
+		{
+			name:       "missing and unexpected inputs",
+			body:       `{"image":[[1,2]],"rows":[],"extra":1}`,
+			wantFields: []string{"scale", "extra"},
+		},
+		{
+			name:       "out of range and wrong types",
+			body:       `{"image":[[256,"x"]],"scale":1e39,"rows":[]}`,
+			wantFields: []string{"image[0][0]", "image[0][1]", "scale"},
+		},
+		{
+			name:       "wrong shape",
+			body:       `{"image":[[1,2,3]],"scale":1,"rows":[]}`,
+			wantFields: []string{"image[0]"},
+		},
+		{
+			name:       "schema violations name the nested field",
+			body:       `{"image":[],"scale":1,"rows":[{"age":-1},{}]}`,
+			wantFields: []string{"rows[0].age", "rows[1]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sig.Coerce(decode(t, tt.body))
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("Coerce: %v", err)
+				}
+				if want := decode(t, tt.want); !reflect.DeepEqual(normalize(t, got), want) {
+					t.Errorf("Coerce = %v, want %v", got, want)
+				}
+				return
+			}
+
+			var verr *signature.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Coerce error = %v, want a *ValidationError", err)
+			}
+			var fields []string
+			for _, fe := range verr.Errors {
+				fields = append(fields, fe.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("error fields = %v, want %v (%v)", fields, tt.wantFields, verr.Errors)
+			}
+		})
+	}
+}
+
+// normalize round-trips a coerced input through JSON so integers compare
+// equal to the decoded expectation
+func normalize(t *testing.T, input map[string]interface{}) map[string]interface{} {
+	t.Helper()
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	return decode(t, string(data))
+}
+
+func TestCoerceCapsErrors(t *testing.T) {
+	sig := &signature.Signature{Inputs: []signature.TensorSpec{
+		{Name: "tokens", DType: signature.Int32, Shape: []int64{signature.Dynamic}},
+		{Name: "rows", Shape: []int64{signature.Dynamic}, Schema: json.RawMessage(`{"type":"integer"}`)},
+	}}
+
+	tests := []struct {
+		name          string
+		size          int
+		wantErrors    int
+		wantTruncated bool
+	}{
+		{"few problems", 3, 6, false},
+		{"exactly the cap", 10, 20, false},
+		{"more than the cap", 100000, 20, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bad := make([]interface{}, tt.size)
+			for i := range bad {
+				bad[i] = fmt.Sprintf("token-%d", i)
+			}
+			_, err := sig.Coerce(map[string]interface{}{"tokens": bad, "rows": bad})
+
+			var verr *signature.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Coerce error = %v, want a *ValidationError", err)
+			}
+			if len(verr.Errors) != tt.wantErrors || verr.Truncated != tt.wantTruncated {
+				t.Errorf("got %d errors, truncated %v; want %d, truncated %v",
+					len(verr.Errors), verr.Truncated, tt.wantErrors, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func TestCoerceReportsBrokenSchemaOnce(t *testing.T) {
+	sig := &signature.Signature{Inputs: []signature.TensorSpec{
+		{Name: "rows", Shape: []int64{signature.Dynamic}, Schema: json.RawMessage(`{"type":`)},
+	}}
+	_, err := sig.Coerce(map[string]interface{}{"rows": []interface{}{1.0, 2.0, 3.0}})
+
+	var verr *signature.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Coerce error = %v, want a *ValidationError", err)
+	}
+	if len(verr.Errors) != 1 || verr.Errors[0].Field != "rows" {
+		t.Errorf("errors = %v, want one for rows", verr.Errors)
+	}
+}
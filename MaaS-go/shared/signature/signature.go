@@ -0,0 +1,200 @@
+// Package signature describes the typed inputs and outputs of a model
+// version. The registry validates signatures when versions are recorded, and
+// the gateway checks and coerces inference requests against them before a
+// request reaches a backend.
+package signature
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	modelpb "maas-platform/shared/proto"
+)
+
+// DType is the element type of a tensor
+type DType string
+
+const (
+	Float16 DType = "float16"
+	Float32 DType = "float32"
+	Float64 DType = "float64"
+	Int8    DType = "int8"
+	Int16   DType = "int16"
+	Int32   DType = "int32"
+	Int64   DType = "int64"
+	Uint8   DType = "uint8"
+	Bool    DType = "bool"
+	String  DType = "string"
+)
+
+// Dynamic marks a dimension whose size is only known per request
+const Dynamic int64 = -1
+
+// ErrInvalid is returned for malformed signatures
+var ErrInvalid = errors.New("invalid signature")
+
+// TensorSpec describes one named input or output. Shape lists the size of
+// each dimension, outermost first; no shape means a scalar. Elements are
+// checked against DType, or against Schema for structured values such as
+// tabular rows.
+type TensorSpec struct {
+	Name     string          `json:"name"`
+	DType    DType           `json:"dtype,omitempty"`
+	Shape    []int64         `json:"shape,omitempty"`
+	Schema   json.RawMessage `json:"schema,omitempty"`
+	Optional bool            `json:"optional,omitempty"`
+}
+
+// Signature lists the inputs a model version accepts and the outputs it returns
+type Signature struct {
+	Inputs  []TensorSpec `json:"inputs"`
+	Outputs []TensorSpec `json:"outputs"`
+}
+
+// Validate checks that names are unique, dtypes known, dimensions positive or
+// dynamic and schemas compile
+func (s *Signature) Validate() error {
+	if len(s.Inputs) == 0 {
+		return fmt.Errorf("%w: at least one input is required", ErrInvalid)
+	}
+	if err := validateSpecs("inputs", s.Inputs); err != nil {
+		return err
+	}
+	return validateSpecs("outputs", s.Outputs)
+}
+
+func validateSpecs(kind string, specs []TensorSpec) error {
+	seen := make(map[string]bool, len(specs))
+	for i, spec := range specs {
+		if spec.Name == "" {
+			return fmt.Errorf("%w: %s[%d] has no name", ErrInvalid, kind, i)
+		}
+		if seen[spec.Name] {
+			return fmt.Errorf("%w: %s has two entries named %q", ErrInvalid, kind, spec.Name)
+		}
+		seen[spec.Name] = true
+
+		for d, size := range spec.Shape {
+			if size < 1 && size != Dynamic {
+				return fmt.Errorf("%w: %s %q dimension %d is %d; use a positive size or -1", ErrInvalid, kind, spec.Name, d, size)
+			}
+		}
+
+		switch {
+		case len(spec.Schema) > 0 && spec.DType != "":
+			return fmt.Errorf("%w: %s %q sets both dtype and schema", ErrInvalid, kind, spec.Name)
+		case len(spec.Schema) > 0:
+			if _, err := compile(spec.Schema); err != nil {
+				return fmt.Errorf("%w: %s %q schema: %v", ErrInvalid, kind, spec.Name, err)
+			}
+		case !spec.DType.valid():
+			return fmt.Errorf("%w: %s %q has unknown dtype %q", ErrInvalid, kind, spec.Name, spec.DType)
+		}
+	}
+	return nil
+}
+
+func (d DType) valid() bool {
+	switch d {
+	case Float16, Float32, Float64, Int8, Int16, Int32, Int64, Uint8, Bool, String:
+		return true
+	}
+	return false
+}
+
+// schemas caches compiled JSON Schemas by their source
+var schemas sync.Map
+
+// compile returns the compiled form of a JSON Schema
+func compile(raw json.RawMessage) (*jsonschema.Schema, error) {
+	key := string(raw)
+	if cached, ok := schemas.Load(key); ok {
+		return cached.(*jsonschema.Schema), nil
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("signature.json", bytes.NewReader(raw)); err != nil {
+		return nil, err
+	}
+	schema, err := compiler.Compile("signature.json")
+	if err != nil {
+		return nil, err
+	}
+	schemas.Store(key, schema)
+	return schema, nil
+}
+
+// Value stores a signature as JSON
+func (s Signature) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan reads a signature stored as JSON
+func (s *Signature) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("cannot scan %T into a signature", src)
+	}
+}
+
+// FromProto converts a protobuf signature; nil stays nil
+func FromProto(pb *modelpb.ModelSignature) *Signature {
+	if pb == nil {
+		return nil
+	}
+	return &Signature{
+		Inputs:  specsFromProto(pb.Inputs),
+		Outputs: specsFromProto(pb.Outputs),
+	}
+}
+
+// ToProto converts a signature to protobuf; nil stays nil
+func ToProto(s *Signature) *modelpb.ModelSignature {
+	if s == nil {
+		return nil
+	}
+	return &modelpb.ModelSignature{
+		Inputs:  specsToProto(s.Inputs),
+		Outputs: specsToProto(s.Outputs),
+	}
+}
+
+func specsFromProto(pbs []*modelpb.TensorSpec) []TensorSpec {
+	specs := make([]TensorSpec, len(pbs))
+	for i, pb := range pbs {
+		specs[i] = TensorSpec{
+			Name:     pb.Name,
+			DType:    DType(pb.Dtype),
+			Shape:    pb.Shape,
+			Optional: pb.Optional,
+		}
+		if pb.JsonSchema != "" {
+			specs[i].Schema = json.RawMessage(pb.JsonSchema)
+		}
+	}
+	return specs
+}
+
+func specsToProto(specs []TensorSpec) []*modelpb.TensorSpec {
+	pbs := make([]*modelpb.TensorSpec, len(specs))
+	for i, spec := range specs {
+		pbs[i] = &modelpb.TensorSpec{
+			Name:       spec.Name,
+			Dtype:      string(spec.DType),
+			Shape:      spec.Shape,
+			JsonSchema: string(spec.Schema),
+			Optional:   spec.Optional,
+		}
+	}
+	return pbs
+}